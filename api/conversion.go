@@ -1,10 +1,14 @@
 package api
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/AlekSi/pointer"
 	v1 "k8s.io/api/storage/v1"
 
 	"github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
 )
 
 func (out *BackupStorage) FromCR(in *v1alpha1.BackupStorage) {
@@ -25,7 +29,27 @@ func (out *MonitoringInstance) FromCR(in *v1alpha1.MonitoringConfig) {
 	out.Url = in.Spec.PMM.URL
 	out.AllowedNamespaces = &in.Spec.AllowedNamespaces
 	out.VerifyTLS = in.Spec.VerifyTLS
-	out.Type = MonitoringInstanceBaseWithNameType(in.Spec.Type)
+	out.Type = MonitoringInstanceBaseType(in.Spec.Type)
+	out.Status = monitoringInstanceStatusFromCR(in)
+}
+
+func monitoringInstanceStatusFromCR(in *v1alpha1.MonitoringConfig) *MonitoringInstanceStatus {
+	annotations := in.GetAnnotations()
+	connectivity, ok := annotations[common.MonitoringStatusAnnotation]
+	if !ok {
+		return nil
+	}
+	status := &MonitoringInstanceStatus{
+		Connectivity: connectivity,
+		LastError:    annotations[common.MonitoringLastErrorAnnotation],
+	}
+	if t, err := time.Parse(time.RFC3339, annotations[common.MonitoringLastCheckAnnotation]); err == nil {
+		status.LastCheckTime = &t
+	}
+	if t, err := time.Parse(time.RFC3339, annotations[common.MonitoringLastSuccessAnnotation]); err == nil {
+		status.LastSuccessTime = &t
+	}
+	return status
 }
 
 func (out *StorageClass) FromCR(in *v1.StorageClass) {
@@ -36,3 +60,30 @@ func (out *StorageClass) FromCR(in *v1.StorageClass) {
 	out.Metadata = &meta
 	out.AllowVolumeExpansion = pointer.To(pointer.Get(in.AllowVolumeExpansion))
 }
+
+// ToCR returns the database cluster of the request in the namespace and the pod scheduling policy of the request
+// as the custom resources. The policy is nil if the request has none.
+func (in *CapacityPlanRequest) ToCR(namespace string) (*v1alpha1.DatabaseCluster, *v1alpha1.PodSchedulingPolicy, error) {
+	db := &v1alpha1.DatabaseCluster{}
+	if err := convertJSON(in.DatabaseCluster, db); err != nil {
+		return nil, nil, err
+	}
+	db.SetNamespace(namespace)
+	if in.PodSchedulingPolicy == nil {
+		return db, nil, nil
+	}
+	psp := &v1alpha1.PodSchedulingPolicy{}
+	if err := convertJSON(in.PodSchedulingPolicy, psp); err != nil {
+		return nil, nil, err
+	}
+	return db, psp, nil
+}
+
+// convertJSON converts between the generated types and the custom resources that share their JSON representation.
+func convertJSON(in, out any) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
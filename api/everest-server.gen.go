@@ -76,6 +76,21 @@ const (
 	DatabaseClusterRestoreSpecDataSourcePitrTypeLatest DatabaseClusterRestoreSpecDataSourcePitrType = "latest"
 )
 
+// Defines values for EngineVersionRuleMode.
+const (
+	All         EngineVersionRuleMode = "all"
+	Pinned      EngineVersionRuleMode = "pinned"
+	Recommended EngineVersionRuleMode = "recommended"
+)
+
+// Defines values for FleetUpgradeRolloutState.
+const (
+	FleetUpgradeRolloutStateFailed    FleetUpgradeRolloutState = "failed"
+	FleetUpgradeRolloutStateIdle      FleetUpgradeRolloutState = "idle"
+	FleetUpgradeRolloutStateRunning   FleetUpgradeRolloutState = "running"
+	FleetUpgradeRolloutStateSucceeded FleetUpgradeRolloutState = "succeeded"
+)
+
 // Defines values for MonitoringInstanceBaseType.
 const (
 	MonitoringInstanceBaseTypePmm MonitoringInstanceBaseType = "pmm"
@@ -96,6 +111,45 @@ const (
 	MonitoringInstanceUpdateParamsTypePmm MonitoringInstanceUpdateParamsType = "pmm"
 )
 
+// Defines values for NamespaceProvisioningJobOperation.
+const (
+	Deprovision NamespaceProvisioningJobOperation = "deprovision"
+	Provision   NamespaceProvisioningJobOperation = "provision"
+	Update      NamespaceProvisioningJobOperation = "update"
+)
+
+// Defines values for NamespaceProvisioningJobState.
+const (
+	NamespaceProvisioningJobStateFailed    NamespaceProvisioningJobState = "failed"
+	NamespaceProvisioningJobStateRunning   NamespaceProvisioningJobState = "running"
+	NamespaceProvisioningJobStateSucceeded NamespaceProvisioningJobState = "succeeded"
+)
+
+// Defines values for NamespaceProvisioningStepState.
+const (
+	NamespaceProvisioningStepStateFailed     NamespaceProvisioningStepState = "failed"
+	NamespaceProvisioningStepStateInProgress NamespaceProvisioningStepState = "inProgress"
+	NamespaceProvisioningStepStatePending    NamespaceProvisioningStepState = "pending"
+	NamespaceProvisioningStepStateSkipped    NamespaceProvisioningStepState = "skipped"
+	NamespaceProvisioningStepStateSucceeded  NamespaceProvisioningStepState = "succeeded"
+)
+
+// Defines values for NamespaceUpgradeProgressState.
+const (
+	NamespaceUpgradeProgressStateFailed     NamespaceUpgradeProgressState = "failed"
+	NamespaceUpgradeProgressStateInProgress NamespaceUpgradeProgressState = "inProgress"
+	NamespaceUpgradeProgressStatePending    NamespaceUpgradeProgressState = "pending"
+	NamespaceUpgradeProgressStateSkipped    NamespaceUpgradeProgressState = "skipped"
+	NamespaceUpgradeProgressStateSucceeded  NamespaceUpgradeProgressState = "succeeded"
+)
+
+// Defines values for PMMTokenRevocationState.
+const (
+	PMMTokenRevocationStateFailed  PMMTokenRevocationState = "failed"
+	PMMTokenRevocationStateRevoked PMMTokenRevocationState = "revoked"
+	PMMTokenRevocationStateSkipped PMMTokenRevocationState = "skipped"
+)
+
 // Defines values for PodSchedulingPolicySpecEngineType.
 const (
 	PodSchedulingPolicySpecEngineTypePostgresql PodSchedulingPolicySpecEngineType = "postgresql"
@@ -103,6 +157,22 @@ const (
 	PodSchedulingPolicySpecEngineTypePxc        PodSchedulingPolicySpecEngineType = "pxc"
 )
 
+// Defines values for PostUpgradeTaskResultState.
+const (
+	PostUpgradeTaskResultStateFailed     PostUpgradeTaskResultState = "failed"
+	PostUpgradeTaskResultStateInProgress PostUpgradeTaskResultState = "inProgress"
+	PostUpgradeTaskResultStatePending    PostUpgradeTaskResultState = "pending"
+	PostUpgradeTaskResultStateSkipped    PostUpgradeTaskResultState = "skipped"
+	PostUpgradeTaskResultStateSucceeded  PostUpgradeTaskResultState = "succeeded"
+)
+
+// Defines values for PostUpgradeTasksJobState.
+const (
+	PostUpgradeTasksJobStateFailed    PostUpgradeTasksJobState = "failed"
+	PostUpgradeTasksJobStateRunning   PostUpgradeTasksJobState = "running"
+	PostUpgradeTasksJobStateSucceeded PostUpgradeTasksJobState = "succeeded"
+)
+
 // Defines values for UpgradeTaskPendingTask.
 const (
 	NotReady      UpgradeTaskPendingTask = "notReady"
@@ -140,6 +210,90 @@ type BackupStorageType string
 // BackupStoragesList defines model for BackupStoragesList.
 type BackupStoragesList = []BackupStorage
 
+// CapacityPlan Placement of a prospective database cluster on the current nodes
+type CapacityPlan struct {
+	Components []CapacityPlanComponent `json:"components"`
+
+	// Fits True if every replica and the storage fit
+	Fits  bool               `json:"fits"`
+	Nodes []CapacityPlanNode `json:"nodes"`
+
+	// PodSchedulingPolicyName Name of the pod scheduling policy used for the placement, empty if none or the policy of the request is used
+	PodSchedulingPolicyName string              `json:"podSchedulingPolicyName,omitempty"`
+	Storage                 CapacityPlanStorage `json:"storage"`
+}
+
+// CapacityPlanComponent defines model for CapacityPlanComponent.
+type CapacityPlanComponent struct {
+	// Component One of engine, proxy or configServer
+	Component string `json:"component"`
+
+	// CpuMillis CPU requested by a replica
+	CpuMillis uint64 `json:"cpuMillis"`
+
+	// MemoryBytes Memory requested by a replica
+	MemoryBytes uint64                `json:"memoryBytes"`
+	Replicas    []CapacityPlanReplica `json:"replicas"`
+}
+
+// CapacityPlanNode defines model for CapacityPlanNode.
+type CapacityPlanNode struct {
+	AllocatableCpuMillis   uint64 `json:"allocatableCpuMillis"`
+	AllocatableMemoryBytes uint64 `json:"allocatableMemoryBytes"`
+
+	// AvailableCpuMillis CPU not requested by the existing pods
+	AvailableCpuMillis uint64 `json:"availableCpuMillis"`
+
+	// AvailableMemoryBytes Memory not requested by the existing pods
+	AvailableMemoryBytes uint64 `json:"availableMemoryBytes"`
+	Name                 string `json:"name"`
+
+	// PlannedCpuMillis CPU requested by the replicas placed on the node
+	PlannedCpuMillis uint64 `json:"plannedCpuMillis"`
+
+	// PlannedMemoryBytes Memory requested by the replicas placed on the node
+	PlannedMemoryBytes uint64 `json:"plannedMemoryBytes"`
+}
+
+// CapacityPlanReplica defines model for CapacityPlanReplica.
+type CapacityPlanReplica struct {
+	Fits bool `json:"fits"`
+
+	// Node Node the replica is placed on, empty if it doesn't fit
+	Node string `json:"node,omitempty"`
+
+	// Reason Reason the replica doesn't fit
+	Reason string `json:"reason,omitempty"`
+}
+
+// CapacityPlanRequest Prospective database cluster to plan the capacity for
+type CapacityPlanRequest struct {
+	// DatabaseCluster DatabaseCluster is the Schema for the databaseclusters API.
+	DatabaseCluster DatabaseCluster `json:"databaseCluster"`
+
+	// PodSchedulingPolicy PodSchedulingPolicy is the Schema for the Pod Scheduling Policy API.
+	PodSchedulingPolicy *PodSchedulingPolicy `json:"podSchedulingPolicy,omitempty"`
+}
+
+// CapacityPlanStorage defines model for CapacityPlanStorage.
+type CapacityPlanStorage struct {
+	// AvailableBytes Capacity available for the volumes, missing if unknown
+	AvailableBytes *uint64 `json:"availableBytes,omitempty"`
+	Fits           bool    `json:"fits"`
+
+	// Reason Reason the storage doesn't fit
+	Reason string `json:"reason,omitempty"`
+
+	// RequestedBytes Total size of the volumes
+	RequestedBytes uint64 `json:"requestedBytes"`
+
+	// StorageClass Storage class of the volumes, the default storage class is used if the database cluster doesn't set one
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// Volumes Number of volumes of the database cluster
+	Volumes int `json:"volumes"`
+}
+
 // CreateBackupStorageParams Backup storage parameters
 type CreateBackupStorageParams struct {
 	AccessKey string `json:"accessKey"`
@@ -627,6 +781,18 @@ type DatabaseClusterComponent struct {
 	Type       *string                              `json:"type,omitempty"`
 }
 
+// DatabaseClusterConfigRollout Result of the engine config template rollout to a database cluster
+type DatabaseClusterConfigRollout struct {
+	// Message Error message if the rollout to the database cluster has failed
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the database cluster
+	Name string `json:"name"`
+
+	// Updated True if the engine config of the database cluster has been changed
+	Updated bool `json:"updated"`
+}
+
 // DatabaseClusterCredential kubernetes object
 type DatabaseClusterCredential struct {
 	ConnectionUrl *string `json:"connectionUrl,omitempty"`
@@ -814,11 +980,87 @@ type DatabaseEngineList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// EngineConfigTemplate Reusable engine config for the database clusters
+type EngineConfigTemplate struct {
+	// Config Engine config in the format of the database engine
+	Config      string  `json:"config"`
+	Description *string `json:"description,omitempty"`
+
+	// EngineType Type of the database engine the template can be used with (pxc, psmdb or postgresql)
+	EngineType string `json:"engineType"`
+
+	// Name Name of the engine config template
+	Name string `json:"name"`
+}
+
+// EngineConfigTemplateList defines model for EngineConfigTemplateList.
+type EngineConfigTemplateList struct {
+	Items []EngineConfigTemplate `json:"items"`
+}
+
+// EngineConfigTemplateRolloutResult Result of the engine config template rollout
+type EngineConfigTemplateRolloutResult struct {
+	Results []DatabaseClusterConfigRollout `json:"results"`
+}
+
+// EngineVersionPolicy Database engine version policy of a namespace
+type EngineVersionPolicy struct {
+	// Engines Version rules by database engine type
+	Engines *map[string]EngineVersionRule `json:"engines,omitempty"`
+}
+
+// EngineVersionRule Allowed and default versions of a database engine
+type EngineVersionRule struct {
+	// AllowedVersions Allowed versions, used with the pinned mode
+	AllowedVersions *[]string `json:"allowedVersions,omitempty"`
+
+	// DefaultVersion Version used for the new database clusters if no version is specified
+	DefaultVersion *string `json:"defaultVersion,omitempty"`
+
+	// Mode Defines the allowed versions:
+	// - all: all the versions available for the database engine
+	// - recommended: only the recommended versions
+	// - pinned: only the versions listed in allowedVersions
+	Mode *EngineVersionRuleMode `json:"mode,omitempty"`
+}
+
+// EngineVersionRuleMode Defines the allowed versions:
+// - all: all the versions available for the database engine
+// - recommended: only the recommended versions
+// - pinned: only the versions listed in allowedVersions
+type EngineVersionRuleMode string
+
 // Error Error response
 type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// FleetUpgradePlan Operators upgrade plan for all namespaces
+type FleetUpgradePlan struct {
+	Namespaces []NamespaceUpgradePlan `json:"namespaces"`
+}
+
+// FleetUpgradePlanApproval Parameters of a rolling upgrade of database engine operators across namespaces
+type FleetUpgradePlanApproval struct {
+	// MaxConcurrency Number of namespaces upgraded at a time
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
+
+	// Namespaces Namespaces to upgrade. If empty, all namespaces with pending upgrades are upgraded.
+	Namespaces *[]string `json:"namespaces,omitempty"`
+}
+
+// FleetUpgradeRollout Progress of a rolling upgrade of database engine operators across namespaces
+type FleetUpgradeRollout struct {
+	FinishedAt     *time.Time                 `json:"finishedAt,omitempty"`
+	MaxConcurrency *int                       `json:"maxConcurrency,omitempty"`
+	Namespaces     []NamespaceUpgradeProgress `json:"namespaces"`
+	StartedAt      *time.Time                 `json:"startedAt,omitempty"`
+	State          FleetUpgradeRolloutState   `json:"state"`
+}
+
+// FleetUpgradeRolloutState defines model for FleetUpgradeRollout.State.
+type FleetUpgradeRolloutState string
+
 // KubernetesClusterInfo kubernetes cluster info
 type KubernetesClusterInfo struct {
 	ClusterType string `json:"clusterType"`
@@ -849,22 +1091,45 @@ type ResourcesCapacity struct {
 	MemoryBytes *uint64 `json:"memoryBytes,omitempty"`
 }
 
-// MonitoringInstance Monitoring instance information
-type MonitoringInstance = MonitoringInstanceBaseWithName
+// MonitoringInstance defines model for MonitoringInstance.
+type MonitoringInstance struct {
+	// AllowedNamespaces List of namespaces allowed to use this monitoring instance
+	// Deprecated:
+	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
+
+	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+
+	// Status Connectivity status of the monitoring instance as of the last periodic check
+	Status *MonitoringInstanceStatus `json:"status,omitempty"`
+
+	// TokenRevocation Result of the revocation of the replaced PMM token. Returned only by the update that replaced the token. The tokens that could not be revoked can be purged with `everestctl monitoring purge-keys`.
+	TokenRevocation *PMMTokenRevocation        `json:"tokenRevocation,omitempty"`
+	Type            MonitoringInstanceBaseType `json:"type,omitempty"`
+	Url             string                     `json:"url,omitempty"`
+
+	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
+	VerifyTLS *bool `json:"verifyTLS,omitempty"`
+}
 
 // MonitoringInstanceBase Monitoring instance information
 type MonitoringInstanceBase struct {
 	// AllowedNamespaces List of namespaces allowed to use this monitoring instance
 	// Deprecated:
-	AllowedNamespaces *[]string                  `json:"allowedNamespaces,omitempty"`
-	Type              MonitoringInstanceBaseType `json:"type,omitempty"`
-	Url               string                     `json:"url,omitempty"`
+	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
+
+	// Type Type of the monitoring instance. Only PMM is supported, since the database operators
+	// export the metrics of the database clusters only through the PMM client.
+	Type MonitoringInstanceBaseType `json:"type,omitempty"`
+	Url  string                     `json:"url,omitempty"`
 
 	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
 	VerifyTLS *bool `json:"verifyTLS,omitempty"`
 }
 
-// MonitoringInstanceBaseType defines model for MonitoringInstanceBase.Type.
+// MonitoringInstanceBaseType Type of the monitoring instance. Only PMM is supported, since the database operators
+// export the metrics of the database clusters only through the PMM client.
 type MonitoringInstanceBaseType string
 
 // MonitoringInstanceBaseWithName defines model for MonitoringInstanceBaseWithName.
@@ -874,16 +1139,20 @@ type MonitoringInstanceBaseWithName struct {
 	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
 
 	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
-	Name      string                             `json:"name,omitempty"`
-	Namespace string                             `json:"namespace,omitempty"`
-	Type      MonitoringInstanceBaseWithNameType `json:"type,omitempty"`
-	Url       string                             `json:"url,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+
+	// Type Type of the monitoring instance. Only PMM is supported, since the database operators
+	// export the metrics of the database clusters only through the PMM client.
+	Type MonitoringInstanceBaseWithNameType `json:"type,omitempty"`
+	Url  string                             `json:"url,omitempty"`
 
 	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
 	VerifyTLS *bool `json:"verifyTLS,omitempty"`
 }
 
-// MonitoringInstanceBaseWithNameType defines model for MonitoringInstanceBaseWithName.Type.
+// MonitoringInstanceBaseWithNameType Type of the monitoring instance. Only PMM is supported, since the database operators
+// export the metrics of the database clusters only through the PMM client.
 type MonitoringInstanceBaseWithNameType string
 
 // MonitoringInstanceCreateParams defines model for MonitoringInstanceCreateParams.
@@ -893,11 +1162,14 @@ type MonitoringInstanceCreateParams struct {
 	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
 
 	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
-	Name      string                             `json:"name,omitempty"`
-	Namespace string                             `json:"namespace,omitempty"`
-	Pmm       *PMMMonitoringInstanceSpec         `json:"pmm,omitempty"`
-	Type      MonitoringInstanceCreateParamsType `json:"type,omitempty"`
-	Url       string                             `json:"url,omitempty"`
+	Name      string                     `json:"name,omitempty"`
+	Namespace string                     `json:"namespace,omitempty"`
+	Pmm       *PMMMonitoringInstanceSpec `json:"pmm,omitempty"`
+
+	// Type Type of the monitoring instance. Only PMM is supported, since the database operators
+	// export the metrics of the database clusters only through the PMM client.
+	Type MonitoringInstanceCreateParamsType `json:"type,omitempty"`
+	Url  string                             `json:"url,omitempty"`
 
 	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
 	VerifyTLS *bool `json:"verifyTLS,omitempty"`
@@ -910,28 +1182,47 @@ type PMMMonitoringInstanceSpec struct {
 	User     string `json:"user,omitempty"`
 }
 
-// MonitoringInstanceCreateParamsType defines model for MonitoringInstanceCreateParams.Type.
+// MonitoringInstanceCreateParamsType Type of the monitoring instance. Only PMM is supported, since the database operators
+// export the metrics of the database clusters only through the PMM client.
 type MonitoringInstanceCreateParamsType string
 
+// MonitoringInstanceDeleteParams defines model for MonitoringInstanceDeleteParams.
+type MonitoringInstanceDeleteParams = MonitoringInstancePMM
+
 // MonitoringInstancePMM defines model for MonitoringInstancePMM.
 type MonitoringInstancePMM struct {
 	Pmm *PMMMonitoringInstanceSpec `json:"pmm,omitempty"`
 }
 
+// MonitoringInstanceStatus Connectivity status of the monitoring instance as of the last periodic check
+type MonitoringInstanceStatus struct {
+	// Connectivity One of ok, unreachable, tlsError, unauthorized (the token has been revoked), expired (the token has expired) or error
+	Connectivity string `json:"connectivity,omitempty"`
+
+	// LastCheckTime Time of the check that observed the current connectivity status
+	LastCheckTime   *time.Time `json:"lastCheckTime,omitempty"`
+	LastError       string     `json:"lastError,omitempty"`
+	LastSuccessTime *time.Time `json:"lastSuccessTime,omitempty"`
+}
+
 // MonitoringInstanceUpdateParams defines model for MonitoringInstanceUpdateParams.
 type MonitoringInstanceUpdateParams struct {
 	// AllowedNamespaces List of namespaces allowed to use this monitoring instance
 	// Deprecated:
-	AllowedNamespaces *[]string                          `json:"allowedNamespaces,omitempty"`
-	Pmm               *PMMMonitoringInstanceSpec         `json:"pmm,omitempty"`
-	Type              MonitoringInstanceUpdateParamsType `json:"type,omitempty"`
-	Url               string                             `json:"url,omitempty"`
+	AllowedNamespaces *[]string                  `json:"allowedNamespaces,omitempty"`
+	Pmm               *PMMMonitoringInstanceSpec `json:"pmm,omitempty"`
+
+	// Type Type of the monitoring instance. Only PMM is supported, since the database operators
+	// export the metrics of the database clusters only through the PMM client.
+	Type MonitoringInstanceUpdateParamsType `json:"type,omitempty"`
+	Url  string                             `json:"url,omitempty"`
 
 	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
 	VerifyTLS *bool `json:"verifyTLS,omitempty"`
 }
 
-// MonitoringInstanceUpdateParamsType defines model for MonitoringInstanceUpdateParams.Type.
+// MonitoringInstanceUpdateParamsType Type of the monitoring instance. Only PMM is supported, since the database operators
+// export the metrics of the database clusters only through the PMM client.
 type MonitoringInstanceUpdateParamsType string
 
 // MonitoringInstancesList defines model for MonitoringInstancesList.
@@ -940,6 +1231,117 @@ type MonitoringInstancesList = []MonitoringInstance
 // NamespaceList defines model for NamespaceList.
 type NamespaceList = []string
 
+// NamespaceOperators Database engine operators installed in a namespace
+type NamespaceOperators struct {
+	// Mongodb Install the MongoDB operator
+	Mongodb *bool `json:"mongodb,omitempty"`
+
+	// Mysql Install the MySQL operator
+	Mysql *bool `json:"mysql,omitempty"`
+
+	// Postgresql Install the PostgreSQL operator
+	Postgresql *bool `json:"postgresql,omitempty"`
+}
+
+// NamespaceProvisioningJob Progress of provisioning, updating or removing a database namespace
+type NamespaceProvisioningJob struct {
+	FinishedAt *time.Time                        `json:"finishedAt,omitempty"`
+	Message    *string                           `json:"message,omitempty"`
+	Namespace  string                            `json:"namespace"`
+	Operation  NamespaceProvisioningJobOperation `json:"operation"`
+	StartedAt  *time.Time                        `json:"startedAt,omitempty"`
+	State      NamespaceProvisioningJobState     `json:"state"`
+	Steps      []NamespaceProvisioningStep       `json:"steps"`
+}
+
+// NamespaceProvisioningJobOperation defines model for NamespaceProvisioningJob.Operation.
+type NamespaceProvisioningJobOperation string
+
+// NamespaceProvisioningJobState defines model for NamespaceProvisioningJob.State.
+type NamespaceProvisioningJobState string
+
+// NamespaceProvisioningJobList defines model for NamespaceProvisioningJobList.
+type NamespaceProvisioningJobList = []NamespaceProvisioningJob
+
+// NamespaceProvisioningRequest Request for provisioning a database namespace
+type NamespaceProvisioningRequest struct {
+	// Namespace Name of the namespace
+	Namespace string `json:"namespace"`
+
+	// Operators Database engine operators installed in a namespace
+	Operators NamespaceOperators `json:"operators"`
+
+	// TakeOwnership If set, an existing namespace is made managed by Everest
+	TakeOwnership *bool `json:"takeOwnership,omitempty"`
+}
+
+// NamespaceProvisioningStep Progress of a single step of a namespace provisioning job
+type NamespaceProvisioningStep struct {
+	Description string                         `json:"description"`
+	Message     *string                        `json:"message,omitempty"`
+	State       NamespaceProvisioningStepState `json:"state"`
+}
+
+// NamespaceProvisioningStepState defines model for NamespaceProvisioningStep.State.
+type NamespaceProvisioningStepState string
+
+// NamespaceQuotaUsage Quota of a namespace and the resources used in the namespace
+type NamespaceQuotaUsage struct {
+	// Quota Limits of the namespace. A missing limit means that the resource is not limited.
+	Quota NamespaceQuota         `json:"quota"`
+	Usage NamespaceResourceUsage `json:"usage"`
+}
+
+// NamespaceQuota Limits of the namespace. A missing limit means that the resource is not limited.
+type NamespaceQuota struct {
+	// Cpu CPU limit as a Kubernetes quantity
+	Cpu *string `json:"cpu,omitempty"`
+
+	// DatabaseClusterBackups Maximum number of database cluster backups
+	DatabaseClusterBackups *int `json:"databaseClusterBackups,omitempty"`
+
+	// DatabaseClusters Maximum number of database clusters
+	DatabaseClusters *int `json:"databaseClusters,omitempty"`
+
+	// Disk Disk limit as a Kubernetes quantity
+	Disk *string `json:"disk,omitempty"`
+
+	// Memory Memory limit as a Kubernetes quantity
+	Memory *string `json:"memory,omitempty"`
+}
+
+// NamespaceResourceUsage defines model for .
+type NamespaceResourceUsage struct {
+	CpuMillis              uint64 `json:"cpuMillis"`
+	DatabaseClusterBackups int    `json:"databaseClusterBackups"`
+	DatabaseClusters       int    `json:"databaseClusters"`
+	DiskSize               uint64 `json:"diskSize"`
+	MemoryBytes            uint64 `json:"memoryBytes"`
+}
+
+// NamespaceUpgradePlan Operators upgrade plan for a single namespace
+type NamespaceUpgradePlan struct {
+	// Blockers Pending actions that need to be performed before the upgrade can be approved
+	Blockers *[]UpgradeTask `json:"blockers,omitempty"`
+
+	// Namespace Name of the namespace
+	Namespace      string         `json:"namespace"`
+	PendingActions *[]UpgradeTask `json:"pendingActions,omitempty"`
+	Upgrades       *[]Upgrade     `json:"upgrades,omitempty"`
+}
+
+// NamespaceUpgradeProgress Progress of the operators upgrade in a single namespace
+type NamespaceUpgradeProgress struct {
+	FinishedAt *time.Time                    `json:"finishedAt,omitempty"`
+	Message    *string                       `json:"message,omitempty"`
+	Namespace  string                        `json:"namespace"`
+	StartedAt  *time.Time                    `json:"startedAt,omitempty"`
+	State      NamespaceUpgradeProgressState `json:"state"`
+}
+
+// NamespaceUpgradeProgressState defines model for NamespaceUpgradeProgress.State.
+type NamespaceUpgradeProgressState string
+
 // OIDCConfig Everest OIDC provider configuration
 type OIDCConfig struct {
 	// ClientId OIDC application clientID
@@ -952,6 +1354,35 @@ type OIDCConfig struct {
 	Scopes []string `json:"scopes"`
 }
 
+// PMMTokenRevocation Result of the revocation of the replaced PMM token. Returned only by the update that replaced the token. The tokens that could not be revoked can be purged with `everestctl monitoring purge-keys`.
+type PMMTokenRevocation struct {
+	Message   string                  `json:"message,omitempty"`
+	State     PMMTokenRevocationState `json:"state"`
+	TokenName string                  `json:"tokenName,omitempty"`
+}
+
+// PMMTokenRevocationState defines model for PMMTokenRevocation.State.
+type PMMTokenRevocationState string
+
+// PodSchedulingComponentPreview defines model for PodSchedulingComponentPreview.
+type PodSchedulingComponentPreview struct {
+	// Component One of engine, proxy or configServer
+	Component string `json:"component"`
+
+	// ExcludedNodes Nodes the pods of the component can't land on
+	ExcludedNodes []PodSchedulingExcludedNode `json:"excludedNodes"`
+
+	// Nodes Nodes the pods of the component could land on
+	Nodes []string                   `json:"nodes"`
+	Rules []PodSchedulingRulePreview `json:"rules"`
+}
+
+// PodSchedulingExcludedNode defines model for PodSchedulingExcludedNode.
+type PodSchedulingExcludedNode struct {
+	Name    string   `json:"name"`
+	Reasons []string `json:"reasons"`
+}
+
 // PodSchedulingPolicy PodSchedulingPolicy is the Schema for the Pod Scheduling Policy API.
 type PodSchedulingPolicy struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
@@ -4545,6 +4976,81 @@ type PodSchedulingPolicyList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// PodSchedulingPolicyPreview Result of the evaluation of a pod scheduling policy against the current nodes
+type PodSchedulingPolicyPreview struct {
+	Components []PodSchedulingComponentPreview `json:"components"`
+	EngineType string                          `json:"engineType"`
+}
+
+// PodSchedulingPolicyPreviewRequest Pod scheduling policy to preview
+type PodSchedulingPolicyPreviewRequest struct {
+	// EngineType Database engine type (pxc, psmdb or postgresql), the engine type of the policy is used if empty
+	EngineType string `json:"engineType,omitempty"`
+
+	// Namespace Namespace of the database cluster, the pod affinity rules without namespaces select the pods of this namespace or of all namespaces if empty
+	Namespace string `json:"namespace,omitempty"`
+
+	// Policy PodSchedulingPolicy is the Schema for the Pod Scheduling Policy API.
+	Policy *PodSchedulingPolicy `json:"policy,omitempty"`
+
+	// PolicyName Name of an existing pod scheduling policy
+	PolicyName string `json:"policyName,omitempty"`
+}
+
+// PodSchedulingRulePreview defines model for PodSchedulingRulePreview.
+type PodSchedulingRulePreview struct {
+	Description string `json:"description"`
+
+	// MatchingNodes Schedulable nodes that satisfy the rule
+	MatchingNodes []string `json:"matchingNodes"`
+
+	// Required Set for the rules required during scheduling, the other rules are preferences
+	Required bool `json:"required"`
+
+	// Satisfiable Set if at least one schedulable node satisfies the rule
+	Satisfiable bool `json:"satisfiable"`
+
+	// Type One of nodeAffinity, podAffinity or podAntiAffinity
+	Type string `json:"type"`
+
+	// Weight Weight of a preferred rule
+	Weight int `json:"weight,omitempty"`
+}
+
+// PostUpgradeTaskResult Result of the post-upgrade task execution for a single database cluster
+type PostUpgradeTaskResult struct {
+	Message *string                    `json:"message,omitempty"`
+	State   PostUpgradeTaskResultState `json:"state"`
+	Task    UpgradeTask                `json:"task"`
+}
+
+// PostUpgradeTaskResultState defines model for PostUpgradeTaskResult.State.
+type PostUpgradeTaskResultState string
+
+// PostUpgradeTasksExecution Parameters of the post-upgrade tasks execution
+type PostUpgradeTasksExecution struct {
+	// BatchSize Number of database clusters updated at a time
+	BatchSize *int `json:"batchSize,omitempty"`
+
+	// Databases Database clusters to execute the pending tasks for. If omitted, all database clusters with pending tasks are selected.
+	Databases *[]string `json:"databases,omitempty"`
+
+	// WaitForReady Wait for the database clusters of a batch to become ready before proceeding with the next batch
+	WaitForReady *bool `json:"waitForReady,omitempty"`
+}
+
+// PostUpgradeTasksJob Progress of the post-upgrade tasks execution in a namespace
+type PostUpgradeTasksJob struct {
+	FinishedAt *time.Time               `json:"finishedAt,omitempty"`
+	Namespace  string                   `json:"namespace"`
+	Results    []PostUpgradeTaskResult  `json:"results"`
+	StartedAt  *time.Time               `json:"startedAt,omitempty"`
+	State      PostUpgradeTasksJobState `json:"state"`
+}
+
+// PostUpgradeTasksJobState defines model for PostUpgradeTasksJob.State.
+type PostUpgradeTasksJobState string
+
 // Settings Everest global settings
 type Settings struct {
 	// OidcConfig Everest OIDC provider configuration
@@ -4562,6 +5068,36 @@ type StorageClass struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// StorageClassInfo Storage class available in the cluster
+type StorageClassInfo struct {
+	// AllowVolumeExpansion Whether the volumes of the storage class can be expanded
+	AllowVolumeExpansion bool `json:"allowVolumeExpansion"`
+
+	// Default Whether the storage class is used by the database clusters without a storage class
+	Default           bool   `json:"default"`
+	Name              string `json:"name"`
+	Provisioner       string `json:"provisioner"`
+	ReclaimPolicy     string `json:"reclaimPolicy,omitempty"`
+	VolumeBindingMode string `json:"volumeBindingMode,omitempty"`
+}
+
+// StorageClassInfoList defines model for StorageClassInfoList.
+type StorageClassInfoList = []StorageClassInfo
+
+// StorageResizeCheck Feasibility of resizing the storage of a database cluster
+type StorageResizeCheck struct {
+	AllowVolumeExpansion bool   `json:"allowVolumeExpansion"`
+	CurrentSize          string `json:"currentSize"`
+	Feasible             bool   `json:"feasible"`
+
+	// Reason Why the resize is not feasible
+	Reason        string `json:"reason,omitempty"`
+	RequestedSize string `json:"requestedSize"`
+
+	// StorageClass Storage class of the database cluster, the default one if the database cluster has none
+	StorageClass string `json:"storageClass,omitempty"`
+}
+
 // UpdateBackupStorageParams Backup storage parameters
 type UpdateBackupStorageParams struct {
 	AccessKey *string `json:"accessKey,omitempty"`
@@ -4615,6 +5151,64 @@ type UpgradeTask struct {
 // UpgradeTaskPendingTask Pending task for the database cluster
 type UpgradeTaskPendingTask string
 
+// UsageAggregate Resources requested and used by a database cluster or by all database clusters in a namespace.
+// The used CPU and memory are missing if the metrics API was never available.
+// The used disk is the space used on the volumes reported by the kubelets, missing if it was never available.
+// The backup size is only known for the backups whose size is reported by the database operator.
+type UsageAggregate struct {
+	// BackupBytes Statistics of a resource over the samples
+	BackupBytes UsageStat `json:"backupBytes"`
+
+	// Backups Maximum number of backups
+	Backups int `json:"backups"`
+
+	// CpuRequestedMillis Statistics of a resource over the samples
+	CpuRequestedMillis UsageStat `json:"cpuRequestedMillis"`
+
+	// CpuUsedMillis Statistics of a resource over the samples
+	CpuUsedMillis *UsageStat `json:"cpuUsedMillis,omitempty"`
+
+	// DiskRequestedBytes Statistics of a resource over the samples
+	DiskRequestedBytes UsageStat `json:"diskRequestedBytes"`
+
+	// DiskUsedBytes Statistics of a resource over the samples
+	DiskUsedBytes *UsageStat `json:"diskUsedBytes,omitempty"`
+	EngineType    string     `json:"engineType,omitempty"`
+
+	// Hours Number of hours covered by the samples
+	Hours float64 `json:"hours"`
+
+	// MemoryRequestedBytes Statistics of a resource over the samples
+	MemoryRequestedBytes UsageStat `json:"memoryRequestedBytes"`
+
+	// MemoryUsedBytes Statistics of a resource over the samples
+	MemoryUsedBytes *UsageStat `json:"memoryUsedBytes,omitempty"`
+
+	// Name Name of the database cluster. Empty for the namespace aggregates.
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace"`
+}
+
+// UsageReport Resources requested and used in a time range
+type UsageReport struct {
+	DatabaseClusters []UsageAggregate `json:"databaseClusters"`
+	From             time.Time        `json:"from"`
+	Namespaces       []UsageAggregate `json:"namespaces"`
+
+	// Samples Number of usage samples taken in the time range
+	Samples int       `json:"samples"`
+	To      time.Time `json:"to"`
+}
+
+// UsageStat Statistics of a resource over the samples
+type UsageStat struct {
+	Average float64 `json:"average"`
+	Peak    float64 `json:"peak"`
+
+	// Total Sum of the resource over time in unit-hours, e.g. millicore-hours or byte-hours
+	Total float64 `json:"total"`
+}
+
 // UserCredentials defines model for UserCredentials.
 type UserCredentials struct {
 	Password *string `json:"password,omitempty"`
@@ -4715,6 +5309,15 @@ type IoK8sApimachineryPkgApisMetaV1StatusV2 struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// DeprovisionNamespaceParams defines parameters for DeprovisionNamespace.
+type DeprovisionNamespaceParams struct {
+	// KeepNamespace If set, the namespace is kept, but it is no longer managed by Everest
+	KeepNamespace *bool `form:"keepNamespace,omitempty" json:"keepNamespace,omitempty"`
+
+	// Force If set, the namespace is removed even if there are database clusters in it
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
 // DeleteDatabaseClusterBackupParams defines parameters for DeleteDatabaseClusterBackup.
 type DeleteDatabaseClusterBackupParams struct {
 	// CleanupBackupStorage If set, remove the backed up data from storage
@@ -4727,6 +5330,12 @@ type DeleteDatabaseClusterParams struct {
 	CleanupBackupStorage *bool `form:"cleanupBackupStorage,omitempty" json:"cleanupBackupStorage,omitempty"`
 }
 
+// CheckDatabaseClusterStorageResizeParams defines parameters for CheckDatabaseClusterStorageResize.
+type CheckDatabaseClusterStorageResizeParams struct {
+	// Size Requested storage size as a Kubernetes quantity, e.g. 50Gi
+	Size string `form:"size" json:"size"`
+}
+
 // ListPodSchedulingPolicyParams defines parameters for ListPodSchedulingPolicy.
 type ListPodSchedulingPolicyParams struct {
 	// EngineType Database engine type that Pod Scheduling Policy is applicable to.
@@ -4734,17 +5343,44 @@ type ListPodSchedulingPolicyParams struct {
 
 	// HasRules Return list of Pod Scheduling Policy that has at least 1 rule.
 	HasRules *bool `form:"hasRules,omitempty" json:"hasRules,omitempty"`
+
+	// Namespace Return list of Pod Scheduling Policy that the database clusters of the namespace are allowed to use.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
 }
 
 // ListPodSchedulingPolicyParamsEngineType defines parameters for ListPodSchedulingPolicy.
 type ListPodSchedulingPolicyParamsEngineType string
 
+// GetUsageReportParams defines parameters for GetUsageReport.
+type GetUsageReportParams struct {
+	// From Start of the time range. Defaults to 30 days before the end of the time range.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the time range. Defaults to the current time.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Namespace Only report the usage in the namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// ApproveFleetUpgradePlanJSONRequestBody defines body for ApproveFleetUpgradePlan for application/json ContentType.
+type ApproveFleetUpgradePlanJSONRequestBody = FleetUpgradePlanApproval
+
+// ProvisionNamespaceJSONRequestBody defines body for ProvisionNamespace for application/json ContentType.
+type ProvisionNamespaceJSONRequestBody = NamespaceProvisioningRequest
+
+// UpdateNamespaceOperatorsJSONRequestBody defines body for UpdateNamespaceOperators for application/json ContentType.
+type UpdateNamespaceOperatorsJSONRequestBody = NamespaceOperators
+
 // CreateBackupStorageJSONRequestBody defines body for CreateBackupStorage for application/json ContentType.
 type CreateBackupStorageJSONRequestBody = CreateBackupStorageParams
 
 // UpdateBackupStorageJSONRequestBody defines body for UpdateBackupStorage for application/json ContentType.
 type UpdateBackupStorageJSONRequestBody = UpdateBackupStorageParams
 
+// PlanDatabaseClusterCapacityJSONRequestBody defines body for PlanDatabaseClusterCapacity for application/json ContentType.
+type PlanDatabaseClusterCapacityJSONRequestBody = CapacityPlanRequest
+
 // CreateDatabaseClusterBackupJSONRequestBody defines body for CreateDatabaseClusterBackup for application/json ContentType.
 type CreateDatabaseClusterBackupJSONRequestBody = DatabaseClusterBackup
 
@@ -4763,18 +5399,36 @@ type UpdateDatabaseClusterJSONRequestBody = DatabaseCluster
 // ApproveUpgradePlanJSONRequestBody defines body for ApproveUpgradePlan for application/json ContentType.
 type ApproveUpgradePlanJSONRequestBody = UpgradePlanApproval
 
+// ExecutePostUpgradeTasksJSONRequestBody defines body for ExecutePostUpgradeTasks for application/json ContentType.
+type ExecutePostUpgradeTasksJSONRequestBody = PostUpgradeTasksExecution
+
 // UpdateDatabaseEngineJSONRequestBody defines body for UpdateDatabaseEngine for application/json ContentType.
 type UpdateDatabaseEngineJSONRequestBody = DatabaseEngine
 
+// CreateEngineConfigTemplateJSONRequestBody defines body for CreateEngineConfigTemplate for application/json ContentType.
+type CreateEngineConfigTemplateJSONRequestBody = EngineConfigTemplate
+
+// UpdateEngineConfigTemplateJSONRequestBody defines body for UpdateEngineConfigTemplate for application/json ContentType.
+type UpdateEngineConfigTemplateJSONRequestBody = EngineConfigTemplate
+
+// UpdateEngineVersionPolicyJSONRequestBody defines body for UpdateEngineVersionPolicy for application/json ContentType.
+type UpdateEngineVersionPolicyJSONRequestBody = EngineVersionPolicy
+
 // CreateMonitoringInstanceJSONRequestBody defines body for CreateMonitoringInstance for application/json ContentType.
 type CreateMonitoringInstanceJSONRequestBody = MonitoringInstanceCreateParams
 
+// DeleteMonitoringInstanceJSONRequestBody defines body for DeleteMonitoringInstance for application/json ContentType.
+type DeleteMonitoringInstanceJSONRequestBody = MonitoringInstanceDeleteParams
+
 // UpdateMonitoringInstanceJSONRequestBody defines body for UpdateMonitoringInstance for application/json ContentType.
 type UpdateMonitoringInstanceJSONRequestBody = MonitoringInstanceUpdateParams
 
 // CreatePodSchedulingPolicyJSONRequestBody defines body for CreatePodSchedulingPolicy for application/json ContentType.
 type CreatePodSchedulingPolicyJSONRequestBody = PodSchedulingPolicy
 
+// PreviewPodSchedulingPolicyJSONRequestBody defines body for PreviewPodSchedulingPolicy for application/json ContentType.
+type PreviewPodSchedulingPolicyJSONRequestBody = PodSchedulingPolicyPreviewRequest
+
 // UpdatePodSchedulingPolicyJSONRequestBody defines body for UpdatePodSchedulingPolicy for application/json ContentType.
 type UpdatePodSchedulingPolicyJSONRequestBody = PodSchedulingPolicy
 
@@ -5220,6 +5874,30 @@ type ServerInterface interface {
 	// Cluster info
 	// (GET /cluster-info)
 	GetKubernetesClusterInfo(ctx echo.Context) error
+	// Get upgrade plan for all namespaces
+	// (GET /database-engines/upgrade-plan)
+	GetFleetUpgradePlan(ctx echo.Context) error
+	// Get upgrade rollout progress
+	// (GET /database-engines/upgrade-plan/rollout)
+	GetFleetUpgradeRollout(ctx echo.Context) error
+	// Start a rolling upgrade of database engine operators
+	// (POST /database-engines/upgrade-plan/rollout)
+	ApproveFleetUpgradePlan(ctx echo.Context) error
+	// List namespace provisioning jobs
+	// (GET /namespace-provisioning)
+	ListNamespaceProvisioningJobs(ctx echo.Context) error
+	// Provision a database namespace
+	// (POST /namespace-provisioning)
+	ProvisionNamespace(ctx echo.Context) error
+	// Remove a database namespace
+	// (DELETE /namespace-provisioning/{namespace})
+	DeprovisionNamespace(ctx echo.Context, namespace string, params DeprovisionNamespaceParams) error
+	// Get namespace provisioning job
+	// (GET /namespace-provisioning/{namespace})
+	GetNamespaceProvisioningJob(ctx echo.Context, namespace string) error
+	// Update database namespace operators
+	// (PUT /namespace-provisioning/{namespace})
+	UpdateNamespaceOperators(ctx echo.Context, namespace string) error
 	// Managed namespaces
 	// (GET /namespaces)
 	ListNamespaces(ctx echo.Context) error
@@ -5238,6 +5916,9 @@ type ServerInterface interface {
 	// Update backup storage
 	// (PATCH /namespaces/{namespace}/backup-storages/{name})
 	UpdateBackupStorage(ctx echo.Context, namespace string, name string) error
+	// Plan database cluster capacity
+	// (POST /namespaces/{namespace}/capacity-plan)
+	PlanDatabaseClusterCapacity(ctx echo.Context, namespace string) error
 	// Create database cluster backup
 	// (POST /namespaces/{namespace}/database-cluster-backups)
 	CreateDatabaseClusterBackup(ctx echo.Context, namespace string) error
@@ -5289,6 +5970,9 @@ type ServerInterface interface {
 	// Get the Point-in-Time recovery info
 	// (GET /namespaces/{namespace}/database-clusters/{name}/pitr)
 	GetDatabaseClusterPitr(ctx echo.Context, namespace string, name string) error
+	// Check database cluster storage resize
+	// (GET /namespaces/{namespace}/database-clusters/{name}/storage-resize)
+	CheckDatabaseClusterStorageResize(ctx echo.Context, namespace string, name string, params CheckDatabaseClusterStorageResizeParams) error
 	// List database engines
 	// (GET /namespaces/{namespace}/database-engines)
 	ListDatabaseEngines(ctx echo.Context, namespace string) error
@@ -5298,12 +5982,42 @@ type ServerInterface interface {
 	// Upgrade database engine operators
 	// (POST /namespaces/{namespace}/database-engines/upgrade-plan/approval)
 	ApproveUpgradePlan(ctx echo.Context, namespace string) error
+	// Get post-upgrade tasks execution progress
+	// (GET /namespaces/{namespace}/database-engines/upgrade-plan/post-upgrade-tasks)
+	GetPostUpgradeTasksJob(ctx echo.Context, namespace string) error
+	// Execute post-upgrade tasks
+	// (POST /namespaces/{namespace}/database-engines/upgrade-plan/post-upgrade-tasks)
+	ExecutePostUpgradeTasks(ctx echo.Context, namespace string) error
 	// Get database engine
 	// (GET /namespaces/{namespace}/database-engines/{name})
 	GetDatabaseEngine(ctx echo.Context, namespace string, name string) error
 	// Update database engine
 	// (PUT /namespaces/{namespace}/database-engines/{name})
 	UpdateDatabaseEngine(ctx echo.Context, namespace string, name string) error
+	// List engine config templates
+	// (GET /namespaces/{namespace}/engine-config-templates)
+	ListEngineConfigTemplates(ctx echo.Context, namespace string) error
+	// Create engine config template
+	// (POST /namespaces/{namespace}/engine-config-templates)
+	CreateEngineConfigTemplate(ctx echo.Context, namespace string) error
+	// Delete engine config template
+	// (DELETE /namespaces/{namespace}/engine-config-templates/{name})
+	DeleteEngineConfigTemplate(ctx echo.Context, namespace string, name string) error
+	// Get engine config template
+	// (GET /namespaces/{namespace}/engine-config-templates/{name})
+	GetEngineConfigTemplate(ctx echo.Context, namespace string, name string) error
+	// Update engine config template
+	// (PUT /namespaces/{namespace}/engine-config-templates/{name})
+	UpdateEngineConfigTemplate(ctx echo.Context, namespace string, name string) error
+	// Roll out engine config template
+	// (POST /namespaces/{namespace}/engine-config-templates/{name}/rollout)
+	RolloutEngineConfigTemplate(ctx echo.Context, namespace string, name string) error
+	// Get engine version policy
+	// (GET /namespaces/{namespace}/engine-version-policy)
+	GetEngineVersionPolicy(ctx echo.Context, namespace string) error
+	// Update engine version policy
+	// (PUT /namespaces/{namespace}/engine-version-policy)
+	UpdateEngineVersionPolicy(ctx echo.Context, namespace string) error
 	// List monitoring instances
 	// (GET /namespaces/{namespace}/monitoring-instances)
 	ListMonitoringInstances(ctx echo.Context, namespace string) error
@@ -5319,6 +6033,9 @@ type ServerInterface interface {
 	// Update monitoring instance
 	// (PATCH /namespaces/{namespace}/monitoring-instances/{name})
 	UpdateMonitoringInstance(ctx echo.Context, namespace string, name string) error
+	// Namespace quota usage
+	// (GET /namespaces/{namespace}/quota)
+	GetNamespaceQuotaUsage(ctx echo.Context, namespace string) error
 	// Get user permissions
 	// (GET /permissions)
 	GetUserPermissions(ctx echo.Context) error
@@ -5328,6 +6045,9 @@ type ServerInterface interface {
 	// Create pod scheduling policy
 	// (POST /pod-scheduling-policies)
 	CreatePodSchedulingPolicy(ctx echo.Context) error
+	// Preview pod scheduling policy
+	// (POST /pod-scheduling-policies/preview)
+	PreviewPodSchedulingPolicy(ctx echo.Context) error
 	// Delete pod scheduling policy
 	// (DELETE /pod-scheduling-policies/{policy-name})
 	DeletePodSchedulingPolicy(ctx echo.Context, policyName string) error
@@ -5349,6 +6069,12 @@ type ServerInterface interface {
 	// Settings
 	// (GET /settings)
 	GetSettings(ctx echo.Context) error
+	// Storage classes
+	// (GET /storage-classes)
+	ListStorageClasses(ctx echo.Context) error
+	// Resource usage report
+	// (GET /usage)
+	GetUsageReport(ctx echo.Context, params GetUsageReportParams) error
 	// Version
 	// (GET /version)
 	VersionInfo(ctx echo.Context) error
@@ -5370,55 +6096,63 @@ func (w *ServerInterfaceWrapper) GetKubernetesClusterInfo(ctx echo.Context) erro
 	return err
 }
 
-// ListNamespaces converts echo context to params.
-func (w *ServerInterfaceWrapper) ListNamespaces(ctx echo.Context) error {
+// GetFleetUpgradePlan converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFleetUpgradePlan(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListNamespaces(ctx)
+	err = w.Handler.GetFleetUpgradePlan(ctx)
 	return err
 }
 
-// ListBackupStorages converts echo context to params.
-func (w *ServerInterfaceWrapper) ListBackupStorages(ctx echo.Context) error {
+// GetFleetUpgradeRollout converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFleetUpgradeRollout(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "namespace" -------------
-	var namespace string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFleetUpgradeRollout(ctx)
+	return err
+}
+
+// ApproveFleetUpgradePlan converts echo context to params.
+func (w *ServerInterfaceWrapper) ApproveFleetUpgradePlan(ctx echo.Context) error {
+	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListBackupStorages(ctx, namespace)
+	err = w.Handler.ApproveFleetUpgradePlan(ctx)
 	return err
 }
 
-// CreateBackupStorage converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateBackupStorage(ctx echo.Context) error {
+// ListNamespaceProvisioningJobs converts echo context to params.
+func (w *ServerInterfaceWrapper) ListNamespaceProvisioningJobs(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "namespace" -------------
-	var namespace string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListNamespaceProvisioningJobs(ctx)
+	return err
+}
+
+// ProvisionNamespace converts echo context to params.
+func (w *ServerInterfaceWrapper) ProvisionNamespace(ctx echo.Context) error {
+	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateBackupStorage(ctx, namespace)
+	err = w.Handler.ProvisionNamespace(ctx)
 	return err
 }
 
-// DeleteBackupStorage converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteBackupStorage(ctx echo.Context) error {
+// DeprovisionNamespace converts echo context to params.
+func (w *ServerInterfaceWrapper) DeprovisionNamespace(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -5428,8 +6162,125 @@ func (w *ServerInterfaceWrapper) DeleteBackupStorage(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeprovisionNamespaceParams
+	// ------------- Optional query parameter "keepNamespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keepNamespace", ctx.QueryParams(), &params.KeepNamespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keepNamespace: %s", err))
+	}
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeprovisionNamespace(ctx, namespace, params)
+	return err
+}
+
+// GetNamespaceProvisioningJob converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNamespaceProvisioningJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNamespaceProvisioningJob(ctx, namespace)
+	return err
+}
+
+// UpdateNamespaceOperators converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNamespaceOperators(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateNamespaceOperators(ctx, namespace)
+	return err
+}
+
+// ListNamespaces converts echo context to params.
+func (w *ServerInterfaceWrapper) ListNamespaces(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListNamespaces(ctx)
+	return err
+}
+
+// ListBackupStorages converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBackupStorages(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBackupStorages(ctx, namespace)
+	return err
+}
+
+// CreateBackupStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateBackupStorage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateBackupStorage(ctx, namespace)
+	return err
+}
+
+// DeleteBackupStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBackupStorage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -5495,6 +6346,24 @@ func (w *ServerInterfaceWrapper) UpdateBackupStorage(ctx echo.Context) error {
 	return err
 }
 
+// PlanDatabaseClusterCapacity converts echo context to params.
+func (w *ServerInterfaceWrapper) PlanDatabaseClusterCapacity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PlanDatabaseClusterCapacity(ctx, namespace)
+	return err
+}
+
 // CreateDatabaseClusterBackup converts echo context to params.
 func (w *ServerInterfaceWrapper) CreateDatabaseClusterBackup(ctx echo.Context) error {
 	var err error
@@ -5923,6 +6792,41 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterPitr(ctx echo.Context) error
 	return err
 }
 
+// CheckDatabaseClusterStorageResize converts echo context to params.
+func (w *ServerInterfaceWrapper) CheckDatabaseClusterStorageResize(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CheckDatabaseClusterStorageResizeParams
+	// ------------- Required query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CheckDatabaseClusterStorageResize(ctx, namespace, name, params)
+	return err
+}
+
 // ListDatabaseEngines converts echo context to params.
 func (w *ServerInterfaceWrapper) ListDatabaseEngines(ctx echo.Context) error {
 	var err error
@@ -5977,6 +6881,42 @@ func (w *ServerInterfaceWrapper) ApproveUpgradePlan(ctx echo.Context) error {
 	return err
 }
 
+// GetPostUpgradeTasksJob converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPostUpgradeTasksJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPostUpgradeTasksJob(ctx, namespace)
+	return err
+}
+
+// ExecutePostUpgradeTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecutePostUpgradeTasks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecutePostUpgradeTasks(ctx, namespace)
+	return err
+}
+
 // GetDatabaseEngine converts echo context to params.
 func (w *ServerInterfaceWrapper) GetDatabaseEngine(ctx echo.Context) error {
 	var err error
@@ -6029,6 +6969,182 @@ func (w *ServerInterfaceWrapper) UpdateDatabaseEngine(ctx echo.Context) error {
 	return err
 }
 
+// ListEngineConfigTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) ListEngineConfigTemplates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListEngineConfigTemplates(ctx, namespace)
+	return err
+}
+
+// CreateEngineConfigTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateEngineConfigTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateEngineConfigTemplate(ctx, namespace)
+	return err
+}
+
+// DeleteEngineConfigTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteEngineConfigTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteEngineConfigTemplate(ctx, namespace, name)
+	return err
+}
+
+// GetEngineConfigTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEngineConfigTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEngineConfigTemplate(ctx, namespace, name)
+	return err
+}
+
+// UpdateEngineConfigTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateEngineConfigTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateEngineConfigTemplate(ctx, namespace, name)
+	return err
+}
+
+// RolloutEngineConfigTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) RolloutEngineConfigTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RolloutEngineConfigTemplate(ctx, namespace, name)
+	return err
+}
+
+// GetEngineVersionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEngineVersionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEngineVersionPolicy(ctx, namespace)
+	return err
+}
+
+// UpdateEngineVersionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateEngineVersionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateEngineVersionPolicy(ctx, namespace)
+	return err
+}
+
 // ListMonitoringInstances converts echo context to params.
 func (w *ServerInterfaceWrapper) ListMonitoringInstances(ctx echo.Context) error {
 	var err error
@@ -6143,6 +7259,24 @@ func (w *ServerInterfaceWrapper) UpdateMonitoringInstance(ctx echo.Context) erro
 	return err
 }
 
+// GetNamespaceQuotaUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNamespaceQuotaUsage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNamespaceQuotaUsage(ctx, namespace)
+	return err
+}
+
 // GetUserPermissions converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUserPermissions(ctx echo.Context) error {
 	var err error
@@ -6176,6 +7310,13 @@ func (w *ServerInterfaceWrapper) ListPodSchedulingPolicy(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hasRules: %s", err))
 	}
 
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ListPodSchedulingPolicy(ctx, params)
 	return err
@@ -6192,6 +7333,17 @@ func (w *ServerInterfaceWrapper) CreatePodSchedulingPolicy(ctx echo.Context) err
 	return err
 }
 
+// PreviewPodSchedulingPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewPodSchedulingPolicy(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreviewPodSchedulingPolicy(ctx)
+	return err
+}
+
 // DeletePodSchedulingPolicy converts echo context to params.
 func (w *ServerInterfaceWrapper) DeletePodSchedulingPolicy(ctx echo.Context) error {
 	var err error
@@ -6286,6 +7438,51 @@ func (w *ServerInterfaceWrapper) GetSettings(ctx echo.Context) error {
 	return err
 }
 
+// ListStorageClasses converts echo context to params.
+func (w *ServerInterfaceWrapper) ListStorageClasses(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListStorageClasses(ctx)
+	return err
+}
+
+// GetUsageReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsageReport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsageReportParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsageReport(ctx, params)
+	return err
+}
+
 // VersionInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) VersionInfo(ctx echo.Context) error {
 	var err error
@@ -6320,17 +7517,27 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
 	router.GET(baseURL+"/cluster-info", wrapper.GetKubernetesClusterInfo)
+	router.GET(baseURL+"/database-engines/upgrade-plan", wrapper.GetFleetUpgradePlan)
+	router.GET(baseURL+"/database-engines/upgrade-plan/rollout", wrapper.GetFleetUpgradeRollout)
+	router.POST(baseURL+"/database-engines/upgrade-plan/rollout", wrapper.ApproveFleetUpgradePlan)
+	router.GET(baseURL+"/namespace-provisioning", wrapper.ListNamespaceProvisioningJobs)
+	router.POST(baseURL+"/namespace-provisioning", wrapper.ProvisionNamespace)
+	router.DELETE(baseURL+"/namespace-provisioning/:namespace", wrapper.DeprovisionNamespace)
+	router.GET(baseURL+"/namespace-provisioning/:namespace", wrapper.GetNamespaceProvisioningJob)
+	router.PUT(baseURL+"/namespace-provisioning/:namespace", wrapper.UpdateNamespaceOperators)
 	router.GET(baseURL+"/namespaces", wrapper.ListNamespaces)
 	router.GET(baseURL+"/namespaces/:namespace/backup-storages", wrapper.ListBackupStorages)
 	router.POST(baseURL+"/namespaces/:namespace/backup-storages", wrapper.CreateBackupStorage)
 	router.DELETE(baseURL+"/namespaces/:namespace/backup-storages/:name", wrapper.DeleteBackupStorage)
 	router.GET(baseURL+"/namespaces/:namespace/backup-storages/:name", wrapper.GetBackupStorage)
 	router.PATCH(baseURL+"/namespaces/:namespace/backup-storages/:name", wrapper.UpdateBackupStorage)
+	router.POST(baseURL+"/namespaces/:namespace/capacity-plan", wrapper.PlanDatabaseClusterCapacity)
 	router.POST(baseURL+"/namespaces/:namespace/database-cluster-backups", wrapper.CreateDatabaseClusterBackup)
 	router.DELETE(baseURL+"/namespaces/:namespace/database-cluster-backups/:name", wrapper.DeleteDatabaseClusterBackup)
 	router.GET(baseURL+"/namespaces/:namespace/database-cluster-backups/:name", wrapper.GetDatabaseClusterBackup)
@@ -6348,19 +7555,32 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/components", wrapper.GetDatabaseClusterComponents)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/credentials", wrapper.GetDatabaseClusterCredentials)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/pitr", wrapper.GetDatabaseClusterPitr)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/storage-resize", wrapper.CheckDatabaseClusterStorageResize)
 	router.GET(baseURL+"/namespaces/:namespace/database-engines", wrapper.ListDatabaseEngines)
 	router.GET(baseURL+"/namespaces/:namespace/database-engines/upgrade-plan", wrapper.GetUpgradePlan)
 	router.POST(baseURL+"/namespaces/:namespace/database-engines/upgrade-plan/approval", wrapper.ApproveUpgradePlan)
+	router.GET(baseURL+"/namespaces/:namespace/database-engines/upgrade-plan/post-upgrade-tasks", wrapper.GetPostUpgradeTasksJob)
+	router.POST(baseURL+"/namespaces/:namespace/database-engines/upgrade-plan/post-upgrade-tasks", wrapper.ExecutePostUpgradeTasks)
 	router.GET(baseURL+"/namespaces/:namespace/database-engines/:name", wrapper.GetDatabaseEngine)
 	router.PUT(baseURL+"/namespaces/:namespace/database-engines/:name", wrapper.UpdateDatabaseEngine)
+	router.GET(baseURL+"/namespaces/:namespace/engine-config-templates", wrapper.ListEngineConfigTemplates)
+	router.POST(baseURL+"/namespaces/:namespace/engine-config-templates", wrapper.CreateEngineConfigTemplate)
+	router.DELETE(baseURL+"/namespaces/:namespace/engine-config-templates/:name", wrapper.DeleteEngineConfigTemplate)
+	router.GET(baseURL+"/namespaces/:namespace/engine-config-templates/:name", wrapper.GetEngineConfigTemplate)
+	router.PUT(baseURL+"/namespaces/:namespace/engine-config-templates/:name", wrapper.UpdateEngineConfigTemplate)
+	router.POST(baseURL+"/namespaces/:namespace/engine-config-templates/:name/rollout", wrapper.RolloutEngineConfigTemplate)
+	router.GET(baseURL+"/namespaces/:namespace/engine-version-policy", wrapper.GetEngineVersionPolicy)
+	router.PUT(baseURL+"/namespaces/:namespace/engine-version-policy", wrapper.UpdateEngineVersionPolicy)
 	router.GET(baseURL+"/namespaces/:namespace/monitoring-instances", wrapper.ListMonitoringInstances)
 	router.POST(baseURL+"/namespaces/:namespace/monitoring-instances", wrapper.CreateMonitoringInstance)
 	router.DELETE(baseURL+"/namespaces/:namespace/monitoring-instances/:name", wrapper.DeleteMonitoringInstance)
 	router.GET(baseURL+"/namespaces/:namespace/monitoring-instances/:name", wrapper.GetMonitoringInstance)
 	router.PATCH(baseURL+"/namespaces/:namespace/monitoring-instances/:name", wrapper.UpdateMonitoringInstance)
+	router.GET(baseURL+"/namespaces/:namespace/quota", wrapper.GetNamespaceQuotaUsage)
 	router.GET(baseURL+"/permissions", wrapper.GetUserPermissions)
 	router.GET(baseURL+"/pod-scheduling-policies", wrapper.ListPodSchedulingPolicy)
 	router.POST(baseURL+"/pod-scheduling-policies", wrapper.CreatePodSchedulingPolicy)
+	router.POST(baseURL+"/pod-scheduling-policies/preview", wrapper.PreviewPodSchedulingPolicy)
 	router.DELETE(baseURL+"/pod-scheduling-policies/:policy-name", wrapper.DeletePodSchedulingPolicy)
 	router.GET(baseURL+"/pod-scheduling-policies/:policy-name", wrapper.GetPodSchedulingPolicy)
 	router.PUT(baseURL+"/pod-scheduling-policies/:policy-name", wrapper.UpdatePodSchedulingPolicy)
@@ -6368,324 +7588,445 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/session", wrapper.DeleteSession)
 	router.POST(baseURL+"/session", wrapper.CreateSession)
 	router.GET(baseURL+"/settings", wrapper.GetSettings)
+	router.GET(baseURL+"/storage-classes", wrapper.ListStorageClasses)
+	router.GET(baseURL+"/usage", wrapper.GetUsageReport)
 	router.GET(baseURL+"/version", wrapper.VersionInfo)
+
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+y9fXfbOJI3+lVwNHtOx72S7KR75u74+WOuY2d683RefG339L0b+U4gEpKxJgEOATpW",
-	"9+a7PwcFgK+gRFl2Yqdrz9npWCTxUgAK9atfFfD7KJJpJgUTWo0Ofx+p6IqlFP75kkbXRXauZU6XzPxA",
-	"45hrLgVNTnOZsVxzpkaHC5ooNh7FTEU5z8zz0aH7lij7MeFiIfOUwsPxKKt9/fuIJon8xOJ3NGUqo5H9",
-	"MWZZziKqWTw61HnRKf8NV5rIBRHlV8SVQ7QkhWJEX3FF5o1mjMYjrlkKFehVxkaHI6VzLpajz2P/A81z",
-	"ujJ/z4vommnTquDrjeYEni9kHrFTqq/O9SphtksLWiS6FJj7ZC5lwqgw34i+yspedp+OR7eTpZyYHyfq",
-	"mmcTmdkhmmSSC81yK7/P41HOlsHGDi/Bfvf7iIkiHR1+GKkfRuMR/a3I2ehy3G11kSfB3tywnC9WF2/O",
-	"G1Kxo9wWCrT7XwXPzUT4YCXUGBv3SVW/nP83i7SppzF/lZkxpsJyBvxbzhajw9Gf9qsFsO9m/35z6gdm",
-	"x3HOqGaN105pTm3Jd18nmSmDaZar7jKJIqbUz2wVlOmTWETN2i+uGIkSWcRl7+3b+5EUmnLBciJqI/yl",
-	"Fl+zkUdGDDmJ2YILZlpqqoB2GcHpK1ZTcfDnybtz+9gqPHKldaYO9/eviznLBdNMTbncj2WkTD8jlmm1",
-	"L29YfsPZp/1PMr/mYjn5xPXVxE5ktQ+js/+nWKhJQucsmcAPo/GI3dI0S0Den9QkZjchUe2+6hWLcqb7",
-	"Jt7j1AnVYqm3f42uOKGazqlix0mhoPPtidB6gXAFw30OCsMMNvwZu7ci+5YiR6evp92lnPF/sFy5cWlN",
-	"uNPX7pmbdLaeG/ubmYK2Rph9XJHcrHHFhIbN1fxMBbH9ms7EOcvNl0RdySKJSSTFDcs1yVkkl4L/Vhan",
-	"zII39SRUM6UJzABBE3JDk4KNCRXxTKR0RXJmSiaFqBUB76jpTLyVud3qD8tpv+R6ev0fMOcjmaaF4HoF",
-	"Czzn80LLXO3H7IYl+4ovJzSPrrhmkS5ytk8zPoHmCtMvNU3jP+VMySKPYO53JtA1F3FXmj9zEZuhon7l",
-	"QlsroZmfTLfPXp1fEF++FayVYfWqqonTSIKLBcvtq4tcplAMEzGsHvgjSjgTmqhinnJtBupfBVPaSHo6",
-	"E8dUCKnJnJEii42Gns7Ea0GOacqSY6rYw0vTSFBNjNiC8kyZpmY211ZrtVpUxqKNS+Q8Y1FjDsdMmTVL",
-	"lKYa1Gfrg2nYNPxFKLpgx1Is+LLIqQ4vm543yYKzJDZKHPY0JlSRmwGmdoxAuUdUkAj2c7NAqm8VKcSC",
-	"a1jcWS7jIoISCxidmTgpd9dD0lv9J54kxI20KrJM5prFfq9YFGZwSM4SRhVT01FoX7K7b7fHznJwesjv",
-	"0RmL+IJHYUubCTpPWGCZvLIP7EpZJHRpZWV+dCWren+n5BRaDCZCPJ+aWqf2vanRJ3GRMPXhcurqM4XB",
-	"JJUJYTS6Iv4dopgxeDRLVkbDtIvKuM5DZZy+vjgLy8p80e2ded/LqTHAfgu3a9YMCk8ZKMcblq864pvX",
-	"rb2wTfOy/Yqvt24xNF4in64YzEhGfDtdl2fiovNyWiiYSna6lhNJmdJLK45QW2dgeXWW+Z2mhGloUP5F",
-	"lkgavzabxw1NzkNK4pf2K0QU6ZzlRjiKRVLEisyZ/sSY7dqci0QuFbFF11SV2aKWLO+YA75HoV2+nJzd",
-	"dp37R7bHiTON/boqP6xZv8Gh91O7tS79z435N/1CU+z4zGq8mjKeCW+3JtJqi+njnW9QpZPgaLjt3iec",
-	"blF1c1nbPfJYZjw0T86aL5Tll5PYjXhkH2tJcmbQzAhQSUq1nbo/vAjM5GqC9s/PUpHlUqzpSWtRdOdV",
-	"NRRjb0GXpYWWThPZfQ68YayFczCgwqaBfVZOQgrGMnEml9lj51JqpXOaGauMEsE+EWdH962Tntpe1p62",
-	"F6Kz8MywmBXAwHj7QusQrBDoqd1Tv8ySy6i+CmyKVF/5Fps3PABwclrwhO3HPGeRlvlqeqcJBhWH5lI8",
-	"d+21PQ/L9+Rl56WQhE9e+knim94d265INtoJYBJMuJg0TIKm+u7MGmPIB+d+2fJfLo7NtHcTEAo1eICY",
-	"aWAwa6btDEmpPiSz0YuDg79MDp5PDl5cPP/z4cGPhwd//q/ZKDjKHoeX2Nm2pu3yuVhlZWPMJ0aMvndT",
-	"UAoWxruPLRwMIPmuAgipBCaWXLCQsje/+3Z40Ezs6xuMWDsE3TKt3e3LdEW1x6sjtijvReLHZx6I8yZ+",
-	"cVjcz0Czu1pv2Sdu1pHZXAsRszxZGUVm2k61zA3AW5BCuN6xeEzYDTMzdeJfsWjB+t3civd1ufVeK2wm",
-	"3r2/eHVIfjH40eJYroiT1YpkEmC80jRJrLFrQGvCKJjSFJYIzbXvRLRGgeQsS3hEg5uhfdLdBZ38y08D",
-	"u1/KBU/NbHse2gkrsB+o1T0i1FnOJXJPOGBto2MBaTSbYYfAoDHF9LjzlSnNPORpJhVsjK2ZlxUASsXq",
-	"/WJ0+OH3bqs7jq3L9vo7Pv3FC8v8s2yC06Up0DCgOjXLzQf//7PZ7N//Z7L3t2fPPhxM/nr5789msyn8",
-	"6/u9v+39T/nXv+/tPXv24ee3P12cvrrke//zQRTptf3rf559YK8uh5ezt/e3fwP/YOWznBhtKPOJ65d3",
-	"DaYslflqZ6G8hWK8XGyhT1s0IWWoKiKtZdp5D3JDdXn7fP2WEyVUBZbIsfnZF1iWBD86XeU9lplRMMrY",
-	"tORGJkUKr/Hgrqn4b2znsT7nv5U9NQWWGLy3HU9lwOvmEIiq34z+fc2u7IYfXqz24+w2MqKQSi9zpv6V",
-	"mD9UGs/DTnbF8nPwequwbfVL84UgSILHxHEx3k8K/jL7KOg1vOnbTFtbqeukf32TdVlRT70O/FQKrqUd",
-	"kXblb8tnpY6pflm/vqoXrX0RlufbwFttoVLSLoscnzkE0P7+/kHAoO3UQ7Pmxuh8oV5hVL2YhrQRT8Pq",
-	"iKcKnCqVUJS1PV3l45Jj4wIswKl/ZD8ezwT4MMwmDThqvrIWT8kWgk10YX7iilBBaJJdUef/pSL2+4jz",
-	"r7kZPRMnK0FTHnkpHCXeIUIWjIJ/dkk1qwq3BZpa0rTQBkJPyWsNTmQpkhU4epl1GpdNA9duj9/orN5N",
-	"krMFy5kwoyGFWSfabIyCnMr43Ail8bbqjsAaTwjMqZTq6KoxLxvVZDKeBoRP5MKIn5lmlA7LuizMiIAY",
-	"UnoNDiaqq1lEbyhPjKBmggvFY0ZobdTCsxW4kpCw4EFjbUVXUjEBAqeeZfELphRnbLcTawGyNNMra36v",
-	"9JWZCSWDA2+Z4lMa11o+JlJfsfwTV2wmYJid2VkkukbFQd2bwTIM0kYnS2vXMYtnktJscs1Wql5K9y1X",
-	"TEozU6i1bvvjErbe0J+IcdqOdQAb3/44d4xUSm8NBCE0lYWAgYxkmhW6QhRlRESYkFvH6jc2lv2UCrpk",
-	"k7LcSaUc9keBqeDpwj/6uJ152rQ1chY8rh05v+Tsoi8L4orIlGvnaanrojHh4CSnRQI8LXGThi+sRuOK",
-	"sFuDJLlOVqQC8jNRagcA18JAyAQQCwz+xG9twD5Pq6ZElgVmtxFjsavty060YX6cjBoFH3Iiwlbc8Nkr",
-	"LbO6SyFM1MnYObS5WJ7KhEersGV1Gn4xZLEGXu0wHzkwPGbYa37DTMZ2mbt9n0a5VGqjWyTL5e0q0GLz",
-	"c+lGhD8aDq0pqfsgjJ2SmS0851SzmQh8YL1Cc2ZeTLibtabwJb9hwpnSU3I0E5FMU0tQk4g6jKeYrrxD",
-	"5X5dY1PBCGK3Lt7DBs54Z3DpmYv6GPph3jjbq43OOHabSRVyF8LvzcLsuxusd+5IgDMqliHT9/Vp/bmv",
-	"wHN/r089XZDb58+OX5+cmbGD2vZmwix2sz14sRkzojm+GowlroiQdWu63xxsNKkWfWJaQ+M4Z0qZlgrS",
-	"aAsB56G+koUG5kSnVF2v8RNXEXpdv7GP/VnrO3biN1+PwfadsypoSObET6gahK2VWz4d4li+mwPSzpKv",
-	"7X9stALdj+h+/Hrux82eJztZW46nVIqlNB2/onbDcxuf80Et57IQEcsHrmR1RfM46KM5d09K36D/uxkx",
-	"QU7P3568nBgI1rMX2Ri9vh3JPq3r1f7KiLIvuy20G5I9XC/VzdSqGVurpRaOLOu/DHJvGyItvE3EF00Z",
-	"VBFIQdMN3lM9A6gaAX+1yB770W7dbYxvPX7BlX4ZsmWboUFARwYjgzTVhdoc0wivNTop5zBNtgprjDS/",
-	"Yed9fMBR/XHbiW8NblEar8/ADQyup70gwSmFBY8quCTcM4+BWl2qPi7p9m7fegyZsvCq7JhpyhO7PUrB",
-	"CDV2bkVBFnkOAbNejmCyHp2+Jn7D7UoyoUpf5FQoqOmChyBE953S0KNK25g/FxroGqzLt421ncsUWmun",
-	"iAV4gPemziPogqvnEMtn/U41/rcqNroyNl08JcZC9IDS7PjXQn4SYCsa49372qFhZYlGDtZ8d8UA6oKQ",
-	"AfBB1tdWTDWDwIVwYK9SwWnnHlg0d1WkVJCc0RgisMpnIgZUIpblYNK5MTqhwaXYvGRSCv5QKqzLzQVh",
-	"m7am9PYNE0t9NTr84cX/9Zf/CDTUz8KfmGB9Yb/dd9qqfeoDmafL6p0y/rcanE9Ugd/WTO6YFBl04u8y",
-	"txy6iNjYKMpgaVz5uZusyPMXYzJ3ApnaKTOtltGH28tpoM1ckb+OWw3iihjBygUEjMwEBBfkzC4Zh88C",
-	"S4aVDZ621O1ffqyr24Ow0UtVSMz292ohU2MrLHOaplTziPCYCc0XnOX1CWINY/jQI9ayd98pt/jqU+YU",
-	"YqxZDsrGQ+D6slxlzM4pq38NCGGRLjMQwMufMirMZu3q9KB3PBPm6acrZlauTalwH+XQLsVjlrOYULIs",
-	"aE6FZiyG7A3L0Ni8gWql0ypU38/qBj9gWunCvmHqt+b884MXP8JglD80LMsPR5P/opPfLp+5fxxM/vrP",
-	"8eHl97U/L60p2CVpezYyp728rvVCHYNqkwtykRdsTP4O2VHkFwEqqR4QZJ6PxiN4YTQeuTeC9GPY0vTR",
-	"RrUZXst3ILDSyELKqUtrmkYy3a/yIVo64/lfmqb4ByuWy2cfJu5f3/uf9v4GJvS6F/a+3wfzuxTv5YdJ",
-	"JeqpMcRrz/b+baOHP7AvVZq3XGflaK3hNdt4fZuApXIf70YsgRnh45VIKFwpnHcHOj9gJrnNIMvlDY+Z",
-	"IosiSUhzzhWZ0jmjaWm6UFAkCeWCaHargzVeSaXDnNZ/uie+s/7NWkC9r8j5J3IDyetW7YBN8W21KbJb",
-	"ndN6JnNt6+v4Orfbxt4HtwTLtipI1zJavrbllCNbarmAYdZR/l2Fn8lch5yuua4CIXM9RKQDgpuNNbEK",
-	"YSUar7oOHHgbfLNDS49kmjIRs7hcCKHKum/5umsl9Mb4WR+Od+2Z3wVjMViFVS6X3Z65KkuZs4XMzeNl",
-	"TmO/N3YCA2uFGhsssRJwlkqgcdN1QTr9UTdaaprUPWWDRdy3tzhUVCKVxk7TtzKGMQ+taf2yJxkq+Nqw",
-	"HE0Xi/11MzXJPSZqkg15muQbT9Mk95WlSbpJmqSRo0meeoqmyzzYNlHTfjb9WlkTQcvEpxRsSCaoVylz",
-	"vuRm7bRpLmjM3XIemu3YwdPkZbC9v6lvdCJpzGodcgke+0flHtHwPfy3nAM+LksY7m1wAWyBKn1kW1Wh",
-	"0jTNOtailfJ3ysbCuW1vWOUxU5qLHpvrpHroGwFGazcZJjjhljQLDOJPNFMVHPa+1ZwByjSfkJhpi1ld",
-	"hBIknSRyqYLOVqvlzyCdhc4TFvZwvQm8Vfm4YJ9wXi6qveVWripogEuYGSxZmHthQ6Cs2U/L8ogTqgcs",
-	"KpDr5d1tA3/My4DFBcef2FhBt4tYAdVdoZ4LtpwnV9b11dYXNc2E9sOD2g+ls3nQMT5h6zGAqtEs+SJm",
-	"yYBVfOxH8diHLZlywkGugbhRhzC7mtTlO9WPLWoim9xtU2s8agMIzr7eBPaKar6SnCWwGYLYapO8w2+6",
-	"QK67LoCAcAOLYbB4G+Hl9y3dyo+4Sez1o4xs23uHIdTd9rs5g/2bJt0hq2h3UtbdGSPB4JyQX+xJR9Uh",
-	"TT5543B/v1AsP7RpFP/384ODae3/D//8Yx1919N4lfok87hZaC6lHvWkgPhx3PT2gHk8aFe9t/0UN9JH",
-	"vpHiFvqYt9DTYHZ7T0Z7a+tpHRhE84QzpU+ctV9pkhcHL36YPH8x+eH5xYsfDv/818M///W/BqOHMHZy",
-	"1GEbNWVc5wCQWviJLrQff5f4byCqptdMrIFSzRMHOi2zL91rdwcM2JlDX5sUrHtvmF/TQTp0bKJj84/n",
-	"2HQrZWvPpvtuGjraY7fDbexyXH/s01M/zgZPn8HTZx7R6TNbcQJ1LVGnAWoDunke1rTEPVIBXpndgQvo",
-	"1WcNMmDrwMGh/uBayxu5LGVzW1rxPihiV+cgxFp7934cwd7oQoPrcQNYb3Ejjn2MOPZVz7FhzecbYJAN",
-	"9kf4g/DnDwR/7MoA2GPFbv5ls9xbp+xN++5hcXO/qVq3SCPtnvMHVp/SVMTVKTLVWditdqkpOePLK02E",
-	"/ES4/k7ZU1Wy2wjWAGS7TMl/yk/sxiXsO0I7U2OSLeElKlb2vA5S5Y2sN9x6w283mWhO4NuYZq/65O8P",
-	"G6mPQPAUJWNA5UVjdVRHlXhFpVzqQONoxWpn7AOh686b6AaNQFmVoVQPjnW2Um8LpqVAyKvWIz+krW/H",
-	"1Q82VdHMJSkTRXhqL0/RVwFLN+eaRzQJ04Lw5X9SdRWc5fD01CHY3YjBNWdjori/gLjL0xp6D1PBUXj4",
-	"Uej+YLqCw/K4hiX0io9W/wVi2AN7/fvmC0303IwJL8+7tQHxbFqd26aYthu+y0r+6M7InWYsj6SgkBXk",
-	"PivPzZ1o+ZGATVeG87l9sTsE7kjc04SKMwMXO+dvNJ5bK6o8Rcwb6bWXvKHqT+rzBk6nj9sc1ebk5OrV",
-	"2x8JNOhGKfjPTFy8P3l/SI7i2NlMhWKLIrF5bGpKKqg0JsZkHZOCx38b4KxpnVOQ0swfTEa1THm0yaeU",
-	"XdHQYTBufp2ap+1kT/ikd5b1BDIaK/RID/eDaZovme6Fjxf1xx6j+kQQLcmnK+5O4CsbWKUVuqbG02E8",
-	"oi+h1piuGJmIuVi2lmfTvN9iJYfznzbPdlx3j2ndPaI53EaSfYirQlphV7Lb07kglFz/h1pzdNd2bmVb",
-	"73p3cvXObm5kD4HRX/U4vcfOMYle48fkNX6V5zLAp8LPcGCCFJCy3jrYudfyCNXxc6lPHYHwWizk2vhQ",
-	"zwgZKQbOSYaHF+EA1/KoeDjFHa503eY21+Zx7/ak5fLo5MpN5PJPvJqcifqNoh9Gy+zFaDxaZj+MLre5",
-	"8LXecjZ8gZ3XPgveNtQ4B6gmvZCsLocM4Fn/+W6BUazrkh6vXSBeOyve8iThdcnZtNt6yPLocFTYBO3P",
-	"41HM1fW5y+Ad9oU9ruzlSrPB1QwJoC7Fc1T27/N4FNGMRlyvvtG+HvvudWacfzCujXdomlUHub92p7A4",
-	"z7o7nW7dGuh++5Iq9ivXVxBAEDi3rnasvfuiddF6x8VtL+B1If6XwQa/DKKuzXV9uUvd025btrqUun1l",
-	"cZam3RiT4fcjuyuNm4e53LWw1j3ILee/feT9JNV9phdvzvfPz98Q+NqfMzsK3pw8YMo2pt2O0xcOYByC",
-	"v57GRdtZmk5qc+5+xvwebvfvDuwdtMWAqWGzdms33d+LZhtv+/np27cDe+ju9d1dLZoqO7ue0RydH2nG",
-	"3WXptQvaM34NF5Dfz4wJ5wKVv+6gy5TNt6u1PE65uHOJQ7bf07dvu+I+z1g0VF/BDWj3NCkfdDJatNWY",
-	"jMEOKe9tGGQ7B2yOwKZX7sSdsjful+9fnxwf95zz/cq654l5x5/+lG+8s8pA09cBvAylwDHn7uhz9+pJ",
-	"EMIrVbD8l7M3PeWUrbFruwuyIpmFzH/42D0cblZ0MIrrY72dZZ0h0zFwfP2g4/B7wqBOZUyqV4l796sG",
-	"Q1X359+Dd2kmNriXHvwC/a8dD1WJc1eH0Ex0PUIz0XAJPbg07z8mKrBWNueDBD4KLJjFgpu+9inFo8Zz",
-	"O+DNk6X9KvUllWdMk5g5woZI0b4PrtuS2oVwgf7Ds/P/5015CrWvLdyY2gdVXkPAGT3sVtcNlZ289FR7",
-	"JuPQRVYyZl6OwcNb3GUw5r2aGCuNV131YTMq4oD0gOjJWXxSmHlWDfzrpZDlz69uWVSEz5C5qN0m7m5v",
-	"tWXCRSj+xm64X0RLaKpzxSmquVqs7KVaZevZrVncLsLL3y5TXnRmzzGFw2W5hjUfXUmp2ExQKwUo+YZL",
-	"UJr2XM+cpGbZloRDWb5N+qg+42om4KzBUiblKUmiutiWLMGcVkaNpKbUT4wvr7QaEz41OqK896AqOGVM",
-	"A4xv3b4OQ1Q7Wp888/puJpxuGpc3+LTHJyiyMWE6mu6N4R4UuAqIQjPnK8I1HJwI2jWXxdJ2hiWuar95",
-	"VXfF0dgswZmYjWwPZyO/I5kSXWwCdBIuVPN5IzK3/mZYv/DkVdW+/2WvWjFfPVN7lUyv+PLKi9RfKNEc",
-	"ijWnbB/5o5WrcasJWLM8LVsIY2Chrq2cp/YuIzeK5GAmnplxtGGXZlJNZLY3JUdEFOXMXleDkGUFriBT",
-	"q5JVWT1LkIko6BIACSuWQB4Y1DUmVCkZceB8SxE2BW+7062rPSChGj0/16y5MVHnK3gKRxjPWbLubr+j",
-	"/nKcGVD2rcEUWhNmTCi5Zquxi2ktuVZ3E6LN3LYz75qt4C1n+3S6fs1WYe0FXYDPyzOxyzbZ+4bAQgge",
-	"bOqaE7z9oAxLNWV/5044MUK/4pm9gBoifRaVtfYPmvC47KM9l/m1GJN3Upv/vLrlyiiaE8nUO6nhzyn5",
-	"SVvpvAmfHmsLD64aMNstXVJZYmpqj2av8dpckddwvY5th9XY5dHRpgx/V6eQYmLPeg4VYttvCqr3YF15",
-	"/WX9pE05b9xxofbjmah9fUVvQPtxsUxKPTd2tL2/TgqM6iyHY98osNbuyBYfjmULtEZ9QiMWkxj0sDVf",
-	"qWZLHpGU5TbcLbqaDodLa24t9EEKLUBl3SflnLvT7Ynd8CPT7L9DwMXOysDFbaAyQGWAyuDpKYM7hVFZ",
-	"S6M7pX61BlXbVAF14zF+02YxquHcrbULsHP8DbZwD9zzyfODg+mQU5pbkqrZV2Vz70d39tnmQ7GTm8ql",
-	"Jd9Qqz3op7wjLWWaUD0TdUuUp2zssZ6d186l4YFYTKRwVrwRtz13e/s2RIzaez7nzLRjJgyek6nL2vfL",
-	"wjSC+d6TZ2y6nJK48PeDWi/Lnm2vWinNUuvQMojNXR2h8xVQfDdM6IImyYqwGx7psovg5uHaQuAwgK7P",
-	"KBW+TRkuSCV9e50xuR1WhH/CALw/Ww9JLFwwUBCQSbfEAGCwdTTk7+6ctqDo6N0JOKXMWxcyk4lcruq9",
-	"s8cJlNeuwnZazN22YiT2riUOhAdoEaBFgBYBwgNUBqgMUBk8BDzYsRtdC+5y+1YEc2FlPIRaMUZmP7Ni",
-	"TdpITuDedMdSmk8ccFE0tXb2mPwmBbPeeTN5wFa2KS+ZjJ+pvT1kZpCZuX9m5ooqO8BWlfUTNbXlYJbZ",
-	"g/A0ZkzdkEBQRCV1266YWJ8Bi0+brXGGgw3ej2MWk4zlEzuKkiy4iAMNIa7xAb64Ufh6SNhY/7uSL2A8",
-	"eG0WtKbAuvhXwfIVgZPpym2/DLRwThGuSASJ1zJWFsQDYWVQ59g+bsvQjz20WUjzXN0FALbfsIaZtwNt",
-	"D4KGYADeVqh2nU3YX+YORiG8bBbzjkah+ai8ZuQBbMOyvfmDGYnQ6YaduI1t6Gwxm/P3ZKzEwQbbTDx9",
-	"+PYGnDDrTpIIXRvUXvO2FLvkUgqXFP1uVhaI+TPJKM+VUZnOiq4/c+ZQrZiZMEVwRYwAbmhiFrN1C7p9",
-	"zxTfVjXGIpfKLlS7G3JFZkZws9HY7lj1yTEbvRbmAXX7Q2M+lGoCTlqY2Wk8G21SUpty8QYl/Jdi+Jmt",
-	"AivqbeO513Ha3VNYqRkw26yGcfu73ep5kszEnNlzwA1IkeXlvjYrAvoIBZi+wRG2WpJEyusi81LyAXQz",
-	"wY3F4t251p9nhO0GYmLD9+zvUB6sF7c3fmxseR+N6fsRNKYgz+DDvY8zUfXCGnGygMlVpgbXDJiyg2RN",
-	"/6ylZxP1q6Z/Zy3zZ1Rovlfu6VMCMgaFHUvxnbbV+hnrC5iJqvMVILR2uBWnO/XVRTOaiQ2KxnprAQe4",
-	"nWIh8zmPYwZJ5GVlc+m5kWrgzbxtyG86E0eJkuP2i1EZuWimCVxZ1viOcGV6ppi+XwU2HqVcbZzN7Ve+",
-	"yQktpMY5HZzTneFfM6077369mV0mJG1lr1ubr53AV5qDQPzUTEErSfjVLAp4EHssV4jasU210uy8akNv",
-	"e7e7g8QK7PHqgr3a1/DydCaAn6rMUxG3GavqE7DtU0aF2VK9i+M7Vb0yG5kh9FF4ZaHPfv+814i8q8pE",
-	"4IHAA4EHAg8EHl8SeIhWJnpd0vUNxjl3bY4O1TyqaD7/Vv1MjXvb2eqbVs++Vt/8Olu039Z6N7Fym+t8",
-	"uml/u2frQrvwjZ/DPKNjEarzpEqKwRh7zszbM/001lHjodB8Ur1ROijByPSxV1ZLtQwpx1iUjv1Kdmb2",
-	"s7zRCK7KLHWqSF4I4bJ1rLN/Jux6sYajG2i7S0GLYKuqRFDzS1Nt8+VcyIwUzkiG44wXdqmVcwA6xcv6",
-	"pzPxCoa9XjRXICN3hsKAU5BrIxPShH3hbp+2Dndr+aHHBpjcS7hby7+NMW+PJeathnbrwW8zYaPfyE7B",
-	"bzPxq7ur3F0alxaJ5lnFZ6txefqa8iEbqjUnTXU0ugJo2GAPaO4IcAVLz1JqYNTbmDhv5VjqkK81rE/K",
-	"C6IqJ4Aiz4zCSVYOiDfWTUNTOdOZ35QnIi75DROVvnqm9vb8xtRWpDOxiyYdG722nSYkTUVY07yVJpwV",
-	"Bwc/RDXFAz+wzVpxJuyU8txlTZqVVkQWCsEggkEEgwgGEQwiC4UsFLJQyEIhC4UsFLJQCDwQeCDwQOCB",
-	"wANZKGShkIV6QizUzqlbLgNKaD44C6o+pn2pUPRG8phkhXbpLN9gOlRDDJgTNTgnqk9umBiFiVFISSEy",
-	"RGSIyBCRIVJSSEmh+x4pKaSkkJJCSgopKQQeCDwQeCDwQOCBlBRSUkhJYWLUN58Y1SBKvmZ21PYNwRQp",
-	"TJHCFCnkoxAWIixEWIiwEPko5KOQj0I+Cvko5KOQj0I+CoEHAg8EHgg8EHggH4V8FPJRjztFKpg0lcvb",
-	"wEw4NT/7Xb7kTCIpFnxZWGBAPC44eUns61nQsWvEOSQnC8Ten4/la8tkjFdL4dVS959B1Z8y1d6UHyRn",
-	"qkQx1bjVac86kQJjYK/YtZXzNEt4xLUbRXIwE8+ArANqxkyqicz2jKUCe9DmGqo7fIkryNSqZFVWzxKE",
-	"S6k3XoO5a3oV3uqLF3niRZ54kSfe6ovKAJUBKoPdb/XtC/b7detgv/YFv2NyT8F+lX2FB6A/lgPQRSOo",
-	"j9iYvpnYKagvCKCbV0avPcggvNdByJ7FijZ6zwzA+7MNPETLqdUpMQAYAu5EFwOX1vyK1kt34Vwe9d4R",
-	"Mz8B0XhnJFHF3G0rRmLvWuJAeIAWAVoEaBEgPEBlgMoAlcFDwIMdu9G14C63b0XfkXdDj7vbcNJdybF9",
-	"m6fcITPzdJkZPNsOz7bDXCIM6cOQPgzpw5A+zCXCXCLMJcJcIswlwlwizCXCXCIEHgg8EHgg8MBcIswl",
-	"wlwizCXCs+0w5g1PtMMT7fBEO2ShEAwiGEQwiGAQWShkoZCFQhYKWShkoZCFQhYKgQcCDwQeCDwQeCAL",
-	"hSwUslBP9UQ7mwElNB+cBdW4A6gnFYreSB6TrNAuneUbTIdqiAFzogbnRPXJDROjMDEKKSlEhogMERki",
-	"MkRKCikpdN8jJYWUFFJSSEkhJYXAA4EHAg8EHgg8kJJCSgopKUyM+uYToxpEydfMjtq+IZgihSlSmCKF",
-	"fBTCQoSFCAsRFiIfhXwU8lHIRyEfhXwU8lHIRyHwQOCBwAOBBwIP5KOQj0I+6nGnSA35ZTzKVBrPu3Pj",
-	"9PztyUu/75csSiTFgi8LCxWIRwr23ZOXJEoKpVkesCzsh+csv2EBE+C49nRgnScvif2KuM+yoJvZDO6Q",
-	"DDGYBP3ZYb7WTMZ40RVedHX/+Vz9CVxtE+FBMrhKTFWNW52ErdM6MAb2wl9bOU+zhEdcu1EkBzPxDKhD",
-	"IIrMpJrIbM/YTbAjbq6hulGYuIJMrUpWZfUsQbgie+OlnLsme+Edw3itKF4riteK4h3DqAxQGaAy2P2O",
-	"4b7Qw1+3Dj1sXzc8JvcUeljZV3gc+2M5jl00QgyJjTCciZ1CDIMAunmB9dpjFcJ7HQQQWqxoYwnNALw/",
-	"28CKtFxsnRIDgCHg3HQReWnNy2l9hhfOAVPvHTHzExCNd40SVczdtmIk9q4lDoQHaBGgRYAWAcIDVAao",
-	"DFAZPAQ82LEbXQvucvtW9B3AN/TwvQ3n7pWM37d55h4yM0+XmcGT9vCkPcxswgBDDDDEAEMMMMTMJsxs",
-	"wswmzGzCzCbMbMLMJsxsQuCBwAOBBwIPzGzCzCbMbMLMJjxpD2Pe8Hw9PF8Pz9dDFgrBIIJBBIMIBpGF",
-	"QhYKWShkoZCFQhYKWShkoRB4IPBA4IHAA4EHslDIQiEL9VTP17MZUELzwVlQjRuJelKh6I3kMckK7dJZ",
-	"vsF0qIYYMCdqcE5Un9wwMQoTo5CSQmSIyBCRISJDpKSQkkL3PVJSSEkhJYWUFFJSCDwQeCDwQOCBwAMp",
-	"KaSkkJLCxKhvPjGqQZR8zeyo7RuCKVKYIoUpUshHISxEWIiwEGEh8lHIRyEfhXwU8lHIRyEfhXwUAg8E",
-	"Hgg8EHgg8EA+Cvko5KMed4pUKGmKiSUXgXv6X8Hvfp8vWZNIigVfFhYaEI8MTl4S934W9O0aiQ5JywLJ",
-	"96dk+eoyGePtUni71P0nUfVnTbX35QdJmyqBTDVudeazzqXAGNhbdm3lPM0SHnHtRpEczMQz4OuAnTGT",
-	"aiKzPWOswDa0uYbqGl/iCjK1KlmV1bME4V7qjTdh7pphhRf74l2eeJcn3uWJF/uiMkBlgMpg94t9++L9",
-	"ft063q99x++Y3FO8X2Vf4Rnoj+UMdNGI6yM2rG8mdorrCwLo5q3Ra88yCO91ELVnsaIN4DMD8P5sAxXR",
-	"8mt1SgwAhoBH0YXBpTXXonXUXTivR713xMxPQDTeH0lUMXfbipHYu5Y4EB6gRYAWAVoECA9QGaAyQGXw",
-	"EPBgx250LbjL7VvRd+rd0BPvNhx2V9Js3+ZBd8jMPF1mBo+3w+PtMJ0Io/owqg+j+jCqD9OJMJ0I04kw",
-	"nQjTiTCdCNOJMJ0IgQcCDwQeCDwwnQjTiTCdCNOJ8Hg7jHnDQ+3wUDs81A5ZKASDCAYRDCIYRBYKWShk",
-	"oZCFQhYKWShkoZCFQuCBwAOBBwIPBB7IQiELhSzUUz3UzmZACc0HZ0E1rgHqSYWiN5LHJCu0S2f5BtOh",
-	"GmLAnKjBOVF9csPEKEyMQkoKkSEiQ0SGiAyRkkJKCt33SEkhJYWUFFJSSEkh8EDggcADgQcCD6SkkJJC",
-	"SgoTo775xKgGUfI1s6O2bwimSGGKFKZIIR+FsBBhIcJChIXIRyEfhXwU8lHIRyEfhXwU8lEIPBB4IPBA",
-	"4IHAA/ko5KOQj3rcKVLBpKlc3gZmwqn52e/yJWcSSbHgy8ICA+JxwclLYl/Pgo5dI84hOVkg9v58LF9b",
-	"JmO8Wgqvlrr/DKr+lKn2pvwgOVMliqnGrU571okUGAN7xa6tnKdZwiOu3SiSg5l4BmQdUDNmUk1ktmcs",
-	"FdiDNtdQ3eFLXEGmViWrsnqWIFxKvfEazF3Tq/BWX7zIEy/yxIs88VZfVAaoDFAZ7H6rb1+w369bB/u1",
-	"L/gdk3sK9qvsKzwA/bEcgC4aQX3ExvTNxE5BfUEA3bwyeu1BBuG9DkL2LFa00XtmAN6fbeAhWk6tTokB",
-	"wBBwJ7oYuLTmV7Reugvn8qj3jpj5CYjGOyOJKuZuWzESe9cSB8IDtAjQIkCLAOEBKgNUBqgMHgIe7NiN",
-	"rgV3uX0r+o68G3rc3YaT7kqO7ds85Q6ZmafLzODZdni2HeYSYUgfhvRhSB+G9GEuEeYSYS4R5hJhLhHm",
-	"EmEuEeYSIfBA4IHAA4EH5hJhLhHmEmEuEZ5thzFveKIdnmiHJ9ohC4VgEMEggkEEg8hCIQuFLBSyUMhC",
-	"IQuFLBSyUAg8EHgg8EDggcADWShkoZCFeqon2tkMKKH54Cyoxh1APalQ9EbymGSFduks32A6VEMMmBM1",
-	"OCeqT26YGIWJUUhJITJEZIjIEJEhUlJISaH7HikppKSQkkJKCikpBB4IPBB4IPBA4IGUFFJSSElhYtQ3",
-	"nxjVIEq+ZnbU9g3BFClMkcIUKeSjEBYiLERYiLAQ+Sjko5CPQj4K+Sjko5CPQj4KgQcCDwQeCDwQeCAf",
-	"hXwU8lGPO0VqyC/jUXYbdWfG6f977Pf8kkGJpFjwZWFhAvEowbx58pJESaE0ywM2BRNLLli3ilfw+8Ba",
-	"Tl4S934W9CabMRySCAZj3Z8E5qvLZIz3WeF9VvefttWfp9W2BB4kUauETtW41bnWOnsDY2Dv9bWV8zRL",
-	"eMS1G0VyMBPPgCEEPshMqonM9ox5BBvf5hqqi4OJK8jUqmRVVs8ShJuwN969uWtOF14ljLeH4u2heHso",
-	"XiWMygCVASqD3a8S7osw/HXrCMP2rcJjck8RhpV9haeuP5ZT10UjkpDYQMKZ2CmSMAigm/dUrz09IbzX",
-	"QZygxYo2ZNAMwPuzDeRHy5PWKTEAGAI+TBd4l9acmdY1eOH8LPXeETM/AdF4DyhRxdxtK0Zi71riQHiA",
-	"FgFaBGgRIDxAZYDKAJXBQ8CDHbvRteAut29F3zl7Q8/Y23C8XknsfZtH6yEz83SZGTxQDw/UwwQmjCPE",
-	"OEKMI8Q4QkxgwgQmTGDCBCZMYMIEJkxgwgQmBB4IPBB4IPDABCZMYMIEJkxgwgP1MOYNj9HDY/TwGD1k",
-	"oRAMIhhEMIhgEFkoZKGQhUIWClkoZKGQhUIWCoEHAg8EHgg8EHggC4UsFLJQT/UYPZsBJTQfnAXVuHio",
-	"JxWK3kgek6zQLp3lG0yHaogBc6IG50T1yQ0TozAxCikpRIaIDBEZIjJESgopKXTfIyWFlBRSUkhJISWF",
-	"wAOBBwIPBB4IPJCSQkoKKSlMjPrmE6MaRMnXzI7aviGYIoUpUpgihXwUwkKEhQgLERYiH4V8FPJRyEch",
-	"H4V8FPJRyEch8EDggcADgQcCD+SjkI9CPupxp0gFk6ZyeRuYCafmZ7/Ll5xJJMWCLwsLDIjHBScviX09",
-	"Czp2jTiH5GSB2PvzsXxtmYzxaim8Wur+M6j6U6bam/KD5EyVKKYatzrtWSdSYAzsFbu2cp5mCY+4dqNI",
-	"DmbiGZB1QM2YSTWR2Z6xVGAP2lxDdYcvcQWZWpWsyupZgnAp9cZrMHdNr8JbffEiT7zIEy/yxFt9URmg",
-	"MkBlsPutvn3Bfr9uHezXvuB3TO4p2K+yr/AA9MdyALpoBPURG9M3EzsF9QUBdPPK6LUHGYT3OgjZs1jR",
-	"Ru+ZAXh/toGHaDm1OiUGAEPAnehi4NKaX9F66S6cy6PeO2LmJyAa74wkqpi7bcVI7F1LHAgP0CJAiwAt",
-	"AoQHqAxQGaAyeAh4sGM3uhbc5fat6DvybuhxdxtOuis5tm/zlDtkZp4uM4Nn2+HZdphLhCF9GNKHIX0Y",
-	"0oe5RJhLhLlEmEuEuUSYS4S5RJhLhMADgQcCDwQemEuEuUSYS4S5RHi2Hca84Yl2eKIdnmiHLBSCQQSD",
-	"CAYRDCILhSwUslDIQiELhSwUslDIQiHwQOCBwAOBBwIPZKGQhUIW6qmeaGczoITmg7OgGncA9aRC0RvJ",
-	"Y5IV2qWzfIPpUA0xYE7U4JyoPrlhYhQmRiElhcgQkSEiQ0SGSEkhJYXue6SkkJJCSgopKaSkEHgg8EDg",
-	"gcADgQdSUkhJISWFiVHffGJUgyj5mtlR2zcEU6QwRQpTpJCPQliIsBBhIcJC5KOQj0I+Cvko5KOQj0I+",
-	"CvkoBB4IPBB4IPBA4IF8FPJRyEc97hSpu/0yHjGx5IJdwM/tKfOqfAY2gfmvXJCTl8R+1HDKJzxaGcPa",
-	"zKtqYRrJMFGkwGjdRsYGkUovc6b+lZg/VBrPawLpkV6tjSHhGW1SOOUD0ML8k4tfFBsdLmiiWGcDOJVx",
-	"RXmdQtvPoRA3/1xq0lyx/IbFoK6g64HvunaVq7nWGmhEuw2vzWt2+1kkdOnypETMI7DgXP6PEyxXFn/O",
-	"VzBnT16SKCmUZnlt6s2lTBgVRiIJVfq9a/1PTDi01x3gN8H3vAEImTg5i8z2tayelmKx2NGSAiGx1CnP",
-	"v/wYpjwHzNBA6W+4CpC3PS86W84W2DKqPYFWpbBVSLqeSgbDwENWNM34P1iuguI9On3tnjXm1Y39jdka",
-	"UlrmhpU2sRP0omr3lJwboefKq+9IihuWw/jIpeC/laUpvx8mNpUOWD5BE6s2rfmQ0hXJGcijELUSvH37",
-	"VgI9uJCH5ErrTB3u7y+5nl7/h5pyuR/JNC3MTrBv5JjzeWGM8f2Y3bBkX/HlhObRFdcs0kXO9mnGJ9BY",
-	"Abb9NI3/VNJOIcO83BDLf/xbzhajw9GfTMWZFMaK2Xd93Q+MeUeffh6PrrmIu+PzMxexw1w1+74aBs9X",
-	"nr06vyi5MjtUbjZVKKYaICNcLiBVE+wN5yEiTMSWWYYggoSbZaWKecq1Ii4lEYwccly6JyyrHE8Nujim",
-	"KUuOqWIPPjxGeGpiRBYcoJRpGlNNa0bLuuV7ziC/NmAcvrphuZmhy0TOqUHE7sX2EpM8jo6lWPDlptnw",
-	"/vXJsXuzvYfUCgntIeda5nTJjhOqAi2tPyVxmWkMCprmNGXaDLzRh5RE8BJgRvgIfrbmxqlZ+Eozof8h",
-	"k8JYum7DjFeCpjyCmIAslzfc6ofpTMxEvW6mXPiEmJSGVPy/SoO3JI5dzbYpNIpkXkYD6AimPBfkPXT+",
-	"LdN0+o6mgQvJwbqxLX11m1ERVnKht4yS+qSMgQdp0oE2mY/IDXxFmPksDu9k9cnWHhMqYprHbil+p4h/",
-	"98EXSNmoQfvXL7CIX9LousjcYJ6aSbMGwQYNBltCKchq4nUHLoqYUg4FdNavM1rftWBbljOwwkeHOi86",
-	"lb9pQzXljV8zqwrl1OK80cbh8ObzeDQvomumTavCOcdRIou47L19e99t5CyHhoW0VaOgQDMWMo/YKdVX",
-	"53qVsNortUmYs2Xf54pFOdN9oi7yJPj7Dcv5YnXx5jxUX3gOLXMaM3u0QH2ooyLPjT7pM0FAcvadytPk",
-	"DJCQuERQ/u9qyqX06AS+1jRfsvWNEexW+wa0i4SpZHtq0X4QV/UJ5zShYssl9b70JPpqM1NIJ9ecQTTV",
-	"UaS9D3mQbeLadUHVdWjCuyq3Lq9b1gahHGVmT6FJj09AyInMvEHjgYaxIXO+XDrtXY6QlxMHUO6VQWOo",
-	"Om0AAXRmbsqUMjoitD42z0KjfudUMY+DQrPRDZuvvgUWmIuQo+qaeBYsUKpHrzmjsYHmQuoz909jvNBc",
-	"j8qhtHg5jGe7wlEsP85ZbPYVmqiugDKq1CeZx2HNooxRn4bE11fZKctTXtEgzcqYoPOExWH9lzW/7Fro",
-	"G5V7Z7424b2tO2SX9eoSbzx6VWJ2+87CXRRJcizTlOtuK8ej28lSgmNloq55NpGZ1RoTsNJZbjfCz1Cm",
-	"ac67oLiHF3NTdeVuRbQjhGvNqkof1zsdkiiXYAfRjKc0ujJ752qaXS/ND2pqLJvpzfOp2e6NZRhwGbgn",
-	"NTPYm0PuAJqV0FdM86iKLqzurR8TLqKkgJWXlGTNDc25LBSxjhynisD5XoIuA6rg4nvwb8PhMwvye2XC",
-	"jolv2OeuIWusBC6KgErxT6B8xwc7z4tZYfA3JQlPuSbSsZ5FOme5qR6mP8mZLnLBYousKwdOjTQzuBAO",
-	"cYHTckBU9IbyxEx766EvuXCZ0X8VrATp8yrugCsFD+zJQw4weqxfw5ZmCKx/Biwy8G5oaZqZc3ZjD3uB",
-	"TdiRa2VLKrkfW6lY6ggCBgC0OMrWRTPPGcmkUtx8yRf1nkYAswrnLjL9jq6oWLK4PDBIX1GzfyzYJ5Jy",
-	"URhxweAalefDBPzQew+KZUi8tC1rX6jy5KZyJK0oy8gD0K8RTbyknKTtWC54Di4ulUmh2JgUImFKkZUs",
-	"bHtyFjFeilLLayYsnqeCsDwHBz/sYkGKMWcp5YKL5WvN0mNZiIDPqvuO975V80wVc2WG2zyDKedaD8Ph",
-	"HNkuqN6urhrbAe/4Dpaco/vVTiFvQ/uQGZk7WXu21waat2d/2XLfKEUKcS3kJ1EyVLYYPxQJW2hSCFhS",
-	"IiYy5VpXHKViOacJ/82F3tQbCqObZgnTjDxjHOb/nEXUoA6uvS8+uirENfAF1VMQQUlnK/fSXtUfF1ov",
-	"pJ2X7T7ZjpQZA3fqiXcLySQGY4oKcvN8+vzPJJbQblNKVYed+0brCzOMphPO4gnPlO+Z0jyFc6e+t2uQ",
-	"/wafmIWbmPGDRhyDu6l0Hpp6cwaKtK9smxcBOiJ3f7BbGulBjl2wjeqrNwTfc7t2qXaLFLjBSo18p2qu",
-	"yzpeqLxv1pdrXSjePR65nmpJYoOOUy6YVRZOvdmV7TTSlPwD9IGn0zUcQAb0k9PEtSLNWFsNRQqRytgl",
-	"ldDoujy4C1o+JacyKxJaRtMwYhNCpsSYjhOzhT24jyKSwuK+aDWBImQyoSKelOo8WoV0lmLJ4g0XAYPZ",
-	"P7EO01/O3rT9pOW4DOr/TMzEyavTs1fHRxevTsjPJd9lV5nSMiNmF6dLWpXvWHJBnk9fHJgZDCk8TXXD",
-	"FYA4YXfNOUxuecP8Z8/9Z9Nh4HKQuWTJo2Ojc4KOKv/QOvdi5iwBM/pmJZmpTeey0BBzknFXHllQnhR5",
-	"w2iKqGLKzucqH8jsRNYzyERkVi9zR7i1rGEjnzAqt6IrNU3p6aba7t/UWiFmDKC2sVkhBn/E9ug7Rf73",
-	"+ft3bdX3FjzhsCORWFplmUmlF/zWqCDbcYO9BAOKnmo705mx/QxUsJ36jeVywkXMbs2CJX+3x8gZO4Rm",
-	"GaN1m0KKyGLTWuwONF75pC13CN0VvTHibMlwSt470xvm56tbarYddTgThMwAlc5GZFKbbOWPTpF6V0t1",
-	"2KD5EDaTDweX0wElWJPENp4JnUNUsytiNgr740sg3Q41uypSKiYGuoKBV3vsx9ruk579MkKYEhtNZJvn",
-	"jFC30EEzTsAUIpSYchsMZN30oSrIiBG3irZu1Gun+ptRo24PBxOguZxK+/rel/kJ05Qn6p83L/rWunuj",
-	"EZJceaVItSrtCnt79P/5vdarS2tIa+kVRv3zgNaoWXhmNZ+B9KtFTcl5HVmVZOQnU3u16Er7RjFdmQyw",
-	"NdoAXr94XAywTeOkOrLBID50w8cJwEmdZekWHjn7gypVpE6/ULGq3vLzDQbX6L0bmvB4bGyQQhj7yVUS",
-	"wHiwysPa7dhqABcfZxWSB2NuqELHQVqheWFaXTwl72zcR+Op1UZ+rGyZLHaapxHls86/t/VWE3C0QEx4",
-	"WAo2XLwSdVvbh0TgEHm9r8H1HuZXIX6ei/geKiXvhTt4N3NxCFbmMV8sWF6RrA7UsLiq4mcu4q/NmYpe",
-	"WgN4u53lQ559qhCNVTs2bBGKtxjRc43ObxPv9Whuna+OFprl5yySpjuh3O8yoMvmIGuewrar7CdkzhbS",
-	"nStbjlct7sz6IuIpOTcj6swXS5tb70mdIgf9o+k1g009AUSgGaGAbMjE+W6lKgvSzd2rLPNKfiKJtDTo",
-	"J8p12Up67Yn+dvGDEvfHo4IHJv8vr0/aozntHaZyvPuGqj1/D/f3qxAxM4NjGan9QrF8six4zPZLTJWr",
-	"PxU8NCt33AbX7H+2a9ZV4zZsM0oRTZJGAol7w3q0vPcJI2weOsImknEIphTLpdWc/3lxcerHxrxbBXpZ",
-	"zTMmB4QvvPNi4BpxG+097oE1OwwjfO45wmcHROGd+N5V4/X/dFMs0c7ToiQtdgIgn65WrZa7eBnTudno",
-	"79YOnI1cR3dAJuTIW+pRQnMXGy/s8nNShOU3L4zCZNbNKW9Ynhsrk4fzWurBsAHN3GDcuTWsjNVxSGaj",
-	"8wLiRgwWzes9ffDpaKwJcE65xg/YqmzoRZFzvTo3c8RuFS8ZzVl+VOgr8xdMHqAy4eeqWNOH0WdTBrCG",
-	"HVn9iZgiLHFg0ySPkqS+golnH49OX/vsCvLRfCRz5/04JLYx5Wkg10zYc0A+kisAztagowQgjiMXuCBZ",
-	"QrmYaHarwQcBCQ/wzBkFcu689fOV4z8+MtuaSCfuVaOx9EdnTMAfdl+0T8ENk3MD7njJIKkoZ0w4Ip/r",
-	"hAFHnkdS0LK3djXWyMbD0fPpwfTApXwJmvHR4eiH6cHU7AEZ1VcwKvuOTZ94aS+Z7olFMPJc+ta6zyyg",
-	"9E6+RhyZWTAlfeWWqA+Mhp6U8/x1PDoc/cR05Wc8tu+9tryxB9DQ4BcHB542ZJa0gYh2Oxn2/9spFieN",
-	"DZorXCFMvvb+C6tvUSTV6jSC/fEeG/PKWMihyn8Rqqf6P3+J6l97C8o5Pph7cTxSRZrSfDU6HDnxeaJf",
-	"06UaHX4YVfIdXZoP9pvpSOunmjVy6gmftfi2lAq6tOvMLYDQnDKbTi2U7gFnUlkLRL8PnkENIb51fRL1",
-	"FntR2gwA62AaXX4ej24nTrNMvGk0sUC2nvPVlPn+7+W/P+/baMCJW7IDxsOFBCRJK5AQUEFX7o2YSgiD",
-	"rGIiDz+EwodslpnbBLvBiuY1o7ZGHrFXHR3Vgy9shGQ1bO3t6vIBp0Gz09vNBdQmfiFAPGtrktWWgou1",
-	"dVKGxZBJtW7qRvbqIkKJYJ9aJcNG/v33nk74/nsgFD5+/Gj+87v5H0JmpS08Gx36HyvWwdhn6ge/lGaj",
-	"cfMFl1Bo3nJLtnzl89hXYMysVuFm4vrCG4VW0bj2sf37eeOdMszYvmL//KdNX63eKiNkXT3wZ+ctG2Lr",
-	"elBMIiZ0TpPJ89mo3ovPpdzuJED6W5GzB5QhlL9WjGW88lpJuhb+k0bA5v3T9mCNTFvv14XbFlxHkR7D",
-	"xG1olcemScHufinj1b3pjkCnXUx+QJ9cdHpYBiAAwWyXftzp1+cvtQvgBnAHcxIGrTtz1+wA/eZQ29AZ",
-	"bhPZZ5/txpIwzdZsMfYFFVhx7TNOGfloiv3YNZtOoIytV/u2C32rNT5+VJbajyHXKK6ldWvJTqqt1tJA",
-	"F0Bomke8M8899rcnx34sp0JgAfzENM7+L45TcIfaflX9xPRWSwqO9FmzqCzVsNX2Qd6LZNU6wcPFgPhY",
-	"EU9gBCzLQOIjrrb7t2X780uH2bIwIGqbsUZL9ynpETs/vryl65PpJt79b791t/du4UxpZ+X5rnQOF6pv",
-	"/L1I98SV5vzJtvfb6KX64n/suiHc2R690Cfnrw52B/eiTxW8OHj+5Rtz7HIJnIKw7Xjx5dtxFEUsM0OG",
-	"OrGN/ntmfEc5blCKvZruDtrxrg6BvsXbY9oBn7pBX1pY9zj15Xib5HAnC4i7MTpsIQsRu4Dit85p/ME7",
-	"ii99KcGO+2CxhzJHXy+IYnrsklZKg5TFpMhs5ihEG7WsUzgruGpGlDAqiqxteXeaUR058ZBAcMuYQrTw",
-	"7up/2UqbDXTAPIBa+Ylp1CkPqFMuH7Mlhku2cu48JuvDlCxzdg/gzJV0P+jszBb2B4FnvrdD8ZkX9WMD",
-	"aGv68RUQ2prWfFmItqYhiNGGY7S81AleTXrBbqknS513F0V5bzjNL+L7BmqPRXVuZ1U5aexmVp019OJT",
-	"sKsQI30tjLRem9wVJd3Dou7CJFzRTxcp3cEkwpW7BiqtX7ZZoQcS4Q+xci3hhov3CyzepwHJHG+OkGx7",
-	"SLYoEtSFHS7/cWGirRJ72k1Xa+4I6su3as0m9TjcQ19mIWPCzw4JP53JV1swXs7ECXr7pJ/OqtxuZgcd",
-	"oH8Qz+fg/fWxuTofyYY6bCdNVg/s4UTX5k6uzU3aaPg+vt3+vf+73/7Bp7lfC9S767buuCy1NQ0U2N9f",
-	"uuY8Kei0G2Raj5Xqo/W4qWG0Vu7RWvFr6msQxB0dUSeM76wkfCHu6qzO8x2cMAE9cuabjIrkCSkSN2qo",
-	"Se5Tk+TVUvgaDoN7I0/vmzRF1YChrEjTPj6adhMyuitPe6/8LCqPp8DE4qq8Hwp2o+t0EAd7v0Z/kHnF",
-	"ZfnIOda7OX8fAamKquTeGMyv5/q07oyqm1scUOrvLaw+7g2kuFdD47hqLOq2J2By1MYLNcb9xH9F9SXw",
-	"dTVH8w7foWcbV1/13j58z0qj1k7UGk9Ba5QDhlrjvrRGYw3ck9qY1Eu9iwbJuM63UB2nkgs94WJywVO4",
-	"olbeMLgmbiG/kCo5NQ1GHfIEdAiMFGqPO2mPDWvtS9sdTCy5uCPf6r7dKRjjlav/jxBrafuKlON9UI6s",
-	"nDed5WLFPHS1+IK2WCz7RbbMacwmWULF0JWTMQFX7Vrhypy4QlTzlNF6LOdMHJX3cyarMeGa0ETJwP0S",
-	"vnB3fZ29ZhyuRBOsvLE4Y/lC5imLyUy4S+rMPk0XZi+yrbF385dC9m31bbHXHd88nz6fHozdJelGe6Wp",
-	"vVdcS1Lej256buyGTn+n9vYZe3G5+9G8bW/ljVmWs8jeli2qu9Frty3fPJ++mB6ELYpfbHGnZly+ZY1S",
-	"7yeqkjvtw37mZXaueC3y3k1X9aX0xz7Nslze0GTAGRmlyghsw+VC25D68AQW8hFIhD26xfwQh6yWXTzy",
-	"0yAwp89s1TAMlaJuIJL2JBhKYKDi2I5msLN8ndi/qCapIp62jVVwLb8fBO9MrqcB3plv7FNB3U66uNHv",
-	"5q4rx30dYrhDjvfuK6kZYPAHX0wPFxjQv44ed1wArv/7CgsYpALuZ6tOpeBamok94UJpKqLtvGzV96T8",
-	"3ljNtOMoCPrX3pafvy5r/2PcZBjoObrcdnC5hSZibQVV4t4+tTlQtEWooSfV1ekwyxT5aGbVR6efFdPT",
-	"mXhJFYuJtPjXP78yqNPszprfMHLNVvaK5EiKBV8W7jJuwVisGmWdF9EVoWpM+MIWdUiyNP04NgUK8tH8",
-	"Gwqrf2kgHDcA2l3C3KijPzu7O2W//bvyun22slh/ycjb/nnx9ZK3A8OHyuau2cuBld+vbfq36uD2u+V2",
-	"fdd8opDy2vIqvbtpBK8MwjL8Mhcdvd2m7j/WzXo/2rY+bPUhDSmktiEKjzEppzVZBV234Ad6uXZagT8x",
-	"vdvye/tHWn64jeLaDjvettrJt7lmcKfVbV0CuL9+bWvfjsN6az/dZO1/lasDUU99O3rKOQgfGnRkLE+5",
-	"UlyKAT7AUHhP+XkZi1soltsQH65IVOQ5EzpZkUQul0CvgyPl+1e3NM0Sdvj9TBwpVaQ2YX4hk0R+Mr09",
-	"e3l0TDKZ8Gg1BqbCFKvIR5rwyHMXczn/eDgTcM9/Nia5TNhhzG7GlQtSjUnOaDwm37feaDtMx+T7Mfl+",
-	"v/c1H7rYeG8u52tfWY4JNLcq0TXWqBAjUIg9sFJtdb8tWNdv39vfZ4KQ2aj21mx0SD6YX4n/j/m/2Qi+",
-	"m43G9d8q8bQeGFm1fvp+NrJ/Xo4Hlt4WbbfA5t/7O1ThZb5FHeY/lzPx2UnySMSbRF+fZsMFP5fzh2t1",
-	"MMRMsfy0tpwfMsqrVRU6le4W6WU0ZdYYMq/Zjwp9xYR2DSOz4uDgxV+I+VXm/DfbncvPoMFlPDEtiovE",
-	"qHdQmXw7RieTMamKIL4IH651XcxZLsCJ5NMLemKnT2V8XpZzCsp7k/V60iKrjdlnd49TGZOqNGKLM3uK",
-	"G7F5woiW056DVGxxF8aIrFuVTBSpkW92G5mWqTSejyw3sMyZ+lcyuhxvNn3PrMb2m2C4odCHK6oI1SRh",
-	"VGnynORFwvoafEXVWZEAg/GVTnsJjB7yUzvwUz3LqrbKgzNne7YqVNGqn9QJr9KHAFehmnoQVbAPX59B",
-	"GdgDXA+DKJTgIA9aD/3Qpm//W7M37v9ua57cjUUJT9U+P0/vUWx32Czrrp7wot8u7y/QhPW5fzW54V1S",
-	"f7RDyu6+egeSIzsvrJ+YxlWFG98jg3l3XzdDzxTbeeE4n/cfbe08dov3a8T24sK/T//9l7Z4/btbnc1D",
-	"MxpxvbJJtzeUJ+BbKYvya/PnQX6gn5iuXqzOtHatesCJu6ZWnL/bI7bq5Oxy6PykrSTtfJCKgQNzEJLi",
-	"4oYm3O5cr+wMh9//968XRMtrJvoR07mrZqdIqxd/fXgBX0hJUipWhGrN0kyrRzW0dam/kUtZ6K0dzxsd",
-	"VFypovRPlUMLfEoil0vLZ9oDsY1qqTXJJe+WAcvgJE8LpckVdcdqf0zkkouPoLjmPOF6jbOrPmceIE1W",
-	"NQ8a69nqoQ/Nw5jud0PPctN37fz+IOtgEIf/xVoZTyk64A+7bFlU5FyvRocfLtcsYi7uRB4ppjUXyy24",
-	"f8jfd195w8C3BUILksTmFIQMg3Nf3QOaAWUdgyf3GinXGuyF+xMTLKeJPRPJSvGG5X77Gy5E91FbhuY1",
-	"OwlCOu0f9qPX9jymB5Ohq2Y7EZZC81/3y6wp8d9HLxnNWW4mqBkAg82sCCziLPJkdDjav3k+Mk9cmW0Z",
-	"G/mt9JXZWHKWwOkOWrbN1toFms6WrpkyXZ6vv8z2CVi1EjuHY92p3Or0qXaxPudyh9aS2s1Arvjyhtpd",
-	"iq0uLnOluuuatin0ZTtdqFEU8VdiDC2yCnyqiqpFTQ0thjY1KgClhjotCx+ie7u11hdInrpK5rLQvfq1",
-	"qrGxuHaYbOR97awIV3b109CCy+ABuOIqSaQRhFiSk5dl+nImbVqakHF9Coah8OfLz/8nAAD//7IahPfG",
-	"BgUA",
+
+	"H4sIAAAAAAAC/+y9+3cbN5Yw+K/gsOectvORlJ2ke6f1/TBry07G3bGtT5I7uxNq22AVSGFUBVQDKNlM",
+	"Jv/7HjwLVYUii6Jky+4750zHYuF5ce/FfeO3ScbLijPClJwc/zaR2RUpsfnnc5xd19W54gKvif4B5zlV",
+	"lDNcnApeEaEokZPjFS4kmU5yIjNBK/19cuz6Imk7I8pWXJTYfJxOqqj3bxNcFPwDyd/gksgKZ/bHnFSC",
+	"ZFiRfHKsRN0b/ycqFeIrxEIv5MZBiqNaEqSuqETL1jIm0wlVpDQTqE1FJscTqQRl68nvU/8DFgJv9N/L",
+	"OrsmSq8q2by1nMT3FRcZOcXq6lxtCmK3tMJ1oQLAXJcl5wXBTPdhQ5OFXfa/TicfZ2s+0z/O5DWtZryy",
+	"RzSrOGWKCAu/36cTQdbJxY4fwfb7bUJYXU6Of5nI7ybTCf61FmRyOe2vuhZFcjc3RNDV5uKn8xZU7Cl3",
+	"gWLW/c+aCo0Iv1gItc7GdWnm58v/JpnS87TwV2qM0RMGDPg3QVaT48kfjhoCOHLYf9TqmsKOE1zhjKrN",
+	"aYGZ3UaMnacFzkhJmEFRjCrBZUUyRW8IyrHCSywJyopaKiIQZ0hdEZTVQugOjOdE9mikTaOjthCv8MR/",
+	"Tm1lRZXsb+FC1ATRFSI3RGyQIFVBM4wwy81qPV2vqJokMdns4jZLfcPzJMArnp9nVySvC8rWp7yg2cbT",
+	"Znvh+lcNdr3MiudIhl6oMt00d8jRigvbxB/VFJGyUhu9Z8YZQf677eMG1LhIpEJUmlEm01vTkmzY6ljQ",
+	"BHTsEIU5wGmMI83w/ihS9JHGkOPfhnCvD+u3zICasDVlZKrx/ONGAy7jbEXX50TcEDGZTshHXFaaCU5s",
+	"ywPAllX1a1oUNIGxJ6fv/PmQHC03CHu0beZjdbkkIp5vcjypKVN//l5DpCQlF5vnG0US4782Hw+dwrW/",
+	"HXGcucl69NHBiObIYoi19xctZRdyGJrs4YW+bzPNzQpyEp/KODhEvV+3oT6y/w2mRW/uPkYwrtpHpsmY",
+	"fKRSWY6Qy8l03ylfj0CTO5w3LRaMp5mqwIyR/GQP0rG8zqKHZZG5v6g0Pxm9dDf1633p6m7mTwsPSbwd",
+	"RMgkpg3gQgLUSRDsojdP5j2S81d1+sJN3IU8JzEsEY2gGV14VKGcE8n+qNo3+i1kTCx5QiA6M7+3lnIX",
+	"E6Yuwt3ANXiWENq2SWmKa8A5Uc2NpmWInqTme57Yjru4+4tO87Sks2uU00SXLnC6C9sFp0jv6/B9j/oD",
+	"9OwHQaFhELZueFGXRE5RSaXUfJCuUM2uGf/ARrOUYRoYgXxebr0rbHcsawASF1zhAkn6axBIHQBGb9at",
+	"96TAMjG+OyKU6c+dKabmD6ddIdlq6aRXDX3TqIvsHjqSKMQPEtf8fvucyexbr9k1QTy9mGZyPfJak0ha",
+	"/G1A2zmWJKILghVpaXmnWOBSHmbmqPQYRBHR1+BwlhEp/0Y2SZX4i7CBdJBbc8KC13nYvW19lHGmMGVE",
+	"IBYp6J/KdtJe5DMNBqHJgDKSIzuFWZfHt8ZCZf588ebcfrb2KnSlVCWPj46u6yURjCgi55Qf5TyTep8Z",
+	"qZQ84jdE3FDy4egDF9eUrWcfqLqaWWSTR+Z0jv6QMzkr8JIUM/NDSyvCH+QsJzcpUB1utJEkE0QNId7D",
+	"NOk0xBKvf4up50X/1m0jQqcBotIc97m5QMP95JmP4z0SPTt9Ne+TckX/ToSkqbvm2ekr980hnZ3nxv5G",
+	"rDmixBb7qESCVIJIwpSxjeqfMUN2X/MFsxq0RPKK10WOMs5uiFBIkIyvGf01DCc1wet5CqyMeYIpIhgu",
+	"0A0uajLVZpsFK/EGCaJHRjWLhjBt5HzBXnNhLbXHAe3XVM2v/93gfMbLsmZUbQyBC7qsFRfyKCc3pDiS",
+	"dD3DIruiimSqFuQIV3Rmlsv0vuS8zP8giOS1yOLbr0Gga8ryPjT/Rlmujwp7yjVrbYCmf9LbPnt5foH8",
+	"+BawFoZNUxmBU0OCshURtulK8NIMQ1huqMf8kRWUMIVkvSypkl490ZCeL9gJZowrtCSornLNoecL9oqh",
+	"E1yS4gRLcv/Q1BCUMw22JDxLorDG5ohaG2qRFcl2ksh5RbIWDudEappFUmFl2Genwzxt2X/HJF6RE2MR",
+	"qgVWabIZaIlWlBS5lVYUR4TJWugDxvaMDHPPMEOZuc9RFveVqGYrqgxxV4LndWZGrM3pLNiLcLseo8Hp",
+	"P9CiQO6kZV1VXCiS+7tiVevDQYIUBEsi50lLqL19+zt2koPjQ/6OrkhGVzRLO0oI08J0gkxe2g+WUlYF",
+	"XltY6R/dyDLe7xydmhUbESFfzvWsc9tu7qylRP5yOXfz6cEMkvICEZxdeYsqQZJogUeRYmMMw52hKqpE",
+	"aozTVxdnaVjpHgm97NXFmYdT64AbK6+mWX0otCSGOWqbdQ98y1jaS8s0z7tN/LyxxNBqhD5cEWF1bL9O",
+	"t+UFu+g1LmtpUMmia0AkiUs7hZHiELZzJsirR+a3Qgm90CT866rgOH/FFBE3uDhPMYl33SaIBUlekoyz",
+	"XKIlUR8IsVtbUlbwtUR2aLlbmvc7St3yATkTqpD/ZHdcONHY01XoGEm/yaN3Dbt06X9u4d/8E6HYyZnl",
+	"eBEzXjAvtxqjlV7Mw8U3M6WD4GS87D4EnP5Qsbis7B15wiuawpOzdoMwfkBid+KZ/aw4EkRhqpmx1Qgs",
+	"6n73bQKTGwQdxs/AyARnW3bSIYo+XjVHMfUSdBgtRTo9d0GvhZYWzo0AlRYN7LeAhNgIy8iJXPqOXXKu",
+	"pBK40lIZRox8iHT4JJ0MzPY8+tolRPujORZNAcQIb5+IDoPf0A4vPw3JVVhdJS5FrK78inULrwA4OK1o",
+	"QY5yKkimuNjMb4VgZuIULuVLt1678zR8XzzvNUpB+MXzYHVyS++fbR8kO+UEIxLMKJu1RII2+06ZbtO4",
+	"H1b+7uJEo71DQDOo1geQRgOts1bKYkiJ1TFaTL598uTPsydPZ0++vXj6p+Mn3x8/+dN/LSbJU/Z6eNCd",
+	"7Wq6Jp+LTRUWo7toMPrdzSfToMa7zlYdTGjyfQaQYgnOW5tg9vp3v45gsbPNdwix9ggSVmPzux/TDdU9",
+	"rx7YMjGoiZ+cuU+ItvUXp4t7DDw589Yybbaxl2vNciKKjWZkeu1YcaEVPG2sdrsj+dSERhCpZr6J1Ras",
+	"3c1RvJ/L0Xs02IK9eXvx8hi90/qj1WOpRA5WG1Rxo8ZLhYvC7N4orQXBRpTGhkSwUH4T2RYGEnucu5eh",
+	"/dK/BR38Q9fE7VdSRkuNbU9TN2Gj7CdmdZ8QFt47ZX9BBTW6tuaxRtNoL8MeAePGMD3t9dKj6Y+0rLiN",
+	"zehgXlXr/2C2ebuaHP/yW3/VPcPW5TThKHXAOjl91yzB8dLSRV9UWCkidIf/79Fi8b/+Z/b4Px49+uXJ",
+	"7C+X/+vRYjE3//rm8X88/p/w1/96/PjRo1/+9vrHi9OXl/Tx//zC6vLa/vU/j34hLy/Hj/P48X/8m7EP",
+	"NjbLmeaGXMzcvrxp0IYDHAwU58B1cLGDftmgSTHDKGAn7YVps67gcdl65WRpx86Jd9PEFmrT1vOqEL5E",
+	"hKRSEaacI0U3o8lbU/uhDj7rc+3M8guLHFvD6/hSDjwWhwyohsXo37bcyu74TcPmPq4+ZhoUXKq1IPKf",
+	"hf5DlvkybWSXRJwbq7dMy1bv2g2SSpL5jJwvxttJ9cjuU9JqeDN0mXauUrdJ33yXdNm4ngYN+CVnVHF7",
+	"Ir0AkfAt8Jjml+301TS08kUanq8TrbpAxag7Fjo5cxpAt//dKwGjrlOvmrUvRmcL9Qyj2cU8xY1omWZH",
+	"tJTGqNIARVrZ000+DT42yowEOPefbOfpghkbBhZOjzJBPlSi4C00MtGF/olKhBnCRXWFnf1XWxcdQjn7",
+	"msPoBXuxYbikmYfCs8IbRNCKYGOfXWNFmsHtgHqWsqxNtM8cvVLGiMxZsdGnJok1Goelyfmw3egs3iYS",
+	"ZEUEYfo0OCOIMKUvRoZ0gIYGSqu17J/AFkuIwakSq+yqhZetaSqezxPAR3ylwU/0MoLBMoaFPhEDhhJf",
+	"GwMTVg0WhTCOBaNM0pwgHJ1aGlsHQmzOmvDZsIfsikvCDMBxE1/rY4UcOHN7nVgJ0IQtWfF7o640JgQP",
+	"jmmlhy9xHq18iri6IuIDlWTBzDHb0aWOjWhccWbu3cqyOaSdRpbOraOJZ1bianZNNjIepd/KDVPiSg9q",
+	"pdvhuIS9L/QvRDjtxjoYGd/+uHQeqRJ/1CoIwiWvbay9jo2qVaNRhIiItENum1e/dbEclZjhNZmFcWcN",
+	"cziaJFDBuwv/1c/NUXzv5CjbeXKe5CzRh4GoRLykyllaYl40NXGM1oBiBGWHNCbMCRuuQz5qTZKqYoMa",
+	"RX7BAnfQvTDTKmRhNBZz+DN/tRnv87xZSma9wORjRkjuZvu0iDbOjlNhk6yQMCLWsmuzl4pXsUkh7agb",
+	"m4xxmm6YklgTTXueD2E8PPrYI7thxXNL5u7ex5ngUu40i5hkhWQU6Megy5o2bYPWHMU2CC2nVPoKFxQr",
+	"smCJDtYqtCS6YUGjzJM1vSHMidJz9GzBdEyAdVCjDDsdTxLVWIfCfR15U40QRD66eA8bOOONwd1Yuvkt",
+	"rXF2VzuNceRjxWXKXGh+bw9m2+6Q3qlzApxhtk6Jvq9O4+9+Au/7e3Xq3QXCfn908urFmT47M9vjBVPc",
+	"Xg8ebFqMaJ+vMsISlYjxWJoeFgdbS4qiT/RqcJ4LIqVeKUOttSBjPFRXvFbGc6JKLK+32ImbCL2+3djH",
+	"/my1HTvw695TI/suSRM0xAXyCBWpsNG44esYw/LtDJAWSz63/bG1CjA/gvnx85kfd1ueLLJ2DE8lZ2uu",
+	"N36FzfeJu/icDWq95DXLWtkA2yhZXmGRJ2005+6LX4xv2YmYQKfnr188n2kVbOAuckmDAzeS/Rrz1eHJ",
+	"kLSN3RXaD8kez5diMbVZxt5sqaNHbs2/2xlp4WUiumrDoIlASopupp0cOEDZCvhruLHrdNh2u0mhYZFu",
+	"9MuULBsP4NyRl0njPFa13B3TaJq1NsmXBk32Cms0OULnQ/6AZ/HnrhHfCtwsCK+PjBnYmJ4eJx2cnFnl",
+	"USZJwn3zOlBnS03n4G7v721AkAmDN2PnRGFa2OuRM4KwSZfyQ/sE9gBHI7I+O32F/IXbh2SBpboQmEkz",
+	"0wVNqRD9NkHQw1LZmD8XGugWrEJrklvTEDcOGXP2RsEz+t7cWQRdcPXSxPJZu1Pk/22Gza60TJfPkZYQ",
+	"vULJuEImf8nIilp497Z2s7AwooaDFd/dMLqzDRkwNsiYtnKsiAlcSAf2SplEO/fBjIuu6hIzJAjO9ego",
+	"fGO50UrYOhwmXmqh0yw4gM1DRructeLCXKagXYVea4k//kTYWl1Njr/79v/6878nFuqx8EfCyFDYb79N",
+	"l7XPfSDzfN20CfG/zeF8wNLYbTVy56iuzCZ+4ML60FlGpppRJkej0uNusUFPv52ipQPI3KLMvCGjXz5e",
+	"zhNrphL9ZdpZEJVIA5avTMDIgpngAkEsyTj9LEEyJCx43mG3f/4+ZrdP0kJvOgHO/t4QsqlIsRa4LLGi",
+	"GaI5YYquKBExgljB2HT0GmvY3R+lI74YZU5NjDURhtl4FTgmy01FLE5Z/quVEJKpkIFgrPwlwUxf1m5O",
+	"r/ROF0x//XBFNOXalArXSZh1SZoTQXKE0brGAjNFSG6yN6yHxjSOKB03ofoeq1v+Ab1KF/ZtUL+D80+f",
+	"fPu9OYzwQ0uy/OXZ7L/w7NfLR+4fT2Z/+cf0+PKb6M9LKwr2nbQDF5n9PfBaD9SpYW18hXS5jin6wWRH",
+	"oXc2pTIOCNLfJ9OJaTCZTlyLpPsxLWn6aKMIw6N8B2QoDa04n7u0pnnGy6Pwvcsznv65LYr/YsFy+eiX",
+	"mfvXN/6nx/9hROhtDR5/c2TE7wDey19mDajnWhCPvj3+t50W/sS91HDeQGfhtLb4Nbv6+j4BS+Ee70cs",
+	"GTHCxyuhVLhSOu/O8PyEmGQ/aLZwQ3Mi0aouCtTGubqSShBcBtEFG0ZSYMqQIh9VcsYrLlXap/Wf7ovf",
+	"rG8ZBdT7iZx9QmiVnOR7XYqvm0uRfFQCx4WooquvZ+vc7xp7m7wSrLdVmnQtwhSKrpxwsoHLJQSzHvPv",
+	"M/yKi1QiOxeqCYQUagxIRwQ3a2lik8yuzjd9A45p7etcjBpdmz8Jy0keCCE1Wb+VnzsaYTDGz9pwvGlP",
+	"/84IyY1U2ORy2euZyjDKkqy40J/XAuf+buwFBkaDUm2QthDAamhx821BOsNRN8oklTeAHg/iobvFaUVB",
+	"U2ndNEOUMc7z0EHr5wPJUMlm43I0XSz2583URHeYqIl25GmirzxNE91VlibqJ2miVo4m+tJTNF3mwb6J",
+	"mrbb/HNlTSQlE59SsCOZIJ6SC7qmmnZ69Sv0Ym6X89BexwGWJg+D/e1NQ6ejHeQFUSmT4In/FO6Ilu3h",
+	"v/nS6MdhhPHWBhfAlpjSfognlAqXVU9atFD+o7SxcO7aGzd5TqSibEDmetF89IswQms/GSaJcGtcJQ7x",
+	"R1zJRh32tlVBjJapu6CcKKuzugglk3SiMxyTxlbL5c+Isf4tC5K2cP2UaNXYuPQ3b+XCyktugarMAlzC",
+	"zGjIGtxLCwJhZo+WocQJViOIysD18vayga/SOYK4dFMXK2gHdQCKTaHeF9wqvtbjFxFnAvnhXuWHYGwe",
+	"VfowLT0mtGoQSz6JWDKCikMd0RMfttQvIDZY5jhomKnaXibfKS5b1NZshLumtljURjg4h3aTuCsafEWC",
+	"FOYyNGCLkLzn37QQuTUBJICbIIbR4I2/3Dl0GzviLrDHpYzs2gePIbXdXlvtZTzjRcHrZARyE/PbSTNE",
+	"ipSVPkgkbG+baZcoSNbJMRgyPr0UgovG92KnjMZO1l67whKtMC3Shi62s+TxcP20ZhTHd4aLPvdhw1fD",
+	"y10SErxjk7H1nvwaRlRzOhHEiGS46K+4iaRAAZ16ZMeIKf3yzhavaupu+Xyc46OjWhJxbDNj/u+nT57M",
+	"o/8//tP3332bAmOFpfzARd4eVHCuJgNZPf74drUewZpGCUp3JiKBbPTAZSOQih6yVHSaLFgwUKSgI020",
+	"qY5gUVAi1QusOpzk2yfffjd7+u3su6cX3353/Ke/HP/pL/81WiFMq8POG9xVhCuqhNF5OyoxXil//q6W",
+	"g7Y6KHxN2BbtuF1Eorcy2+hOtzviwM6cQr2Lwbp240zVTksHWzXYqv/1bNWOUvY2Vrt+82Sh7YPqFVly",
+	"3F7J60uvUAQFhaCg0AMqKLSXmyfmErFnJzrQ3XgYcYk79O54ZnYL984gP2v5d/aOBR1r4o9W3kpPCsvt",
+	"cMW78Pq7OUdprFHbu7Hte6ELBK6HrcB6iRv02Ieox74cqATX/r5DDbIWRVB/QP35F1J/LGUYtceCXf/L",
+	"Fi7oFE6cD72M6nC/zVr3yAzul240Up9UmOVNYaCmvHlnXXKOzuj6SiHGPyCq/ihtoZzqY2ZowCQwzdF/",
+	"8g/kxtVgcDEKlZyiam0aYbaxJVhQkwq0XXAbjKjeJaI5gO8jmr0cgr+vHxOfQLIwltTkVLeoI3r+6MY3",
+	"stkgMXBRczMOKaHbSoj044DMWI2gFMc7d3043RXMA0DQy84nf6SdvtPmh/D2o+K8kIiW9j0cddXfViao",
+	"ohku0p5e0/M/sbxKYrn5eopV+utevt4t5U4B3J8A3KEAxxC04RQ+wSn0f9BbgWN5WMeSauITEN6ZtITE",
+	"Xf+23aCtPbfD/P1YLseBzJtSfPYRuGLj4wLeu7LH84qIjDNsEr1ct1AKeab4e2RkuhCh6e7F/hG4Ksf2",
+	"bcZVoqRK67uVokJhOC+kR428oOqLL3oBp7fHfarvhfefzbxq/ypPox4JM/9ZsIu3L94eo2d57mSmWpJV",
+	"XdjURDlHjao0RVpknaKa5v8xmY6KtGnWaKrRuQZY8ZJmu2xK1RVO1fdx+HWqv3bzd02XQSwbiE0ViuTP",
+	"1Hg7mMJiTdSg+ngRf/Y6qs/tURx9uKLZVXuBTaaoW2o+H+dH9CNEi+mDkTCdRdQhz7Z4vwclp1PadmM7",
+	"0N1DorsHhMNdTXJI42o0rbQp2d3plCGMrv9dbqnGtp9Z2c673ZzctDnMjOxVYLBXPUzrsT1nsBo/KKux",
+	"PRQbiXvhgmpTrqhaGvNIO9K0azUOIYijixe+bI3nH+szd+SA3nGbR2pJsKQNlOVKT2V+C5HGcTaPSed/",
+	"VH3MpsgVBRKoqRj/+HbhwOkI53Eljlt7nHpwX448cM+fO0LHXlSeRKTUa1bx2u3IY5fpIsVtXPhh8eKJ",
+	"AmO68wHh/nEo+65t+8mGN+7upOZJ+fTV2anujyrT3txKTfH63l5tp60Ggt0n7VZ4VhekR4LhDQJbBHa5",
+	"6VOWxdPRDCqeLf0iKbGl5/0r6rEdFyd4yE7rfXoKP+y0U9ajooyRHJU83+/Vbrfcv+96zCFEC+nJ9HNt",
+	"PZardX7GAyZQ2VRNTl4TPGWGeBGJQLiz5eMFm+kfj/X/xEJSbD7v3QgW4LprVFbhGEWF3XvFFqRubQEa",
+	"NQyTaSHQJnV2Tm3BoiAYXBSTVqUKfeZmzJH1E01GyFCiiCCy4kySbQkmI+b4oSBEeZW8wGzPF+29qim9",
+	"1oAqrdsZj1VRNAxAJrW45vH6UQwvvHcfr3cXo4vmuRyx/2eVrmqDiz3hcBqe8rfUrnm8Fg49VBKuHB5A",
+	"50pQbwFWiT+ecGYLgHlm7AK1nnaX8ibU+mgGDDodwgph5Cwj22vitg+oLzO4kRUPmjpqXltoH75lUs6Q",
+	"4ZvbYlxB2TSkM5Zr7cLkKKFrn0MUfC2IvJ8jXFFG5dV+lqr+se86ptvRkdv3gDa/r30txJJ5Rkhz82ip",
+	"qBnTTaYTWWcZIZYjuuy1y92vAllJdAc9/y0YTpxY9Iqt+NZEMB/6pdWlxBs35uNFOjkxPPNlXuAyYLVT",
+	"+bezbYXe/usU1obQfqrLbAyFZ2+aC82pJN4eYu8Yn9nwy2Rd6XSzdfWdhsf4az9e+R64cx5128l7Y+il",
+	"YDXqAM+Ga3MnTjE2Ggy45xO5tlX9mhYFjSFnSybF6aaT40lti2tpqYnK63NXfWlcD1tq+vlGkdHTjEl+",
+	"DeB5FvanK3HgCmdUbb7SvZ747fUwzn+YRuedQrPmEa5XroKmE8JdZfFtNNDv+xxL8jNVVxqtTRXyNswb",
+	"k+h+w9qwFOtmvSbsjNy4Vyt2DXX6+vVFp8dginX7ONJ7cxTcHIi8ptWMV/ZinRmrFRGD9cj7RdjDLKGA",
+	"aeyYmCSCe6aTWhSO/08uf58OrHT7e3DpuZKK2JuO+DOOpUcyjxvHP8FoTH1lfy176Wpqp/0oMcMcvdUa",
+	"zOnr10Yl84FbUyQpy0hbVwoSjX4lRLezgxIlaCaH0rqlV5EEr9dWGdWTWQvmvKUTVWXZv+rHIpZFgePf",
+	"OqVUbzvYDRF0tbn46Typ89pP3qWtOCJM1oKgi5/Oj87Pf0Kmt3/lJZ3HPoLptBjHgQwowXjSFr9n9mVH",
+	"/06RBVz7PUhfjtyKHi/enNvPzix6Z560nMlZgZekMBxexoKNRpVZRCR3c+aNRer4t1sOcifsbQRq2JpZ",
+	"RrGUd3o37df99PXrkTu0ntw74OMvSEHuYufjl56bGVtLT65Mj9iTqDRP6/2IK/o3smknIeOKXpPNneFy",
+	"uqBE+PUALiuJ6Kw8LymbTO+KYhKi3enr1wn5pyLZWE56PuB2PnEFPW6oKUkfhzEl7kqEw1dTsrwigvKc",
+	"Zii7Itn1YLWQGydqd4xjttg2v54a/ynOruzrkqqQxoanf8a1uuLCuFUfKVOv9Zqwpk6KIDf8muSPp7r8",
+	"ORX9Vu7nx4gLRPSgLR7Krw9AAw2AE73tdB06/auHlYGO1V1D3HRcsj7rH8LoYgN6GcESeshezmvzbpff",
+	"zW1z//uoZx7lvyNOfa8c2nqLd7M56b1xo0wC/f4p0TXI072xd0q9oWuwNu9p2HsxaLdzUU3OmL/FYWVe",
+	"RsqXg3FPBt1f60Yvnofxk3UsSvOa0vZxNuf/56fto0SPg28d6tS22zFeCs8D1E8Fv6GSckbZ+q98eYBR",
+	"tYpGmlps1PhpvBklv9H/jhxlw4dxK1vqlkjFLVJhCNJzWndQZPxWQnEqkyzd/Hx5N8GKPWPqnlZUPQKp",
+	"bmEbjk/9XJFqvKNlEoNsGgy3dh2Xe2DaXlxoEF23MZS47VnzDPUe6O16GY9XjN7jELmFeMNhGfEAA+jJ",
+	"xXgINWxUwwZfk7cfGBHyiiZqrfv695g14W5hOeHpbPsMrXmm/aWN9x5X2a2NL1zsgR8GJw/x70jK1gVB",
+	"Gi070Qrtg/xvvuyd265on1Gp9oGVWL/YZDqhzC8xTd7TiZZyqjHukniFfs6tsP0/NVf4Xbo0ofnWBRJm",
+	"ees5TBleK+uibBt2/9SDJcoa23cxuxg/R89QSaU+LPt0pnnzRzaPOvnp/fNappENXk2+qNl/ItMOa94n",
+	"aZwP6J81ZspakvvBXqlKswnd47V7cbx5fmGomHDyBYbONLeaYGBkKq8ToRdUXt8CGs3DnMmXNvcdcIy2",
+	"2MZaWy/RYe7h3o7Bwx13Qmlofwr/ScsREjbeHi1aTWL5g7u/3OdQvJvGcpPuyiz9+xPbypPuJy7Fc/5h",
+	"FrUseHadJLhTF8OATYlQx4YYsWb+JUEVEVquI7l/BcZWt7dLcOGb5gnwG8POR0k2DgoXWF4PFfA9VIZw",
+	"V9CzTPUyxw9YWZ1KVRkx3h6i5ij08Xfq7aWFOFOiQSnKxiDTp1VW7kLFuBeBJAbQsDjy9tWLk5OhQG0r",
+	"WSLdxr89JnbU1LI+qFeJ0H4zinlk3z2875q+SIGISlkT8e7sp4FxwmqsbbsP4oxXRA50dh/3in5qR1m4",
+	"PcbrDHOmoJxwD+8IZRahZfNLVeCM5MbVZ+yRc3RGVC0Yya0/cLlx3M+WLNOcMnQKRkxbvMz8U/p0G524",
+	"4vIbnP0z1IioxdrHvPrM0kwVsSXXNJldk418Px8fGznekthXyO0SI1rYqo6brabrl+5hT+9HRiXPmee6",
+	"uE5e60C2kGJ9Koh2zKVKzrsWg6ZsnzsdUqa7rzQHy/NApsR4OJOPWVHnJH/D82QEov7Z1drLo5cuo6zv",
+	"PypUaCWFs7E3bQteL6MFJO/dWy7MoHdiYTvNoCaSffRd2tqLDlr3x76TnQQs8HvsHoZfyk6Ua4Fw5MMO",
+	"4zHEvqu5V52bdN6KH2jnfoZyIRKNBopbnfIcNU2Ra/tZS1wt2B3mDC7YjqTBBbvn3LTPXeWqAeehaX4L",
+	"1s/zW7BWot+9Q/PuK10laGV3ld9EpwTBrLSorTZD8uOz1nd74C3pMVCpHwlJopR5XzonPnqdszi3S2+6",
+	"v5JtzpnIIUNle7b0YqIOTbXaRIoxGSi61y62t2OyF899ARV9e/Un0ReCh2PylbVM0CWRSLeLwNhwPJuO",
+	"5aereMJQV5n0fUHyF7XGs+bgX60ZDz+//EiyOi26aonSTUkE+kD1O29mTKR4+GCvZ8XNUp3oKbGicrVZ",
+	"sBakyEdN3K5ul09ostJteHDcvAJPlaH57IpzSRYMWyiYkW8oN0zTPsAtUMlFk3TZjG+l4aYblQtmHgUO",
+	"MPHnqMcJqV9rQSxHlnWpR/1A6PpKySmic80jNLQJzq6igUtClAlR9IuIj8jekCVhSqJHnt8tmONNU9+g",
+	"dz5JkE0RUdn88XTBtGBRK6LZbF1q+FFlvERs3QodJIWbmq8iCNu6cLkmwQVbTOwOFxN/I+kRXcUZs8kS",
+	"q+zKVwPmwiZL6c72y8tmff9bt1kw3euRfNzA9IqurzxIsTPktI9iHktvHVbDbCJM0zgGsCKiDCs0Z2Cj",
+	"4uzktNQ6KVXuFNGTBXukz9EW09NINePVY20XZ3VRjJiB8TCBG0jPKnkz1gAJEpYlowcNhCUpSKY0HRNR",
+	"ThGWkmdU31ENCNuAt9uZJ3Kb2geSmtFXXWjP3ELU5cZ8/aNEJqBQbjud4XGcGBD21qr/YEWYqa5PQTa2",
+	"RAJmwS60YE7FtYSuAXBNNqaVk316W78mmzT3Mlsw3YOfI6zJ2CyIkRC2uQNTGn1TbFCP/Uf3FJkG+hWt",
+	"7PtVkhhAB2nt77igeZzoJAh6xaboDVf6Py+1T1BO0QtO5BuuzJ9z9KOy0Pkp/cy7HTxJNUZOt7kxjSQm",
+	"TWZZq1oJlbriARduHZZj28ZujLKWRnJinM0MLSYHsevXA8U72Dbe8Fg/Kj3OT+5db9t5waLeV/iGNBZD",
+	"x+emrhiLuaaWxArVlSCakrCpReI8Cb7Ilh2QysaYkhs+bMVXrMiaZqgkwhYxy67m45XMTuUZTXXd0jMd",
+	"DcrGMwacu9xVH2bEDFPLEX7QXP9wZuCq8QAzAGYAzODLYwa3Ko5lJY0+Sv1sfu+JKobdeB2/LbNo1nDu",
+	"aO3CyDkuvEFgtibo6Uw/+hdHslKm4tf/ghO4A6lIvgrLvRveOSSbj9WdHCoHSb7FVge0H8MHGFeoJAph",
+	"tWCxJEpLMvW6nsVrZ9JwjYy3wEnxGtzaxHGbNWQES+K8sCVRC4YVkrx0b7F4stCLIH736BGZr+cor00/",
+	"zJyV5bFdr9xIRUpr0NIaG96YlSux0a2JtpLUuCg2iNzQTIUtGjMPVVYFTivQMUbJFGu2R6hF/PRdp3RH",
+	"5znR/zQH8PZsu0pi1QUunGbSHzGhMNg5WvDnK8MPrVL07M0LY5TSrS54xQu+3sS7s4/EaI3G9da639Jd",
+	"KxpibzrgAPUAJAKQCEAiAPUAmAEwA2AG96EeHLiNvgR3uf8qki8c8HyMa0ULmcOeFSvSZnxW8MyG3VDb",
+	"xSkuEpdWzp6iXzkj1jqvkcfIyraQccXzR/LxY/DMgGfm7j0zV1jaA7asbNhRE5GDJrN78dPoM3VHojcV",
+	"Qd2uK0fWZkDy0/Zq7NbtFYfznOSoImJmT5GjFWV5YiHILb5PV+3Bt6uELfo/1PlihAfPzZLSlG6A/lkT",
+	"sUHmvdFw7Xv0k84oQiXKsHSOY6PEG4eV1jqn9nMXhv7szZoZ19/lbRTAbgsrmHk50O4gKQgm1NtGq90m",
+	"Ew6PeYBQaBprYj5QKNSdHC+6F9kwrFfcm5BoNt2SE/eRDe3vrpL7FyMljhbYFuzLV99+MkaYbeV/t1fY",
+	"jkexJFdik8b3m6YsA+bfUYWpkJplOik6/ubEoWgYbemr9FgaADe4IEw5s6C79/TwXVajJXIuLaHa25BK",
+	"tNCAW0ym9saKkWMxecX0B58718KHwCZM9PTCovFisotJ7SpgPOoZlwCGv5FNMs8s/u55nIGIvo4CmzFi",
+	"m+Uw7n63Vz0tigVbEqSTTY2SwvVuJc1dmrDdoxlA780kEiqOCs71k9wOSj6AbsGolli8OddMLjWw3UHM",
+	"THv3uxnP0IsPG29dee8Rlui94ZgMPTIdH79fsGYXVojjtUGukAEbCTBhg2jL/qykp8zzK83S/2gl80eY",
+	"Kfo43OlzZGBsGHbOdRSzmdZjrB9gwZrNh/mplcMtOEMdagMOKh2jsdZaowe4m2LFxZLmOWEa5mGyJfe+",
+	"kebgMXNTevjNF+xZIfm02zALkYuSaFQgrN0PUal3Jom6WwY2nZRU7sTmbpOvEqEZV4DTSZymcjxaU/lg",
+	"MDtkUO0lr1uZr1ucMIiDxvETiYIWkuZXKt2H3OtyNYsSgKLRLF51Ve8F89ccZyQumt7pbRrPF8z4pxrx",
+	"lOVdj1XTRY/lEsEXE2/i+GNUf3kx0Ufoo/DCoI9++/1xK/KuGRMUD1A8QPEAxQMUj0+peGx7ZCC+YJxx",
+	"1+boYEWzxs3nW8UF1O/sZosvrYF7Lb78ele0v9YGL7FwzfW67rrf7li6UC58429pP6NdQvRKYHAxaGHP",
+	"iXmmCiHjqv2RKTprWjTv1Wgh08deLVi4NRpBynksgmG/gZ3GfiJai6AyFLTFErkqWYibqm48Jwtm6cUK",
+	"jnwV3VJmReaqakAQ2aXtM2CYuZAZzpyQrH+x4yxYwAGzKRrmny/YS3Ps8dCuUI2rDz3ibfumb5ITDoW7",
+	"fdg73K1jh55qxeROwt3a40LM24OJeYu03Tj4bcFs9Bs6KPhtwX6+IgaBBLFqa10oWjX+bDkNb2pKH7Ih",
+	"Ozipp8PZ1YJ1kMgMaBzg0pCedanZh6FMTJyXcqzrkG4VrP2jU7ERQKJHmuGYwuZckjbdtDiVE53pTXjn",
+	"dk1vCGv4lfam+oupy0gXLGJie3PSqeZr+3FC1GaEEedtOOGifvLkuyxiPOYHspsrat+q3p73XUbQbLgi",
+	"eKFAGQRlEJRBUAZBGQQvFHihwAsFXijwQoEXCrxQoHiA4gGKBygeoHiAFwq8UOCF+oK8UAenbrkMKKbo",
+	"6Cyo+EyHUqHwDac5qmrl0lm+wnSoFhggJ2p0TtQQ3CAxChKjwCUFmiFohqAZgmYILilwSYH5HlxS4JIC",
+	"lxS4pMAlBYoHKB6geIDiAYoHuKTAJQUuKUiM+uoTo2JE/azZUfsvBFKkIEUKUqTAHwVqIaiFoBaCWgj+",
+	"KPBHgT8K/FHgjwJ/FPijwB8FigcoHqB4gOIBigf4o8AfBf6oh50ilUyaEvxjAhNO9c/+lvenqjnIiq5r",
+	"qxggrxe8eI5s8ypp2NXgHJOTpdtteZrKz1bxHJ6Wgqel7j6Dajhlqnsp30vOVNBiQuMYwK0Xds0ZGAp2",
+	"ThVaVgXNqHKniJ4s2CN9jtY1o5FqxqvHWlIxd9DuGZo3fJEbSM8qeTPWAAmaR6l3PoN5aHoVvOoLD3nC",
+	"Q57wkCe86gvMAJgBMIPDX/UdCvb7ee9gv+4Dv1N0R8F+jXwFBdAfSgF01grqQzamb8EOCupLKtDtJ6O3",
+	"FjJI33UmZM/qiuaf5gDenu3wQ3SMWr0REwpDwpzoYuDKyK5orXQXzuQR7w5p/DQajeuNkayX7lrREHvT",
+	"AQeoByARgEQAEgGoB8AMgBkAM7gP9eDAbfQluMv9VzFU8m5subsdle6Cj+3rrHIHnpkv1zMDte2gth3k",
+	"EkFIH4T0QUgfhPRBLhHkEkEuEeQSQS4R5BJBLhHkEoHiAYoHKB6geEAuEeQSQS4R5BJBbTuIeYOKdlDR",
+	"DiragRcKlEFQBkEZBGUQvFDghQIvFHihwAsFXijwQoEXChQPUDxA8QDFAxQP8EKBFwq8UF9qRTubAcUU",
+	"HZ0FFZ/pUCoUvuE0R1WtXDrLV5gO1QID5ESNzokaghskRkFiFLikQDMEzRA0Q9AMwSUFLikw34NLClxS",
+	"4JIClxS4pEDxAMUDFA9QPEDxAJcUuKTAJQWJUV99YlSMqJ81O2r/hUCKFKRIQYoU+KNALQS1ENRCUAvB",
+	"HwX+KPBHgT8K/FHgjwJ/FPijQPEAxQMUD1A8QPEAfxT4o8Af9bBTpMb8Mp1UssyXfdw4PX/94rm/9/05",
+	"a56youvaqgrIawq27YvnKCtqqYhISBa24zkRNyQhApxEX0fO+eI5sr2Q61Ylzcz6cMdkiOl2Wx7K8rNW",
+	"PIeHruChq7vP5xpO4OqKCPeSwRV0qtA4BnDrvV9zBoZ7OBcPLauCZlS5U0RPFuyRPkfrKNJINePVYy03",
+	"mRtx9wzNi8LIDaRnlbwZa4AEzRPZOx/lPDTZC94YhmdF4VlReFYU3hgGZgDMAJjB4W8MD4Ue/rx36GH3",
+	"ueEpuqPQw0a+gnLsD6UcO2uFGCIbYbhgB4UYJhXo9gPWW8sqpO86E0BodUXzT3MAb892eEU6JrbeiAmF",
+	"IWHcdBF5ZWTltDbDC2eAiXeHNH4ajcb1xkjWS3etaIi96YAD1AOQCEAiAIkA1ANgBsAMgBnch3pw4Db6",
+	"Etzl/qsYKsA3tvjejrp7weP3ddbcA8/Ml+uZgUp7UGkPMpsgwBACDCHAEAIMIbMJMpsgswkymyCzCTKb",
+	"ILMJMptA8QDFAxQPUDwgswkymyCzCTKboNIexLxBfT2orwf19cALBcogKIOgDIIyCF4o8EKBFwq8UOCF",
+	"Ai8UeKHACwWKBygeoHiA4gGKB3ihwAsFXqgvtb6ezYBiio7OgorPdCgVCt9wmqOqVi6d5StMh2qBAXKi",
+	"RudEDcENEqMgMQpcUqAZgmYImiFohuCSApcUmO/BJQUuKXBJgUsKXFKgeIDiAYoHKB6geIBLClxS4JKC",
+	"xKivPjEqRtTPmh21/0IgRQpSpCBFCvxRoBaCWghqIaiF4I8CfxT4o8AfBf4o8EeBPwr8UaB4gOIBigco",
+	"HqB4gD8K/FHgj3rYKVK/J0YlbE1Z4p3+l+Z3f8/7c9U8ZEXXtVUNkNcMXjxHrn2VtO1qiI5Jy9LttrxO",
+	"5aereA6vS8HrUnefRDWcNdW9l+8lbSooMqFxDODWI7vmDAwRO78KLauCZlS5U0RPFuyRPkfrndFINePV",
+	"Yy2smGto9wzNM77IDaRnlbwZa4AEzbvUO1/CPDTDCh72hbc84S1PeMsTHvYFZgDMAJjB4Q/7DsX7/bx3",
+	"vF/3jd8puqN4v0a+ghroD6UGOmvF9SEb1rdgB8X1JRXo9qvRW2sZpO86E7VndUXzT3MAb892uCI6dq3e",
+	"iAmFIWFRdGFwZWRatIa6C2f1iHeHNH4ajcb1xkjWS3etaIi96YAD1AOQCEAiAIkA1ANgBsAMgBnch3pw",
+	"4Db6Etzl/qsYqno3tuLdjmJ3wc32dRa6A8/Ml+uZgfJ2UN4O0okgqg+i+iCqD6L6IJ0I0okgnQjSiSCd",
+	"CNKJIJ0I0olA8QDFAxQPUDwgnQjSiSCdCNKJoLwdxLxBUTsoagdF7cALBcogKIOgDIIyCF4o8EKBFwq8",
+	"UOCFAi8UeKHACwWKBygeoHiA4gGKB3ihwAsFXqgvtaidzYBiio7OgorPdCgVCt9wmqOqVi6d5StMh2qB",
+	"AXKiRudEDcENEqMgMQpcUqAZgmYImiFohuCSApcUmO/BJQUuKXBJgUsKXFKgeIDiAYoHKB6geIBLClxS",
+	"4JKCxKivPjEqRtTPmh21/0IgRQpSpCBFCvxRoBaCWghqIaiF4I8CfxT4o8AfBf4o8EeBPwr8UaB4gOIB",
+	"igcoHqB4gD8K/FHgj3rYKVLJpCnBPyYw4VT/7G95f6qag6zouraKAfJ6wYvnyDavkoZdDc4xOVm63Zan",
+	"qfxsFc/haSl4WuruM6iGU6a6l/K95EwFLSY0jgHcemHXnIGhYOdUoWVV0Iwqd4royYI90udoXTMaqWa8",
+	"eqwlFXMH7Z6hecMXuYH0rJI3Yw2QoHmUeuczmIemV8GrvvCQJzzkCQ95wqu+wAyAGQAzOPxV36Fgv5/3",
+	"DvbrPvA7RXcU7NfIV1AA/aEUQGetoD5kY/oW7KCgvqQC3X4yemshg/RdZ0L2rK5o/mkO4O3ZDj9Ex6jV",
+	"GzGhMCTMiS4GrozsitZKd+FMHvHukMZPo9G43hjJeumuFQ2xNx1wgHoAEgFIBCARgHoAzACYATCD+1AP",
+	"DtxGX4K73H8VQyXvxpa721HpLvjYvs4qd+CZ+XI9M1DbDmrbQS4RhPRBSB+E9EFIH+QSQS4R5BJBLhHk",
+	"EkEuEeQSQS4RKB6geIDiAYoH5BJBLhHkEkEuEdS2g5g3qGgHFe2goh14oUAZBGUQlEFQBsELBV4o8EKB",
+	"Fwq8UOCFAi8UeKFA8QDFAxQPUDxA8QAvFHihwAv1pVa0sxlQTNHRWVDxmQ6lQuEbTnNU1cqls3yF6VAt",
+	"MEBO1OicqCG4QWIUJEaBSwo0Q9AMQTMEzRBcUuCSAvM9uKTAJQUuKXBJgUsKFA9QPEDxAMUDFA9wSYFL",
+	"ClxSkBj11SdGxYj6WbOj9l8IpEhBihSkSIE/CtRCUAtBLQS1EPxR4I8CfxT4o8AfBf4o8EeBPwoUD1A8",
+	"QPEAxQMUD/BHgT8K/FEPO0VqzC/TSfUx62PG6f9z4u98f8aan6zourZqAvJagm754jnKiloqIhIyBWFr",
+	"ykh/ipfm95GzvHiOXPsqaU3WZzgmEUy32/Ielp+u4jm8ZwXvWd192tZwnlZXEriXRK2gOoXGMYBbz/qa",
+	"MzBMwnlyaFkVNKPKnSJ6smCP9Dlaf5BGqhmvHmvxyFx8u2doHg5GbiA9q+TNWAMkaF7C3vn25qE5XfCU",
+	"MLweCq+Hwuuh8JQwMANgBsAMDn9KeCjC8Oe9Iwy7rwpP0R1FGDbyFVRdfyhV11krkhDZQMIFOyiSMKlA",
+	"t9+p3lo9IX3XmThBqyuaf5oDeHu2w/nRsaT1RkwoDAkbpgu8KyNjpjUNXjg7S7w7pPHTaDSuN0ayXrpr",
+	"RUPsTQccoB6ARAASAUgEoB4AMwBmAMzgPtSDA7fRl+Au91/FUJ29sTX2dpTXC469r7O0HnhmvlzPDBTU",
+	"g4J6kMAEcYQQRwhxhBBHCAlMkMAECUyQwAQJTJDABAlMkMAEigcoHqB4gOIBCUyQwAQJTJDABAX1IOYN",
+	"yuhBGT0oowdeKFAGQRkEZRCUQfBCgRcKvFDghQIvFHihwAsFXihQPEDxAMUDFA9QPMALBV4o8EJ9qWX0",
+	"bAYUU3R0FlR8pkOpUPiG0xxVtXLpLF9hOlQLDJATNTonaghukBgFiVHgkgLNEDRD0AxBMwSXFLikwHwP",
+	"LilwSYFLClxS4JICxQMUD1A8QPEAxQNcUuCSApcUJEZ99YlRMaJ+1uyo/RcCKVKQIgUpUuCPArUQ1EJQ",
+	"C0EtBH8U+KPAHwX+KPBHgT8K/FHgjwLFAxQPUDxA8QDFA/xR4I8Cf9TDTpFKJk0J/jGBCaf6Z3/L+1PV",
+	"HGRF17VVDJDXC148R7Z5lTTsanCOycnS7bY8TeVnq3gOT0vB01J3n0E1nDLVvZTvJWcqaDGhcQzg1gu7",
+	"5gwMBTunCi2rgmZUuVNETxbskT5H65rRSDXj1WMtqZg7aPcMzRu+yA2kZ5W8GWuABM2j1DufwTw0vQpe",
+	"9YWHPOEhT3jIE171BWYAzACYweGv+g4F+/28d7Bf94HfKbqjYL9GvoIC6A+lADprBfUhG9O3YAcF9SUV",
+	"6PaT0VsLGaTvOhOyZ3VF809zAG/PdvghOkat3ogJhSFhTnQxcGVkV7RWugtn8oh3hzR+Go3G9cZI1kt3",
+	"rWiIvemAA9QDkAhAIgCJANQDYAbADIAZ3Id6cOA2+hLc5f6rGCp5N7bc3Y5Kd8HH9nVWuQPPzJfrmYHa",
+	"dlDbDnKJIKQPQvogpA9C+iCXCHKJIJcIcokglwhyiSCXCHKJQPEAxQMUD1A8IJcIcokglwhyiaC2HcS8",
+	"QUU7qGgHFe3ACwXKICiDoAyCMgheKPBCgRcKvFDghQIvFHihwAsFigcoHqB4gOIBigd4ocALBV6oL7Wi",
+	"nc2AYoqOzoKKz3QoFQrfcJqjqlYuneUrTIdqgQFyokbnRA3BDRKjIDEKXFKgGYJmCJohaIbgkgKXFJjv",
+	"wSUFLilwSYFLClxSoHiA4gGKBygeoHiASwpcUuCSgsSorz4xquUo+ZzZUfsvBFKkIEUKUqTAHwVqIaiF",
+	"oBaCWgj+KPBHgT8K/FHgjwJ/FPijwB8FigcoHqB4gOIBigf4o8AfBf6oh50idbtfphPC1pSRC/NzF2Ve",
+	"hm96w7qrhtaL58h2ahnlC5ptUIaZxquGMDVkCKtL49H6mGkZhEu1FkT+s9B/yDJfTi53QS9aYwp4UmFV",
+	"O+ZjVAv9T8reSTI5XuFCkt4FcMrzxuV1atZ+bgZx+OdSk5aSiBuSG3Zltp7o15er3MzRaswiumt4pZvZ",
+	"62dV4LUFJmU5zYwE5/J/HGCptPrncmNw9sVzlBW1VEREqLfkvCCYaYgUWKq3bvU/Eua0vf4B/5Rs5wVA",
+	"k4kjSEaYQuvmawCL1R2pHAJL7PL88/dpl+cIDE2M/hOVCeftQEMny9kBO0K1d6A1KWyNJh2nkpljoCkp",
+	"Glf070TIJHifnb5y31p4dWN/I3aGEofcsCATO0CvmnXP0bkGupCefWec3RBhzoevGf01jCb9fVjYVDrj",
+	"5WO4sGzTig/aIymIgUfNohG8fPuaG/fgih+jK6UqeXx0tKZqfv3vck75UcbLstY3wZGGo6DLWnEhj3Jy",
+	"Q4ojSdczLLIrqkimakGOcEVnZrFMmczAMv9DcDulBPNwIYZ//Jsgq8nx5A964oozwpQ8cns9Spx5j5/+",
+	"Pp1cU5b3z+dvlOVO54rk++YYvL/y7OX5RfCV2aNy2BSayuaANHApM6maV7SxECHCcutZ1n9kBSVM6SeP",
+	"S6okcimJRshBJ8E8Yb3K+VxrFye4JMUJluTej0cDT840yJIHVBKFc6xwJLTsSb6ngtxQ8iGVsye1ZcgT",
+	"oz6OhhSSNLlBeK0p2UG1FkKD1bjCe6TaoM/t0OvEf/frTyBa+zptw07f62turvKZvKbVjFdWeZkZvCBi",
+	"cqxETbbcftN4D5d7AfvMYliSayagqjiq3C67YNwmMbzACi+xJEFC0DLDo+pjNkXmrkdcoEYCcM+px215",
+	"8tqjKyt6T6YT8hGXVaF3beWJ24E40lr6m3jjP/nV5H5X7tJtYlSaiBOTV67FUF6rvl2tkZY9r2fNJMLn",
+	"0Ubdoh3fdocWhrdkoLazhkQaPu5y2npf3n7xO/nIWV2QiIu0EbS12t8ijPFS+LyRrjXD1On9xndUyxnB",
+	"Us2e4scHwN3rQ294nljPxG0CLwuSyNu3oWN1Qcarhi120ZuNKORt/BZHfVtvHGpOzaK1rWxg22JBXCo2",
+	"Ya3r2sua46Fi90f1ttOrpCuEFSr0ASB9ILIDpxCKJGMY3Xo9Ksm/3jLiQ6l8mMs0DvGz/KtdiiLmSXHH",
+	"A1BoKDzRZta72zCkyLdB4aXrW942yt4z4ae22tLF7va5pm8kqd5Va4FzcoHltb3hd938+oqY1bYXUlhe",
+	"RyF4GpvD69ddztx3ABAp8ZokqciodfZGcxoqMVFzmvTYqeD6kjJbrLOMkNwAY4VpYf6hQVqRPKG8Tid6",
+	"xbv4bgSTvrVA/+jXNwKoshWomTaKJnXQUyxwSRQRchDysgF9D7hLjQvn9Ne2tvu0O8ubulwSc8l1j0t6",
+	"CVeTPjbxnxrFKKOlPpGnfZVxOvFjyC3SRxhecbd8W+TCHbDb2IoLY4XnJVUmBlLfwf0lGttSuycWJNgM",
+	"5wu2F6/+gKn6gYszgvNNC26aGrug+xnThn/3l2YYgTkFG8ea8ZIgoUdGS7LihntzjbumnoW32jHyUdle",
+	"CePB7yPQ7a98mZAjHcGMwSREjU0viGBdxNIMVF6R/JnhFMGOoFFl5pCkB+eWQNf7Kgx/2UfwT7GtxHFK",
+	"hYXab6U9vtNEdiZYzWXqGtE9ZzdYmE3rIRJHpC1a5CwMPdTiPJpyqM0PbildRhWfoN1VA+kU3zonptZS",
+	"gnRf3hBBpELrgi9xgaRv2MUMTvPshLMVXe86wbevXpy4lt1lR4MkV6m4wGtyUmCZkt+irygPVacMyjfs",
+	"1N5SmWlk/Iemk/nZmp5PiZBUKsLU33lRl0R642m+YbikmYkPrwS/odZWNF+wBYvnduKZdiiGY8j/d3B+",
+	"eDL0M9ul4CzjIkSGq8yYPyhDb83mXxOF51q6T5i5tKXbrvTlxwqztMEr1QrJK/5BR6UQI1gm1qQ7oRvT",
+	"CxHdLU9bNWPDQ/dMMMuxyJ1Z5o8S+bb3biwJixply4wP8BVb8UH08rC5wdSKwM6TMiTnjDufn6NDuHFo",
+	"l8QTh4v2NAxr6B9HZGwfnqU9bGTGTl9oXoHG7Y7J+ZlTTnv8taEaMXAXZAWm5WnQj28rplsQPqdGNHjN",
+	"87uz+Zi9tXcyTZ9xcw6XI/DNG85H3YHdzqnrz7U5I5L+Sk6uSHbdx4cfCJZ0SQujP5mkA/qrN636YzaS",
+	"zE5pfgjL+8jhLIFeOO3hwMqsqSDp3oJgmaYfp6Cb7XovYBjr9gqfs/+SfHDBcsyl1Fw4w2Yrhy5Gx6bp",
+	"hqbgHOOMHGLAidF5AHPjQ+rCIDqiFGK/M3rDc5xd15XbvFFm5J4akB0hoGFzg/dxL8uIlM613jse5wl+",
+	"04mFqAQxru20dP9TN/5Beo8yUlxzSmsqXLbWuJeysayza6LSZrwLc53wOg+7t62PnHeMCOTY0PZwmhR1",
+	"cZGRU6yuztVmmMbWQ90lyQRRQ6CuRZH8/YYIutpc/HSemu/3JA4ZSbdvQHR4OejXu4hcDSFCx3n1hlST",
+	"YTNqHAQ2SVoTxJpsX4zR6NwCukMaVPJqGLfaat/dPgSc0wLva1R4G8Lz/LRVgfvGA6dRP8uUD8wcdSm1",
+	"7CZ9hHdT7j1ef6wdQHlW6csZFwOBNozPeOW9hF7sURwpQddrJxuFE/JwamvFraPqreHC2ZjGm7t2Y2Hi",
+	"/u2N4o7NT9+xAURWkkGzRRQSYkwVk+mEcXXm/imI0agn4ShtEEo6SKQPHL37Z+u1IGunY/fMjNbzjMJt",
+	"YxziIbiifxdyYT4kTUO9A9PEaMY6OX1nBi5JycXGBvxTqa2W/tItiRI0k+jZ6Sv0AUvEiEnN9AJ/PFhO",
+	"5bUPyjAz2Z85awnyglRcqEa61k6Ogig5jWemastk/qJxso0JJbxm/AMLZ2lbSBe46Bt2Zw6A8hhuUblr",
+	"PNRDPd8osptG9alqQ4S50uwSUglOH7XZELFgbvRNU2bErKrPPAq8pkVB91tFVtXv5K166sMMM++/fd39",
+	"nbxNz7twTU8nV7wWCeA3Nl7TAGX8hogGI6RxkMg4KCjndUtmtsdm9XxNMwfAyA5wOyjtzyXnyMYHehpp",
+	"PLvYMyI5vytP9fZAudgeZw8qiegDIE7i5rRFqA35XQ5x3zPDDPZkvYaRKlq6shk9ZuFhfuI47/jrvX0h",
+	"JCQGHZ9zCyvzXS7BU8cWqqr1IJ6MQj6XwbcW2PqMTvGxu+sgkwGM6d+ssAWAaf9YBrHCkFfKbqeoVPoa",
+	"NAaAEGPVpD2FiTva2A0RTtAZwVEqgq9HNlVcpYS6c1uQ3in/8SI19ClD2og4MzQ3RaZISKlpLeOC2F+t",
+	"HKHcX2MYYVeHdjt2u/ErTUOciBNBcsIUxYXsi4kVlvIDF3lav5JEDNjWfh+Y7JQII2NwlpiMMC1i5Gkt",
+	"sGr37Af/7VRxe1J7O3bKzp2C0qBG5X0RXqHSxuO+i6ouihNellQdcptWguvlvEmCew9DZLOVO7HXxMtq",
+	"Rp/Gm05BlHJjVscVLbEOEiBiM6+u1/oHOS+JwvObp3Nt9NCOhkQ0svsSeVW8dd3FyGyYuiKKZoEGXaGa",
+	"K3xDpoiyrKiN/lGEPLAbLCivJbIx4k4hM3k9gYx1vKYewMq73EaA/NZ4RKbIL+z3eSKmkCnK6oTI4L+Y",
+	"8V2qqRP9NYWZvzEqaEmVl+QbydWgPxJE1YIZzzTLo9jwKB9P3DhznXmIw4CqkepN8k9Is+UV/mdNQvzv",
+	"sklpplKaDy70x17PPow4ClvFys6YW7tUQW0rQZSg5IY0zmWXtxdW0sD9xELFZqWZXGTjA7Nj+UJJS+M9",
+	"NsY/DzK308x47WoXia73nV3pmy8Pb5GoK8wQRivyAZWU1Rpc5nA1y/MZyP7ofXC2Tb7y0LYJwbUMj8KE",
+	"k7SgDEnNhr9muPCQcpC2Z7miQipkSzFJMkU1K4iUaMNrux5BMkIDKBXXF7oJFcYMESH0dqwun8xeFKTE",
+	"VDt2XylSnvCaJS7XfhuvQzZ4Juul1MfNlEM5t3pzHC5HxtXrstQVJVIVNNpgSGd0v1oU8pZEn43PhYO1",
+	"TyS1Nay62B9W7hclUc2sIurTtuww/igKslKoZoakWO4DOkKEGxEUF/RXl9UfL9ScrpYwFEGPCDX4vyQZ",
+	"riVBVHkDf3ZVs2s9Em++GhCETFnpGj1u9uOqdjFu8bK7J7sRKg/ZiY8450VuxCXM0M3T+dM/oZybdetR",
+	"mjks7lOmiHbpGfNykGhSmPINkYqW5kmbb0wzo+4bOS3jhT4/s4gTE8ke8hL0vIIYRjo0ti25ZniEcH+Q",
+	"jzhTo3JGppMO9aakSkGZT6gxRBqiBi0b+aOMsiJiq2kT2G86Oy+oNw5lbqeKo5woLbjot4v0cdtOjtM4",
+	"jjRHfzf8wGfqKkFspFPgxNGQ+qwth0I1K3nu6tXh7NozF7vyOTrlVV3gyNhia83NkTagzfQVdu8u74wz",
+	"a/3ONjMzBC9mmOWzwM4Tkb/Gql+sfqIsYTb0X2wuxruzn7opGOFcRu1fR0q8eHl69vLk2cXLF+hvIdjX",
+	"UplUvEL6Fsdr3IzvEnAZejr/9onGYIIl6bAbKo0pm9lbc2mQm98Q3+2p7zYfZ2IfJS7ZvLQTzXPS+pP7",
+	"aGNFcuIkAcosJWnUxkvjUmcIV9SNh3RwUS1aQlOGJZEWn5tSg0L4PHvCMk29xL0O1Q3YIkWe9k2YTz3d",
+	"ydCXub+xlUL0GZjZpppCGC7tCVMl0V/P377psr7XeOOWTlDOLbOsuFQr+hEx7hKotEWGOUuDsphOtOyn",
+	"VQW7qV+J4DPKcvJREyz6wb5QpeUQXFUExzIFZ5m10EdlAczipa8H6d63usI3GpwdGM7RWyd6G/x8aUOG",
+	"5fGCIbQwWvVigmYRsoUfHSP1DqfmHTPd0Vwmvzy5nI8YwYokdvGEKaEh6IdYTNKpPsGd0K1icVWXmM0E",
+	"wbkR8KLP/qztPen+MECYI1uowC7PCaGO0A1nnBlRyBgCcN5KboxFn7Rv/hlyVLT3ol451t8uSOPucCMC",
+	"tMkpyNd3TuYviMK0kP+4+XaI1l2LVrWjxjeHGqq0FPb62f/r79rlJrpHbH6PYRhx9wTXiCQ8Tc1nBvoN",
+	"UWN0HmtWIc/xg569Ibog30iiGpHBXI22NpAnHldeyFaItYGtNmbBSJE+Rcg8AhhGt+qRkz+wlHXp+Atm",
+	"m6aVxzdzuJrv3ehiIlPEBapZToSfJKHjGSpPczfDe0PpDcuQvDLmjir10pwFmgem5cVzXT3EOJrir5Yb",
+	"+bOyY5LccZ5WAYFtNsi9r5qEocWUm0pDwXyKQN3l9ikQOI083muS3tOpm3pW/eUOJkVvmXvTs3Ipzhbm",
+	"OV2tiGjyNxuzdZhCJ5B+7nRMNhjcob8cDh/06EOj0VDZ5F6Y4a2O6ENXnd0mfzzAuZXYPFspIs5JxvV2",
+	"UmWlQ62IaWPdpgxJ28XHtTf+cxdi6kpaWFtEPkfnvHQM3mfkWutJnH1r+I+2pZtLvTAagSIIG80GzZxH",
+	"h8swkGrfXmHMK/4BFdxG1er4/rBKfO0D3brDj6oJPp3UNIH871696J7mfPCYwnkPHVUXf4+Pjtr5cTnP",
+	"5FEtiZita5qTo6BTCfmHmqaw8sBrcMv9Z7dmTTXuwtanpMOlW7XpXAtr0fLWJ0jev+/k/cwFwXadJ+u1",
+	"5Zz/eXFx6s9Gt21qSFjOM0VPtMXPGS9G0oi7aO/wDozkMCgecMfFAw7QKLwR35tqPP+f7ypTcDBaBKfF",
+	"QQrIh6tNZ+Uu/UJvbjH5wcqBi4nb6AGaCXrmJfWswMKV3WKW/BwUDfnp175zTqyZU/szhZYyabpkXlxn",
+	"J8GZW3GH1ApWWuo4RouJSTKSUuuiIt7pvaOjrEhmjFNu8SOuKhuAWguqNjpXu7RXxXOCBRHPanWl/zLI",
+	"ozstzc/NsHoPk9/1GDSZ3vEHpIewjgNbgfVZUcQUjLz3UceFOZ8ceq87ceGsH8fILiY8NHBNmPkneY+u",
+	"jOJsBTqMjIrjnAuUaeMVZTNFPipjgzC11Mw3JxTwpbPWLzfO//Ge2NVkqnBNBZFEvXfChPnD3ov2qzHD",
+	"CMqURDR4kGQmCGEunJEqkyh9SkTGGQ67tdQYORuPJ0/nT+ZPXDVJhis6OZ58N38y13dAhdWVOZUjF4Mz",
+	"89BeEzUQkanhufardd2sQumNfK2UEzKcfGN3EvD8VT45nvxIVGNndOEQr6zf2CvQZsHfPnni3YbEOm1M",
+	"sSyLDEf/7RiLg8YOzpWe0CBf9/411Leqi4Y6NWC/v8PFvBSCi9Tk75gcmP5Pn2L6V16CcoYP4hrqXMuy",
+	"xGIzOZ448HlHv8Jrk1PZwNdmPh75cJeZDaWTRy5EdVa5KOnt2NeEg6XDf/Uo4U39ThHDEjO8tpTpSMaQ",
+	"8A9cWGNIaKrpzgpYspXy3J1NTlufnc5jbYGhOKIvjbUseHZNhHTPxPQ7Otm7EuZxG9PA78qIMEti2pqY",
+	"aZ8y3SOgHwpCVBx3fo+005sLyGZvsvmRqBbu2qTXVh2biJpCWsDk8neTwmzReOZF45k1ZEy6RDbZSXlH",
+	"ghcFr9VuCmwRRidf3WlceiyNq35jcdUCO3lcfzgTXEa1fOQIzD5zi/1EyO2nA/w+CL8digWsGUTsistt",
+	"GGiSG4wJ41A8W7Am2cw+UGBHytH7En88aXy075viGi78xe1FKl7JlnNowcIUlqGvjAW7ySCatn36rcw1",
+	"QZArijJfmNqW7398eYHGke57mxtj/N0RbabIySbekORlYaTo5zzf3BkCdacJaT8JnHKR04YN2k16G8Ae",
+	"JxvX3mmyQ1uM4ttPzSjOAsKYWhcPgEd8/+Qv9z/9Mx8Q57ZvdfXAAR4SqzrXJ7MnV7mruzng7iykq2vN",
+	"eq/L2F2+8QDov/ky+PrCHE0T7SDw1YO4CKEYPm6P6DlS/EObcgLnPI0m/Ctfyvu8lYcm1QuC63l/nNdw",
+	"SyGGw50YvwPoUQz7Pe7r1uAYMfIhmrmvm0UONV9VfJAMgymmGZDKGM299UFHg2lHK2vfr2nyO/ot/P77",
+	"8OXanbi1TSpRTqWJn9dbc3UD5uiV4YSEhS+USYUt32ndfA4YCxag8X42s/1m6VW/t6Ww+apleHLjv9ej",
+	"x7/bmcj7FJWHc34TpUTdh4CQpGpfbDWB2W8aAYs38P6kt/4QI0otN27yr3r/64uofff3XkB6UIwxnFmc",
+	"zhynBo5gi8MywMB1PywJxKzIctqCKLKb55ob3V/mzQsS78No761tqbGHXaRqCk079TNssG3JGVXc+O0M",
+	"e2EZ6Z+q0WnscvM0m44CQO+JRfc42wtSpXhbVLnk+Jdt6asxIlD9URvTJz6OpJVC2mZJ0wh3e06UVCAH",
+	"UdMOPKlE16RSU+N38vH1JnKCiMQ16ldonkJrlnhNSBVvvVlW98WFfhWQ0ev0J0tuCHNJKC6iP1kGgA4t",
+	"1pRC2W+Rlw+E959pEODC0iOw/YfO9s1xkQN4/sG205T6Nsi8B0ylgwj6uVnc5WdQDb8gtfD7J9/f//Rv",
+	"eB/HVvq67RdfeHCW5GFNdaSiWo/QU41BZLfV0WlUjeAyRKFNLScXPY3zvHlxLTSdNsJaM3Q0nY0vkXVl",
+	"68SkZSlvzPmEspStIheA/jYyjT0AZnOPymqz0wRGh48akO48+1ffQ9VY7ZmC0PJlCC3utPoiS9JOfW8K",
+	"qxxvrsb9ZysHwjO2m54/ja15P+Ny62heuz0lvfn2tTibMTQW/h13gYyZ9pFV0mdeSd99Hq7Gg04rauv3",
+	"8yTcW6VCRzH41hNA/RqcX4Jc2d40OBoOcDR0kCwiBQtk5KA8xqmQCYIVkc6R0B7ZyEbffOPzQ7/5xmSI",
+	"vn//Xv/nN/0/CC1CcPNicux/bNJIdcCt/M6T0mIybTdwj8/qVo5kQ5Pfp34CWZGsM7hGXD94a9CmyKz9",
+	"bP9+2moTqufaJvbPf9injptWofCrm8f82WtlK8e6HdSzjDAlcDF7upjEu/g9wO1WAMS/1oLcIwzN+FvB",
+	"GMrwboWkW+E/cGbSs/9hd7AFpp32MXC7gOsx0hODuC2u8tA46d0LzYlNu1LTCX5y0dthqChhKgZY0s9H",
+	"SM/3dAvABXCL+GBzaH3M3XIDDItDXUFnvExkv43zodgGMkFxka7vUsKNyv9+nvA06DH2pvZ9Cf0wR8Nn",
+	"ldS+T+W6AS1toyWLVHvR0sicjhSaZ7SH514fXlPt2YnNXSlzNGD/J9dT4Ia6nYF5H5KqzOtvw0RlbbF7",
+	"XR/oLSvsD00LV9TDF//wGamDlligtnuWZYefTRkny5oDkfucNUi6XxIfcebYTy7pZrjCGVWbkEm3y4Ki",
+	"X5pqHpXDqBJco6KiN4nnjFZUmYp/PLyL4OrBNQmd1h8liIG68WvrXW1QALB9C7nAmXl4wRTv0/9x2RMf",
+	"uLgmIrznTNLvYS+Yed5HTn1hELMmp4m7KiEFz/Tyo8IdwctuR9dVTWWr9kdcUX25WbDw1jgu7Fug7n1J",
+	"t9hyjrp7xdE+Q2U23DwVrXeDmaIz/975gom6sOEwstKT+EQGmwpsn9lGOS8xZWH9uoud27MTKu1Jkrz9",
+	"jL/DhgVrKt22HriyD07IqXk0mm2mIYXXvJThu/t5w/NXdGWfswjn/b7qv37+PizX18HF10SiSpCM5ITF",
+	"xcn9W/mpJ7TM68wSKT61B+Kf3XJ9us7D+Kn75Hg5J9IWNjHFITjCbqhk9GuB2Yt2bfYTB5Sv1bXp96e3",
+	"3gq//XS3T7wEiNzoxBGn+KF9PU+7+B9W/GyBWZ8As4aAkgnio/Jm3GBb78Ju41n04s1ejoXeFuxAu2I+",
+	"Bqy+HX5iJYGvlZukNzsgIw/B+bMbfkfvYogzffvk6adfjEW3HDl+Zdfx7adfx7MsI9XDCCF5aJbwAYzv",
+	"KQp7ssXA6W7BHW9rHB8i3gEzh5E0d/BLa+J8mPxyus/LVg4WpqiY5mE21NJWS33tHKi/eKfppR8luXFf",
+	"Ce++TDM+jt+G7QfjDMlRXZl92VyRjqWmE6ufFQSzuupaoXrL2Baqf3eEumfBRLB23NYXsRc3G+mMuAe2",
+	"8iNRwFPukadcPmRJDEi2cXQ8JOlDj8wFuQPlzI10N9rZmR3sX0Q987sdq595UD80BW3LPj6DhrZlNZ9W",
+	"RduyENDRxutoIvAEzyY9YPfkk4Hn3YZR3pme5on4rhW1h8I695OqHDQOE6vOWnzxS5CrQEf6XDrSdm5y",
+	"Wy3pDoi6ryYBRX+5mtItRCKg3C2q0nay3Z5jHAeF3Qfl2uATIN5PQLxfhkrmYshAJdtfJVvVBfDC4TTj",
+	"B6ET7ZXkmqw61DYUhamGco872CS/6poqnc1C8usBya895IsIxsMZOUDvnwDbo8r9MDtpAP0XsXyOvl8f",
+	"mqnzgVyo427SYnPPFk4wbR5k2tzFje4pME8e/eavf93K52sedK07X5bc2w2UuN+fu+V8UarTYSrTdl0p",
+	"Pq2H7RoGaeUOpRVPU5/DQdzjEbHD+NZMwg9iX9zpfz/ACJPgI2d+ycBIviBG4k4NOMldchLRkMLnMBjc",
+	"mfP0rp2mwBoglBXctA/PTbtLM7qtn/ZO/bPAPL4ETyxQ5d24YHeaTkf5YO9W6E96XoEsH7iP9XbG3wfg",
+	"VAVWcmcezM9n+rTmjGabe7y+foMF5bVsik7IwUCKOxU0TprFAm/7AkSO6LyAY9xN/FcWk8Dn5RyC5IQp",
+	"iot9WEfUK7z5cc9MI1oncI0vgWuEAwOucVdco0UDd8Q2ZvGot+EgFVViD9ZxyilTM8pmF7QkSJCMmwpf",
+	"+gWDT8RKTvWCgYd8ATzEnBRwj1txjx209rnlDmc316PTX8mYd19MrblQazCuRneg1rJgmaUuu5bwrpYr",
+	"Tax/05zFme9RhnUBuCVB8krU7NpWvdMjcF2Nc0nQWvAP/rHKTsU8W3gQ3fCiLgkiHyvMJOUsGU+nK/J1",
+	"6MEt4czCDFjYnTl7zkLlRn9eGsLIlF9syouhf9aYKao2U0Tm6zn605Mf6YDjxx3Qw+CqLbQxeAVM9RZR",
+	"bxpwCSbjEEZ4ovykbNW+KXi7MBbX96AYt5du/n+FEHa7V4jkuItIDhLwpkcuFsxjqcUPtAexHNXVWuCc",
+	"hCrHYyinIiw3xXvde3jIDSLbDxnEIfIL9izPqR4OF8VmiqhCuJA88YSdHxxnujWiipTSVgBmxMojS4Iq",
+	"IlZclCRHC7YkKy6IkTzwShG/GjNGA2S/Vr8W+6rnzdP50/mTqXv4W5CMlyVh7j3RWhKk/M61Otbbr3vg",
+	"nRd5mJbo1tI9014JkpnAbb04X4Y8eqD95un82/mTtKL2zg5nKrd+zRwl3iewklupNx7zKosrnos0L6p+",
+	"Iv5xhCv9niUuRpQeCiwjcQ23ngXeknfzBRDyMwMR8uCI+T7ecQhbfObRIPmCv5naHEPDqLe+DT3WLwyM",
+	"Yz/vrcXybWD/fJxEc4+Z/0Vheb3Hc7jxE9uekgqsNNbFwyIzLCIfSVZbUWNIeEldz6dc+iv6Qo/zV758",
+	"GJR9T9d0ar9QUL59vnw7fllrj7frP8zHr7U4sXUTnrAGucOOe18qLJz3oxnUEYUX/e2cA8azISVjumB0",
+	"TuatkiAnZ38nQuoZ9L0dRI7UbePsnCVltKzL5p2RGztAeJ+jvxwsQgSTXtoSq+yqUYV0nPRa6JP3b5zI",
+	"ulC2l7XjEosT9nGXnqlwwd5Jgt7/+PIC3RUnfW82K3B23WKVKUb30hwR6RL/1yrDdPf50mNo8v2IAIGA",
+	"v1sIZ4QQ8+2nZtZhe5YqH0Ri7/dP/nL/0z9j3DhOtosDhoaDIOHo+WGybUeniQ19aimuSQfaN5DfMeK7",
+	"cW87w9mX4RYifrFfikvaQRfMNYfFsoRz32b3vUUBtMMpqR19/y9OTPcXNT9MRw87aB7o/65i5kexgLu5",
+	"qm2TWcbZiq5nipSVMYqMdfoYjc0yFjsECkNs95omfaZ2dydmoIuwlK/ZgJLaMfhPD/CfDiBjREsW5MjC",
+	"HHmg71UNjA1MsytQYMFedLi3tO9kEpYRhJtxPlB1ZS9nR+PzioiMMzzPeDlAs/oKZ1wZsDvPRXuVjkaa",
+	"xUpUErE2Bgpn6Eh26N44C/bhirDkJz1m5spSGVO+fVvZ2DhucFETiSRRiA701i+YRg+YDhdRS5HN12p+",
+	"SO41pbcnUfKTSgJjlwqcbFT5MDJ0oiNY2bB0MHTj7y8k3LbKxwDzHHqwf8GeNY0CuzSt+nbXzDBBLQrb",
+	"GfPh0iAPkolsVWcGEeJ+TATffzH+00/iyUkz2Af6NrBF8YNYyHi/6p4EnTLRATF+Vp0DvLZfMK1r8+Eh",
+	"hD7emLj3za0F/+wKszWxzk0NQAOlJonE3+jax9u/z2umaKHbbUx/wYtC6xa1GjZQAisBtQQY3tfM8Jy9",
+	"9AvRj4400+KWx+6wMFnWKIdtMeFgFDcxsslYmAXbZoeyYfON2Ske1nHsvqmmmbdtokE6XxKpZJ/eulIs",
+	"+8zCBnj2ZxX/3CmcmTAkYI1fMGvUJ6nFowfHHF3I3KziBc02+8Xtdn3Ybixkx/IUOWx1v7givq3elaCZ",
+	"2jqwSy1ySc+1bATbYV4bpjSedBkCWcgK14UKIw9EqNjDcHGJpxZEX7/fq71fMBQfovm1aeKg8BFBqkKT",
+	"8R3Q3lYV7QGi+33pSTsx/eXAKX5qLQlI8k51k72ocue127pD6fZrt+SMKq5xe0aZVJhl+yXBN/1R6K8l",
+	"e9xLhUmGcrwO3V+F2UdQuL1B+SpEyddVt4D4Q7/aEjuHiI4DIjpSiBgRUgPu/R90SwxtE0hTX3ygncMy",
+	"id5rrHrvAu8k0RbJ51jLitxKhP67ze+oSKboDUHXZGPDO6wMXVuwmzR22RrrvM6uEJZTXSfHDHWMqrJ8",
+	"P9UDMvRe/9sMFvfUGZZU57eaGXB7juFwij7KPjRavft7ub9nCwuTwCGHoi9fD+PF53uyLnF8wGxuG3SR",
+	"oPxhbjN8Yyev3z2v69vGV6SY15CLxoRmnb5+jRS/JiHnLjWCyaC/4dc2oU13cQW89D9xXlLWKnqKRcOO",
+	"5gMRGLfjO1sWeV/Vr3rs7vU+cz9gnmfPYZjnpc/WWEQUd/iA1EgUmk9+hxiT7dOn7pcHHWDSOWaGt7HL",
+	"kclf+/CvlDHtMLby+itnKyCEAG2PMCvuJQdVOr19ZAzJQdRtrSsgN3xuucGew3ZdqdylK/n0AFCWgE8d",
+	"Ymv9TCrbP2uu8H6OTNNlq8/EeA+dyciszknbXS/jfMGMwqo3zQWSGS70P93Li+0YuiXZcJZHC6DSHKip",
+	"tZyOkP+RqMC7/o/u807i9V5c9otzSab2C2aUvWmyufIsrtUOcTw5/kgYEbiwJda3E2RDd4YMKyJKKo0X",
+	"fTzVxSVEQ/dQbqmWJnoJK5O4VgtBmCo2qOBrmxZnrMHfvPyIy6ogx98s2DMp69JqvCsdM/NBE93Z82cn",
+	"zsNja53rYSV6jwvqKfr9ki/fHy/Y+/fvF6yaIsELcpyTm2lDJ3KKBMH5FH3TadFN552ib6bom6PBZp7u",
+	"W+2WfLm1yXqKzHKbEd1i9U2uAWrqG1qodrbfBazbt9/tbwuG0GIStVpMjtEv+lfk/6P/bzEx/RaTafxb",
+	"A57OBw2rzk/fLCb2z8vpyNG7oO0P2P776IApQsTI+Dn0fy4X7HcHyWcs3wX6GM3GA37Jl/e36mQZW0nE",
+	"abOuyX1Wku1MBSz9dtVkJRExukUc/VmtrghTbmFoUT958u2fkf6VC/qr+XFy+bvh4Dyf6RXltRZWGjf2",
+	"Hm7piueoGQL5Ibx8dN08RdDUSrNMrOEkXvDxVWCxD2bBgpipGnlLV4zFM0m02KNIvmDJhGw33qyZIk7G",
+	"njYTaLccrxWiCpV4E4LLKEOYbVrC3UV/8nQyuAswm624GJg/KtXQNNBzfrii2dWCqSY6jspudkZfmuQr",
+	"RJUMFfI2VXB6he3hcBu+/+Y9kgqzXC6YZlD6CKNFNB3CjzMnFmc+dG6owP4pz88DIoyLIXrRLe+nF2+u",
+	"/1Oeo2Y0dNoGR4aXBUGKzwfesrDDXWhhNZZeCatLTSDVx0yvTJa5qQbKpVoLIv9ZTC6nYx7eMFeul2LS",
+	"CzV7uMISYYUKgqVCT5GoCzK04Cssz+qCyNZyey+tH7CWQdTphEoK0iHGoRXH+sLnqnXawzeI6zggrmOA",
+	"k0cXSxK/9o/ySE20GQ6GSPOV+yks2Z9pwJaW3MPnjzwYuQOgh1GhB8lDHkUPwzr0kMi1RRw7qgS5oeTD",
+	"iHwlorN+gmUfr1aUUbUxV4/h9ngAcfEaUybtNeHU7gX7wMU1EYhx8wAAy3XfcGf0BbtGeKiqYuPrxQfq",
+	"XrCX1JTzfG9/emOq3Ok1MUQ+UqliOgqt3vcCsdAPoQxvOPYFaycBOTmCIE9oscjFfXHh0B1lvC5yVOg9",
+	"avHQ9sRSa2hGGrN1SB0ghK6JnBV17h8acO+7EZxdGUgjKpHEisoV1XLK3Oc75MOvVVMlSbFCOdcPtRlo",
+	"oA1RUyRdSq45P0kKkikH2HLBHpknvWSlNW/3qwkVpxl2hx12+Ngu22FH3jrvCPh6haxI8uJTi4OflRm7",
+	"NbjHCfZjzYojT0afmSG7XYCXoxPZkjy2h+nncEf4YC6I3+zMs9uFp6UJZriGwEDw2C30v9g/kZYK93uD",
+	"MbGE7e8wRnB7MH4PyufX/y7nuKIlzq4oI2Izr67X+gc5L4nC85un83OFVS3/cfMtiHe3DpW6PfWOjJs6",
+	"mLDMCyZAVaAZPbCXR25LN+MK2+DDCceFw/yr0c5DN4l8jmrYQPh3GdrzqSVe31bu8VZFhiucabOHeWz0",
+	"BtPCuAvCUJ42/5byTaXu4Kahexj5LKzqHhF3y6yAv/ub9CwMGyyIkLaBtPOLSmKcqqM0KcpucEHtzfXS",
+	"Yrj5/a8/X9gEjGGN6dxNc1ASxref4CWeC85RqT2iWClSVko+rGd1Iqj/xNe8Vns7w3d6MKiUdXBghKM1",
+	"MR46OMmGOqKV4KVhLdGSvPnPZ4Iax31ZS+0fvLFWyvcFX1P23jCuJS2o2uINiXHmHp4HlUScNIlFQ1e9",
+	"2UOUgHTnF3ol9N6Vi0UwsE7Gd/tfrJTxJZnU/mXJlmS1oGozOf7lcgsRU3argBZJlDZl7/kMqe/lBQO/",
+	"FhN1XBQ2WTslGJz76e5RDAhzjEbuLVCOFjwQ/2mgaJPGZ1mBpST7AtN2Rq5zJID5EJ7IXaR/oMIyR0k5",
+	"I2K6YN6hYouM6mgEdMMLHeZJPlaYhZci2+0EadV3ai1jKGTl3DY6cfu8z1OMZnrFVhwiFW5315+3sWub",
+	"EGcDnffC3ZPTd1NUkpKLzRTlVF4bPGuXUkDu3nXevx3FyCoiOpXI9C99/1/8WKqiJUECs7V3HdoXSE30",
+	"03otyNq48IKsYfaJpAmJlqYeJNOTUJ7TDBfFRq/OcTQ33snpO7MUu1M3ALW+v+a5U9rTkkqiBM28QNRQ",
+	"9nwoqBSvyZkZbpfZ5VxhoTz7jfaPXlhyNg7g756gHG90+sSKO2onLE/0GghZ0hBrRSutuCixsg80kZke",
+	"YDIiAOwly3etNHKjmzZDK1L8Dtbztjm1CB96+SkPNo4rRhPgiPsXmHQKrTt34ent8PwOV91qPxbqOnVF",
+	"Kd3MbiLFLFyRM30x3ucl7KbZT5IKgPa9h0WntuD12+Q5wYIILadqOUwTkQWB5YC1KCbHk6Obp5PfL8OY",
+	"PWajA13UldYvBSkM41e8y5edbUM2VN18nPw+HT9miMftj9j9dLtxX7oH8PrD2i8HrRadEam4iId3vxw2",
+	"7HNz/0ej2h/2GvR5txxTayjkxJrRQzapkc1QUV7l2GFwW7Ey9tKWVhUGH6OC9WeNCUSUtite8loNqlnN",
+	"jHHfQ5ANNQ8qh7Gbn8YOHPIaXNA8z2yq54vnQYYz4VOK2zCxZq60RXyfDQlSS6NAdUt/tqqJRVMOVBIe",
+	"jRW5CSfT2CBIyW98bFlzPWirAl5bwdedYjN7k3B46tU6g5OXv///AwAtvkr9rsgFAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -77,6 +77,21 @@ const (
 	DatabaseClusterRestoreSpecDataSourcePitrTypeLatest DatabaseClusterRestoreSpecDataSourcePitrType = "latest"
 )
 
+// Defines values for EngineVersionRuleMode.
+const (
+	All         EngineVersionRuleMode = "all"
+	Pinned      EngineVersionRuleMode = "pinned"
+	Recommended EngineVersionRuleMode = "recommended"
+)
+
+// Defines values for FleetUpgradeRolloutState.
+const (
+	FleetUpgradeRolloutStateFailed    FleetUpgradeRolloutState = "failed"
+	FleetUpgradeRolloutStateIdle      FleetUpgradeRolloutState = "idle"
+	FleetUpgradeRolloutStateRunning   FleetUpgradeRolloutState = "running"
+	FleetUpgradeRolloutStateSucceeded FleetUpgradeRolloutState = "succeeded"
+)
+
 // Defines values for MonitoringInstanceBaseType.
 const (
 	MonitoringInstanceBaseTypePmm MonitoringInstanceBaseType = "pmm"
@@ -97,6 +112,45 @@ const (
 	MonitoringInstanceUpdateParamsTypePmm MonitoringInstanceUpdateParamsType = "pmm"
 )
 
+// Defines values for NamespaceProvisioningJobOperation.
+const (
+	Deprovision NamespaceProvisioningJobOperation = "deprovision"
+	Provision   NamespaceProvisioningJobOperation = "provision"
+	Update      NamespaceProvisioningJobOperation = "update"
+)
+
+// Defines values for NamespaceProvisioningJobState.
+const (
+	NamespaceProvisioningJobStateFailed    NamespaceProvisioningJobState = "failed"
+	NamespaceProvisioningJobStateRunning   NamespaceProvisioningJobState = "running"
+	NamespaceProvisioningJobStateSucceeded NamespaceProvisioningJobState = "succeeded"
+)
+
+// Defines values for NamespaceProvisioningStepState.
+const (
+	NamespaceProvisioningStepStateFailed     NamespaceProvisioningStepState = "failed"
+	NamespaceProvisioningStepStateInProgress NamespaceProvisioningStepState = "inProgress"
+	NamespaceProvisioningStepStatePending    NamespaceProvisioningStepState = "pending"
+	NamespaceProvisioningStepStateSkipped    NamespaceProvisioningStepState = "skipped"
+	NamespaceProvisioningStepStateSucceeded  NamespaceProvisioningStepState = "succeeded"
+)
+
+// Defines values for NamespaceUpgradeProgressState.
+const (
+	NamespaceUpgradeProgressStateFailed     NamespaceUpgradeProgressState = "failed"
+	NamespaceUpgradeProgressStateInProgress NamespaceUpgradeProgressState = "inProgress"
+	NamespaceUpgradeProgressStatePending    NamespaceUpgradeProgressState = "pending"
+	NamespaceUpgradeProgressStateSkipped    NamespaceUpgradeProgressState = "skipped"
+	NamespaceUpgradeProgressStateSucceeded  NamespaceUpgradeProgressState = "succeeded"
+)
+
+// Defines values for PMMTokenRevocationState.
+const (
+	PMMTokenRevocationStateFailed  PMMTokenRevocationState = "failed"
+	PMMTokenRevocationStateRevoked PMMTokenRevocationState = "revoked"
+	PMMTokenRevocationStateSkipped PMMTokenRevocationState = "skipped"
+)
+
 // Defines values for PodSchedulingPolicySpecEngineType.
 const (
 	PodSchedulingPolicySpecEngineTypePostgresql PodSchedulingPolicySpecEngineType = "postgresql"
@@ -104,6 +158,22 @@ const (
 	PodSchedulingPolicySpecEngineTypePxc        PodSchedulingPolicySpecEngineType = "pxc"
 )
 
+// Defines values for PostUpgradeTaskResultState.
+const (
+	PostUpgradeTaskResultStateFailed     PostUpgradeTaskResultState = "failed"
+	PostUpgradeTaskResultStateInProgress PostUpgradeTaskResultState = "inProgress"
+	PostUpgradeTaskResultStatePending    PostUpgradeTaskResultState = "pending"
+	PostUpgradeTaskResultStateSkipped    PostUpgradeTaskResultState = "skipped"
+	PostUpgradeTaskResultStateSucceeded  PostUpgradeTaskResultState = "succeeded"
+)
+
+// Defines values for PostUpgradeTasksJobState.
+const (
+	PostUpgradeTasksJobStateFailed    PostUpgradeTasksJobState = "failed"
+	PostUpgradeTasksJobStateRunning   PostUpgradeTasksJobState = "running"
+	PostUpgradeTasksJobStateSucceeded PostUpgradeTasksJobState = "succeeded"
+)
+
 // Defines values for UpgradeTaskPendingTask.
 const (
 	NotReady      UpgradeTaskPendingTask = "notReady"
@@ -141,6 +211,90 @@ type BackupStorageType string
 // BackupStoragesList defines model for BackupStoragesList.
 type BackupStoragesList = []BackupStorage
 
+// CapacityPlan Placement of a prospective database cluster on the current nodes
+type CapacityPlan struct {
+	Components []CapacityPlanComponent `json:"components"`
+
+	// Fits True if every replica and the storage fit
+	Fits  bool               `json:"fits"`
+	Nodes []CapacityPlanNode `json:"nodes"`
+
+	// PodSchedulingPolicyName Name of the pod scheduling policy used for the placement, empty if none or the policy of the request is used
+	PodSchedulingPolicyName string              `json:"podSchedulingPolicyName,omitempty"`
+	Storage                 CapacityPlanStorage `json:"storage"`
+}
+
+// CapacityPlanComponent defines model for CapacityPlanComponent.
+type CapacityPlanComponent struct {
+	// Component One of engine, proxy or configServer
+	Component string `json:"component"`
+
+	// CpuMillis CPU requested by a replica
+	CpuMillis uint64 `json:"cpuMillis"`
+
+	// MemoryBytes Memory requested by a replica
+	MemoryBytes uint64                `json:"memoryBytes"`
+	Replicas    []CapacityPlanReplica `json:"replicas"`
+}
+
+// CapacityPlanNode defines model for CapacityPlanNode.
+type CapacityPlanNode struct {
+	AllocatableCpuMillis   uint64 `json:"allocatableCpuMillis"`
+	AllocatableMemoryBytes uint64 `json:"allocatableMemoryBytes"`
+
+	// AvailableCpuMillis CPU not requested by the existing pods
+	AvailableCpuMillis uint64 `json:"availableCpuMillis"`
+
+	// AvailableMemoryBytes Memory not requested by the existing pods
+	AvailableMemoryBytes uint64 `json:"availableMemoryBytes"`
+	Name                 string `json:"name"`
+
+	// PlannedCpuMillis CPU requested by the replicas placed on the node
+	PlannedCpuMillis uint64 `json:"plannedCpuMillis"`
+
+	// PlannedMemoryBytes Memory requested by the replicas placed on the node
+	PlannedMemoryBytes uint64 `json:"plannedMemoryBytes"`
+}
+
+// CapacityPlanReplica defines model for CapacityPlanReplica.
+type CapacityPlanReplica struct {
+	Fits bool `json:"fits"`
+
+	// Node Node the replica is placed on, empty if it doesn't fit
+	Node string `json:"node,omitempty"`
+
+	// Reason Reason the replica doesn't fit
+	Reason string `json:"reason,omitempty"`
+}
+
+// CapacityPlanRequest Prospective database cluster to plan the capacity for
+type CapacityPlanRequest struct {
+	// DatabaseCluster DatabaseCluster is the Schema for the databaseclusters API.
+	DatabaseCluster DatabaseCluster `json:"databaseCluster"`
+
+	// PodSchedulingPolicy PodSchedulingPolicy is the Schema for the Pod Scheduling Policy API.
+	PodSchedulingPolicy *PodSchedulingPolicy `json:"podSchedulingPolicy,omitempty"`
+}
+
+// CapacityPlanStorage defines model for CapacityPlanStorage.
+type CapacityPlanStorage struct {
+	// AvailableBytes Capacity available for the volumes, missing if unknown
+	AvailableBytes *uint64 `json:"availableBytes,omitempty"`
+	Fits           bool    `json:"fits"`
+
+	// Reason Reason the storage doesn't fit
+	Reason string `json:"reason,omitempty"`
+
+	// RequestedBytes Total size of the volumes
+	RequestedBytes uint64 `json:"requestedBytes"`
+
+	// StorageClass Storage class of the volumes, the default storage class is used if the database cluster doesn't set one
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// Volumes Number of volumes of the database cluster
+	Volumes int `json:"volumes"`
+}
+
 // CreateBackupStorageParams Backup storage parameters
 type CreateBackupStorageParams struct {
 	AccessKey string `json:"accessKey"`
@@ -628,6 +782,18 @@ type DatabaseClusterComponent struct {
 	Type       *string                              `json:"type,omitempty"`
 }
 
+// DatabaseClusterConfigRollout Result of the engine config template rollout to a database cluster
+type DatabaseClusterConfigRollout struct {
+	// Message Error message if the rollout to the database cluster has failed
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the database cluster
+	Name string `json:"name"`
+
+	// Updated True if the engine config of the database cluster has been changed
+	Updated bool `json:"updated"`
+}
+
 // DatabaseClusterCredential kubernetes object
 type DatabaseClusterCredential struct {
 	ConnectionUrl *string `json:"connectionUrl,omitempty"`
@@ -815,11 +981,87 @@ type DatabaseEngineList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// EngineConfigTemplate Reusable engine config for the database clusters
+type EngineConfigTemplate struct {
+	// Config Engine config in the format of the database engine
+	Config      string  `json:"config"`
+	Description *string `json:"description,omitempty"`
+
+	// EngineType Type of the database engine the template can be used with (pxc, psmdb or postgresql)
+	EngineType string `json:"engineType"`
+
+	// Name Name of the engine config template
+	Name string `json:"name"`
+}
+
+// EngineConfigTemplateList defines model for EngineConfigTemplateList.
+type EngineConfigTemplateList struct {
+	Items []EngineConfigTemplate `json:"items"`
+}
+
+// EngineConfigTemplateRolloutResult Result of the engine config template rollout
+type EngineConfigTemplateRolloutResult struct {
+	Results []DatabaseClusterConfigRollout `json:"results"`
+}
+
+// EngineVersionPolicy Database engine version policy of a namespace
+type EngineVersionPolicy struct {
+	// Engines Version rules by database engine type
+	Engines *map[string]EngineVersionRule `json:"engines,omitempty"`
+}
+
+// EngineVersionRule Allowed and default versions of a database engine
+type EngineVersionRule struct {
+	// AllowedVersions Allowed versions, used with the pinned mode
+	AllowedVersions *[]string `json:"allowedVersions,omitempty"`
+
+	// DefaultVersion Version used for the new database clusters if no version is specified
+	DefaultVersion *string `json:"defaultVersion,omitempty"`
+
+	// Mode Defines the allowed versions:
+	// - all: all the versions available for the database engine
+	// - recommended: only the recommended versions
+	// - pinned: only the versions listed in allowedVersions
+	Mode *EngineVersionRuleMode `json:"mode,omitempty"`
+}
+
+// EngineVersionRuleMode Defines the allowed versions:
+// - all: all the versions available for the database engine
+// - recommended: only the recommended versions
+// - pinned: only the versions listed in allowedVersions
+type EngineVersionRuleMode string
+
 // Error Error response
 type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// FleetUpgradePlan Operators upgrade plan for all namespaces
+type FleetUpgradePlan struct {
+	Namespaces []NamespaceUpgradePlan `json:"namespaces"`
+}
+
+// FleetUpgradePlanApproval Parameters of a rolling upgrade of database engine operators across namespaces
+type FleetUpgradePlanApproval struct {
+	// MaxConcurrency Number of namespaces upgraded at a time
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
+
+	// Namespaces Namespaces to upgrade. If empty, all namespaces with pending upgrades are upgraded.
+	Namespaces *[]string `json:"namespaces,omitempty"`
+}
+
+// FleetUpgradeRollout Progress of a rolling upgrade of database engine operators across namespaces
+type FleetUpgradeRollout struct {
+	FinishedAt     *time.Time                 `json:"finishedAt,omitempty"`
+	MaxConcurrency *int                       `json:"maxConcurrency,omitempty"`
+	Namespaces     []NamespaceUpgradeProgress `json:"namespaces"`
+	StartedAt      *time.Time                 `json:"startedAt,omitempty"`
+	State          FleetUpgradeRolloutState   `json:"state"`
+}
+
+// FleetUpgradeRolloutState defines model for FleetUpgradeRollout.State.
+type FleetUpgradeRolloutState string
+
 // KubernetesClusterInfo kubernetes cluster info
 type KubernetesClusterInfo struct {
 	ClusterType string `json:"clusterType"`
@@ -850,22 +1092,45 @@ type ResourcesCapacity struct {
 	MemoryBytes *uint64 `json:"memoryBytes,omitempty"`
 }
 
-// MonitoringInstance Monitoring instance information
-type MonitoringInstance = MonitoringInstanceBaseWithName
+// MonitoringInstance defines model for MonitoringInstance.
+type MonitoringInstance struct {
+	// AllowedNamespaces List of namespaces allowed to use this monitoring instance
+	// Deprecated:
+	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
+
+	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+
+	// Status Connectivity status of the monitoring instance as of the last periodic check
+	Status *MonitoringInstanceStatus `json:"status,omitempty"`
+
+	// TokenRevocation Result of the revocation of the replaced PMM token. Returned only by the update that replaced the token. The tokens that could not be revoked can be purged with `everestctl monitoring purge-keys`.
+	TokenRevocation *PMMTokenRevocation        `json:"tokenRevocation,omitempty"`
+	Type            MonitoringInstanceBaseType `json:"type,omitempty"`
+	Url             string                     `json:"url,omitempty"`
+
+	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
+	VerifyTLS *bool `json:"verifyTLS,omitempty"`
+}
 
 // MonitoringInstanceBase Monitoring instance information
 type MonitoringInstanceBase struct {
 	// AllowedNamespaces List of namespaces allowed to use this monitoring instance
 	// Deprecated:
-	AllowedNamespaces *[]string                  `json:"allowedNamespaces,omitempty"`
-	Type              MonitoringInstanceBaseType `json:"type,omitempty"`
-	Url               string                     `json:"url,omitempty"`
+	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
+
+	// Type Type of the monitoring instance. Only PMM is supported, since the database operators
+	// export the metrics of the database clusters only through the PMM client.
+	Type MonitoringInstanceBaseType `json:"type,omitempty"`
+	Url  string                     `json:"url,omitempty"`
 
 	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
 	VerifyTLS *bool `json:"verifyTLS,omitempty"`
 }
 
-// MonitoringInstanceBaseType defines model for MonitoringInstanceBase.Type.
+// MonitoringInstanceBaseType Type of the monitoring instance. Only PMM is supported, since the database operators
+// export the metrics of the database clusters only through the PMM client.
 type MonitoringInstanceBaseType string
 
 // MonitoringInstanceBaseWithName defines model for MonitoringInstanceBaseWithName.
@@ -875,16 +1140,20 @@ type MonitoringInstanceBaseWithName struct {
 	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
 
 	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
-	Name      string                             `json:"name,omitempty"`
-	Namespace string                             `json:"namespace,omitempty"`
-	Type      MonitoringInstanceBaseWithNameType `json:"type,omitempty"`
-	Url       string                             `json:"url,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+
+	// Type Type of the monitoring instance. Only PMM is supported, since the database operators
+	// export the metrics of the database clusters only through the PMM client.
+	Type MonitoringInstanceBaseWithNameType `json:"type,omitempty"`
+	Url  string                             `json:"url,omitempty"`
 
 	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
 	VerifyTLS *bool `json:"verifyTLS,omitempty"`
 }
 
-// MonitoringInstanceBaseWithNameType defines model for MonitoringInstanceBaseWithName.Type.
+// MonitoringInstanceBaseWithNameType Type of the monitoring instance. Only PMM is supported, since the database operators
+// export the metrics of the database clusters only through the PMM client.
 type MonitoringInstanceBaseWithNameType string
 
 // MonitoringInstanceCreateParams defines model for MonitoringInstanceCreateParams.
@@ -894,11 +1163,14 @@ type MonitoringInstanceCreateParams struct {
 	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
 
 	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
-	Name      string                             `json:"name,omitempty"`
-	Namespace string                             `json:"namespace,omitempty"`
-	Pmm       *PMMMonitoringInstanceSpec         `json:"pmm,omitempty"`
-	Type      MonitoringInstanceCreateParamsType `json:"type,omitempty"`
-	Url       string                             `json:"url,omitempty"`
+	Name      string                     `json:"name,omitempty"`
+	Namespace string                     `json:"namespace,omitempty"`
+	Pmm       *PMMMonitoringInstanceSpec `json:"pmm,omitempty"`
+
+	// Type Type of the monitoring instance. Only PMM is supported, since the database operators
+	// export the metrics of the database clusters only through the PMM client.
+	Type MonitoringInstanceCreateParamsType `json:"type,omitempty"`
+	Url  string                             `json:"url,omitempty"`
 
 	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
 	VerifyTLS *bool `json:"verifyTLS,omitempty"`
@@ -911,28 +1183,47 @@ type PMMMonitoringInstanceSpec struct {
 	User     string `json:"user,omitempty"`
 }
 
-// MonitoringInstanceCreateParamsType defines model for MonitoringInstanceCreateParams.Type.
+// MonitoringInstanceCreateParamsType Type of the monitoring instance. Only PMM is supported, since the database operators
+// export the metrics of the database clusters only through the PMM client.
 type MonitoringInstanceCreateParamsType string
 
+// MonitoringInstanceDeleteParams defines model for MonitoringInstanceDeleteParams.
+type MonitoringInstanceDeleteParams = MonitoringInstancePMM
+
 // MonitoringInstancePMM defines model for MonitoringInstancePMM.
 type MonitoringInstancePMM struct {
 	Pmm *PMMMonitoringInstanceSpec `json:"pmm,omitempty"`
 }
 
+// MonitoringInstanceStatus Connectivity status of the monitoring instance as of the last periodic check
+type MonitoringInstanceStatus struct {
+	// Connectivity One of ok, unreachable, tlsError, unauthorized (the token has been revoked), expired (the token has expired) or error
+	Connectivity string `json:"connectivity,omitempty"`
+
+	// LastCheckTime Time of the check that observed the current connectivity status
+	LastCheckTime   *time.Time `json:"lastCheckTime,omitempty"`
+	LastError       string     `json:"lastError,omitempty"`
+	LastSuccessTime *time.Time `json:"lastSuccessTime,omitempty"`
+}
+
 // MonitoringInstanceUpdateParams defines model for MonitoringInstanceUpdateParams.
 type MonitoringInstanceUpdateParams struct {
 	// AllowedNamespaces List of namespaces allowed to use this monitoring instance
 	// Deprecated:
-	AllowedNamespaces *[]string                          `json:"allowedNamespaces,omitempty"`
-	Pmm               *PMMMonitoringInstanceSpec         `json:"pmm,omitempty"`
-	Type              MonitoringInstanceUpdateParamsType `json:"type,omitempty"`
-	Url               string                             `json:"url,omitempty"`
+	AllowedNamespaces *[]string                  `json:"allowedNamespaces,omitempty"`
+	Pmm               *PMMMonitoringInstanceSpec `json:"pmm,omitempty"`
+
+	// Type Type of the monitoring instance. Only PMM is supported, since the database operators
+	// export the metrics of the database clusters only through the PMM client.
+	Type MonitoringInstanceUpdateParamsType `json:"type,omitempty"`
+	Url  string                             `json:"url,omitempty"`
 
 	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
 	VerifyTLS *bool `json:"verifyTLS,omitempty"`
 }
 
-// MonitoringInstanceUpdateParamsType defines model for MonitoringInstanceUpdateParams.Type.
+// MonitoringInstanceUpdateParamsType Type of the monitoring instance. Only PMM is supported, since the database operators
+// export the metrics of the database clusters only through the PMM client.
 type MonitoringInstanceUpdateParamsType string
 
 // MonitoringInstancesList defines model for MonitoringInstancesList.
@@ -941,6 +1232,117 @@ type MonitoringInstancesList = []MonitoringInstance
 // NamespaceList defines model for NamespaceList.
 type NamespaceList = []string
 
+// NamespaceOperators Database engine operators installed in a namespace
+type NamespaceOperators struct {
+	// Mongodb Install the MongoDB operator
+	Mongodb *bool `json:"mongodb,omitempty"`
+
+	// Mysql Install the MySQL operator
+	Mysql *bool `json:"mysql,omitempty"`
+
+	// Postgresql Install the PostgreSQL operator
+	Postgresql *bool `json:"postgresql,omitempty"`
+}
+
+// NamespaceProvisioningJob Progress of provisioning, updating or removing a database namespace
+type NamespaceProvisioningJob struct {
+	FinishedAt *time.Time                        `json:"finishedAt,omitempty"`
+	Message    *string                           `json:"message,omitempty"`
+	Namespace  string                            `json:"namespace"`
+	Operation  NamespaceProvisioningJobOperation `json:"operation"`
+	StartedAt  *time.Time                        `json:"startedAt,omitempty"`
+	State      NamespaceProvisioningJobState     `json:"state"`
+	Steps      []NamespaceProvisioningStep       `json:"steps"`
+}
+
+// NamespaceProvisioningJobOperation defines model for NamespaceProvisioningJob.Operation.
+type NamespaceProvisioningJobOperation string
+
+// NamespaceProvisioningJobState defines model for NamespaceProvisioningJob.State.
+type NamespaceProvisioningJobState string
+
+// NamespaceProvisioningJobList defines model for NamespaceProvisioningJobList.
+type NamespaceProvisioningJobList = []NamespaceProvisioningJob
+
+// NamespaceProvisioningRequest Request for provisioning a database namespace
+type NamespaceProvisioningRequest struct {
+	// Namespace Name of the namespace
+	Namespace string `json:"namespace"`
+
+	// Operators Database engine operators installed in a namespace
+	Operators NamespaceOperators `json:"operators"`
+
+	// TakeOwnership If set, an existing namespace is made managed by Everest
+	TakeOwnership *bool `json:"takeOwnership,omitempty"`
+}
+
+// NamespaceProvisioningStep Progress of a single step of a namespace provisioning job
+type NamespaceProvisioningStep struct {
+	Description string                         `json:"description"`
+	Message     *string                        `json:"message,omitempty"`
+	State       NamespaceProvisioningStepState `json:"state"`
+}
+
+// NamespaceProvisioningStepState defines model for NamespaceProvisioningStep.State.
+type NamespaceProvisioningStepState string
+
+// NamespaceQuotaUsage Quota of a namespace and the resources used in the namespace
+type NamespaceQuotaUsage struct {
+	// Quota Limits of the namespace. A missing limit means that the resource is not limited.
+	Quota NamespaceQuota         `json:"quota"`
+	Usage NamespaceResourceUsage `json:"usage"`
+}
+
+// NamespaceQuota Limits of the namespace. A missing limit means that the resource is not limited.
+type NamespaceQuota struct {
+	// Cpu CPU limit as a Kubernetes quantity
+	Cpu *string `json:"cpu,omitempty"`
+
+	// DatabaseClusterBackups Maximum number of database cluster backups
+	DatabaseClusterBackups *int `json:"databaseClusterBackups,omitempty"`
+
+	// DatabaseClusters Maximum number of database clusters
+	DatabaseClusters *int `json:"databaseClusters,omitempty"`
+
+	// Disk Disk limit as a Kubernetes quantity
+	Disk *string `json:"disk,omitempty"`
+
+	// Memory Memory limit as a Kubernetes quantity
+	Memory *string `json:"memory,omitempty"`
+}
+
+// NamespaceResourceUsage defines model for .
+type NamespaceResourceUsage struct {
+	CpuMillis              uint64 `json:"cpuMillis"`
+	DatabaseClusterBackups int    `json:"databaseClusterBackups"`
+	DatabaseClusters       int    `json:"databaseClusters"`
+	DiskSize               uint64 `json:"diskSize"`
+	MemoryBytes            uint64 `json:"memoryBytes"`
+}
+
+// NamespaceUpgradePlan Operators upgrade plan for a single namespace
+type NamespaceUpgradePlan struct {
+	// Blockers Pending actions that need to be performed before the upgrade can be approved
+	Blockers *[]UpgradeTask `json:"blockers,omitempty"`
+
+	// Namespace Name of the namespace
+	Namespace      string         `json:"namespace"`
+	PendingActions *[]UpgradeTask `json:"pendingActions,omitempty"`
+	Upgrades       *[]Upgrade     `json:"upgrades,omitempty"`
+}
+
+// NamespaceUpgradeProgress Progress of the operators upgrade in a single namespace
+type NamespaceUpgradeProgress struct {
+	FinishedAt *time.Time                    `json:"finishedAt,omitempty"`
+	Message    *string                       `json:"message,omitempty"`
+	Namespace  string                        `json:"namespace"`
+	StartedAt  *time.Time                    `json:"startedAt,omitempty"`
+	State      NamespaceUpgradeProgressState `json:"state"`
+}
+
+// NamespaceUpgradeProgressState defines model for NamespaceUpgradeProgress.State.
+type NamespaceUpgradeProgressState string
+
 // OIDCConfig Everest OIDC provider configuration
 type OIDCConfig struct {
 	// ClientId OIDC application clientID
@@ -953,6 +1355,35 @@ type OIDCConfig struct {
 	Scopes []string `json:"scopes"`
 }
 
+// PMMTokenRevocation Result of the revocation of the replaced PMM token. Returned only by the update that replaced the token. The tokens that could not be revoked can be purged with `everestctl monitoring purge-keys`.
+type PMMTokenRevocation struct {
+	Message   string                  `json:"message,omitempty"`
+	State     PMMTokenRevocationState `json:"state"`
+	TokenName string                  `json:"tokenName,omitempty"`
+}
+
+// PMMTokenRevocationState defines model for PMMTokenRevocation.State.
+type PMMTokenRevocationState string
+
+// PodSchedulingComponentPreview defines model for PodSchedulingComponentPreview.
+type PodSchedulingComponentPreview struct {
+	// Component One of engine, proxy or configServer
+	Component string `json:"component"`
+
+	// ExcludedNodes Nodes the pods of the component can't land on
+	ExcludedNodes []PodSchedulingExcludedNode `json:"excludedNodes"`
+
+	// Nodes Nodes the pods of the component could land on
+	Nodes []string                   `json:"nodes"`
+	Rules []PodSchedulingRulePreview `json:"rules"`
+}
+
+// PodSchedulingExcludedNode defines model for PodSchedulingExcludedNode.
+type PodSchedulingExcludedNode struct {
+	Name    string   `json:"name"`
+	Reasons []string `json:"reasons"`
+}
+
 // PodSchedulingPolicy PodSchedulingPolicy is the Schema for the Pod Scheduling Policy API.
 type PodSchedulingPolicy struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
@@ -4546,6 +4977,81 @@ type PodSchedulingPolicyList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// PodSchedulingPolicyPreview Result of the evaluation of a pod scheduling policy against the current nodes
+type PodSchedulingPolicyPreview struct {
+	Components []PodSchedulingComponentPreview `json:"components"`
+	EngineType string                          `json:"engineType"`
+}
+
+// PodSchedulingPolicyPreviewRequest Pod scheduling policy to preview
+type PodSchedulingPolicyPreviewRequest struct {
+	// EngineType Database engine type (pxc, psmdb or postgresql), the engine type of the policy is used if empty
+	EngineType string `json:"engineType,omitempty"`
+
+	// Namespace Namespace of the database cluster, the pod affinity rules without namespaces select the pods of this namespace or of all namespaces if empty
+	Namespace string `json:"namespace,omitempty"`
+
+	// Policy PodSchedulingPolicy is the Schema for the Pod Scheduling Policy API.
+	Policy *PodSchedulingPolicy `json:"policy,omitempty"`
+
+	// PolicyName Name of an existing pod scheduling policy
+	PolicyName string `json:"policyName,omitempty"`
+}
+
+// PodSchedulingRulePreview defines model for PodSchedulingRulePreview.
+type PodSchedulingRulePreview struct {
+	Description string `json:"description"`
+
+	// MatchingNodes Schedulable nodes that satisfy the rule
+	MatchingNodes []string `json:"matchingNodes"`
+
+	// Required Set for the rules required during scheduling, the other rules are preferences
+	Required bool `json:"required"`
+
+	// Satisfiable Set if at least one schedulable node satisfies the rule
+	Satisfiable bool `json:"satisfiable"`
+
+	// Type One of nodeAffinity, podAffinity or podAntiAffinity
+	Type string `json:"type"`
+
+	// Weight Weight of a preferred rule
+	Weight int `json:"weight,omitempty"`
+}
+
+// PostUpgradeTaskResult Result of the post-upgrade task execution for a single database cluster
+type PostUpgradeTaskResult struct {
+	Message *string                    `json:"message,omitempty"`
+	State   PostUpgradeTaskResultState `json:"state"`
+	Task    UpgradeTask                `json:"task"`
+}
+
+// PostUpgradeTaskResultState defines model for PostUpgradeTaskResult.State.
+type PostUpgradeTaskResultState string
+
+// PostUpgradeTasksExecution Parameters of the post-upgrade tasks execution
+type PostUpgradeTasksExecution struct {
+	// BatchSize Number of database clusters updated at a time
+	BatchSize *int `json:"batchSize,omitempty"`
+
+	// Databases Database clusters to execute the pending tasks for. If omitted, all database clusters with pending tasks are selected.
+	Databases *[]string `json:"databases,omitempty"`
+
+	// WaitForReady Wait for the database clusters of a batch to become ready before proceeding with the next batch
+	WaitForReady *bool `json:"waitForReady,omitempty"`
+}
+
+// PostUpgradeTasksJob Progress of the post-upgrade tasks execution in a namespace
+type PostUpgradeTasksJob struct {
+	FinishedAt *time.Time               `json:"finishedAt,omitempty"`
+	Namespace  string                   `json:"namespace"`
+	Results    []PostUpgradeTaskResult  `json:"results"`
+	StartedAt  *time.Time               `json:"startedAt,omitempty"`
+	State      PostUpgradeTasksJobState `json:"state"`
+}
+
+// PostUpgradeTasksJobState defines model for PostUpgradeTasksJob.State.
+type PostUpgradeTasksJobState string
+
 // Settings Everest global settings
 type Settings struct {
 	// OidcConfig Everest OIDC provider configuration
@@ -4563,6 +5069,36 @@ type StorageClass struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// StorageClassInfo Storage class available in the cluster
+type StorageClassInfo struct {
+	// AllowVolumeExpansion Whether the volumes of the storage class can be expanded
+	AllowVolumeExpansion bool `json:"allowVolumeExpansion"`
+
+	// Default Whether the storage class is used by the database clusters without a storage class
+	Default           bool   `json:"default"`
+	Name              string `json:"name"`
+	Provisioner       string `json:"provisioner"`
+	ReclaimPolicy     string `json:"reclaimPolicy,omitempty"`
+	VolumeBindingMode string `json:"volumeBindingMode,omitempty"`
+}
+
+// StorageClassInfoList defines model for StorageClassInfoList.
+type StorageClassInfoList = []StorageClassInfo
+
+// StorageResizeCheck Feasibility of resizing the storage of a database cluster
+type StorageResizeCheck struct {
+	AllowVolumeExpansion bool   `json:"allowVolumeExpansion"`
+	CurrentSize          string `json:"currentSize"`
+	Feasible             bool   `json:"feasible"`
+
+	// Reason Why the resize is not feasible
+	Reason        string `json:"reason,omitempty"`
+	RequestedSize string `json:"requestedSize"`
+
+	// StorageClass Storage class of the database cluster, the default one if the database cluster has none
+	StorageClass string `json:"storageClass,omitempty"`
+}
+
 // UpdateBackupStorageParams Backup storage parameters
 type UpdateBackupStorageParams struct {
 	AccessKey *string `json:"accessKey,omitempty"`
@@ -4616,6 +5152,64 @@ type UpgradeTask struct {
 // UpgradeTaskPendingTask Pending task for the database cluster
 type UpgradeTaskPendingTask string
 
+// UsageAggregate Resources requested and used by a database cluster or by all database clusters in a namespace.
+// The used CPU and memory are missing if the metrics API was never available.
+// The used disk is the space used on the volumes reported by the kubelets, missing if it was never available.
+// The backup size is only known for the backups whose size is reported by the database operator.
+type UsageAggregate struct {
+	// BackupBytes Statistics of a resource over the samples
+	BackupBytes UsageStat `json:"backupBytes"`
+
+	// Backups Maximum number of backups
+	Backups int `json:"backups"`
+
+	// CpuRequestedMillis Statistics of a resource over the samples
+	CpuRequestedMillis UsageStat `json:"cpuRequestedMillis"`
+
+	// CpuUsedMillis Statistics of a resource over the samples
+	CpuUsedMillis *UsageStat `json:"cpuUsedMillis,omitempty"`
+
+	// DiskRequestedBytes Statistics of a resource over the samples
+	DiskRequestedBytes UsageStat `json:"diskRequestedBytes"`
+
+	// DiskUsedBytes Statistics of a resource over the samples
+	DiskUsedBytes *UsageStat `json:"diskUsedBytes,omitempty"`
+	EngineType    string     `json:"engineType,omitempty"`
+
+	// Hours Number of hours covered by the samples
+	Hours float64 `json:"hours"`
+
+	// MemoryRequestedBytes Statistics of a resource over the samples
+	MemoryRequestedBytes UsageStat `json:"memoryRequestedBytes"`
+
+	// MemoryUsedBytes Statistics of a resource over the samples
+	MemoryUsedBytes *UsageStat `json:"memoryUsedBytes,omitempty"`
+
+	// Name Name of the database cluster. Empty for the namespace aggregates.
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace"`
+}
+
+// UsageReport Resources requested and used in a time range
+type UsageReport struct {
+	DatabaseClusters []UsageAggregate `json:"databaseClusters"`
+	From             time.Time        `json:"from"`
+	Namespaces       []UsageAggregate `json:"namespaces"`
+
+	// Samples Number of usage samples taken in the time range
+	Samples int       `json:"samples"`
+	To      time.Time `json:"to"`
+}
+
+// UsageStat Statistics of a resource over the samples
+type UsageStat struct {
+	Average float64 `json:"average"`
+	Peak    float64 `json:"peak"`
+
+	// Total Sum of the resource over time in unit-hours, e.g. millicore-hours or byte-hours
+	Total float64 `json:"total"`
+}
+
 // UserCredentials defines model for UserCredentials.
 type UserCredentials struct {
 	Password *string `json:"password,omitempty"`
@@ -4716,6 +5310,15 @@ type IoK8sApimachineryPkgApisMetaV1StatusV2 struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// DeprovisionNamespaceParams defines parameters for DeprovisionNamespace.
+type DeprovisionNamespaceParams struct {
+	// KeepNamespace If set, the namespace is kept, but it is no longer managed by Everest
+	KeepNamespace *bool `form:"keepNamespace,omitempty" json:"keepNamespace,omitempty"`
+
+	// Force If set, the namespace is removed even if there are database clusters in it
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
 // DeleteDatabaseClusterBackupParams defines parameters for DeleteDatabaseClusterBackup.
 type DeleteDatabaseClusterBackupParams struct {
 	// CleanupBackupStorage If set, remove the backed up data from storage
@@ -4728,6 +5331,12 @@ type DeleteDatabaseClusterParams struct {
 	CleanupBackupStorage *bool `form:"cleanupBackupStorage,omitempty" json:"cleanupBackupStorage,omitempty"`
 }
 
+// CheckDatabaseClusterStorageResizeParams defines parameters for CheckDatabaseClusterStorageResize.
+type CheckDatabaseClusterStorageResizeParams struct {
+	// Size Requested storage size as a Kubernetes quantity, e.g. 50Gi
+	Size string `form:"size" json:"size"`
+}
+
 // ListPodSchedulingPolicyParams defines parameters for ListPodSchedulingPolicy.
 type ListPodSchedulingPolicyParams struct {
 	// EngineType Database engine type that Pod Scheduling Policy is applicable to.
@@ -4735,17 +5344,44 @@ type ListPodSchedulingPolicyParams struct {
 
 	// HasRules Return list of Pod Scheduling Policy that has at least 1 rule.
 	HasRules *bool `form:"hasRules,omitempty" json:"hasRules,omitempty"`
+
+	// Namespace Return list of Pod Scheduling Policy that the database clusters of the namespace are allowed to use.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
 }
 
 // ListPodSchedulingPolicyParamsEngineType defines parameters for ListPodSchedulingPolicy.
 type ListPodSchedulingPolicyParamsEngineType string
 
+// GetUsageReportParams defines parameters for GetUsageReport.
+type GetUsageReportParams struct {
+	// From Start of the time range. Defaults to 30 days before the end of the time range.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the time range. Defaults to the current time.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Namespace Only report the usage in the namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// ApproveFleetUpgradePlanJSONRequestBody defines body for ApproveFleetUpgradePlan for application/json ContentType.
+type ApproveFleetUpgradePlanJSONRequestBody = FleetUpgradePlanApproval
+
+// ProvisionNamespaceJSONRequestBody defines body for ProvisionNamespace for application/json ContentType.
+type ProvisionNamespaceJSONRequestBody = NamespaceProvisioningRequest
+
+// UpdateNamespaceOperatorsJSONRequestBody defines body for UpdateNamespaceOperators for application/json ContentType.
+type UpdateNamespaceOperatorsJSONRequestBody = NamespaceOperators
+
 // CreateBackupStorageJSONRequestBody defines body for CreateBackupStorage for application/json ContentType.
 type CreateBackupStorageJSONRequestBody = CreateBackupStorageParams
 
 // UpdateBackupStorageJSONRequestBody defines body for UpdateBackupStorage for application/json ContentType.
 type UpdateBackupStorageJSONRequestBody = UpdateBackupStorageParams
 
+// PlanDatabaseClusterCapacityJSONRequestBody defines body for PlanDatabaseClusterCapacity for application/json ContentType.
+type PlanDatabaseClusterCapacityJSONRequestBody = CapacityPlanRequest
+
 // CreateDatabaseClusterBackupJSONRequestBody defines body for CreateDatabaseClusterBackup for application/json ContentType.
 type CreateDatabaseClusterBackupJSONRequestBody = DatabaseClusterBackup
 
@@ -4764,18 +5400,36 @@ type UpdateDatabaseClusterJSONRequestBody = DatabaseCluster
 // ApproveUpgradePlanJSONRequestBody defines body for ApproveUpgradePlan for application/json ContentType.
 type ApproveUpgradePlanJSONRequestBody = UpgradePlanApproval
 
+// ExecutePostUpgradeTasksJSONRequestBody defines body for ExecutePostUpgradeTasks for application/json ContentType.
+type ExecutePostUpgradeTasksJSONRequestBody = PostUpgradeTasksExecution
+
 // UpdateDatabaseEngineJSONRequestBody defines body for UpdateDatabaseEngine for application/json ContentType.
 type UpdateDatabaseEngineJSONRequestBody = DatabaseEngine
 
+// CreateEngineConfigTemplateJSONRequestBody defines body for CreateEngineConfigTemplate for application/json ContentType.
+type CreateEngineConfigTemplateJSONRequestBody = EngineConfigTemplate
+
+// UpdateEngineConfigTemplateJSONRequestBody defines body for UpdateEngineConfigTemplate for application/json ContentType.
+type UpdateEngineConfigTemplateJSONRequestBody = EngineConfigTemplate
+
+// UpdateEngineVersionPolicyJSONRequestBody defines body for UpdateEngineVersionPolicy for application/json ContentType.
+type UpdateEngineVersionPolicyJSONRequestBody = EngineVersionPolicy
+
 // CreateMonitoringInstanceJSONRequestBody defines body for CreateMonitoringInstance for application/json ContentType.
 type CreateMonitoringInstanceJSONRequestBody = MonitoringInstanceCreateParams
 
+// DeleteMonitoringInstanceJSONRequestBody defines body for DeleteMonitoringInstance for application/json ContentType.
+type DeleteMonitoringInstanceJSONRequestBody = MonitoringInstanceDeleteParams
+
 // UpdateMonitoringInstanceJSONRequestBody defines body for UpdateMonitoringInstance for application/json ContentType.
 type UpdateMonitoringInstanceJSONRequestBody = MonitoringInstanceUpdateParams
 
 // CreatePodSchedulingPolicyJSONRequestBody defines body for CreatePodSchedulingPolicy for application/json ContentType.
 type CreatePodSchedulingPolicyJSONRequestBody = PodSchedulingPolicy
 
+// PreviewPodSchedulingPolicyJSONRequestBody defines body for PreviewPodSchedulingPolicy for application/json ContentType.
+type PreviewPodSchedulingPolicyJSONRequestBody = PodSchedulingPolicyPreviewRequest
+
 // UpdatePodSchedulingPolicyJSONRequestBody defines body for UpdatePodSchedulingPolicy for application/json ContentType.
 type UpdatePodSchedulingPolicyJSONRequestBody = PodSchedulingPolicy
 
@@ -5292,6 +5946,36 @@ type ClientInterface interface {
 	// GetKubernetesClusterInfo request
 	GetKubernetesClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetFleetUpgradePlan request
+	GetFleetUpgradePlan(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFleetUpgradeRollout request
+	GetFleetUpgradeRollout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveFleetUpgradePlanWithBody request with any body
+	ApproveFleetUpgradePlanWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApproveFleetUpgradePlan(ctx context.Context, body ApproveFleetUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListNamespaceProvisioningJobs request
+	ListNamespaceProvisioningJobs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProvisionNamespaceWithBody request with any body
+	ProvisionNamespaceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ProvisionNamespace(ctx context.Context, body ProvisionNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeprovisionNamespace request
+	DeprovisionNamespace(ctx context.Context, namespace string, params *DeprovisionNamespaceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNamespaceProvisioningJob request
+	GetNamespaceProvisioningJob(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateNamespaceOperatorsWithBody request with any body
+	UpdateNamespaceOperatorsWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNamespaceOperators(ctx context.Context, namespace string, body UpdateNamespaceOperatorsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListNamespaces request
 	ListNamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5314,6 +5998,11 @@ type ClientInterface interface {
 
 	UpdateBackupStorage(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PlanDatabaseClusterCapacityWithBody request with any body
+	PlanDatabaseClusterCapacityWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PlanDatabaseClusterCapacity(ctx context.Context, namespace string, body PlanDatabaseClusterCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateDatabaseClusterBackupWithBody request with any body
 	CreateDatabaseClusterBackupWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5375,6 +6064,9 @@ type ClientInterface interface {
 	// GetDatabaseClusterPitr request
 	GetDatabaseClusterPitr(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CheckDatabaseClusterStorageResize request
+	CheckDatabaseClusterStorageResize(ctx context.Context, namespace string, name string, params *CheckDatabaseClusterStorageResizeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDatabaseEngines request
 	ListDatabaseEngines(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5386,6 +6078,14 @@ type ClientInterface interface {
 
 	ApproveUpgradePlan(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPostUpgradeTasksJob request
+	GetPostUpgradeTasksJob(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExecutePostUpgradeTasksWithBody request with any body
+	ExecutePostUpgradeTasksWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExecutePostUpgradeTasks(ctx context.Context, namespace string, body ExecutePostUpgradeTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDatabaseEngine request
 	GetDatabaseEngine(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5394,6 +6094,36 @@ type ClientInterface interface {
 
 	UpdateDatabaseEngine(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListEngineConfigTemplates request
+	ListEngineConfigTemplates(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateEngineConfigTemplateWithBody request with any body
+	CreateEngineConfigTemplateWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateEngineConfigTemplate(ctx context.Context, namespace string, body CreateEngineConfigTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteEngineConfigTemplate request
+	DeleteEngineConfigTemplate(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEngineConfigTemplate request
+	GetEngineConfigTemplate(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateEngineConfigTemplateWithBody request with any body
+	UpdateEngineConfigTemplateWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateEngineConfigTemplate(ctx context.Context, namespace string, name string, body UpdateEngineConfigTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RolloutEngineConfigTemplate request
+	RolloutEngineConfigTemplate(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEngineVersionPolicy request
+	GetEngineVersionPolicy(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateEngineVersionPolicyWithBody request with any body
+	UpdateEngineVersionPolicyWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateEngineVersionPolicy(ctx context.Context, namespace string, body UpdateEngineVersionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListMonitoringInstances request
 	ListMonitoringInstances(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5402,8 +6132,10 @@ type ClientInterface interface {
 
 	CreateMonitoringInstance(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// DeleteMonitoringInstance request
-	DeleteMonitoringInstance(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// DeleteMonitoringInstanceWithBody request with any body
+	DeleteMonitoringInstanceWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteMonitoringInstance(ctx context.Context, namespace string, name string, body DeleteMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetMonitoringInstance request
 	GetMonitoringInstance(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -5413,6 +6145,9 @@ type ClientInterface interface {
 
 	UpdateMonitoringInstance(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetNamespaceQuotaUsage request
+	GetNamespaceQuotaUsage(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUserPermissions request
 	GetUserPermissions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5424,6 +6159,11 @@ type ClientInterface interface {
 
 	CreatePodSchedulingPolicy(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PreviewPodSchedulingPolicyWithBody request with any body
+	PreviewPodSchedulingPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreviewPodSchedulingPolicy(ctx context.Context, body PreviewPodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeletePodSchedulingPolicy request
 	DeletePodSchedulingPolicy(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5449,6 +6189,12 @@ type ClientInterface interface {
 	// GetSettings request
 	GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListStorageClasses request
+	ListStorageClasses(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsageReport request
+	GetUsageReport(ctx context.Context, params *GetUsageReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// VersionInfo request
 	VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -5465,8 +6211,8 @@ func (c *Client) GetKubernetesClusterInfo(ctx context.Context, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListNamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListNamespacesRequest(c.Server)
+func (c *Client) GetFleetUpgradePlan(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFleetUpgradePlanRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -5477,8 +6223,8 @@ func (c *Client) ListNamespaces(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListBackupStorages(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListBackupStoragesRequest(c.Server, namespace)
+func (c *Client) GetFleetUpgradeRollout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFleetUpgradeRolloutRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -5489,7 +6235,139 @@ func (c *Client) ListBackupStorages(ctx context.Context, namespace string, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateBackupStorageWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) ApproveFleetUpgradePlanWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveFleetUpgradePlanRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveFleetUpgradePlan(ctx context.Context, body ApproveFleetUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveFleetUpgradePlanRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListNamespaceProvisioningJobs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNamespaceProvisioningJobsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProvisionNamespaceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProvisionNamespaceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProvisionNamespace(ctx context.Context, body ProvisionNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProvisionNamespaceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeprovisionNamespace(ctx context.Context, namespace string, params *DeprovisionNamespaceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeprovisionNamespaceRequest(c.Server, namespace, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNamespaceProvisioningJob(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNamespaceProvisioningJobRequest(c.Server, namespace)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateNamespaceOperatorsWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNamespaceOperatorsRequestWithBody(c.Server, namespace, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateNamespaceOperators(ctx context.Context, namespace string, body UpdateNamespaceOperatorsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNamespaceOperatorsRequest(c.Server, namespace, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListNamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNamespacesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBackupStorages(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBackupStoragesRequest(c.Server, namespace)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBackupStorageWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewCreateBackupStorageRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
@@ -5561,6 +6439,30 @@ func (c *Client) UpdateBackupStorage(ctx context.Context, namespace string, name
 	return c.Client.Do(req)
 }
 
+func (c *Client) PlanDatabaseClusterCapacityWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPlanDatabaseClusterCapacityRequestWithBody(c.Server, namespace, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PlanDatabaseClusterCapacity(ctx context.Context, namespace string, body PlanDatabaseClusterCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPlanDatabaseClusterCapacityRequest(c.Server, namespace, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) CreateDatabaseClusterBackupWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewCreateDatabaseClusterBackupRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
@@ -5825,6 +6727,18 @@ func (c *Client) GetDatabaseClusterPitr(ctx context.Context, namespace string, n
 	return c.Client.Do(req)
 }
 
+func (c *Client) CheckDatabaseClusterStorageResize(ctx context.Context, namespace string, name string, params *CheckDatabaseClusterStorageResizeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckDatabaseClusterStorageResizeRequest(c.Server, namespace, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListDatabaseEngines(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListDatabaseEnginesRequest(c.Server, namespace)
 	if err != nil {
@@ -5873,8 +6787,8 @@ func (c *Client) ApproveUpgradePlan(ctx context.Context, namespace string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseEngine(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseEngineRequest(c.Server, namespace, name)
+func (c *Client) GetPostUpgradeTasksJob(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPostUpgradeTasksJobRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -5885,8 +6799,8 @@ func (c *Client) GetDatabaseEngine(ctx context.Context, namespace string, name s
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseEngineWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseEngineRequestWithBody(c.Server, namespace, name, contentType, body)
+func (c *Client) ExecutePostUpgradeTasksWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecutePostUpgradeTasksRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5897,8 +6811,8 @@ func (c *Client) UpdateDatabaseEngineWithBody(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseEngine(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseEngineRequest(c.Server, namespace, name, body)
+func (c *Client) ExecutePostUpgradeTasks(ctx context.Context, namespace string, body ExecutePostUpgradeTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecutePostUpgradeTasksRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5909,8 +6823,8 @@ func (c *Client) UpdateDatabaseEngine(ctx context.Context, namespace string, nam
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMonitoringInstances(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMonitoringInstancesRequest(c.Server, namespace)
+func (c *Client) GetDatabaseEngine(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseEngineRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -5921,8 +6835,8 @@ func (c *Client) ListMonitoringInstances(ctx context.Context, namespace string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMonitoringInstanceWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMonitoringInstanceRequestWithBody(c.Server, namespace, contentType, body)
+func (c *Client) UpdateDatabaseEngineWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseEngineRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5933,8 +6847,8 @@ func (c *Client) CreateMonitoringInstanceWithBody(ctx context.Context, namespace
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMonitoringInstance(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMonitoringInstanceRequest(c.Server, namespace, body)
+func (c *Client) UpdateDatabaseEngine(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseEngineRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5945,8 +6859,8 @@ func (c *Client) CreateMonitoringInstance(ctx context.Context, namespace string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMonitoringInstance(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMonitoringInstanceRequest(c.Server, namespace, name)
+func (c *Client) ListEngineConfigTemplates(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEngineConfigTemplatesRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -5957,8 +6871,8 @@ func (c *Client) DeleteMonitoringInstance(ctx context.Context, namespace string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMonitoringInstance(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMonitoringInstanceRequest(c.Server, namespace, name)
+func (c *Client) CreateEngineConfigTemplateWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEngineConfigTemplateRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5969,8 +6883,8 @@ func (c *Client) GetMonitoringInstance(ctx context.Context, namespace string, na
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMonitoringInstanceWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMonitoringInstanceRequestWithBody(c.Server, namespace, name, contentType, body)
+func (c *Client) CreateEngineConfigTemplate(ctx context.Context, namespace string, body CreateEngineConfigTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEngineConfigTemplateRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5981,8 +6895,8 @@ func (c *Client) UpdateMonitoringInstanceWithBody(ctx context.Context, namespace
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMonitoringInstance(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMonitoringInstanceRequest(c.Server, namespace, name, body)
+func (c *Client) DeleteEngineConfigTemplate(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteEngineConfigTemplateRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -5993,8 +6907,8 @@ func (c *Client) UpdateMonitoringInstance(ctx context.Context, namespace string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUserPermissions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserPermissionsRequest(c.Server)
+func (c *Client) GetEngineConfigTemplate(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEngineConfigTemplateRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6005,8 +6919,8 @@ func (c *Client) GetUserPermissions(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListPodSchedulingPolicy(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListPodSchedulingPolicyRequest(c.Server, params)
+func (c *Client) UpdateEngineConfigTemplateWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEngineConfigTemplateRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6017,8 +6931,8 @@ func (c *Client) ListPodSchedulingPolicy(ctx context.Context, params *ListPodSch
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePodSchedulingPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePodSchedulingPolicyRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateEngineConfigTemplate(ctx context.Context, namespace string, name string, body UpdateEngineConfigTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEngineConfigTemplateRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6029,8 +6943,8 @@ func (c *Client) CreatePodSchedulingPolicyWithBody(ctx context.Context, contentT
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePodSchedulingPolicy(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePodSchedulingPolicyRequest(c.Server, body)
+func (c *Client) RolloutEngineConfigTemplate(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRolloutEngineConfigTemplateRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6041,8 +6955,8 @@ func (c *Client) CreatePodSchedulingPolicy(ctx context.Context, body CreatePodSc
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePodSchedulingPolicy(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePodSchedulingPolicyRequest(c.Server, policyName)
+func (c *Client) GetEngineVersionPolicy(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEngineVersionPolicyRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -6053,8 +6967,8 @@ func (c *Client) DeletePodSchedulingPolicy(ctx context.Context, policyName strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPodSchedulingPolicy(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPodSchedulingPolicyRequest(c.Server, policyName)
+func (c *Client) UpdateEngineVersionPolicyWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEngineVersionPolicyRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6065,8 +6979,8 @@ func (c *Client) GetPodSchedulingPolicy(ctx context.Context, policyName string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePodSchedulingPolicyWithBody(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePodSchedulingPolicyRequestWithBody(c.Server, policyName, contentType, body)
+func (c *Client) UpdateEngineVersionPolicy(ctx context.Context, namespace string, body UpdateEngineVersionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEngineVersionPolicyRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6077,8 +6991,8 @@ func (c *Client) UpdatePodSchedulingPolicyWithBody(ctx context.Context, policyNa
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePodSchedulingPolicy(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePodSchedulingPolicyRequest(c.Server, policyName, body)
+func (c *Client) ListMonitoringInstances(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMonitoringInstancesRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -6089,8 +7003,8 @@ func (c *Client) UpdatePodSchedulingPolicy(ctx context.Context, policyName strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetKubernetesClusterResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetKubernetesClusterResourcesRequest(c.Server)
+func (c *Client) CreateMonitoringInstanceWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMonitoringInstanceRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6101,8 +7015,8 @@ func (c *Client) GetKubernetesClusterResources(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteSessionRequest(c.Server)
+func (c *Client) CreateMonitoringInstance(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMonitoringInstanceRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6113,8 +7027,8 @@ func (c *Client) DeleteSession(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSessionRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteMonitoringInstanceWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMonitoringInstanceRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6125,8 +7039,8 @@ func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSessionRequest(c.Server, body)
+func (c *Client) DeleteMonitoringInstance(ctx context.Context, namespace string, name string, body DeleteMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMonitoringInstanceRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6137,8 +7051,8 @@ func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSettingsRequest(c.Server)
+func (c *Client) GetMonitoringInstance(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMonitoringInstanceRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6149,8 +7063,8 @@ func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVersionInfoRequest(c.Server)
+func (c *Client) UpdateMonitoringInstanceWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMonitoringInstanceRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6161,165 +7075,256 @@ func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-// NewGetKubernetesClusterInfoRequest generates requests for GetKubernetesClusterInfo
-func NewGetKubernetesClusterInfoRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateMonitoringInstance(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMonitoringInstanceRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/cluster-info")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetNamespaceQuotaUsage(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNamespaceQuotaUsageRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListNamespacesRequest generates requests for ListNamespaces
-func NewListNamespacesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetUserPermissions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserPermissionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListPodSchedulingPolicy(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPodSchedulingPolicyRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListBackupStoragesRequest generates requests for ListBackupStorages
-func NewListBackupStoragesRequest(server string, namespace string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) CreatePodSchedulingPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePodSchedulingPolicyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreatePodSchedulingPolicy(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePodSchedulingPolicyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PreviewPodSchedulingPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewPodSchedulingPolicyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PreviewPodSchedulingPolicy(ctx context.Context, body PreviewPodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewPodSchedulingPolicyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateBackupStorageRequest calls the generic CreateBackupStorage builder with application/json body
-func NewCreateBackupStorageRequest(server string, namespace string, body CreateBackupStorageJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeletePodSchedulingPolicy(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePodSchedulingPolicyRequest(c.Server, policyName)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateBackupStorageRequestWithBody(server, namespace, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateBackupStorageRequestWithBody generates requests for CreateBackupStorage with any type of body
-func NewCreateBackupStorageRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetPodSchedulingPolicy(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPodSchedulingPolicyRequest(c.Server, policyName)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdatePodSchedulingPolicyWithBody(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePodSchedulingPolicyRequestWithBody(c.Server, policyName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdatePodSchedulingPolicy(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePodSchedulingPolicyRequest(c.Server, policyName, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetKubernetesClusterResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKubernetesClusterResourcesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) DeleteSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSessionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSessionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteBackupStorageRequest generates requests for DeleteBackupStorage
-func NewDeleteBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
+func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSessionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) ListStorageClasses(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListStorageClassesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) GetUsageReport(ctx context.Context, params *GetUsageReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsageReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionInfoRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetKubernetesClusterInfoRequest generates requests for GetKubernetesClusterInfo
+func NewGetKubernetesClusterInfoRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/cluster-info")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6329,7 +7334,7 @@ func NewDeleteBackupStorageRequest(server string, namespace string, name string)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6337,30 +7342,43 @@ func NewDeleteBackupStorageRequest(server string, namespace string, name string)
 	return req, nil
 }
 
-// NewGetBackupStorageRequest generates requests for GetBackupStorage
-func NewGetBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewGetFleetUpgradePlanRequest generates requests for GetFleetUpgradePlan
+func NewGetFleetUpgradePlanRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/database-engines/upgrade-plan")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewGetFleetUpgradeRolloutRequest generates requests for GetFleetUpgradeRollout
+func NewGetFleetUpgradeRolloutRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/database-engines/upgrade-plan/rollout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6378,41 +7396,56 @@ func NewGetBackupStorageRequest(server string, namespace string, name string) (*
 	return req, nil
 }
 
-// NewUpdateBackupStorageRequest calls the generic UpdateBackupStorage builder with application/json body
-func NewUpdateBackupStorageRequest(server string, namespace string, name string, body UpdateBackupStorageJSONRequestBody) (*http.Request, error) {
+// NewApproveFleetUpgradePlanRequest calls the generic ApproveFleetUpgradePlan builder with application/json body
+func NewApproveFleetUpgradePlanRequest(server string, body ApproveFleetUpgradePlanJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateBackupStorageRequestWithBody(server, namespace, name, "application/json", bodyReader)
+	return NewApproveFleetUpgradePlanRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateBackupStorageRequestWithBody generates requests for UpdateBackupStorage with any type of body
-func NewUpdateBackupStorageRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewApproveFleetUpgradePlanRequestWithBody generates requests for ApproveFleetUpgradePlan with any type of body
+func NewApproveFleetUpgradePlanRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/database-engines/upgrade-plan/rollout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListNamespaceProvisioningJobsRequest generates requests for ListNamespaceProvisioningJobs
+func NewListNamespaceProvisioningJobsRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespace-provisioning")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6422,44 +7455,35 @@ func NewUpdateBackupStorageRequestWithBody(server string, namespace string, name
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewCreateDatabaseClusterBackupRequest calls the generic CreateDatabaseClusterBackup builder with application/json body
-func NewCreateDatabaseClusterBackupRequest(server string, namespace string, body CreateDatabaseClusterBackupJSONRequestBody) (*http.Request, error) {
+// NewProvisionNamespaceRequest calls the generic ProvisionNamespace builder with application/json body
+func NewProvisionNamespaceRequest(server string, body ProvisionNamespaceJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseClusterBackupRequestWithBody(server, namespace, "application/json", bodyReader)
+	return NewProvisionNamespaceRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateDatabaseClusterBackupRequestWithBody generates requests for CreateDatabaseClusterBackup with any type of body
-func NewCreateDatabaseClusterBackupRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+// NewProvisionNamespaceRequestWithBody generates requests for ProvisionNamespace with any type of body
+func NewProvisionNamespaceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups", pathParam0)
+	operationPath := fmt.Sprintf("/namespace-provisioning")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6479,8 +7503,8 @@ func NewCreateDatabaseClusterBackupRequestWithBody(server string, namespace stri
 	return req, nil
 }
 
-// NewDeleteDatabaseClusterBackupRequest generates requests for DeleteDatabaseClusterBackup
-func NewDeleteDatabaseClusterBackupRequest(server string, namespace string, name string, params *DeleteDatabaseClusterBackupParams) (*http.Request, error) {
+// NewDeprovisionNamespaceRequest generates requests for DeprovisionNamespace
+func NewDeprovisionNamespaceRequest(server string, namespace string, params *DeprovisionNamespaceParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6490,19 +7514,12 @@ func NewDeleteDatabaseClusterBackupRequest(server string, namespace string, name
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespace-provisioning/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6515,8 +7532,25 @@ func NewDeleteDatabaseClusterBackupRequest(server string, namespace string, name
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.CleanupBackupStorage != nil {
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cleanupBackupStorage", runtime.ParamLocationQuery, *params.CleanupBackupStorage); err != nil {
+		if params.KeepNamespace != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keepNamespace", runtime.ParamLocationQuery, *params.KeepNamespace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6527,6 +7561,7 @@ func NewDeleteDatabaseClusterBackupRequest(server string, namespace string, name
 					}
 				}
 			}
+
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
@@ -6540,8 +7575,8 @@ func NewDeleteDatabaseClusterBackupRequest(server string, namespace string, name
 	return req, nil
 }
 
-// NewGetDatabaseClusterBackupRequest generates requests for GetDatabaseClusterBackup
-func NewGetDatabaseClusterBackupRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewGetNamespaceProvisioningJobRequest generates requests for GetNamespaceProvisioningJob
+func NewGetNamespaceProvisioningJobRequest(server string, namespace string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6551,19 +7586,12 @@ func NewGetDatabaseClusterBackupRequest(server string, namespace string, name st
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespace-provisioning/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6581,19 +7609,19 @@ func NewGetDatabaseClusterBackupRequest(server string, namespace string, name st
 	return req, nil
 }
 
-// NewCreateDatabaseClusterRestoreRequest calls the generic CreateDatabaseClusterRestore builder with application/json body
-func NewCreateDatabaseClusterRestoreRequest(server string, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
+// NewUpdateNamespaceOperatorsRequest calls the generic UpdateNamespaceOperators builder with application/json body
+func NewUpdateNamespaceOperatorsRequest(server string, namespace string, body UpdateNamespaceOperatorsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseClusterRestoreRequestWithBody(server, namespace, "application/json", bodyReader)
+	return NewUpdateNamespaceOperatorsRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// NewCreateDatabaseClusterRestoreRequestWithBody generates requests for CreateDatabaseClusterRestore with any type of body
-func NewCreateDatabaseClusterRestoreRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateNamespaceOperatorsRequestWithBody generates requests for UpdateNamespaceOperators with any type of body
+func NewUpdateNamespaceOperatorsRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6608,7 +7636,7 @@ func NewCreateDatabaseClusterRestoreRequestWithBody(server string, namespace str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores", pathParam0)
+	operationPath := fmt.Sprintf("/namespace-provisioning/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6618,7 +7646,7 @@ func NewCreateDatabaseClusterRestoreRequestWithBody(server string, namespace str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -6628,20 +7656,40 @@ func NewCreateDatabaseClusterRestoreRequestWithBody(server string, namespace str
 	return req, nil
 }
 
-// NewDeleteDatabaseClusterRestoreRequest generates requests for DeleteDatabaseClusterRestore
-func NewDeleteDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewListNamespacesRequest generates requests for ListNamespaces
+func NewListNamespacesRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	operationPath := fmt.Sprintf("/namespaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	return req, nil
+}
+
+// NewListBackupStoragesRequest generates requests for ListBackupStorages
+func NewListBackupStoragesRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -6651,7 +7699,7 @@ func NewDeleteDatabaseClusterRestoreRequest(server string, namespace string, nam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6661,7 +7709,7 @@ func NewDeleteDatabaseClusterRestoreRequest(server string, namespace string, nam
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6669,20 +7717,24 @@ func NewDeleteDatabaseClusterRestoreRequest(server string, namespace string, nam
 	return req, nil
 }
 
-// NewGetDatabaseClusterRestoreRequest generates requests for GetDatabaseClusterRestore
-func NewGetDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+// NewCreateBackupStorageRequest calls the generic CreateBackupStorage builder with application/json body
+func NewCreateBackupStorageRequest(server string, namespace string, body CreateBackupStorageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBackupStorageRequestWithBody(server, namespace, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewCreateBackupStorageRequestWithBody generates requests for CreateBackupStorage with any type of body
+func NewCreateBackupStorageRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -6692,7 +7744,7 @@ func NewGetDatabaseClusterRestoreRequest(server string, namespace string, name s
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6702,27 +7754,18 @@ func NewGetDatabaseClusterRestoreRequest(server string, namespace string, name s
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewUpdateDatabaseClusterRestoreRequest calls the generic UpdateDatabaseClusterRestore builder with application/json body
-func NewUpdateDatabaseClusterRestoreRequest(server string, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseClusterRestoreRequestWithBody(server, namespace, name, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewUpdateDatabaseClusterRestoreRequestWithBody generates requests for UpdateDatabaseClusterRestore with any type of body
-func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteBackupStorageRequest generates requests for DeleteBackupStorage
+func NewDeleteBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6744,7 +7787,7 @@ func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6754,18 +7797,16 @@ func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewListDatabaseClustersRequest generates requests for ListDatabaseClusters
-func NewListDatabaseClustersRequest(server string, namespace string) (*http.Request, error) {
+// NewGetBackupStorageRequest generates requests for GetBackupStorage
+func NewGetBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6775,12 +7816,19 @@ func NewListDatabaseClustersRequest(server string, namespace string) (*http.Requ
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6798,19 +7846,19 @@ func NewListDatabaseClustersRequest(server string, namespace string) (*http.Requ
 	return req, nil
 }
 
-// NewCreateDatabaseClusterRequest calls the generic CreateDatabaseCluster builder with application/json body
-func NewCreateDatabaseClusterRequest(server string, namespace string, body CreateDatabaseClusterJSONRequestBody) (*http.Request, error) {
+// NewUpdateBackupStorageRequest calls the generic UpdateBackupStorage builder with application/json body
+func NewUpdateBackupStorageRequest(server string, namespace string, name string, body UpdateBackupStorageJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseClusterRequestWithBody(server, namespace, "application/json", bodyReader)
+	return NewUpdateBackupStorageRequestWithBody(server, namespace, name, "application/json", bodyReader)
 }
 
-// NewCreateDatabaseClusterRequestWithBody generates requests for CreateDatabaseCluster with any type of body
-func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateBackupStorageRequestWithBody generates requests for UpdateBackupStorage with any type of body
+func NewUpdateBackupStorageRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6820,12 +7868,19 @@ func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, co
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6835,7 +7890,7 @@ func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -6845,20 +7900,24 @@ func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, co
 	return req, nil
 }
 
-// NewListDatabaseClusterBackupsRequest generates requests for ListDatabaseClusterBackups
-func NewListDatabaseClusterBackupsRequest(server string, namespace string, clusterName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+// NewPlanDatabaseClusterCapacityRequest calls the generic PlanDatabaseClusterCapacity builder with application/json body
+func NewPlanDatabaseClusterCapacityRequest(server string, namespace string, body PlanDatabaseClusterCapacityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPlanDatabaseClusterCapacityRequestWithBody(server, namespace, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewPlanDatabaseClusterCapacityRequestWithBody generates requests for PlanDatabaseClusterCapacity with any type of body
+func NewPlanDatabaseClusterCapacityRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster-name", runtime.ParamLocationPath, clusterName)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -6868,7 +7927,7 @@ func NewListDatabaseClusterBackupsRequest(server string, namespace string, clust
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backups", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/capacity-plan", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6878,28 +7937,34 @@ func NewListDatabaseClusterBackupsRequest(server string, namespace string, clust
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListDatabaseClusterRestoresRequest generates requests for ListDatabaseClusterRestores
-func NewListDatabaseClusterRestoresRequest(server string, namespace string, clusterName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+// NewCreateDatabaseClusterBackupRequest calls the generic CreateDatabaseClusterBackup builder with application/json body
+func NewCreateDatabaseClusterBackupRequest(server string, namespace string, body CreateDatabaseClusterBackupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseClusterBackupRequestWithBody(server, namespace, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewCreateDatabaseClusterBackupRequestWithBody generates requests for CreateDatabaseClusterBackup with any type of body
+func NewCreateDatabaseClusterBackupRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster-name", runtime.ParamLocationPath, clusterName)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -6909,7 +7974,7 @@ func NewListDatabaseClusterRestoresRequest(server string, namespace string, clus
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/restores", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6919,16 +7984,18 @@ func NewListDatabaseClusterRestoresRequest(server string, namespace string, clus
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDeleteDatabaseClusterRequest generates requests for DeleteDatabaseCluster
-func NewDeleteDatabaseClusterRequest(server string, namespace string, name string, params *DeleteDatabaseClusterParams) (*http.Request, error) {
+// NewDeleteDatabaseClusterBackupRequest generates requests for DeleteDatabaseClusterBackup
+func NewDeleteDatabaseClusterBackupRequest(server string, namespace string, name string, params *DeleteDatabaseClusterBackupParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6950,7 +8017,7 @@ func NewDeleteDatabaseClusterRequest(server string, namespace string, name strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6964,6 +8031,7 @@ func NewDeleteDatabaseClusterRequest(server string, namespace string, name strin
 		queryValues := queryURL.Query()
 
 		if params.CleanupBackupStorage != nil {
+
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cleanupBackupStorage", runtime.ParamLocationQuery, *params.CleanupBackupStorage); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
@@ -6975,6 +8043,7 @@ func NewDeleteDatabaseClusterRequest(server string, namespace string, name strin
 					}
 				}
 			}
+
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
@@ -6988,8 +8057,8 @@ func NewDeleteDatabaseClusterRequest(server string, namespace string, name strin
 	return req, nil
 }
 
-// NewGetDatabaseClusterRequest generates requests for GetDatabaseCluster
-func NewGetDatabaseClusterRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewGetDatabaseClusterBackupRequest generates requests for GetDatabaseClusterBackup
+func NewGetDatabaseClusterBackupRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7011,7 +8080,7 @@ func NewGetDatabaseClusterRequest(server string, namespace string, name string)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7029,19 +8098,19 @@ func NewGetDatabaseClusterRequest(server string, namespace string, name string)
 	return req, nil
 }
 
-// NewUpdateDatabaseClusterRequest calls the generic UpdateDatabaseCluster builder with application/json body
-func NewUpdateDatabaseClusterRequest(server string, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody) (*http.Request, error) {
+// NewCreateDatabaseClusterRestoreRequest calls the generic CreateDatabaseClusterRestore builder with application/json body
+func NewCreateDatabaseClusterRestoreRequest(server string, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseClusterRequestWithBody(server, namespace, name, "application/json", bodyReader)
+	return NewCreateDatabaseClusterRestoreRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// NewUpdateDatabaseClusterRequestWithBody generates requests for UpdateDatabaseCluster with any type of body
-func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateDatabaseClusterRestoreRequestWithBody generates requests for CreateDatabaseClusterRestore with any type of body
+func NewCreateDatabaseClusterRestoreRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7051,19 +8120,12 @@ func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, na
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7073,7 +8135,7 @@ func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, na
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -7083,8 +8145,8 @@ func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, na
 	return req, nil
 }
 
-// NewGetDatabaseClusterComponentsRequest generates requests for GetDatabaseClusterComponents
-func NewGetDatabaseClusterComponentsRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewDeleteDatabaseClusterRestoreRequest generates requests for DeleteDatabaseClusterRestore
+func NewDeleteDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7106,7 +8168,7 @@ func NewGetDatabaseClusterComponentsRequest(server string, namespace string, nam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/components", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7116,7 +8178,7 @@ func NewGetDatabaseClusterComponentsRequest(server string, namespace string, nam
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7124,8 +8186,8 @@ func NewGetDatabaseClusterComponentsRequest(server string, namespace string, nam
 	return req, nil
 }
 
-// NewGetDatabaseClusterCredentialsRequest generates requests for GetDatabaseClusterCredentials
-func NewGetDatabaseClusterCredentialsRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewGetDatabaseClusterRestoreRequest generates requests for GetDatabaseClusterRestore
+func NewGetDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7147,7 +8209,7 @@ func NewGetDatabaseClusterCredentialsRequest(server string, namespace string, na
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/credentials", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7165,8 +8227,19 @@ func NewGetDatabaseClusterCredentialsRequest(server string, namespace string, na
 	return req, nil
 }
 
-// NewGetDatabaseClusterPitrRequest generates requests for GetDatabaseClusterPitr
-func NewGetDatabaseClusterPitrRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewUpdateDatabaseClusterRestoreRequest calls the generic UpdateDatabaseClusterRestore builder with application/json body
+func NewUpdateDatabaseClusterRestoreRequest(server string, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseClusterRestoreRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseClusterRestoreRequestWithBody generates requests for UpdateDatabaseClusterRestore with any type of body
+func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7188,7 +8261,7 @@ func NewGetDatabaseClusterPitrRequest(server string, namespace string, name stri
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/pitr", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7198,16 +8271,18 @@ func NewGetDatabaseClusterPitrRequest(server string, namespace string, name stri
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListDatabaseEnginesRequest generates requests for ListDatabaseEngines
-func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Request, error) {
+// NewListDatabaseClustersRequest generates requests for ListDatabaseClusters
+func NewListDatabaseClustersRequest(server string, namespace string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7222,7 +8297,7 @@ func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7240,8 +8315,19 @@ func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Reque
 	return req, nil
 }
 
-// NewGetUpgradePlanRequest generates requests for GetUpgradePlan
-func NewGetUpgradePlanRequest(server string, namespace string) (*http.Request, error) {
+// NewCreateDatabaseClusterRequest calls the generic CreateDatabaseCluster builder with application/json body
+func NewCreateDatabaseClusterRequest(server string, namespace string, body CreateDatabaseClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseClusterRequestWithBody(server, namespace, "application/json", bodyReader)
+}
+
+// NewCreateDatabaseClusterRequestWithBody generates requests for CreateDatabaseCluster with any type of body
+func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7256,7 +8342,7 @@ func NewGetUpgradePlanRequest(server string, namespace string) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7266,27 +8352,18 @@ func NewGetUpgradePlanRequest(server string, namespace string) (*http.Request, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewApproveUpgradePlanRequest calls the generic ApproveUpgradePlan builder with application/json body
-func NewApproveUpgradePlanRequest(server string, namespace string, body ApproveUpgradePlanJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewApproveUpgradePlanRequestWithBody(server, namespace, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewApproveUpgradePlanRequestWithBody generates requests for ApproveUpgradePlan with any type of body
-func NewApproveUpgradePlanRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+// NewListDatabaseClusterBackupsRequest generates requests for ListDatabaseClusterBackups
+func NewListDatabaseClusterBackupsRequest(server string, namespace string, clusterName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7296,12 +8373,19 @@ func NewApproveUpgradePlanRequestWithBody(server string, namespace string, conte
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster-name", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan/approval", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backups", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7311,18 +8395,16 @@ func NewApproveUpgradePlanRequestWithBody(server string, namespace string, conte
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetDatabaseEngineRequest generates requests for GetDatabaseEngine
-func NewGetDatabaseEngineRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewListDatabaseClusterRestoresRequest generates requests for ListDatabaseClusterRestores
+func NewListDatabaseClusterRestoresRequest(server string, namespace string, clusterName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7334,7 +8416,7 @@ func NewGetDatabaseEngineRequest(server string, namespace string, name string) (
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster-name", runtime.ParamLocationPath, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -7344,7 +8426,7 @@ func NewGetDatabaseEngineRequest(server string, namespace string, name string) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/restores", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7362,19 +8444,8 @@ func NewGetDatabaseEngineRequest(server string, namespace string, name string) (
 	return req, nil
 }
 
-// NewUpdateDatabaseEngineRequest calls the generic UpdateDatabaseEngine builder with application/json body
-func NewUpdateDatabaseEngineRequest(server string, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseEngineRequestWithBody(server, namespace, name, "application/json", bodyReader)
-}
-
-// NewUpdateDatabaseEngineRequestWithBody generates requests for UpdateDatabaseEngine with any type of body
-func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteDatabaseClusterRequest generates requests for DeleteDatabaseCluster
+func NewDeleteDatabaseClusterRequest(server string, namespace string, name string, params *DeleteDatabaseClusterParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7396,7 +8467,7 @@ func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, nam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7406,18 +8477,38 @@ func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, nam
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.CleanupBackupStorage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cleanupBackupStorage", runtime.ParamLocationQuery, *params.CleanupBackupStorage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewListMonitoringInstancesRequest generates requests for ListMonitoringInstances
-func NewListMonitoringInstancesRequest(server string, namespace string) (*http.Request, error) {
+// NewGetDatabaseClusterRequest generates requests for GetDatabaseCluster
+func NewGetDatabaseClusterRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7427,12 +8518,19 @@ func NewListMonitoringInstancesRequest(server string, namespace string) (*http.R
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7450,19 +8548,19 @@ func NewListMonitoringInstancesRequest(server string, namespace string) (*http.R
 	return req, nil
 }
 
-// NewCreateMonitoringInstanceRequest calls the generic CreateMonitoringInstance builder with application/json body
-func NewCreateMonitoringInstanceRequest(server string, namespace string, body CreateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
+// NewUpdateDatabaseClusterRequest calls the generic UpdateDatabaseCluster builder with application/json body
+func NewUpdateDatabaseClusterRequest(server string, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateMonitoringInstanceRequestWithBody(server, namespace, "application/json", bodyReader)
+	return NewUpdateDatabaseClusterRequestWithBody(server, namespace, name, "application/json", bodyReader)
 }
 
-// NewCreateMonitoringInstanceRequestWithBody generates requests for CreateMonitoringInstance with any type of body
-func NewCreateMonitoringInstanceRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateDatabaseClusterRequestWithBody generates requests for UpdateDatabaseCluster with any type of body
+func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7472,12 +8570,19 @@ func NewCreateMonitoringInstanceRequestWithBody(server string, namespace string,
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7487,7 +8592,7 @@ func NewCreateMonitoringInstanceRequestWithBody(server string, namespace string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -7497,8 +8602,8 @@ func NewCreateMonitoringInstanceRequestWithBody(server string, namespace string,
 	return req, nil
 }
 
-// NewDeleteMonitoringInstanceRequest generates requests for DeleteMonitoringInstance
-func NewDeleteMonitoringInstanceRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewGetDatabaseClusterComponentsRequest generates requests for GetDatabaseClusterComponents
+func NewGetDatabaseClusterComponentsRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7520,7 +8625,7 @@ func NewDeleteMonitoringInstanceRequest(server string, namespace string, name st
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/components", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7530,7 +8635,7 @@ func NewDeleteMonitoringInstanceRequest(server string, namespace string, name st
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7538,8 +8643,8 @@ func NewDeleteMonitoringInstanceRequest(server string, namespace string, name st
 	return req, nil
 }
 
-// NewGetMonitoringInstanceRequest generates requests for GetMonitoringInstance
-func NewGetMonitoringInstanceRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewGetDatabaseClusterCredentialsRequest generates requests for GetDatabaseClusterCredentials
+func NewGetDatabaseClusterCredentialsRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7561,7 +8666,7 @@ func NewGetMonitoringInstanceRequest(server string, namespace string, name strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/credentials", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7579,19 +8684,8 @@ func NewGetMonitoringInstanceRequest(server string, namespace string, name strin
 	return req, nil
 }
 
-// NewUpdateMonitoringInstanceRequest calls the generic UpdateMonitoringInstance builder with application/json body
-func NewUpdateMonitoringInstanceRequest(server string, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMonitoringInstanceRequestWithBody(server, namespace, name, "application/json", bodyReader)
-}
-
-// NewUpdateMonitoringInstanceRequestWithBody generates requests for UpdateMonitoringInstance with any type of body
-func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetDatabaseClusterPitrRequest generates requests for GetDatabaseClusterPitr
+func NewGetDatabaseClusterPitrRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7613,7 +8707,7 @@ func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/pitr", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7623,53 +8717,38 @@ func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetUserPermissionsRequest generates requests for GetUserPermissions
-func NewGetUserPermissionsRequest(server string) (*http.Request, error) {
+// NewCheckDatabaseClusterStorageResizeRequest generates requests for CheckDatabaseClusterStorageResize
+func NewCheckDatabaseClusterStorageResizeRequest(server string, namespace string, name string, params *CheckDatabaseClusterStorageResizeParams) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/permissions")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam0 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewListPodSchedulingPolicyRequest generates requests for ListPodSchedulingPolicy
-func NewListPodSchedulingPolicyRequest(server string, params *ListPodSchedulingPolicyParams) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/pod-scheduling-policies")
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/storage-resize", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7682,30 +8761,14 @@ func NewListPodSchedulingPolicyRequest(server string, params *ListPodSchedulingP
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.EngineType != nil {
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "engineType", runtime.ParamLocationQuery, *params.EngineType); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-		}
-
-		if params.HasRules != nil {
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hasRules", runtime.ParamLocationQuery, *params.HasRules); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, params.Size); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
 		}
@@ -7721,27 +8784,57 @@ func NewListPodSchedulingPolicyRequest(server string, params *ListPodSchedulingP
 	return req, nil
 }
 
-// NewCreatePodSchedulingPolicyRequest calls the generic CreatePodSchedulingPolicy builder with application/json body
-func NewCreatePodSchedulingPolicyRequest(server string, body CreatePodSchedulingPolicyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewListDatabaseEnginesRequest generates requests for ListDatabaseEngines
+func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreatePodSchedulingPolicyRequestWithBody(server, "application/json", bodyReader)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewCreatePodSchedulingPolicyRequestWithBody generates requests for CreatePodSchedulingPolicy with any type of body
-func NewCreatePodSchedulingPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetUpgradePlanRequest generates requests for GetUpgradePlan
+func NewGetUpgradePlanRequest(server string, namespace string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/pod-scheduling-policies")
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7751,23 +8844,32 @@ func NewCreatePodSchedulingPolicyRequestWithBody(server string, contentType stri
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeletePodSchedulingPolicyRequest generates requests for DeletePodSchedulingPolicy
-func NewDeletePodSchedulingPolicyRequest(server string, policyName string) (*http.Request, error) {
+// NewApproveUpgradePlanRequest calls the generic ApproveUpgradePlan builder with application/json body
+func NewApproveUpgradePlanRequest(server string, namespace string, body ApproveUpgradePlanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApproveUpgradePlanRequestWithBody(server, namespace, "application/json", bodyReader)
+}
+
+// NewApproveUpgradePlanRequestWithBody generates requests for ApproveUpgradePlan with any type of body
+func NewApproveUpgradePlanRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -7777,7 +8879,7 @@ func NewDeletePodSchedulingPolicyRequest(server string, policyName string) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan/approval", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7787,21 +8889,23 @@ func NewDeletePodSchedulingPolicyRequest(server string, policyName string) (*htt
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetPodSchedulingPolicyRequest generates requests for GetPodSchedulingPolicy
-func NewGetPodSchedulingPolicyRequest(server string, policyName string) (*http.Request, error) {
+// NewGetPostUpgradeTasksJobRequest generates requests for GetPostUpgradeTasksJob
+func NewGetPostUpgradeTasksJobRequest(server string, namespace string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -7811,7 +8915,7 @@ func NewGetPodSchedulingPolicyRequest(server string, policyName string) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan/post-upgrade-tasks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7829,24 +8933,24 @@ func NewGetPodSchedulingPolicyRequest(server string, policyName string) (*http.R
 	return req, nil
 }
 
-// NewUpdatePodSchedulingPolicyRequest calls the generic UpdatePodSchedulingPolicy builder with application/json body
-func NewUpdatePodSchedulingPolicyRequest(server string, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody) (*http.Request, error) {
+// NewExecutePostUpgradeTasksRequest calls the generic ExecutePostUpgradeTasks builder with application/json body
+func NewExecutePostUpgradeTasksRequest(server string, namespace string, body ExecutePostUpgradeTasksJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePodSchedulingPolicyRequestWithBody(server, policyName, "application/json", bodyReader)
+	return NewExecutePostUpgradeTasksRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// NewUpdatePodSchedulingPolicyRequestWithBody generates requests for UpdatePodSchedulingPolicy with any type of body
-func NewUpdatePodSchedulingPolicyRequestWithBody(server string, policyName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewExecutePostUpgradeTasksRequestWithBody generates requests for ExecutePostUpgradeTasks with any type of body
+func NewExecutePostUpgradeTasksRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -7856,7 +8960,7 @@ func NewUpdatePodSchedulingPolicyRequestWithBody(server string, policyName strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan/post-upgrade-tasks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7866,7 +8970,7 @@ func NewUpdatePodSchedulingPolicyRequestWithBody(server string, policyName strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -7876,16 +8980,30 @@ func NewUpdatePodSchedulingPolicyRequestWithBody(server string, policyName strin
 	return req, nil
 }
 
-// NewGetKubernetesClusterResourcesRequest generates requests for GetKubernetesClusterResources
-func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, error) {
+// NewGetDatabaseEngineRequest generates requests for GetDatabaseEngine
+func NewGetDatabaseEngineRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/resources")
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7903,16 +9021,77 @@ func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, erro
 	return req, nil
 }
 
-// NewDeleteSessionRequest generates requests for DeleteSession
-func NewDeleteSessionRequest(server string) (*http.Request, error) {
+// NewUpdateDatabaseEngineRequest calls the generic UpdateDatabaseEngine builder with application/json body
+func NewUpdateDatabaseEngineRequest(server string, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseEngineRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseEngineRequestWithBody generates requests for UpdateDatabaseEngine with any type of body
+func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListEngineConfigTemplatesRequest generates requests for ListEngineConfigTemplates
+func NewListEngineConfigTemplatesRequest(server string, namespace string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/session")
+	operationPath := fmt.Sprintf("/namespaces/%s/engine-config-templates", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7922,7 +9101,7 @@ func NewDeleteSessionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7930,27 +9109,34 @@ func NewDeleteSessionRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateSessionRequest calls the generic CreateSession builder with application/json body
-func NewCreateSessionRequest(server string, body CreateSessionJSONRequestBody) (*http.Request, error) {
+// NewCreateEngineConfigTemplateRequest calls the generic CreateEngineConfigTemplate builder with application/json body
+func NewCreateEngineConfigTemplateRequest(server string, namespace string, body CreateEngineConfigTemplateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateSessionRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateEngineConfigTemplateRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// NewCreateSessionRequestWithBody generates requests for CreateSession with any type of body
-func NewCreateSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateEngineConfigTemplateRequestWithBody generates requests for CreateEngineConfigTemplate with any type of body
+func NewCreateEngineConfigTemplateRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/session")
+	operationPath := fmt.Sprintf("/namespaces/%s/engine-config-templates", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7970,16 +9156,30 @@ func NewCreateSessionRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewGetSettingsRequest generates requests for GetSettings
-func NewGetSettingsRequest(server string) (*http.Request, error) {
+// NewDeleteEngineConfigTemplateRequest generates requests for DeleteEngineConfigTemplate
+func NewDeleteEngineConfigTemplateRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/settings")
+	operationPath := fmt.Sprintf("/namespaces/%s/engine-config-templates/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7989,7 +9189,7 @@ func NewGetSettingsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7997,16 +9197,30 @@ func NewGetSettingsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewVersionInfoRequest generates requests for VersionInfo
-func NewVersionInfoRequest(server string) (*http.Request, error) {
+// NewGetEngineConfigTemplateRequest generates requests for GetEngineConfigTemplate
+func NewGetEngineConfigTemplateRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/version")
+	operationPath := fmt.Sprintf("/namespaces/%s/engine-config-templates/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8024,390 +9238,2747 @@ func NewVersionInfoRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewUpdateEngineConfigTemplateRequest calls the generic UpdateEngineConfigTemplate builder with application/json body
+func NewUpdateEngineConfigTemplateRequest(server string, namespace string, name string, body UpdateEngineConfigTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateEngineConfigTemplateRequestWithBody(server, namespace, name, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewUpdateEngineConfigTemplateRequestWithBody generates requests for UpdateEngineConfigTemplate with any type of body
+func NewUpdateEngineConfigTemplateRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
+	var pathParam1 string
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetKubernetesClusterInfoWithResponse request
-	GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListNamespacesWithResponse request
-	ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListBackupStoragesWithResponse request
-	ListBackupStoragesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/engine-config-templates/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateBackupStorageWithBodyWithResponse request with any body
-	CreateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateBackupStorageWithResponse(ctx context.Context, namespace string, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteBackupStorageWithResponse request
-	DeleteBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetBackupStorageWithResponse request
-	GetBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error)
+	return req, nil
+}
 
-	// UpdateBackupStorageWithBodyWithResponse request with any body
-	UpdateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+// NewRolloutEngineConfigTemplateRequest generates requests for RolloutEngineConfigTemplate
+func NewRolloutEngineConfigTemplateRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
 
-	UpdateBackupStorageWithResponse(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+	var pathParam0 string
 
-	// CreateDatabaseClusterBackupWithBodyWithResponse request with any body
-	CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+	var pathParam1 string
 
-	// DeleteDatabaseClusterBackupWithResponse request
-	DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterBackupParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabaseClusterBackupWithResponse request
-	GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateDatabaseClusterRestoreWithBodyWithResponse request with any body
-	CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/engine-config-templates/%s/rollout", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteDatabaseClusterRestoreWithResponse request
-	DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabaseClusterRestoreWithResponse request
-	GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error)
+	return req, nil
+}
 
-	// UpdateDatabaseClusterRestoreWithBodyWithResponse request with any body
-	UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+// NewGetEngineVersionPolicyRequest generates requests for GetEngineVersionPolicy
+func NewGetEngineVersionPolicyRequest(server string, namespace string) (*http.Request, error) {
+	var err error
 
-	UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+	var pathParam0 string
 
-	// ListDatabaseClustersWithResponse request
-	ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateDatabaseClusterWithBodyWithResponse request with any body
-	CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/engine-version-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListDatabaseClusterBackupsWithResponse request
-	ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListDatabaseClusterRestoresWithResponse request
-	ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteDatabaseClusterWithResponse request
-	DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error)
+	return req, nil
+}
 
-	// GetDatabaseClusterWithResponse request
-	GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error)
+// NewUpdateEngineVersionPolicyRequest calls the generic UpdateEngineVersionPolicy builder with application/json body
+func NewUpdateEngineVersionPolicyRequest(server string, namespace string, body UpdateEngineVersionPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateEngineVersionPolicyRequestWithBody(server, namespace, "application/json", bodyReader)
+}
 
-	// UpdateDatabaseClusterWithBodyWithResponse request with any body
-	UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+// NewUpdateEngineVersionPolicyRequestWithBody generates requests for UpdateEngineVersionPolicy with any type of body
+func NewUpdateEngineVersionPolicyRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+	var pathParam0 string
 
-	// GetDatabaseClusterComponentsWithResponse request
-	GetDatabaseClusterComponentsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterComponentsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabaseClusterCredentialsWithResponse request
-	GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabaseClusterPitrWithResponse request
-	GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/engine-version-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListDatabaseEnginesWithResponse request
-	ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetUpgradePlanWithResponse request
-	GetUpgradePlanWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetUpgradePlanResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApproveUpgradePlanWithBodyWithResponse request with any body
-	ApproveUpgradePlanWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	ApproveUpgradePlanWithResponse(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error)
+	return req, nil
+}
 
-	// GetDatabaseEngineWithResponse request
-	GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error)
+// NewListMonitoringInstancesRequest generates requests for ListMonitoringInstances
+func NewListMonitoringInstancesRequest(server string, namespace string) (*http.Request, error) {
+	var err error
 
-	// UpdateDatabaseEngineWithBodyWithResponse request with any body
-	UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+	var pathParam0 string
 
-	UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListMonitoringInstancesWithResponse request
-	ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateMonitoringInstanceWithBodyWithResponse request with any body
-	CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateMonitoringInstanceWithResponse(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteMonitoringInstanceWithResponse request
-	DeleteMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetMonitoringInstanceWithResponse request
-	GetMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error)
+	return req, nil
+}
 
-	// UpdateMonitoringInstanceWithBodyWithResponse request with any body
-	UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+// NewCreateMonitoringInstanceRequest calls the generic CreateMonitoringInstance builder with application/json body
+func NewCreateMonitoringInstanceRequest(server string, namespace string, body CreateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMonitoringInstanceRequestWithBody(server, namespace, "application/json", bodyReader)
+}
 
-	UpdateMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+// NewCreateMonitoringInstanceRequestWithBody generates requests for CreateMonitoringInstance with any type of body
+func NewCreateMonitoringInstanceRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetUserPermissionsWithResponse request
-	GetUserPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPermissionsResponse, error)
+	var pathParam0 string
 
-	// ListPodSchedulingPolicyWithResponse request
-	ListPodSchedulingPolicyWithResponse(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*ListPodSchedulingPolicyResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreatePodSchedulingPolicyWithBodyWithResponse request with any body
-	CreatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreatePodSchedulingPolicyWithResponse(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeletePodSchedulingPolicyWithResponse request
-	DeletePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*DeletePodSchedulingPolicyResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetPodSchedulingPolicyWithResponse request
-	GetPodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*GetPodSchedulingPolicyResponse, error)
-
-	// UpdatePodSchedulingPolicyWithBodyWithResponse request with any body
-	UpdatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdatePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetKubernetesClusterResourcesWithResponse request
-	GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error)
+	return req, nil
+}
 
-	// DeleteSessionWithResponse request
-	DeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error)
+// NewDeleteMonitoringInstanceRequest calls the generic DeleteMonitoringInstance builder with application/json body
+func NewDeleteMonitoringInstanceRequest(server string, namespace string, name string, body DeleteMonitoringInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteMonitoringInstanceRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
 
-	// CreateSessionWithBodyWithResponse request with any body
-	CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+// NewDeleteMonitoringInstanceRequestWithBody generates requests for DeleteMonitoringInstance with any type of body
+func NewDeleteMonitoringInstanceRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+	var pathParam0 string
 
-	// GetSettingsWithResponse request
-	GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// VersionInfoWithResponse request
-	VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error)
-}
+	var pathParam1 string
 
-type GetKubernetesClusterInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *KubernetesClusterInfo
-	JSON400      *Error
-	JSON500      *Error
-}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetKubernetesClusterInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetKubernetesClusterInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type ListNamespacesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *NamespaceList
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListNamespacesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListNamespacesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetMonitoringInstanceRequest generates requests for GetMonitoringInstance
+func NewGetMonitoringInstanceRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListBackupStoragesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStoragesList
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r ListBackupStoragesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListBackupStoragesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStorage
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateMonitoringInstanceRequest calls the generic UpdateMonitoringInstance builder with application/json body
+func NewUpdateMonitoringInstanceRequest(server string, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMonitoringInstanceRequestWithBody(server, namespace, name, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUpdateMonitoringInstanceRequestWithBody generates requests for UpdateMonitoringInstance with any type of body
+func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStorage
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStorage
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDatabaseClusterBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterBackup
-	JSON201      *DatabaseClusterBackup
-	JSON202      *DatabaseClusterBackup
-	JSON400      *Error
-	JSON500      *Error
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetNamespaceQuotaUsageRequest generates requests for GetNamespaceQuotaUsage
+func NewGetNamespaceQuotaUsageRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/quota", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserPermissionsRequest generates requests for GetUserPermissions
+func NewGetUserPermissionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPodSchedulingPolicyRequest generates requests for ListPodSchedulingPolicy
+func NewListPodSchedulingPolicyRequest(server string, params *ListPodSchedulingPolicyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pod-scheduling-policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EngineType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "engineType", runtime.ParamLocationQuery, *params.EngineType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HasRules != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hasRules", runtime.ParamLocationQuery, *params.HasRules); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Namespace != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, *params.Namespace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePodSchedulingPolicyRequest calls the generic CreatePodSchedulingPolicy builder with application/json body
+func NewCreatePodSchedulingPolicyRequest(server string, body CreatePodSchedulingPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePodSchedulingPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePodSchedulingPolicyRequestWithBody generates requests for CreatePodSchedulingPolicy with any type of body
+func NewCreatePodSchedulingPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pod-scheduling-policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPreviewPodSchedulingPolicyRequest calls the generic PreviewPodSchedulingPolicy builder with application/json body
+func NewPreviewPodSchedulingPolicyRequest(server string, body PreviewPodSchedulingPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewPodSchedulingPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPreviewPodSchedulingPolicyRequestWithBody generates requests for PreviewPodSchedulingPolicy with any type of body
+func NewPreviewPodSchedulingPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pod-scheduling-policies/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePodSchedulingPolicyRequest generates requests for DeletePodSchedulingPolicy
+func NewDeletePodSchedulingPolicyRequest(server string, policyName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPodSchedulingPolicyRequest generates requests for GetPodSchedulingPolicy
+func NewGetPodSchedulingPolicyRequest(server string, policyName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePodSchedulingPolicyRequest calls the generic UpdatePodSchedulingPolicy builder with application/json body
+func NewUpdatePodSchedulingPolicyRequest(server string, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePodSchedulingPolicyRequestWithBody(server, policyName, "application/json", bodyReader)
+}
+
+// NewUpdatePodSchedulingPolicyRequestWithBody generates requests for UpdatePodSchedulingPolicy with any type of body
+func NewUpdatePodSchedulingPolicyRequestWithBody(server string, policyName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetKubernetesClusterResourcesRequest generates requests for GetKubernetesClusterResources
+func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteSessionRequest generates requests for DeleteSession
+func NewDeleteSessionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSessionRequest calls the generic CreateSession builder with application/json body
+func NewCreateSessionRequest(server string, body CreateSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSessionRequestWithBody generates requests for CreateSession with any type of body
+func NewCreateSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSettingsRequest generates requests for GetSettings
+func NewGetSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListStorageClassesRequest generates requests for ListStorageClasses
+func NewListStorageClassesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/storage-classes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsageReportRequest generates requests for GetUsageReport
+func NewGetUsageReportRequest(server string, params *GetUsageReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Namespace != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, *params.Namespace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVersionInfoRequest generates requests for VersionInfo
+func NewVersionInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetKubernetesClusterInfoWithResponse request
+	GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error)
+
+	// GetFleetUpgradePlanWithResponse request
+	GetFleetUpgradePlanWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFleetUpgradePlanResponse, error)
+
+	// GetFleetUpgradeRolloutWithResponse request
+	GetFleetUpgradeRolloutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFleetUpgradeRolloutResponse, error)
+
+	// ApproveFleetUpgradePlanWithBodyWithResponse request with any body
+	ApproveFleetUpgradePlanWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveFleetUpgradePlanResponse, error)
+
+	ApproveFleetUpgradePlanWithResponse(ctx context.Context, body ApproveFleetUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveFleetUpgradePlanResponse, error)
+
+	// ListNamespaceProvisioningJobsWithResponse request
+	ListNamespaceProvisioningJobsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespaceProvisioningJobsResponse, error)
+
+	// ProvisionNamespaceWithBodyWithResponse request with any body
+	ProvisionNamespaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProvisionNamespaceResponse, error)
+
+	ProvisionNamespaceWithResponse(ctx context.Context, body ProvisionNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*ProvisionNamespaceResponse, error)
+
+	// DeprovisionNamespaceWithResponse request
+	DeprovisionNamespaceWithResponse(ctx context.Context, namespace string, params *DeprovisionNamespaceParams, reqEditors ...RequestEditorFn) (*DeprovisionNamespaceResponse, error)
+
+	// GetNamespaceProvisioningJobWithResponse request
+	GetNamespaceProvisioningJobWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceProvisioningJobResponse, error)
+
+	// UpdateNamespaceOperatorsWithBodyWithResponse request with any body
+	UpdateNamespaceOperatorsWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNamespaceOperatorsResponse, error)
+
+	UpdateNamespaceOperatorsWithResponse(ctx context.Context, namespace string, body UpdateNamespaceOperatorsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespaceOperatorsResponse, error)
+
+	// ListNamespacesWithResponse request
+	ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error)
+
+	// ListBackupStoragesWithResponse request
+	ListBackupStoragesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error)
+
+	// CreateBackupStorageWithBodyWithResponse request with any body
+	CreateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+
+	CreateBackupStorageWithResponse(ctx context.Context, namespace string, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+
+	// DeleteBackupStorageWithResponse request
+	DeleteBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error)
+
+	// GetBackupStorageWithResponse request
+	GetBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error)
+
+	// UpdateBackupStorageWithBodyWithResponse request with any body
+	UpdateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+
+	UpdateBackupStorageWithResponse(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+
+	// PlanDatabaseClusterCapacityWithBodyWithResponse request with any body
+	PlanDatabaseClusterCapacityWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PlanDatabaseClusterCapacityResponse, error)
+
+	PlanDatabaseClusterCapacityWithResponse(ctx context.Context, namespace string, body PlanDatabaseClusterCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*PlanDatabaseClusterCapacityResponse, error)
+
+	// CreateDatabaseClusterBackupWithBodyWithResponse request with any body
+	CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+
+	CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+
+	// DeleteDatabaseClusterBackupWithResponse request
+	DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterBackupParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error)
+
+	// GetDatabaseClusterBackupWithResponse request
+	GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error)
+
+	// CreateDatabaseClusterRestoreWithBodyWithResponse request with any body
+	CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+
+	CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+
+	// DeleteDatabaseClusterRestoreWithResponse request
+	DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error)
+
+	// GetDatabaseClusterRestoreWithResponse request
+	GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error)
+
+	// UpdateDatabaseClusterRestoreWithBodyWithResponse request with any body
+	UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+
+	UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+
+	// ListDatabaseClustersWithResponse request
+	ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error)
+
+	// CreateDatabaseClusterWithBodyWithResponse request with any body
+	CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+
+	CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+
+	// ListDatabaseClusterBackupsWithResponse request
+	ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error)
+
+	// ListDatabaseClusterRestoresWithResponse request
+	ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error)
+
+	// DeleteDatabaseClusterWithResponse request
+	DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error)
+
+	// GetDatabaseClusterWithResponse request
+	GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error)
+
+	// UpdateDatabaseClusterWithBodyWithResponse request with any body
+	UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+
+	UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+
+	// GetDatabaseClusterComponentsWithResponse request
+	GetDatabaseClusterComponentsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterComponentsResponse, error)
+
+	// GetDatabaseClusterCredentialsWithResponse request
+	GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error)
+
+	// GetDatabaseClusterPitrWithResponse request
+	GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error)
+
+	// CheckDatabaseClusterStorageResizeWithResponse request
+	CheckDatabaseClusterStorageResizeWithResponse(ctx context.Context, namespace string, name string, params *CheckDatabaseClusterStorageResizeParams, reqEditors ...RequestEditorFn) (*CheckDatabaseClusterStorageResizeResponse, error)
+
+	// ListDatabaseEnginesWithResponse request
+	ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error)
+
+	// GetUpgradePlanWithResponse request
+	GetUpgradePlanWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetUpgradePlanResponse, error)
+
+	// ApproveUpgradePlanWithBodyWithResponse request with any body
+	ApproveUpgradePlanWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error)
+
+	ApproveUpgradePlanWithResponse(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error)
+
+	// GetPostUpgradeTasksJobWithResponse request
+	GetPostUpgradeTasksJobWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetPostUpgradeTasksJobResponse, error)
+
+	// ExecutePostUpgradeTasksWithBodyWithResponse request with any body
+	ExecutePostUpgradeTasksWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecutePostUpgradeTasksResponse, error)
+
+	ExecutePostUpgradeTasksWithResponse(ctx context.Context, namespace string, body ExecutePostUpgradeTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecutePostUpgradeTasksResponse, error)
+
+	// GetDatabaseEngineWithResponse request
+	GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error)
+
+	// UpdateDatabaseEngineWithBodyWithResponse request with any body
+	UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+
+	UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+
+	// ListEngineConfigTemplatesWithResponse request
+	ListEngineConfigTemplatesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListEngineConfigTemplatesResponse, error)
+
+	// CreateEngineConfigTemplateWithBodyWithResponse request with any body
+	CreateEngineConfigTemplateWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEngineConfigTemplateResponse, error)
+
+	CreateEngineConfigTemplateWithResponse(ctx context.Context, namespace string, body CreateEngineConfigTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEngineConfigTemplateResponse, error)
+
+	// DeleteEngineConfigTemplateWithResponse request
+	DeleteEngineConfigTemplateWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteEngineConfigTemplateResponse, error)
+
+	// GetEngineConfigTemplateWithResponse request
+	GetEngineConfigTemplateWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetEngineConfigTemplateResponse, error)
+
+	// UpdateEngineConfigTemplateWithBodyWithResponse request with any body
+	UpdateEngineConfigTemplateWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEngineConfigTemplateResponse, error)
+
+	UpdateEngineConfigTemplateWithResponse(ctx context.Context, namespace string, name string, body UpdateEngineConfigTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEngineConfigTemplateResponse, error)
+
+	// RolloutEngineConfigTemplateWithResponse request
+	RolloutEngineConfigTemplateWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*RolloutEngineConfigTemplateResponse, error)
+
+	// GetEngineVersionPolicyWithResponse request
+	GetEngineVersionPolicyWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetEngineVersionPolicyResponse, error)
+
+	// UpdateEngineVersionPolicyWithBodyWithResponse request with any body
+	UpdateEngineVersionPolicyWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEngineVersionPolicyResponse, error)
+
+	UpdateEngineVersionPolicyWithResponse(ctx context.Context, namespace string, body UpdateEngineVersionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEngineVersionPolicyResponse, error)
+
+	// ListMonitoringInstancesWithResponse request
+	ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error)
+
+	// CreateMonitoringInstanceWithBodyWithResponse request with any body
+	CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+
+	CreateMonitoringInstanceWithResponse(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+
+	// DeleteMonitoringInstanceWithBodyWithResponse request with any body
+	DeleteMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error)
+
+	DeleteMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, body DeleteMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error)
+
+	// GetMonitoringInstanceWithResponse request
+	GetMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error)
+
+	// UpdateMonitoringInstanceWithBodyWithResponse request with any body
+	UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+
+	UpdateMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+
+	// GetNamespaceQuotaUsageWithResponse request
+	GetNamespaceQuotaUsageWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceQuotaUsageResponse, error)
+
+	// GetUserPermissionsWithResponse request
+	GetUserPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPermissionsResponse, error)
+
+	// ListPodSchedulingPolicyWithResponse request
+	ListPodSchedulingPolicyWithResponse(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*ListPodSchedulingPolicyResponse, error)
+
+	// CreatePodSchedulingPolicyWithBodyWithResponse request with any body
+	CreatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error)
+
+	CreatePodSchedulingPolicyWithResponse(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error)
+
+	// PreviewPodSchedulingPolicyWithBodyWithResponse request with any body
+	PreviewPodSchedulingPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewPodSchedulingPolicyResponse, error)
+
+	PreviewPodSchedulingPolicyWithResponse(ctx context.Context, body PreviewPodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewPodSchedulingPolicyResponse, error)
+
+	// DeletePodSchedulingPolicyWithResponse request
+	DeletePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*DeletePodSchedulingPolicyResponse, error)
+
+	// GetPodSchedulingPolicyWithResponse request
+	GetPodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*GetPodSchedulingPolicyResponse, error)
+
+	// UpdatePodSchedulingPolicyWithBodyWithResponse request with any body
+	UpdatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error)
+
+	UpdatePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error)
+
+	// GetKubernetesClusterResourcesWithResponse request
+	GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error)
+
+	// DeleteSessionWithResponse request
+	DeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error)
+
+	// CreateSessionWithBodyWithResponse request with any body
+	CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+
+	CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+
+	// GetSettingsWithResponse request
+	GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error)
+
+	// ListStorageClassesWithResponse request
+	ListStorageClassesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListStorageClassesResponse, error)
+
+	// GetUsageReportWithResponse request
+	GetUsageReportWithResponse(ctx context.Context, params *GetUsageReportParams, reqEditors ...RequestEditorFn) (*GetUsageReportResponse, error)
+
+	// VersionInfoWithResponse request
+	VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error)
+}
+
+type GetKubernetesClusterInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterInfo
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKubernetesClusterInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKubernetesClusterInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFleetUpgradePlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FleetUpgradePlan
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFleetUpgradePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFleetUpgradePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFleetUpgradeRolloutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FleetUpgradeRollout
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFleetUpgradeRolloutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFleetUpgradeRolloutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveFleetUpgradePlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *FleetUpgradeRollout
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveFleetUpgradePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveFleetUpgradePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNamespaceProvisioningJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceProvisioningJobList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNamespaceProvisioningJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNamespaceProvisioningJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProvisionNamespaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *NamespaceProvisioningJob
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ProvisionNamespaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProvisionNamespaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeprovisionNamespaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *NamespaceProvisioningJob
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeprovisionNamespaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeprovisionNamespaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNamespaceProvisioningJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceProvisioningJob
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNamespaceProvisioningJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNamespaceProvisioningJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNamespaceOperatorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *NamespaceProvisioningJob
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNamespaceOperatorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNamespaceOperatorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNamespacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNamespacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNamespacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBackupStoragesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStoragesList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBackupStoragesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBackupStoragesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStorage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStorage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStorage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PlanDatabaseClusterCapacityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CapacityPlan
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PlanDatabaseClusterCapacityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PlanDatabaseClusterCapacityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackup
+	JSON201      *DatabaseClusterBackup
+	JSON202      *DatabaseClusterBackup
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackup
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestore
+	JSON201      *DatabaseClusterRestore
+	JSON202      *DatabaseClusterRestore
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestore
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestore
+	JSON201      *DatabaseClusterRestore
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON201      *DatabaseCluster
+	JSON202      *DatabaseCluster
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClusterBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackupList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClusterBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClusterBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClusterRestoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestoreList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClusterRestoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClusterRestoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterComponentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterComponents
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterComponentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterComponentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterCredentialsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterCredential
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterCredentialsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterCredentialsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterPitrResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterPitr
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterPitrResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterPitrResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckDatabaseClusterStorageResizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StorageResizeCheck
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckDatabaseClusterStorageResizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckDatabaseClusterStorageResizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseEnginesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseEngineList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseEnginesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseEnginesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUpgradePlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpgradePlan
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUpgradePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUpgradePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveUpgradePlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveUpgradePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveUpgradePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPostUpgradeTasksJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostUpgradeTasksJob
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPostUpgradeTasksJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPostUpgradeTasksJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExecutePostUpgradeTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *PostUpgradeTasksJob
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExecutePostUpgradeTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExecutePostUpgradeTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseEngineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseEngine
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseEngineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseEngineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseEngineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseEngine
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseEngineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseEngineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEngineConfigTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineConfigTemplateList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEngineConfigTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEngineConfigTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateEngineConfigTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineConfigTemplate
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEngineConfigTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEngineConfigTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEngineConfigTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEngineConfigTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEngineConfigTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEngineConfigTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineConfigTemplate
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEngineConfigTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEngineConfigTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateEngineConfigTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineConfigTemplate
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateEngineConfigTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateEngineConfigTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RolloutEngineConfigTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineConfigTemplateRolloutResult
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RolloutEngineConfigTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RolloutEngineConfigTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEngineVersionPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineVersionPolicy
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEngineVersionPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEngineVersionPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateEngineVersionPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineVersionPolicy
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateEngineVersionPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateEngineVersionPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMonitoringInstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstancesList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMonitoringInstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMonitoringInstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNamespaceQuotaUsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceQuotaUsage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNamespaceQuotaUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNamespaceQuotaUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserPermissionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserPermissions
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserPermissionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserPermissionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPodSchedulingPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PodSchedulingPolicyList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPodSchedulingPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPodSchedulingPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePodSchedulingPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PodSchedulingPolicy
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePodSchedulingPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePodSchedulingPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewPodSchedulingPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PodSchedulingPolicyPreview
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewPodSchedulingPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewPodSchedulingPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePodSchedulingPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON400      *Error
+	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateDatabaseClusterBackupResponse) Status() string {
+func (r DeletePodSchedulingPolicyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8415,23 +11986,23 @@ func (r CreateDatabaseClusterBackupResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseClusterBackupResponse) StatusCode() int {
+func (r DeletePodSchedulingPolicyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteDatabaseClusterBackupResponse struct {
+type GetPodSchedulingPolicyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON200      *PodSchedulingPolicy
 	JSON400      *Error
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteDatabaseClusterBackupResponse) Status() string {
+func (r GetPodSchedulingPolicyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8439,23 +12010,23 @@ func (r DeleteDatabaseClusterBackupResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseClusterBackupResponse) StatusCode() int {
+func (r GetPodSchedulingPolicyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetDatabaseClusterBackupResponse struct {
+type UpdatePodSchedulingPolicyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterBackup
+	JSON200      *PodSchedulingPolicy
 	JSON400      *Error
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDatabaseClusterBackupResponse) Status() string {
+func (r UpdatePodSchedulingPolicyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8463,25 +12034,23 @@ func (r GetDatabaseClusterBackupResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterBackupResponse) StatusCode() int {
+func (r UpdatePodSchedulingPolicyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateDatabaseClusterRestoreResponse struct {
+type GetKubernetesClusterResourcesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestore
-	JSON201      *DatabaseClusterRestore
-	JSON202      *DatabaseClusterRestore
+	JSON200      *KubernetesClusterResources
 	JSON400      *Error
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateDatabaseClusterRestoreResponse) Status() string {
+func (r GetKubernetesClusterResourcesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8489,23 +12058,22 @@ func (r CreateDatabaseClusterRestoreResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseClusterRestoreResponse) StatusCode() int {
+func (r GetKubernetesClusterResourcesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteDatabaseClusterRestoreResponse struct {
+type DeleteSessionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
-	JSON400      *Error
+	JSON429      *Error
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteDatabaseClusterRestoreResponse) Status() string {
+func (r DeleteSessionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8513,23 +12081,26 @@ func (r DeleteDatabaseClusterRestoreResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseClusterRestoreResponse) StatusCode() int {
+func (r DeleteSessionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetDatabaseClusterRestoreResponse struct {
+type CreateSessionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestore
-	JSON400      *Error
-	JSON500      *Error
+	JSON200      *struct {
+		Token *string `json:"token,omitempty"`
+	}
+	JSON400 *Error
+	JSON429 *Error
+	JSON500 *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDatabaseClusterRestoreResponse) Status() string {
+func (r CreateSessionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8537,24 +12108,21 @@ func (r GetDatabaseClusterRestoreResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterRestoreResponse) StatusCode() int {
+func (r CreateSessionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateDatabaseClusterRestoreResponse struct {
+type GetSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestore
-	JSON201      *DatabaseClusterRestore
-	JSON400      *Error
-	JSON500      *Error
+	JSON200      *Settings
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateDatabaseClusterRestoreResponse) Status() string {
+func (r GetSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8562,23 +12130,23 @@ func (r UpdateDatabaseClusterRestoreResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseClusterRestoreResponse) StatusCode() int {
+func (r GetSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ListDatabaseClustersResponse struct {
+type ListStorageClassesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterList
+	JSON200      *StorageClassInfoList
 	JSON400      *Error
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r ListDatabaseClustersResponse) Status() string {
+func (r ListStorageClassesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8586,1267 +12154,1737 @@ func (r ListDatabaseClustersResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseClustersResponse) StatusCode() int {
+func (r ListStorageClassesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateDatabaseClusterResponse struct {
+type GetUsageReportResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DatabaseCluster
-	JSON201      *DatabaseCluster
-	JSON202      *DatabaseCluster
+	JSON200      *UsageReport
 	JSON400      *Error
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateDatabaseClusterResponse) Status() string {
+func (r GetUsageReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsageReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VersionInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Version
+}
+
+// Status returns HTTPResponse.Status
+func (r VersionInfoResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VersionInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetKubernetesClusterInfoWithResponse request returning *GetKubernetesClusterInfoResponse
+func (c *ClientWithResponses) GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error) {
+	rsp, err := c.GetKubernetesClusterInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKubernetesClusterInfoResponse(rsp)
+}
+
+// GetFleetUpgradePlanWithResponse request returning *GetFleetUpgradePlanResponse
+func (c *ClientWithResponses) GetFleetUpgradePlanWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFleetUpgradePlanResponse, error) {
+	rsp, err := c.GetFleetUpgradePlan(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFleetUpgradePlanResponse(rsp)
+}
+
+// GetFleetUpgradeRolloutWithResponse request returning *GetFleetUpgradeRolloutResponse
+func (c *ClientWithResponses) GetFleetUpgradeRolloutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFleetUpgradeRolloutResponse, error) {
+	rsp, err := c.GetFleetUpgradeRollout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFleetUpgradeRolloutResponse(rsp)
+}
+
+// ApproveFleetUpgradePlanWithBodyWithResponse request with arbitrary body returning *ApproveFleetUpgradePlanResponse
+func (c *ClientWithResponses) ApproveFleetUpgradePlanWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveFleetUpgradePlanResponse, error) {
+	rsp, err := c.ApproveFleetUpgradePlanWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveFleetUpgradePlanResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApproveFleetUpgradePlanWithResponse(ctx context.Context, body ApproveFleetUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveFleetUpgradePlanResponse, error) {
+	rsp, err := c.ApproveFleetUpgradePlan(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveFleetUpgradePlanResponse(rsp)
+}
+
+// ListNamespaceProvisioningJobsWithResponse request returning *ListNamespaceProvisioningJobsResponse
+func (c *ClientWithResponses) ListNamespaceProvisioningJobsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespaceProvisioningJobsResponse, error) {
+	rsp, err := c.ListNamespaceProvisioningJobs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNamespaceProvisioningJobsResponse(rsp)
+}
+
+// ProvisionNamespaceWithBodyWithResponse request with arbitrary body returning *ProvisionNamespaceResponse
+func (c *ClientWithResponses) ProvisionNamespaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProvisionNamespaceResponse, error) {
+	rsp, err := c.ProvisionNamespaceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProvisionNamespaceResponse(rsp)
+}
+
+func (c *ClientWithResponses) ProvisionNamespaceWithResponse(ctx context.Context, body ProvisionNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*ProvisionNamespaceResponse, error) {
+	rsp, err := c.ProvisionNamespace(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProvisionNamespaceResponse(rsp)
+}
+
+// DeprovisionNamespaceWithResponse request returning *DeprovisionNamespaceResponse
+func (c *ClientWithResponses) DeprovisionNamespaceWithResponse(ctx context.Context, namespace string, params *DeprovisionNamespaceParams, reqEditors ...RequestEditorFn) (*DeprovisionNamespaceResponse, error) {
+	rsp, err := c.DeprovisionNamespace(ctx, namespace, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeprovisionNamespaceResponse(rsp)
+}
+
+// GetNamespaceProvisioningJobWithResponse request returning *GetNamespaceProvisioningJobResponse
+func (c *ClientWithResponses) GetNamespaceProvisioningJobWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceProvisioningJobResponse, error) {
+	rsp, err := c.GetNamespaceProvisioningJob(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNamespaceProvisioningJobResponse(rsp)
+}
+
+// UpdateNamespaceOperatorsWithBodyWithResponse request with arbitrary body returning *UpdateNamespaceOperatorsResponse
+func (c *ClientWithResponses) UpdateNamespaceOperatorsWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNamespaceOperatorsResponse, error) {
+	rsp, err := c.UpdateNamespaceOperatorsWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNamespaceOperatorsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNamespaceOperatorsWithResponse(ctx context.Context, namespace string, body UpdateNamespaceOperatorsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespaceOperatorsResponse, error) {
+	rsp, err := c.UpdateNamespaceOperators(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNamespaceOperatorsResponse(rsp)
+}
+
+// ListNamespacesWithResponse request returning *ListNamespacesResponse
+func (c *ClientWithResponses) ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error) {
+	rsp, err := c.ListNamespaces(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNamespacesResponse(rsp)
+}
+
+// ListBackupStoragesWithResponse request returning *ListBackupStoragesResponse
+func (c *ClientWithResponses) ListBackupStoragesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error) {
+	rsp, err := c.ListBackupStorages(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBackupStoragesResponse(rsp)
+}
+
+// CreateBackupStorageWithBodyWithResponse request with arbitrary body returning *CreateBackupStorageResponse
+func (c *ClientWithResponses) CreateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
+	rsp, err := c.CreateBackupStorageWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBackupStorageResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBackupStorageWithResponse(ctx context.Context, namespace string, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
+	rsp, err := c.CreateBackupStorage(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBackupStorageResponse(rsp)
+}
+
+// DeleteBackupStorageWithResponse request returning *DeleteBackupStorageResponse
+func (c *ClientWithResponses) DeleteBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error) {
+	rsp, err := c.DeleteBackupStorage(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBackupStorageResponse(rsp)
+}
+
+// GetBackupStorageWithResponse request returning *GetBackupStorageResponse
+func (c *ClientWithResponses) GetBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error) {
+	rsp, err := c.GetBackupStorage(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBackupStorageResponse(rsp)
+}
+
+// UpdateBackupStorageWithBodyWithResponse request with arbitrary body returning *UpdateBackupStorageResponse
+func (c *ClientWithResponses) UpdateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
+	rsp, err := c.UpdateBackupStorageWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBackupStorageResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBackupStorageWithResponse(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
+	rsp, err := c.UpdateBackupStorage(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBackupStorageResponse(rsp)
+}
+
+// PlanDatabaseClusterCapacityWithBodyWithResponse request with arbitrary body returning *PlanDatabaseClusterCapacityResponse
+func (c *ClientWithResponses) PlanDatabaseClusterCapacityWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PlanDatabaseClusterCapacityResponse, error) {
+	rsp, err := c.PlanDatabaseClusterCapacityWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePlanDatabaseClusterCapacityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PlanDatabaseClusterCapacityWithResponse(ctx context.Context, namespace string, body PlanDatabaseClusterCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*PlanDatabaseClusterCapacityResponse, error) {
+	rsp, err := c.PlanDatabaseClusterCapacity(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePlanDatabaseClusterCapacityResponse(rsp)
+}
+
+// CreateDatabaseClusterBackupWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterBackupResponse
+func (c *ClientWithResponses) CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
+	rsp, err := c.CreateDatabaseClusterBackupWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterBackupResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
+	rsp, err := c.CreateDatabaseClusterBackup(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateDatabaseClusterBackupResponse(rsp)
 }
 
-type ListDatabaseClusterBackupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterBackupList
-	JSON400      *Error
-	JSON500      *Error
+// DeleteDatabaseClusterBackupWithResponse request returning *DeleteDatabaseClusterBackupResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterBackupParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error) {
+	rsp, err := c.DeleteDatabaseClusterBackup(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterBackupResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDatabaseClusterBackupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetDatabaseClusterBackupWithResponse request returning *GetDatabaseClusterBackupResponse
+func (c *ClientWithResponses) GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error) {
+	rsp, err := c.GetDatabaseClusterBackup(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetDatabaseClusterBackupResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseClusterBackupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.CreateDatabaseClusterRestoreWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateDatabaseClusterRestoreResponse(rsp)
 }
 
-type ListDatabaseClusterRestoresResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestoreList
-	JSON400      *Error
-	JSON500      *Error
+func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.CreateDatabaseClusterRestore(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterRestoreResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDatabaseClusterRestoresResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteDatabaseClusterRestoreWithResponse request returning *DeleteDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.DeleteDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteDatabaseClusterRestoreResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseClusterRestoresResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetDatabaseClusterRestoreWithResponse request returning *GetDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.GetDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetDatabaseClusterRestoreResponse(rsp)
 }
 
-type DeleteDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
-	JSON400      *Error
-	JSON500      *Error
+// UpdateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterRestoreWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterRestore(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ListDatabaseClustersWithResponse request returning *ListDatabaseClustersResponse
+func (c *ClientWithResponses) ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error) {
+	rsp, err := c.ListDatabaseClusters(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseListDatabaseClustersResponse(rsp)
 }
 
-type GetDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseCluster
-	JSON400      *Error
-	JSON500      *Error
+// CreateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterResponse
+func (c *ClientWithResponses) CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
+	rsp, err := c.CreateDatabaseClusterWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
+	rsp, err := c.CreateDatabaseCluster(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateDatabaseClusterResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ListDatabaseClusterBackupsWithResponse request returning *ListDatabaseClusterBackupsResponse
+func (c *ClientWithResponses) ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error) {
+	rsp, err := c.ListDatabaseClusterBackups(ctx, namespace, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseListDatabaseClusterBackupsResponse(rsp)
 }
 
-type UpdateDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseCluster
-	JSON400      *Error
-	JSON500      *Error
+// ListDatabaseClusterRestoresWithResponse request returning *ListDatabaseClusterRestoresResponse
+func (c *ClientWithResponses) ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error) {
+	rsp, err := c.ListDatabaseClusterRestores(ctx, namespace, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseClusterRestoresResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteDatabaseClusterWithResponse request returning *DeleteDatabaseClusterResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error) {
+	rsp, err := c.DeleteDatabaseCluster(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteDatabaseClusterResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetDatabaseClusterWithResponse request returning *GetDatabaseClusterResponse
+func (c *ClientWithResponses) GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error) {
+	rsp, err := c.GetDatabaseCluster(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetDatabaseClusterResponse(rsp)
 }
 
-type GetDatabaseClusterComponentsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterComponents
-	JSON400      *Error
-	JSON500      *Error
+// UpdateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterComponentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
+	rsp, err := c.UpdateDatabaseCluster(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateDatabaseClusterResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterComponentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetDatabaseClusterComponentsWithResponse request returning *GetDatabaseClusterComponentsResponse
+func (c *ClientWithResponses) GetDatabaseClusterComponentsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterComponentsResponse, error) {
+	rsp, err := c.GetDatabaseClusterComponents(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetDatabaseClusterComponentsResponse(rsp)
 }
 
-type GetDatabaseClusterCredentialsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterCredential
-	JSON400      *Error
-	JSON500      *Error
+// GetDatabaseClusterCredentialsWithResponse request returning *GetDatabaseClusterCredentialsResponse
+func (c *ClientWithResponses) GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error) {
+	rsp, err := c.GetDatabaseClusterCredentials(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterCredentialsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterCredentialsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetDatabaseClusterPitrWithResponse request returning *GetDatabaseClusterPitrResponse
+func (c *ClientWithResponses) GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error) {
+	rsp, err := c.GetDatabaseClusterPitr(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetDatabaseClusterPitrResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterCredentialsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CheckDatabaseClusterStorageResizeWithResponse request returning *CheckDatabaseClusterStorageResizeResponse
+func (c *ClientWithResponses) CheckDatabaseClusterStorageResizeWithResponse(ctx context.Context, namespace string, name string, params *CheckDatabaseClusterStorageResizeParams, reqEditors ...RequestEditorFn) (*CheckDatabaseClusterStorageResizeResponse, error) {
+	rsp, err := c.CheckDatabaseClusterStorageResize(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCheckDatabaseClusterStorageResizeResponse(rsp)
 }
 
-type GetDatabaseClusterPitrResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterPitr
-	JSON400      *Error
-	JSON500      *Error
+// ListDatabaseEnginesWithResponse request returning *ListDatabaseEnginesResponse
+func (c *ClientWithResponses) ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error) {
+	rsp, err := c.ListDatabaseEngines(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseEnginesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterPitrResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetUpgradePlanWithResponse request returning *GetUpgradePlanResponse
+func (c *ClientWithResponses) GetUpgradePlanWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetUpgradePlanResponse, error) {
+	rsp, err := c.GetUpgradePlan(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetUpgradePlanResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterPitrResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ApproveUpgradePlanWithBodyWithResponse request with arbitrary body returning *ApproveUpgradePlanResponse
+func (c *ClientWithResponses) ApproveUpgradePlanWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error) {
+	rsp, err := c.ApproveUpgradePlanWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseApproveUpgradePlanResponse(rsp)
 }
 
-type ListDatabaseEnginesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseEngineList
-	JSON400      *Error
-	JSON500      *Error
+func (c *ClientWithResponses) ApproveUpgradePlanWithResponse(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error) {
+	rsp, err := c.ApproveUpgradePlan(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveUpgradePlanResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDatabaseEnginesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetPostUpgradeTasksJobWithResponse request returning *GetPostUpgradeTasksJobResponse
+func (c *ClientWithResponses) GetPostUpgradeTasksJobWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetPostUpgradeTasksJobResponse, error) {
+	rsp, err := c.GetPostUpgradeTasksJob(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetPostUpgradeTasksJobResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseEnginesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ExecutePostUpgradeTasksWithBodyWithResponse request with arbitrary body returning *ExecutePostUpgradeTasksResponse
+func (c *ClientWithResponses) ExecutePostUpgradeTasksWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecutePostUpgradeTasksResponse, error) {
+	rsp, err := c.ExecutePostUpgradeTasksWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseExecutePostUpgradeTasksResponse(rsp)
 }
 
-type GetUpgradePlanResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UpgradePlan
-	JSON400      *Error
-	JSON500      *Error
+func (c *ClientWithResponses) ExecutePostUpgradeTasksWithResponse(ctx context.Context, namespace string, body ExecutePostUpgradeTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecutePostUpgradeTasksResponse, error) {
+	rsp, err := c.ExecutePostUpgradeTasks(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecutePostUpgradeTasksResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUpgradePlanResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetDatabaseEngineWithResponse request returning *GetDatabaseEngineResponse
+func (c *ClientWithResponses) GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error) {
+	rsp, err := c.GetDatabaseEngine(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetDatabaseEngineResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUpgradePlanResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateDatabaseEngineWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseEngineResponse
+func (c *ClientWithResponses) UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
+	rsp, err := c.UpdateDatabaseEngineWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateDatabaseEngineResponse(rsp)
 }
 
-type ApproveUpgradePlanResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON500      *Error
+func (c *ClientWithResponses) UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
+	rsp, err := c.UpdateDatabaseEngine(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseEngineResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ApproveUpgradePlanResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ListEngineConfigTemplatesWithResponse request returning *ListEngineConfigTemplatesResponse
+func (c *ClientWithResponses) ListEngineConfigTemplatesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListEngineConfigTemplatesResponse, error) {
+	rsp, err := c.ListEngineConfigTemplates(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseListEngineConfigTemplatesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApproveUpgradePlanResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateEngineConfigTemplateWithBodyWithResponse request with arbitrary body returning *CreateEngineConfigTemplateResponse
+func (c *ClientWithResponses) CreateEngineConfigTemplateWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEngineConfigTemplateResponse, error) {
+	rsp, err := c.CreateEngineConfigTemplateWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateEngineConfigTemplateResponse(rsp)
 }
 
-type GetDatabaseEngineResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseEngine
-	JSON400      *Error
-	JSON500      *Error
+func (c *ClientWithResponses) CreateEngineConfigTemplateWithResponse(ctx context.Context, namespace string, body CreateEngineConfigTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEngineConfigTemplateResponse, error) {
+	rsp, err := c.CreateEngineConfigTemplate(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEngineConfigTemplateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseEngineResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteEngineConfigTemplateWithResponse request returning *DeleteEngineConfigTemplateResponse
+func (c *ClientWithResponses) DeleteEngineConfigTemplateWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteEngineConfigTemplateResponse, error) {
+	rsp, err := c.DeleteEngineConfigTemplate(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteEngineConfigTemplateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseEngineResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetEngineConfigTemplateWithResponse request returning *GetEngineConfigTemplateResponse
+func (c *ClientWithResponses) GetEngineConfigTemplateWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetEngineConfigTemplateResponse, error) {
+	rsp, err := c.GetEngineConfigTemplate(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetEngineConfigTemplateResponse(rsp)
 }
 
-type UpdateDatabaseEngineResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseEngine
-	JSON400      *Error
-	JSON500      *Error
+// UpdateEngineConfigTemplateWithBodyWithResponse request with arbitrary body returning *UpdateEngineConfigTemplateResponse
+func (c *ClientWithResponses) UpdateEngineConfigTemplateWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEngineConfigTemplateResponse, error) {
+	rsp, err := c.UpdateEngineConfigTemplateWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEngineConfigTemplateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseEngineResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateEngineConfigTemplateWithResponse(ctx context.Context, namespace string, name string, body UpdateEngineConfigTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEngineConfigTemplateResponse, error) {
+	rsp, err := c.UpdateEngineConfigTemplate(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateEngineConfigTemplateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseEngineResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// RolloutEngineConfigTemplateWithResponse request returning *RolloutEngineConfigTemplateResponse
+func (c *ClientWithResponses) RolloutEngineConfigTemplateWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*RolloutEngineConfigTemplateResponse, error) {
+	rsp, err := c.RolloutEngineConfigTemplate(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseRolloutEngineConfigTemplateResponse(rsp)
 }
 
-type ListMonitoringInstancesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstancesList
-	JSON400      *Error
-	JSON500      *Error
+// GetEngineVersionPolicyWithResponse request returning *GetEngineVersionPolicyResponse
+func (c *ClientWithResponses) GetEngineVersionPolicyWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetEngineVersionPolicyResponse, error) {
+	rsp, err := c.GetEngineVersionPolicy(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEngineVersionPolicyResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListMonitoringInstancesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateEngineVersionPolicyWithBodyWithResponse request with arbitrary body returning *UpdateEngineVersionPolicyResponse
+func (c *ClientWithResponses) UpdateEngineVersionPolicyWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEngineVersionPolicyResponse, error) {
+	rsp, err := c.UpdateEngineVersionPolicyWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateEngineVersionPolicyResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMonitoringInstancesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateEngineVersionPolicyWithResponse(ctx context.Context, namespace string, body UpdateEngineVersionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEngineVersionPolicyResponse, error) {
+	rsp, err := c.UpdateEngineVersionPolicy(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateEngineVersionPolicyResponse(rsp)
 }
 
-type CreateMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
-	JSON400      *Error
-	JSON500      *Error
+// ListMonitoringInstancesWithResponse request returning *ListMonitoringInstancesResponse
+func (c *ClientWithResponses) ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error) {
+	rsp, err := c.ListMonitoringInstances(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMonitoringInstancesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CreateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *CreateMonitoringInstanceResponse
+func (c *ClientWithResponses) CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
+	rsp, err := c.CreateMonitoringInstanceWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateMonitoringInstanceResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CreateMonitoringInstanceWithResponse(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
+	rsp, err := c.CreateMonitoringInstance(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateMonitoringInstanceResponse(rsp)
 }
 
-type DeleteMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
+// DeleteMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *DeleteMonitoringInstanceResponse
+func (c *ClientWithResponses) DeleteMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error) {
+	rsp, err := c.DeleteMonitoringInstanceWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMonitoringInstanceResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) DeleteMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, body DeleteMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error) {
+	rsp, err := c.DeleteMonitoringInstance(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteMonitoringInstanceResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetMonitoringInstanceWithResponse request returning *GetMonitoringInstanceResponse
+func (c *ClientWithResponses) GetMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error) {
+	rsp, err := c.GetMonitoringInstance(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetMonitoringInstanceResponse(rsp)
 }
 
-type GetMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
+// UpdateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *UpdateMonitoringInstanceResponse
+func (c *ClientWithResponses) UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
+	rsp, err := c.UpdateMonitoringInstanceWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMonitoringInstanceResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
+	rsp, err := c.UpdateMonitoringInstance(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateMonitoringInstanceResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetNamespaceQuotaUsageWithResponse request returning *GetNamespaceQuotaUsageResponse
+func (c *ClientWithResponses) GetNamespaceQuotaUsageWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceQuotaUsageResponse, error) {
+	rsp, err := c.GetNamespaceQuotaUsage(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetNamespaceQuotaUsageResponse(rsp)
 }
 
-type UpdateMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
+// GetUserPermissionsWithResponse request returning *GetUserPermissionsResponse
+func (c *ClientWithResponses) GetUserPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPermissionsResponse, error) {
+	rsp, err := c.GetUserPermissions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserPermissionsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ListPodSchedulingPolicyWithResponse request returning *ListPodSchedulingPolicyResponse
+func (c *ClientWithResponses) ListPodSchedulingPolicyWithResponse(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*ListPodSchedulingPolicyResponse, error) {
+	rsp, err := c.ListPodSchedulingPolicy(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseListPodSchedulingPolicyResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreatePodSchedulingPolicyWithBodyWithResponse request with arbitrary body returning *CreatePodSchedulingPolicyResponse
+func (c *ClientWithResponses) CreatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error) {
+	rsp, err := c.CreatePodSchedulingPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreatePodSchedulingPolicyResponse(rsp)
 }
 
-type GetUserPermissionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserPermissions
-	JSON400      *Error
-	JSON500      *Error
+func (c *ClientWithResponses) CreatePodSchedulingPolicyWithResponse(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error) {
+	rsp, err := c.CreatePodSchedulingPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePodSchedulingPolicyResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUserPermissionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PreviewPodSchedulingPolicyWithBodyWithResponse request with arbitrary body returning *PreviewPodSchedulingPolicyResponse
+func (c *ClientWithResponses) PreviewPodSchedulingPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewPodSchedulingPolicyResponse, error) {
+	rsp, err := c.PreviewPodSchedulingPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePreviewPodSchedulingPolicyResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserPermissionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PreviewPodSchedulingPolicyWithResponse(ctx context.Context, body PreviewPodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewPodSchedulingPolicyResponse, error) {
+	rsp, err := c.PreviewPodSchedulingPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePreviewPodSchedulingPolicyResponse(rsp)
 }
 
-type ListPodSchedulingPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PodSchedulingPolicyList
-	JSON400      *Error
-	JSON500      *Error
+// DeletePodSchedulingPolicyWithResponse request returning *DeletePodSchedulingPolicyResponse
+func (c *ClientWithResponses) DeletePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*DeletePodSchedulingPolicyResponse, error) {
+	rsp, err := c.DeletePodSchedulingPolicy(ctx, policyName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePodSchedulingPolicyResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListPodSchedulingPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetPodSchedulingPolicyWithResponse request returning *GetPodSchedulingPolicyResponse
+func (c *ClientWithResponses) GetPodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*GetPodSchedulingPolicyResponse, error) {
+	rsp, err := c.GetPodSchedulingPolicy(ctx, policyName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetPodSchedulingPolicyResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListPodSchedulingPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdatePodSchedulingPolicyWithBodyWithResponse request with arbitrary body returning *UpdatePodSchedulingPolicyResponse
+func (c *ClientWithResponses) UpdatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error) {
+	rsp, err := c.UpdatePodSchedulingPolicyWithBody(ctx, policyName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdatePodSchedulingPolicyResponse(rsp)
 }
 
-type CreatePodSchedulingPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PodSchedulingPolicy
-	JSON400      *Error
-	JSON500      *Error
+func (c *ClientWithResponses) UpdatePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error) {
+	rsp, err := c.UpdatePodSchedulingPolicy(ctx, policyName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePodSchedulingPolicyResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreatePodSchedulingPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetKubernetesClusterResourcesWithResponse request returning *GetKubernetesClusterResourcesResponse
+func (c *ClientWithResponses) GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error) {
+	rsp, err := c.GetKubernetesClusterResources(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetKubernetesClusterResourcesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreatePodSchedulingPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteSessionWithResponse request returning *DeleteSessionResponse
+func (c *ClientWithResponses) DeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error) {
+	rsp, err := c.DeleteSession(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteSessionResponse(rsp)
 }
 
-type DeletePodSchedulingPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
-	JSON400      *Error
-	JSON500      *Error
+// CreateSessionWithBodyWithResponse request with arbitrary body returning *CreateSessionResponse
+func (c *ClientWithResponses) CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
+	rsp, err := c.CreateSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSessionResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeletePodSchedulingPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
+	rsp, err := c.CreateSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateSessionResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeletePodSchedulingPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetSettingsWithResponse request returning *GetSettingsResponse
+func (c *ClientWithResponses) GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error) {
+	rsp, err := c.GetSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetSettingsResponse(rsp)
 }
 
-type GetPodSchedulingPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PodSchedulingPolicy
-	JSON400      *Error
-	JSON500      *Error
+// ListStorageClassesWithResponse request returning *ListStorageClassesResponse
+func (c *ClientWithResponses) ListStorageClassesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListStorageClassesResponse, error) {
+	rsp, err := c.ListStorageClasses(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListStorageClassesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPodSchedulingPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetUsageReportWithResponse request returning *GetUsageReportResponse
+func (c *ClientWithResponses) GetUsageReportWithResponse(ctx context.Context, params *GetUsageReportParams, reqEditors ...RequestEditorFn) (*GetUsageReportResponse, error) {
+	rsp, err := c.GetUsageReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetUsageReportResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPodSchedulingPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// VersionInfoWithResponse request returning *VersionInfoResponse
+func (c *ClientWithResponses) VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error) {
+	rsp, err := c.VersionInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseVersionInfoResponse(rsp)
 }
 
-type UpdatePodSchedulingPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PodSchedulingPolicy
-	JSON400      *Error
-	JSON500      *Error
-}
+// ParseGetKubernetesClusterInfoResponse parses an HTTP response from a GetKubernetesClusterInfoWithResponse call
+func ParseGetKubernetesClusterInfoResponse(rsp *http.Response) (*GetKubernetesClusterInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdatePodSchedulingPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetKubernetesClusterInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePodSchedulingPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return 0
-}
 
-type GetKubernetesClusterResourcesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *KubernetesClusterResources
-	JSON400      *Error
-	JSON500      *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetKubernetesClusterResourcesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetFleetUpgradePlanResponse parses an HTTP response from a GetFleetUpgradePlanWithResponse call
+func ParseGetFleetUpgradePlanResponse(rsp *http.Response) (*GetFleetUpgradePlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetKubernetesClusterResourcesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetFleetUpgradePlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteSessionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON429      *Error
-	JSON500      *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FleetUpgradePlan
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteSessionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSessionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-type CreateSessionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Token *string `json:"token,omitempty"`
+// ParseGetFleetUpgradeRolloutResponse parses an HTTP response from a GetFleetUpgradeRolloutWithResponse call
+func ParseGetFleetUpgradeRolloutResponse(rsp *http.Response) (*GetFleetUpgradeRolloutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *Error
-	JSON429 *Error
-	JSON500 *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateSessionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetFleetUpgradeRolloutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateSessionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FleetUpgradeRollout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return 0
-}
 
-type GetSettingsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Settings
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSettingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseApproveFleetUpgradePlanResponse parses an HTTP response from a ApproveFleetUpgradePlanWithResponse call
+func ParseApproveFleetUpgradePlanResponse(rsp *http.Response) (*ApproveFleetUpgradePlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSettingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ApproveFleetUpgradePlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type VersionInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Version
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest FleetUpgradeRollout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
 
-// Status returns HTTPResponse.Status
-func (r VersionInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VersionInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// GetKubernetesClusterInfoWithResponse request returning *GetKubernetesClusterInfoResponse
-func (c *ClientWithResponses) GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error) {
-	rsp, err := c.GetKubernetesClusterInfo(ctx, reqEditors...)
+// ParseListNamespaceProvisioningJobsResponse parses an HTTP response from a ListNamespaceProvisioningJobsWithResponse call
+func ParseListNamespaceProvisioningJobsResponse(rsp *http.Response) (*ListNamespaceProvisioningJobsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetKubernetesClusterInfoResponse(rsp)
-}
 
-// ListNamespacesWithResponse request returning *ListNamespacesResponse
-func (c *ClientWithResponses) ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error) {
-	rsp, err := c.ListNamespaces(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListNamespaceProvisioningJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListNamespacesResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamespaceProvisioningJobList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ListBackupStoragesWithResponse request returning *ListBackupStoragesResponse
-func (c *ClientWithResponses) ListBackupStoragesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error) {
-	rsp, err := c.ListBackupStorages(ctx, namespace, reqEditors...)
+// ParseProvisionNamespaceResponse parses an HTTP response from a ProvisionNamespaceWithResponse call
+func ParseProvisionNamespaceResponse(rsp *http.Response) (*ProvisionNamespaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListBackupStoragesResponse(rsp)
-}
 
-// CreateBackupStorageWithBodyWithResponse request with arbitrary body returning *CreateBackupStorageResponse
-func (c *ClientWithResponses) CreateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
-	rsp, err := c.CreateBackupStorageWithBody(ctx, namespace, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ProvisionNamespaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest NamespaceProvisioningJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCreateBackupStorageResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateBackupStorageWithResponse(ctx context.Context, namespace string, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
-	rsp, err := c.CreateBackupStorage(ctx, namespace, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateBackupStorageResponse(rsp)
+	return response, nil
 }
 
-// DeleteBackupStorageWithResponse request returning *DeleteBackupStorageResponse
-func (c *ClientWithResponses) DeleteBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error) {
-	rsp, err := c.DeleteBackupStorage(ctx, namespace, name, reqEditors...)
+// ParseDeprovisionNamespaceResponse parses an HTTP response from a DeprovisionNamespaceWithResponse call
+func ParseDeprovisionNamespaceResponse(rsp *http.Response) (*DeprovisionNamespaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteBackupStorageResponse(rsp)
-}
 
-// GetBackupStorageWithResponse request returning *GetBackupStorageResponse
-func (c *ClientWithResponses) GetBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error) {
-	rsp, err := c.GetBackupStorage(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeprovisionNamespaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetBackupStorageResponse(rsp)
-}
 
-// UpdateBackupStorageWithBodyWithResponse request with arbitrary body returning *UpdateBackupStorageResponse
-func (c *ClientWithResponses) UpdateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
-	rsp, err := c.UpdateBackupStorageWithBody(ctx, namespace, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateBackupStorageResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest NamespaceProvisioningJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
 
-func (c *ClientWithResponses) UpdateBackupStorageWithResponse(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
-	rsp, err := c.UpdateBackupStorage(ctx, namespace, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateBackupStorageResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// CreateDatabaseClusterBackupWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterBackupResponse
-func (c *ClientWithResponses) CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
-	rsp, err := c.CreateDatabaseClusterBackupWithBody(ctx, namespace, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateDatabaseClusterBackupResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
-	rsp, err := c.CreateDatabaseClusterBackup(ctx, namespace, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseCreateDatabaseClusterBackupResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteDatabaseClusterBackupWithResponse request returning *DeleteDatabaseClusterBackupResponse
-func (c *ClientWithResponses) DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterBackupParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error) {
-	rsp, err := c.DeleteDatabaseClusterBackup(ctx, namespace, name, params, reqEditors...)
+// ParseGetNamespaceProvisioningJobResponse parses an HTTP response from a GetNamespaceProvisioningJobWithResponse call
+func ParseGetNamespaceProvisioningJobResponse(rsp *http.Response) (*GetNamespaceProvisioningJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDatabaseClusterBackupResponse(rsp)
-}
 
-// GetDatabaseClusterBackupWithResponse request returning *GetDatabaseClusterBackupResponse
-func (c *ClientWithResponses) GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error) {
-	rsp, err := c.GetDatabaseClusterBackup(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetNamespaceProvisioningJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetDatabaseClusterBackupResponse(rsp)
-}
 
-// CreateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.CreateDatabaseClusterRestoreWithBody(ctx, namespace, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamespaceProvisioningJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCreateDatabaseClusterRestoreResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.CreateDatabaseClusterRestore(ctx, namespace, body, reqEditors...)
+// ParseUpdateNamespaceOperatorsResponse parses an HTTP response from a UpdateNamespaceOperatorsWithResponse call
+func ParseUpdateNamespaceOperatorsResponse(rsp *http.Response) (*UpdateNamespaceOperatorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterRestoreResponse(rsp)
-}
 
-// DeleteDatabaseClusterRestoreWithResponse request returning *DeleteDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.DeleteDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateNamespaceOperatorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteDatabaseClusterRestoreResponse(rsp)
-}
 
-// GetDatabaseClusterRestoreWithResponse request returning *GetDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.GetDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest NamespaceProvisioningJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetDatabaseClusterRestoreResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.UpdateDatabaseClusterRestoreWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+// ParseListNamespacesResponse parses an HTTP response from a ListNamespacesWithResponse call
+func ParseListNamespacesResponse(rsp *http.Response) (*ListNamespacesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.UpdateDatabaseClusterRestore(ctx, namespace, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListNamespacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
-}
 
-// ListDatabaseClustersWithResponse request returning *ListDatabaseClustersResponse
-func (c *ClientWithResponses) ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error) {
-	rsp, err := c.ListDatabaseClusters(ctx, namespace, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamespaceList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseListDatabaseClustersResponse(rsp)
+
+	return response, nil
 }
 
-// CreateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterResponse
-func (c *ClientWithResponses) CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
-	rsp, err := c.CreateDatabaseClusterWithBody(ctx, namespace, contentType, body, reqEditors...)
+// ParseListBackupStoragesResponse parses an HTTP response from a ListBackupStoragesWithResponse call
+func ParseListBackupStoragesResponse(rsp *http.Response) (*ListBackupStoragesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
-	rsp, err := c.CreateDatabaseCluster(ctx, namespace, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListBackupStoragesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateDatabaseClusterResponse(rsp)
-}
 
-// ListDatabaseClusterBackupsWithResponse request returning *ListDatabaseClusterBackupsResponse
-func (c *ClientWithResponses) ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error) {
-	rsp, err := c.ListDatabaseClusterBackups(ctx, namespace, clusterName, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStoragesList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseListDatabaseClusterBackupsResponse(rsp)
-}
 
-// ListDatabaseClusterRestoresWithResponse request returning *ListDatabaseClusterRestoresResponse
-func (c *ClientWithResponses) ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error) {
-	rsp, err := c.ListDatabaseClusterRestores(ctx, namespace, clusterName, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListDatabaseClusterRestoresResponse(rsp)
+	return response, nil
 }
 
-// DeleteDatabaseClusterWithResponse request returning *DeleteDatabaseClusterResponse
-func (c *ClientWithResponses) DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error) {
-	rsp, err := c.DeleteDatabaseCluster(ctx, namespace, name, params, reqEditors...)
+// ParseCreateBackupStorageResponse parses an HTTP response from a CreateBackupStorageWithResponse call
+func ParseCreateBackupStorageResponse(rsp *http.Response) (*CreateBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDatabaseClusterResponse(rsp)
-}
 
-// GetDatabaseClusterWithResponse request returning *GetDatabaseClusterResponse
-func (c *ClientWithResponses) GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error) {
-	rsp, err := c.GetDatabaseCluster(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetDatabaseClusterResponse(rsp)
-}
 
-// UpdateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterResponse
-func (c *ClientWithResponses) UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
-	rsp, err := c.UpdateDatabaseClusterWithBody(ctx, namespace, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateDatabaseClusterResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStorage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
-	rsp, err := c.UpdateDatabaseCluster(ctx, namespace, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseUpdateDatabaseClusterResponse(rsp)
+
+	return response, nil
 }
 
-// GetDatabaseClusterComponentsWithResponse request returning *GetDatabaseClusterComponentsResponse
-func (c *ClientWithResponses) GetDatabaseClusterComponentsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterComponentsResponse, error) {
-	rsp, err := c.GetDatabaseClusterComponents(ctx, namespace, name, reqEditors...)
+// ParseDeleteBackupStorageResponse parses an HTTP response from a DeleteBackupStorageWithResponse call
+func ParseDeleteBackupStorageResponse(rsp *http.Response) (*DeleteBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDatabaseClusterComponentsResponse(rsp)
-}
 
-// GetDatabaseClusterCredentialsWithResponse request returning *GetDatabaseClusterCredentialsResponse
-func (c *ClientWithResponses) GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error) {
-	rsp, err := c.GetDatabaseClusterCredentials(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetDatabaseClusterCredentialsResponse(rsp)
-}
 
-// GetDatabaseClusterPitrWithResponse request returning *GetDatabaseClusterPitrResponse
-func (c *ClientWithResponses) GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error) {
-	rsp, err := c.GetDatabaseClusterPitr(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetDatabaseClusterPitrResponse(rsp)
+
+	return response, nil
 }
 
-// ListDatabaseEnginesWithResponse request returning *ListDatabaseEnginesResponse
-func (c *ClientWithResponses) ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error) {
-	rsp, err := c.ListDatabaseEngines(ctx, namespace, reqEditors...)
+// ParseGetBackupStorageResponse parses an HTTP response from a GetBackupStorageWithResponse call
+func ParseGetBackupStorageResponse(rsp *http.Response) (*GetBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDatabaseEnginesResponse(rsp)
-}
 
-// GetUpgradePlanWithResponse request returning *GetUpgradePlanResponse
-func (c *ClientWithResponses) GetUpgradePlanWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetUpgradePlanResponse, error) {
-	rsp, err := c.GetUpgradePlan(ctx, namespace, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetUpgradePlanResponse(rsp)
-}
 
-// ApproveUpgradePlanWithBodyWithResponse request with arbitrary body returning *ApproveUpgradePlanResponse
-func (c *ClientWithResponses) ApproveUpgradePlanWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error) {
-	rsp, err := c.ApproveUpgradePlanWithBody(ctx, namespace, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStorage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseApproveUpgradePlanResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ApproveUpgradePlanWithResponse(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error) {
-	rsp, err := c.ApproveUpgradePlan(ctx, namespace, body, reqEditors...)
+// ParseUpdateBackupStorageResponse parses an HTTP response from a UpdateBackupStorageWithResponse call
+func ParseUpdateBackupStorageResponse(rsp *http.Response) (*UpdateBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseApproveUpgradePlanResponse(rsp)
-}
 
-// GetDatabaseEngineWithResponse request returning *GetDatabaseEngineResponse
-func (c *ClientWithResponses) GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error) {
-	rsp, err := c.GetDatabaseEngine(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetDatabaseEngineResponse(rsp)
-}
 
-// UpdateDatabaseEngineWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseEngineResponse
-func (c *ClientWithResponses) UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
-	rsp, err := c.UpdateDatabaseEngineWithBody(ctx, namespace, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStorage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateDatabaseEngineResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
-	rsp, err := c.UpdateDatabaseEngine(ctx, namespace, name, body, reqEditors...)
+// ParsePlanDatabaseClusterCapacityResponse parses an HTTP response from a PlanDatabaseClusterCapacityWithResponse call
+func ParsePlanDatabaseClusterCapacityResponse(rsp *http.Response) (*PlanDatabaseClusterCapacityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDatabaseEngineResponse(rsp)
-}
 
-// ListMonitoringInstancesWithResponse request returning *ListMonitoringInstancesResponse
-func (c *ClientWithResponses) ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error) {
-	rsp, err := c.ListMonitoringInstances(ctx, namespace, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PlanDatabaseClusterCapacityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListMonitoringInstancesResponse(rsp)
-}
 
-// CreateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *CreateMonitoringInstanceResponse
-func (c *ClientWithResponses) CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
-	rsp, err := c.CreateMonitoringInstanceWithBody(ctx, namespace, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CapacityPlan
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCreateMonitoringInstanceResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateMonitoringInstanceWithResponse(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
-	rsp, err := c.CreateMonitoringInstance(ctx, namespace, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateMonitoringInstanceResponse(rsp)
+	return response, nil
 }
 
-// DeleteMonitoringInstanceWithResponse request returning *DeleteMonitoringInstanceResponse
-func (c *ClientWithResponses) DeleteMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error) {
-	rsp, err := c.DeleteMonitoringInstance(ctx, namespace, name, reqEditors...)
+// ParseCreateDatabaseClusterBackupResponse parses an HTTP response from a CreateDatabaseClusterBackupWithResponse call
+func ParseCreateDatabaseClusterBackupResponse(rsp *http.Response) (*CreateDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteMonitoringInstanceResponse(rsp)
-}
 
-// GetMonitoringInstanceWithResponse request returning *GetMonitoringInstanceResponse
-func (c *ClientWithResponses) GetMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error) {
-	rsp, err := c.GetMonitoringInstance(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetMonitoringInstanceResponse(rsp)
-}
 
-// UpdateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *UpdateMonitoringInstanceResponse
-func (c *ClientWithResponses) UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
-	rsp, err := c.UpdateMonitoringInstanceWithBody(ctx, namespace, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateMonitoringInstanceResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-func (c *ClientWithResponses) UpdateMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
-	rsp, err := c.UpdateMonitoringInstance(ctx, namespace, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateMonitoringInstanceResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// GetUserPermissionsWithResponse request returning *GetUserPermissionsResponse
-func (c *ClientWithResponses) GetUserPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPermissionsResponse, error) {
-	rsp, err := c.GetUserPermissions(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetUserPermissionsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
 
-// ListPodSchedulingPolicyWithResponse request returning *ListPodSchedulingPolicyResponse
-func (c *ClientWithResponses) ListPodSchedulingPolicyWithResponse(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*ListPodSchedulingPolicyResponse, error) {
-	rsp, err := c.ListPodSchedulingPolicy(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListPodSchedulingPolicyResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// CreatePodSchedulingPolicyWithBodyWithResponse request with arbitrary body returning *CreatePodSchedulingPolicyResponse
-func (c *ClientWithResponses) CreatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error) {
-	rsp, err := c.CreatePodSchedulingPolicyWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreatePodSchedulingPolicyResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) CreatePodSchedulingPolicyWithResponse(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error) {
-	rsp, err := c.CreatePodSchedulingPolicy(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseCreatePodSchedulingPolicyResponse(rsp)
+
+	return response, nil
 }
 
-// DeletePodSchedulingPolicyWithResponse request returning *DeletePodSchedulingPolicyResponse
-func (c *ClientWithResponses) DeletePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*DeletePodSchedulingPolicyResponse, error) {
-	rsp, err := c.DeletePodSchedulingPolicy(ctx, policyName, reqEditors...)
+// ParseDeleteDatabaseClusterBackupResponse parses an HTTP response from a DeleteDatabaseClusterBackupWithResponse call
+func ParseDeleteDatabaseClusterBackupResponse(rsp *http.Response) (*DeleteDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeletePodSchedulingPolicyResponse(rsp)
-}
 
-// GetPodSchedulingPolicyWithResponse request returning *GetPodSchedulingPolicyResponse
-func (c *ClientWithResponses) GetPodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*GetPodSchedulingPolicyResponse, error) {
-	rsp, err := c.GetPodSchedulingPolicy(ctx, policyName, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetPodSchedulingPolicyResponse(rsp)
-}
 
-// UpdatePodSchedulingPolicyWithBodyWithResponse request with arbitrary body returning *UpdatePodSchedulingPolicyResponse
-func (c *ClientWithResponses) UpdatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error) {
-	rsp, err := c.UpdatePodSchedulingPolicyWithBody(ctx, policyName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdatePodSchedulingPolicyResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdatePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error) {
-	rsp, err := c.UpdatePodSchedulingPolicy(ctx, policyName, body, reqEditors...)
+// ParseGetDatabaseClusterBackupResponse parses an HTTP response from a GetDatabaseClusterBackupWithResponse call
+func ParseGetDatabaseClusterBackupResponse(rsp *http.Response) (*GetDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePodSchedulingPolicyResponse(rsp)
-}
 
-// GetKubernetesClusterResourcesWithResponse request returning *GetKubernetesClusterResourcesResponse
-func (c *ClientWithResponses) GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error) {
-	rsp, err := c.GetKubernetesClusterResources(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetKubernetesClusterResourcesResponse(rsp)
-}
 
-// DeleteSessionWithResponse request returning *DeleteSessionResponse
-func (c *ClientWithResponses) DeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error) {
-	rsp, err := c.DeleteSession(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseDeleteSessionResponse(rsp)
+
+	return response, nil
 }
 
-// CreateSessionWithBodyWithResponse request with arbitrary body returning *CreateSessionResponse
-func (c *ClientWithResponses) CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
-	rsp, err := c.CreateSessionWithBody(ctx, contentType, body, reqEditors...)
+// ParseCreateDatabaseClusterRestoreResponse parses an HTTP response from a CreateDatabaseClusterRestoreWithResponse call
+func ParseCreateDatabaseClusterRestoreResponse(rsp *http.Response) (*CreateDatabaseClusterRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateSessionResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
-	rsp, err := c.CreateSession(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateDatabaseClusterRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateSessionResponse(rsp)
-}
 
-// GetSettingsWithResponse request returning *GetSettingsResponse
-func (c *ClientWithResponses) GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error) {
-	rsp, err := c.GetSettings(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetSettingsResponse(rsp)
-}
 
-// VersionInfoWithResponse request returning *VersionInfoResponse
-func (c *ClientWithResponses) VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error) {
-	rsp, err := c.VersionInfo(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseVersionInfoResponse(rsp)
+	return response, nil
 }
 
-// ParseGetKubernetesClusterInfoResponse parses an HTTP response from a GetKubernetesClusterInfoWithResponse call
-func ParseGetKubernetesClusterInfoResponse(rsp *http.Response) (*GetKubernetesClusterInfoResponse, error) {
+// ParseDeleteDatabaseClusterRestoreResponse parses an HTTP response from a DeleteDatabaseClusterRestoreWithResponse call
+func ParseDeleteDatabaseClusterRestoreResponse(rsp *http.Response) (*DeleteDatabaseClusterRestoreResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetKubernetesClusterInfoResponse{
+	response := &DeleteDatabaseClusterRestoreResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KubernetesClusterInfo
+		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9871,52 +13909,74 @@ func ParseGetKubernetesClusterInfoResponse(rsp *http.Response) (*GetKubernetesCl
 	return response, nil
 }
 
-// ParseListNamespacesResponse parses an HTTP response from a ListNamespacesWithResponse call
-func ParseListNamespacesResponse(rsp *http.Response) (*ListNamespacesResponse, error) {
+// ParseGetDatabaseClusterRestoreResponse parses an HTTP response from a GetDatabaseClusterRestoreWithResponse call
+func ParseGetDatabaseClusterRestoreResponse(rsp *http.Response) (*GetDatabaseClusterRestoreResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListNamespacesResponse{
+	response := &GetDatabaseClusterRestoreResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NamespaceList
+		var dest DatabaseClusterRestore
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseListBackupStoragesResponse parses an HTTP response from a ListBackupStoragesWithResponse call
-func ParseListBackupStoragesResponse(rsp *http.Response) (*ListBackupStoragesResponse, error) {
+// ParseUpdateDatabaseClusterRestoreResponse parses an HTTP response from a UpdateDatabaseClusterRestoreWithResponse call
+func ParseUpdateDatabaseClusterRestoreResponse(rsp *http.Response) (*UpdateDatabaseClusterRestoreResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListBackupStoragesResponse{
+	response := &UpdateDatabaseClusterRestoreResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStoragesList
+		var dest DatabaseClusterRestore
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -9936,22 +13996,22 @@ func ParseListBackupStoragesResponse(rsp *http.Response) (*ListBackupStoragesRes
 	return response, nil
 }
 
-// ParseCreateBackupStorageResponse parses an HTTP response from a CreateBackupStorageWithResponse call
-func ParseCreateBackupStorageResponse(rsp *http.Response) (*CreateBackupStorageResponse, error) {
+// ParseListDatabaseClustersResponse parses an HTTP response from a ListDatabaseClustersWithResponse call
+func ParseListDatabaseClustersResponse(rsp *http.Response) (*ListDatabaseClustersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateBackupStorageResponse{
+	response := &ListDatabaseClustersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStorage
+		var dest DatabaseClusterList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9976,20 +14036,41 @@ func ParseCreateBackupStorageResponse(rsp *http.Response) (*CreateBackupStorageR
 	return response, nil
 }
 
-// ParseDeleteBackupStorageResponse parses an HTTP response from a DeleteBackupStorageWithResponse call
-func ParseDeleteBackupStorageResponse(rsp *http.Response) (*DeleteBackupStorageResponse, error) {
+// ParseCreateDatabaseClusterResponse parses an HTTP response from a CreateDatabaseClusterWithResponse call
+func ParseCreateDatabaseClusterResponse(rsp *http.Response) (*CreateDatabaseClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBackupStorageResponse{
+	response := &CreateDatabaseClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10009,22 +14090,22 @@ func ParseDeleteBackupStorageResponse(rsp *http.Response) (*DeleteBackupStorageR
 	return response, nil
 }
 
-// ParseGetBackupStorageResponse parses an HTTP response from a GetBackupStorageWithResponse call
-func ParseGetBackupStorageResponse(rsp *http.Response) (*GetBackupStorageResponse, error) {
+// ParseListDatabaseClusterBackupsResponse parses an HTTP response from a ListDatabaseClusterBackupsWithResponse call
+func ParseListDatabaseClusterBackupsResponse(rsp *http.Response) (*ListDatabaseClusterBackupsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBackupStorageResponse{
+	response := &ListDatabaseClusterBackupsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStorage
+		var dest DatabaseClusterBackupList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10049,22 +14130,22 @@ func ParseGetBackupStorageResponse(rsp *http.Response) (*GetBackupStorageRespons
 	return response, nil
 }
 
-// ParseUpdateBackupStorageResponse parses an HTTP response from a UpdateBackupStorageWithResponse call
-func ParseUpdateBackupStorageResponse(rsp *http.Response) (*UpdateBackupStorageResponse, error) {
+// ParseListDatabaseClusterRestoresResponse parses an HTTP response from a ListDatabaseClusterRestoresWithResponse call
+func ParseListDatabaseClusterRestoresResponse(rsp *http.Response) (*ListDatabaseClusterRestoresResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateBackupStorageResponse{
+	response := &ListDatabaseClusterRestoresResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStorage
+		var dest DatabaseClusterRestoreList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10089,41 +14170,27 @@ func ParseUpdateBackupStorageResponse(rsp *http.Response) (*UpdateBackupStorageR
 	return response, nil
 }
 
-// ParseCreateDatabaseClusterBackupResponse parses an HTTP response from a CreateDatabaseClusterBackupWithResponse call
-func ParseCreateDatabaseClusterBackupResponse(rsp *http.Response) (*CreateDatabaseClusterBackupResponse, error) {
+// ParseDeleteDatabaseClusterResponse parses an HTTP response from a DeleteDatabaseClusterWithResponse call
+func ParseDeleteDatabaseClusterResponse(rsp *http.Response) (*DeleteDatabaseClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseClusterBackupResponse{
+	response := &DeleteDatabaseClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterBackup
+		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseClusterBackup
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest DatabaseClusterBackup
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10143,22 +14210,22 @@ func ParseCreateDatabaseClusterBackupResponse(rsp *http.Response) (*CreateDataba
 	return response, nil
 }
 
-// ParseDeleteDatabaseClusterBackupResponse parses an HTTP response from a DeleteDatabaseClusterBackupWithResponse call
-func ParseDeleteDatabaseClusterBackupResponse(rsp *http.Response) (*DeleteDatabaseClusterBackupResponse, error) {
+// ParseGetDatabaseClusterResponse parses an HTTP response from a GetDatabaseClusterWithResponse call
+func ParseGetDatabaseClusterResponse(rsp *http.Response) (*GetDatabaseClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseClusterBackupResponse{
+	response := &GetDatabaseClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		var dest DatabaseCluster
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10183,22 +14250,22 @@ func ParseDeleteDatabaseClusterBackupResponse(rsp *http.Response) (*DeleteDataba
 	return response, nil
 }
 
-// ParseGetDatabaseClusterBackupResponse parses an HTTP response from a GetDatabaseClusterBackupWithResponse call
-func ParseGetDatabaseClusterBackupResponse(rsp *http.Response) (*GetDatabaseClusterBackupResponse, error) {
+// ParseUpdateDatabaseClusterResponse parses an HTTP response from a UpdateDatabaseClusterWithResponse call
+func ParseUpdateDatabaseClusterResponse(rsp *http.Response) (*UpdateDatabaseClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterBackupResponse{
+	response := &UpdateDatabaseClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterBackup
+		var dest DatabaseCluster
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10223,41 +14290,27 @@ func ParseGetDatabaseClusterBackupResponse(rsp *http.Response) (*GetDatabaseClus
 	return response, nil
 }
 
-// ParseCreateDatabaseClusterRestoreResponse parses an HTTP response from a CreateDatabaseClusterRestoreWithResponse call
-func ParseCreateDatabaseClusterRestoreResponse(rsp *http.Response) (*CreateDatabaseClusterRestoreResponse, error) {
+// ParseGetDatabaseClusterComponentsResponse parses an HTTP response from a GetDatabaseClusterComponentsWithResponse call
+func ParseGetDatabaseClusterComponentsResponse(rsp *http.Response) (*GetDatabaseClusterComponentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseClusterRestoreResponse{
+	response := &GetDatabaseClusterComponentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestore
+		var dest DatabaseClusterComponents
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseClusterRestore
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest DatabaseClusterRestore
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10277,22 +14330,22 @@ func ParseCreateDatabaseClusterRestoreResponse(rsp *http.Response) (*CreateDatab
 	return response, nil
 }
 
-// ParseDeleteDatabaseClusterRestoreResponse parses an HTTP response from a DeleteDatabaseClusterRestoreWithResponse call
-func ParseDeleteDatabaseClusterRestoreResponse(rsp *http.Response) (*DeleteDatabaseClusterRestoreResponse, error) {
+// ParseGetDatabaseClusterCredentialsResponse parses an HTTP response from a GetDatabaseClusterCredentialsWithResponse call
+func ParseGetDatabaseClusterCredentialsResponse(rsp *http.Response) (*GetDatabaseClusterCredentialsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseClusterRestoreResponse{
+	response := &GetDatabaseClusterCredentialsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		var dest DatabaseClusterCredential
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10317,22 +14370,22 @@ func ParseDeleteDatabaseClusterRestoreResponse(rsp *http.Response) (*DeleteDatab
 	return response, nil
 }
 
-// ParseGetDatabaseClusterRestoreResponse parses an HTTP response from a GetDatabaseClusterRestoreWithResponse call
-func ParseGetDatabaseClusterRestoreResponse(rsp *http.Response) (*GetDatabaseClusterRestoreResponse, error) {
+// ParseGetDatabaseClusterPitrResponse parses an HTTP response from a GetDatabaseClusterPitrWithResponse call
+func ParseGetDatabaseClusterPitrResponse(rsp *http.Response) (*GetDatabaseClusterPitrResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterRestoreResponse{
+	response := &GetDatabaseClusterPitrResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestore
+		var dest DatabaseClusterPitr
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10357,34 +14410,27 @@ func ParseGetDatabaseClusterRestoreResponse(rsp *http.Response) (*GetDatabaseClu
 	return response, nil
 }
 
-// ParseUpdateDatabaseClusterRestoreResponse parses an HTTP response from a UpdateDatabaseClusterRestoreWithResponse call
-func ParseUpdateDatabaseClusterRestoreResponse(rsp *http.Response) (*UpdateDatabaseClusterRestoreResponse, error) {
+// ParseCheckDatabaseClusterStorageResizeResponse parses an HTTP response from a CheckDatabaseClusterStorageResizeWithResponse call
+func ParseCheckDatabaseClusterStorageResizeResponse(rsp *http.Response) (*CheckDatabaseClusterStorageResizeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseClusterRestoreResponse{
+	response := &CheckDatabaseClusterStorageResizeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestore
+		var dest StorageResizeCheck
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseClusterRestore
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10404,22 +14450,22 @@ func ParseUpdateDatabaseClusterRestoreResponse(rsp *http.Response) (*UpdateDatab
 	return response, nil
 }
 
-// ParseListDatabaseClustersResponse parses an HTTP response from a ListDatabaseClustersWithResponse call
-func ParseListDatabaseClustersResponse(rsp *http.Response) (*ListDatabaseClustersResponse, error) {
+// ParseListDatabaseEnginesResponse parses an HTTP response from a ListDatabaseEnginesWithResponse call
+func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseClustersResponse{
+	response := &ListDatabaseEnginesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterList
+		var dest DatabaseEngineList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10444,41 +14490,27 @@ func ParseListDatabaseClustersResponse(rsp *http.Response) (*ListDatabaseCluster
 	return response, nil
 }
 
-// ParseCreateDatabaseClusterResponse parses an HTTP response from a CreateDatabaseClusterWithResponse call
-func ParseCreateDatabaseClusterResponse(rsp *http.Response) (*CreateDatabaseClusterResponse, error) {
+// ParseGetUpgradePlanResponse parses an HTTP response from a GetUpgradePlanWithResponse call
+func ParseGetUpgradePlanResponse(rsp *http.Response) (*GetUpgradePlanResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseClusterResponse{
+	response := &GetUpgradePlanResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseCluster
+		var dest UpgradePlan
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseCluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest DatabaseCluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10498,27 +14530,20 @@ func ParseCreateDatabaseClusterResponse(rsp *http.Response) (*CreateDatabaseClus
 	return response, nil
 }
 
-// ParseListDatabaseClusterBackupsResponse parses an HTTP response from a ListDatabaseClusterBackupsWithResponse call
-func ParseListDatabaseClusterBackupsResponse(rsp *http.Response) (*ListDatabaseClusterBackupsResponse, error) {
+// ParseApproveUpgradePlanResponse parses an HTTP response from a ApproveUpgradePlanWithResponse call
+func ParseApproveUpgradePlanResponse(rsp *http.Response) (*ApproveUpgradePlanResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseClusterBackupsResponse{
+	response := &ApproveUpgradePlanResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterBackupList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10538,33 +14563,40 @@ func ParseListDatabaseClusterBackupsResponse(rsp *http.Response) (*ListDatabaseC
 	return response, nil
 }
 
-// ParseListDatabaseClusterRestoresResponse parses an HTTP response from a ListDatabaseClusterRestoresWithResponse call
-func ParseListDatabaseClusterRestoresResponse(rsp *http.Response) (*ListDatabaseClusterRestoresResponse, error) {
+// ParseGetPostUpgradeTasksJobResponse parses an HTTP response from a GetPostUpgradeTasksJobWithResponse call
+func ParseGetPostUpgradeTasksJobResponse(rsp *http.Response) (*GetPostUpgradeTasksJobResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseClusterRestoresResponse{
+	response := &GetPostUpgradeTasksJobResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestoreList
+		var dest PostUpgradeTasksJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -10578,26 +14610,26 @@ func ParseListDatabaseClusterRestoresResponse(rsp *http.Response) (*ListDatabase
 	return response, nil
 }
 
-// ParseDeleteDatabaseClusterResponse parses an HTTP response from a DeleteDatabaseClusterWithResponse call
-func ParseDeleteDatabaseClusterResponse(rsp *http.Response) (*DeleteDatabaseClusterResponse, error) {
+// ParseExecutePostUpgradeTasksResponse parses an HTTP response from a ExecutePostUpgradeTasksWithResponse call
+func ParseExecutePostUpgradeTasksResponse(rsp *http.Response) (*ExecutePostUpgradeTasksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseClusterResponse{
+	response := &ExecutePostUpgradeTasksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest PostUpgradeTasksJob
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
@@ -10606,6 +14638,13 @@ func ParseDeleteDatabaseClusterResponse(rsp *http.Response) (*DeleteDatabaseClus
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10618,22 +14657,22 @@ func ParseDeleteDatabaseClusterResponse(rsp *http.Response) (*DeleteDatabaseClus
 	return response, nil
 }
 
-// ParseGetDatabaseClusterResponse parses an HTTP response from a GetDatabaseClusterWithResponse call
-func ParseGetDatabaseClusterResponse(rsp *http.Response) (*GetDatabaseClusterResponse, error) {
+// ParseGetDatabaseEngineResponse parses an HTTP response from a GetDatabaseEngineWithResponse call
+func ParseGetDatabaseEngineResponse(rsp *http.Response) (*GetDatabaseEngineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterResponse{
+	response := &GetDatabaseEngineResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseCluster
+		var dest DatabaseEngine
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10658,22 +14697,22 @@ func ParseGetDatabaseClusterResponse(rsp *http.Response) (*GetDatabaseClusterRes
 	return response, nil
 }
 
-// ParseUpdateDatabaseClusterResponse parses an HTTP response from a UpdateDatabaseClusterWithResponse call
-func ParseUpdateDatabaseClusterResponse(rsp *http.Response) (*UpdateDatabaseClusterResponse, error) {
+// ParseUpdateDatabaseEngineResponse parses an HTTP response from a UpdateDatabaseEngineWithResponse call
+func ParseUpdateDatabaseEngineResponse(rsp *http.Response) (*UpdateDatabaseEngineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseClusterResponse{
+	response := &UpdateDatabaseEngineResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseCluster
+		var dest DatabaseEngine
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10698,22 +14737,22 @@ func ParseUpdateDatabaseClusterResponse(rsp *http.Response) (*UpdateDatabaseClus
 	return response, nil
 }
 
-// ParseGetDatabaseClusterComponentsResponse parses an HTTP response from a GetDatabaseClusterComponentsWithResponse call
-func ParseGetDatabaseClusterComponentsResponse(rsp *http.Response) (*GetDatabaseClusterComponentsResponse, error) {
+// ParseListEngineConfigTemplatesResponse parses an HTTP response from a ListEngineConfigTemplatesWithResponse call
+func ParseListEngineConfigTemplatesResponse(rsp *http.Response) (*ListEngineConfigTemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterComponentsResponse{
+	response := &ListEngineConfigTemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterComponents
+		var dest EngineConfigTemplateList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10738,22 +14777,22 @@ func ParseGetDatabaseClusterComponentsResponse(rsp *http.Response) (*GetDatabase
 	return response, nil
 }
 
-// ParseGetDatabaseClusterCredentialsResponse parses an HTTP response from a GetDatabaseClusterCredentialsWithResponse call
-func ParseGetDatabaseClusterCredentialsResponse(rsp *http.Response) (*GetDatabaseClusterCredentialsResponse, error) {
+// ParseCreateEngineConfigTemplateResponse parses an HTTP response from a CreateEngineConfigTemplateWithResponse call
+func ParseCreateEngineConfigTemplateResponse(rsp *http.Response) (*CreateEngineConfigTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterCredentialsResponse{
+	response := &CreateEngineConfigTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterCredential
+		var dest EngineConfigTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10778,33 +14817,33 @@ func ParseGetDatabaseClusterCredentialsResponse(rsp *http.Response) (*GetDatabas
 	return response, nil
 }
 
-// ParseGetDatabaseClusterPitrResponse parses an HTTP response from a GetDatabaseClusterPitrWithResponse call
-func ParseGetDatabaseClusterPitrResponse(rsp *http.Response) (*GetDatabaseClusterPitrResponse, error) {
+// ParseDeleteEngineConfigTemplateResponse parses an HTTP response from a DeleteEngineConfigTemplateWithResponse call
+func ParseDeleteEngineConfigTemplateResponse(rsp *http.Response) (*DeleteEngineConfigTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterPitrResponse{
+	response := &DeleteEngineConfigTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterPitr
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -10818,22 +14857,22 @@ func ParseGetDatabaseClusterPitrResponse(rsp *http.Response) (*GetDatabaseCluste
 	return response, nil
 }
 
-// ParseListDatabaseEnginesResponse parses an HTTP response from a ListDatabaseEnginesWithResponse call
-func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesResponse, error) {
+// ParseGetEngineConfigTemplateResponse parses an HTTP response from a GetEngineConfigTemplateWithResponse call
+func ParseGetEngineConfigTemplateResponse(rsp *http.Response) (*GetEngineConfigTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseEnginesResponse{
+	response := &GetEngineConfigTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseEngineList
+		var dest EngineConfigTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10846,6 +14885,13 @@ func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesR
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10858,22 +14904,22 @@ func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesR
 	return response, nil
 }
 
-// ParseGetUpgradePlanResponse parses an HTTP response from a GetUpgradePlanWithResponse call
-func ParseGetUpgradePlanResponse(rsp *http.Response) (*GetUpgradePlanResponse, error) {
+// ParseUpdateEngineConfigTemplateResponse parses an HTTP response from a UpdateEngineConfigTemplateWithResponse call
+func ParseUpdateEngineConfigTemplateResponse(rsp *http.Response) (*UpdateEngineConfigTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUpgradePlanResponse{
+	response := &UpdateEngineConfigTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UpgradePlan
+		var dest EngineConfigTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10886,6 +14932,13 @@ func ParseGetUpgradePlanResponse(rsp *http.Response) (*GetUpgradePlanResponse, e
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10898,20 +14951,27 @@ func ParseGetUpgradePlanResponse(rsp *http.Response) (*GetUpgradePlanResponse, e
 	return response, nil
 }
 
-// ParseApproveUpgradePlanResponse parses an HTTP response from a ApproveUpgradePlanWithResponse call
-func ParseApproveUpgradePlanResponse(rsp *http.Response) (*ApproveUpgradePlanResponse, error) {
+// ParseRolloutEngineConfigTemplateResponse parses an HTTP response from a RolloutEngineConfigTemplateWithResponse call
+func ParseRolloutEngineConfigTemplateResponse(rsp *http.Response) (*RolloutEngineConfigTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApproveUpgradePlanResponse{
+	response := &RolloutEngineConfigTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EngineConfigTemplateRolloutResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10919,6 +14979,13 @@ func ParseApproveUpgradePlanResponse(rsp *http.Response) (*ApproveUpgradePlanRes
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10931,22 +14998,22 @@ func ParseApproveUpgradePlanResponse(rsp *http.Response) (*ApproveUpgradePlanRes
 	return response, nil
 }
 
-// ParseGetDatabaseEngineResponse parses an HTTP response from a GetDatabaseEngineWithResponse call
-func ParseGetDatabaseEngineResponse(rsp *http.Response) (*GetDatabaseEngineResponse, error) {
+// ParseGetEngineVersionPolicyResponse parses an HTTP response from a GetEngineVersionPolicyWithResponse call
+func ParseGetEngineVersionPolicyResponse(rsp *http.Response) (*GetEngineVersionPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseEngineResponse{
+	response := &GetEngineVersionPolicyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseEngine
+		var dest EngineVersionPolicy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10971,22 +15038,22 @@ func ParseGetDatabaseEngineResponse(rsp *http.Response) (*GetDatabaseEngineRespo
 	return response, nil
 }
 
-// ParseUpdateDatabaseEngineResponse parses an HTTP response from a UpdateDatabaseEngineWithResponse call
-func ParseUpdateDatabaseEngineResponse(rsp *http.Response) (*UpdateDatabaseEngineResponse, error) {
+// ParseUpdateEngineVersionPolicyResponse parses an HTTP response from a UpdateEngineVersionPolicyWithResponse call
+func ParseUpdateEngineVersionPolicyResponse(rsp *http.Response) (*UpdateEngineVersionPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseEngineResponse{
+	response := &UpdateEngineVersionPolicyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseEngine
+		var dest EngineVersionPolicy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11225,6 +15292,46 @@ func ParseUpdateMonitoringInstanceResponse(rsp *http.Response) (*UpdateMonitorin
 	return response, nil
 }
 
+// ParseGetNamespaceQuotaUsageResponse parses an HTTP response from a GetNamespaceQuotaUsageWithResponse call
+func ParseGetNamespaceQuotaUsageResponse(rsp *http.Response) (*GetNamespaceQuotaUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNamespaceQuotaUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamespaceQuotaUsage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetUserPermissionsResponse parses an HTTP response from a GetUserPermissionsWithResponse call
 func ParseGetUserPermissionsResponse(rsp *http.Response) (*GetUserPermissionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -11345,6 +15452,53 @@ func ParseCreatePodSchedulingPolicyResponse(rsp *http.Response) (*CreatePodSched
 	return response, nil
 }
 
+// ParsePreviewPodSchedulingPolicyResponse parses an HTTP response from a PreviewPodSchedulingPolicyWithResponse call
+func ParsePreviewPodSchedulingPolicyResponse(rsp *http.Response) (*PreviewPodSchedulingPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewPodSchedulingPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PodSchedulingPolicyPreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseDeletePodSchedulingPolicyResponse parses an HTTP response from a DeletePodSchedulingPolicyWithResponse call
 func ParseDeletePodSchedulingPolicyResponse(rsp *http.Response) (*DeletePodSchedulingPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -11607,6 +15761,87 @@ func ParseGetSettingsResponse(rsp *http.Response) (*GetSettingsResponse, error)
 			return nil, err
 		}
 		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListStorageClassesResponse parses an HTTP response from a ListStorageClassesWithResponse call
+func ParseListStorageClassesResponse(rsp *http.Response) (*ListStorageClassesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListStorageClassesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StorageClassInfoList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsageReportResponse parses an HTTP response from a GetUsageReportWithResponse call
+func ParseGetUsageReportResponse(rsp *http.Response) (*GetUsageReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsageReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsageReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
@@ -11632,6 +15867,7 @@ func ParseVersionInfoResponse(rsp *http.Response) (*VersionInfoResponse, error)
 			return nil, err
 		}
 		response.JSON200 = &dest
+
 	}
 
 	return response, nil
@@ -11639,319 +15875,437 @@ func ParseVersionInfoResponse(rsp *http.Response) (*VersionInfoResponse, error)
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+y9fXfbOJI3+lVwNHtOx72S7KR75u74+WOuY2d683RefG339L0b+U4gEpKxJgEOATpW",
-	"9+a7PwcFgK+gRFl2Yqdrz9npWCTxUgAK9atfFfD7KJJpJgUTWo0Ofx+p6IqlFP75kkbXRXauZU6XzPxA",
-	"45hrLgVNTnOZsVxzpkaHC5ooNh7FTEU5z8zz0aH7lij7MeFiIfOUwsPxKKt9/fuIJon8xOJ3NGUqo5H9",
-	"MWZZziKqWTw61HnRKf8NV5rIBRHlV8SVQ7QkhWJEX3FF5o1mjMYjrlkKFehVxkaHI6VzLpajz2P/A81z",
-	"ujJ/z4vommnTquDrjeYEni9kHrFTqq/O9SphtksLWiS6FJj7ZC5lwqgw34i+yspedp+OR7eTpZyYHyfq",
-	"mmcTmdkhmmSSC81yK7/P41HOlsHGDi/Bfvf7iIkiHR1+GKkfRuMR/a3I2ehy3G11kSfB3tywnC9WF2/O",
-	"G1Kxo9wWCrT7XwXPzUT4YCXUGBv3SVW/nP83i7SppzF/lZkxpsJyBvxbzhajw9Gf9qsFsO9m/35z6gdm",
-	"x3HOqGaN105pTm3Jd18nmSmDaZar7jKJIqbUz2wVlOmTWETN2i+uGIkSWcRl7+3b+5EUmnLBciJqI/yl",
-	"Fl+zkUdGDDmJ2YILZlpqqoB2GcHpK1ZTcfDnybtz+9gqPHKldaYO9/eviznLBdNMTbncj2WkTD8jlmm1",
-	"L29YfsPZp/1PMr/mYjn5xPXVxE5ktQ+js/+nWKhJQucsmcAPo/GI3dI0S0Den9QkZjchUe2+6hWLcqb7",
-	"Jt7j1AnVYqm3f42uOKGazqlix0mhoPPtidB6gXAFw30OCsMMNvwZu7ci+5YiR6evp92lnPF/sFy5cWlN",
-	"uNPX7pmbdLaeG/ubmYK2Rph9XJHcrHHFhIbN1fxMBbH9ms7EOcvNl0RdySKJSSTFDcs1yVkkl4L/Vhan",
-	"zII39SRUM6UJzABBE3JDk4KNCRXxTKR0RXJmSiaFqBUB76jpTLyVud3qD8tpv+R6ev0fMOcjmaaF4HoF",
-	"Czzn80LLXO3H7IYl+4ovJzSPrrhmkS5ytk8zPoHmCtMvNU3jP+VMySKPYO53JtA1F3FXmj9zEZuhon7l",
-	"QlsroZmfTLfPXp1fEF++FayVYfWqqonTSIKLBcvtq4tcplAMEzGsHvgjSjgTmqhinnJtBupfBVPaSHo6",
-	"E8dUCKnJnJEii42Gns7Ea0GOacqSY6rYw0vTSFBNjNiC8kyZpmY211ZrtVpUxqKNS+Q8Y1FjDsdMmTVL",
-	"lKYa1Gfrg2nYNPxFKLpgx1Is+LLIqQ4vm543yYKzJDZKHPY0JlSRmwGmdoxAuUdUkAj2c7NAqm8VKcSC",
-	"a1jcWS7jIoISCxidmTgpd9dD0lv9J54kxI20KrJM5prFfq9YFGZwSM4SRhVT01FoX7K7b7fHznJwesjv",
-	"0RmL+IJHYUubCTpPWGCZvLIP7EpZJHRpZWV+dCWren+n5BRaDCZCPJ+aWqf2vanRJ3GRMPXhcurqM4XB",
-	"JJUJYTS6Iv4dopgxeDRLVkbDtIvKuM5DZZy+vjgLy8p80e2ded/LqTHAfgu3a9YMCk8ZKMcblq864pvX",
-	"rb2wTfOy/Yqvt24xNF4in64YzEhGfDtdl2fiovNyWiiYSna6lhNJmdJLK45QW2dgeXWW+Z2mhGloUP5F",
-	"lkgavzabxw1NzkNK4pf2K0QU6ZzlRjiKRVLEisyZ/sSY7dqci0QuFbFF11SV2aKWLO+YA75HoV2+nJzd",
-	"dp37R7bHiTON/boqP6xZv8Gh91O7tS79z435N/1CU+z4zGq8mjKeCW+3JtJqi+njnW9QpZPgaLjt3iec",
-	"blF1c1nbPfJYZjw0T86aL5Tll5PYjXhkH2tJcmbQzAhQSUq1nbo/vAjM5GqC9s/PUpHlUqzpSWtRdOdV",
-	"NRRjb0GXpYWWThPZfQ68YayFczCgwqaBfVZOQgrGMnEml9lj51JqpXOaGauMEsE+EWdH962Tntpe1p62",
-	"F6Kz8MywmBXAwHj7QusQrBDoqd1Tv8ySy6i+CmyKVF/5Fps3PABwclrwhO3HPGeRlvlqeqcJBhWH5lI8",
-	"d+21PQ/L9+Rl56WQhE9e+knim94d265INtoJYBJMuJg0TIKm+u7MGmPIB+d+2fJfLo7NtHcTEAo1eICY",
-	"aWAwa6btDEmpPiSz0YuDg79MDp5PDl5cPP/z4cGPhwd//q/ZKDjKHoeX2Nm2pu3yuVhlZWPMJ0aMvndT",
-	"UAoWxruPLRwMIPmuAgipBCaWXLCQsje/+3Z40Ezs6xuMWDsE3TKt3e3LdEW1x6sjtijvReLHZx6I8yZ+",
-	"cVjcz0Czu1pv2Sdu1pHZXAsRszxZGUVm2k61zA3AW5BCuN6xeEzYDTMzdeJfsWjB+t3civd1ufVeK2wm",
-	"3r2/eHVIfjH40eJYroiT1YpkEmC80jRJrLFrQGvCKJjSFJYIzbXvRLRGgeQsS3hEg5uhfdLdBZ38y08D",
-	"u1/KBU/NbHse2gkrsB+o1T0i1FnOJXJPOGBto2MBaTSbYYfAoDHF9LjzlSnNPORpJhVsjK2ZlxUASsXq",
-	"/WJ0+OH3bqs7jq3L9vo7Pv3FC8v8s2yC06Up0DCgOjXLzQf//7PZ7N//Z7L3t2fPPhxM/nr5789msyn8",
-	"6/u9v+39T/nXv+/tPXv24ee3P12cvrrke//zQRTptf3rf559YK8uh5ezt/e3fwP/YOWznBhtKPOJ65d3",
-	"DaYslflqZ6G8hWK8XGyhT1s0IWWoKiKtZdp5D3JDdXn7fP2WEyVUBZbIsfnZF1iWBD86XeU9lplRMMrY",
-	"tORGJkUKr/Hgrqn4b2znsT7nv5U9NQWWGLy3HU9lwOvmEIiq34z+fc2u7IYfXqz24+w2MqKQSi9zpv6V",
-	"mD9UGs/DTnbF8nPwequwbfVL84UgSILHxHEx3k8K/jL7KOg1vOnbTFtbqeukf32TdVlRT70O/FQKrqUd",
-	"kXblb8tnpY6pflm/vqoXrX0RlufbwFttoVLSLoscnzkE0P7+/kHAoO3UQ7Pmxuh8oV5hVL2YhrQRT8Pq",
-	"iKcKnCqVUJS1PV3l45Jj4wIswKl/ZD8ezwT4MMwmDThqvrIWT8kWgk10YX7iilBBaJJdUef/pSL2+4jz",
-	"r7kZPRMnK0FTHnkpHCXeIUIWjIJ/dkk1qwq3BZpa0rTQBkJPyWsNTmQpkhU4epl1GpdNA9duj9/orN5N",
-	"krMFy5kwoyGFWSfabIyCnMr43Ail8bbqjsAaTwjMqZTq6KoxLxvVZDKeBoRP5MKIn5lmlA7LuizMiIAY",
-	"UnoNDiaqq1lEbyhPjKBmggvFY0ZobdTCsxW4kpCw4EFjbUVXUjEBAqeeZfELphRnbLcTawGyNNMra36v",
-	"9JWZCSWDA2+Z4lMa11o+JlJfsfwTV2wmYJid2VkkukbFQd2bwTIM0kYnS2vXMYtnktJscs1Wql5K9y1X",
-	"TEozU6i1bvvjErbe0J+IcdqOdQAb3/44d4xUSm8NBCE0lYWAgYxkmhW6QhRlRESYkFvH6jc2lv2UCrpk",
-	"k7LcSaUc9keBqeDpwj/6uJ152rQ1chY8rh05v+Tsoi8L4orIlGvnaanrojHh4CSnRQI8LXGThi+sRuOK",
-	"sFuDJLlOVqQC8jNRagcA18JAyAQQCwz+xG9twD5Pq6ZElgVmtxFjsavty060YX6cjBoFH3Iiwlbc8Nkr",
-	"LbO6SyFM1MnYObS5WJ7KhEersGV1Gn4xZLEGXu0wHzkwPGbYa37DTMZ2mbt9n0a5VGqjWyTL5e0q0GLz",
-	"c+lGhD8aDq0pqfsgjJ2SmS0851SzmQh8YL1Cc2ZeTLibtabwJb9hwpnSU3I0E5FMU0tQk4g6jKeYrrxD",
-	"5X5dY1PBCGK3Lt7DBs54Z3DpmYv6GPph3jjbq43OOHabSRVyF8LvzcLsuxusd+5IgDMqliHT9/Vp/bmv",
-	"wHN/r089XZDb58+OX5+cmbGD2vZmwix2sz14sRkzojm+GowlroiQdWu63xxsNKkWfWJaQ+M4Z0qZlgrS",
-	"aAsB56G+koUG5kSnVF2v8RNXEXpdv7GP/VnrO3biN1+PwfadsypoSObET6gahK2VWz4d4li+mwPSzpKv",
-	"7X9stALdj+h+/Hrux82eJztZW46nVIqlNB2/onbDcxuf80Et57IQEcsHrmR1RfM46KM5d09K36D/uxkx",
-	"QU7P3568nBgI1rMX2Ri9vh3JPq3r1f7KiLIvuy20G5I9XC/VzdSqGVurpRaOLOu/DHJvGyItvE3EF00Z",
-	"VBFIQdMN3lM9A6gaAX+1yB770W7dbYxvPX7BlX4ZsmWboUFARwYjgzTVhdoc0wivNTop5zBNtgprjDS/",
-	"Yed9fMBR/XHbiW8NblEar8/ADQyup70gwSmFBY8quCTcM4+BWl2qPi7p9m7fegyZsvCq7JhpyhO7PUrB",
-	"CDV2bkVBFnkOAbNejmCyHp2+Jn7D7UoyoUpf5FQoqOmChyBE953S0KNK25g/FxroGqzLt421ncsUWmun",
-	"iAV4gPemziPogqvnEMtn/U41/rcqNroyNl08JcZC9IDS7PjXQn4SYCsa49372qFhZYlGDtZ8d8UA6oKQ",
-	"AfBB1tdWTDWDwIVwYK9SwWnnHlg0d1WkVJCc0RgisMpnIgZUIpblYNK5MTqhwaXYvGRSCv5QKqzLzQVh",
-	"m7am9PYNE0t9NTr84cX/9Zf/CDTUz8KfmGB9Yb/dd9qqfeoDmafL6p0y/rcanE9Ugd/WTO6YFBl04u8y",
-	"txy6iNjYKMpgaVz5uZusyPMXYzJ3ApnaKTOtltGH28tpoM1ckb+OWw3iihjBygUEjMwEBBfkzC4Zh88C",
-	"S4aVDZ621O1ffqyr24Ow0UtVSMz292ohU2MrLHOaplTziPCYCc0XnOX1CWINY/jQI9ayd98pt/jqU+YU",
-	"YqxZDsrGQ+D6slxlzM4pq38NCGGRLjMQwMufMirMZu3q9KB3PBPm6acrZlauTalwH+XQLsVjlrOYULIs",
-	"aE6FZiyG7A3L0Ni8gWql0ypU38/qBj9gWunCvmHqt+b884MXP8JglD80LMsPR5P/opPfLp+5fxxM/vrP",
-	"8eHl97U/L60p2CVpezYyp728rvVCHYNqkwtykRdsTP4O2VHkFwEqqR4QZJ6PxiN4YTQeuTeC9GPY0vTR",
-	"RrUZXst3ILDSyELKqUtrmkYy3a/yIVo64/lfmqb4ByuWy2cfJu5f3/uf9v4GJvS6F/a+3wfzuxTv5YdJ",
-	"JeqpMcRrz/b+baOHP7AvVZq3XGflaK3hNdt4fZuApXIf70YsgRnh45VIKFwpnHcHOj9gJrnNIMvlDY+Z",
-	"IosiSUhzzhWZ0jmjaWm6UFAkCeWCaHargzVeSaXDnNZ/uie+s/7NWkC9r8j5J3IDyetW7YBN8W21KbJb",
-	"ndN6JnNt6+v4Orfbxt4HtwTLtipI1zJavrbllCNbarmAYdZR/l2Fn8lch5yuua4CIXM9RKQDgpuNNbEK",
-	"YSUar7oOHHgbfLNDS49kmjIRs7hcCKHKum/5umsl9Mb4WR+Od+2Z3wVjMViFVS6X3Z65KkuZs4XMzeNl",
-	"TmO/N3YCA2uFGhsssRJwlkqgcdN1QTr9UTdaaprUPWWDRdy3tzhUVCKVxk7TtzKGMQ+taf2yJxkq+Nqw",
-	"HE0Xi/11MzXJPSZqkg15muQbT9Mk95WlSbpJmqSRo0meeoqmyzzYNlHTfjb9WlkTQcvEpxRsSCaoVylz",
-	"vuRm7bRpLmjM3XIemu3YwdPkZbC9v6lvdCJpzGodcgke+0flHtHwPfy3nAM+LksY7m1wAWyBKn1kW1Wh",
-	"0jTNOtailfJ3ysbCuW1vWOUxU5qLHpvrpHroGwFGazcZJjjhljQLDOJPNFMVHPa+1ZwByjSfkJhpi1ld",
-	"hBIknSRyqYLOVqvlzyCdhc4TFvZwvQm8Vfm4YJ9wXi6qveVWripogEuYGSxZmHthQ6Cs2U/L8ogTqgcs",
-	"KpDr5d1tA3/My4DFBcef2FhBt4tYAdVdoZ4LtpwnV9b11dYXNc2E9sOD2g+ls3nQMT5h6zGAqtEs+SJm",
-	"yYBVfOxH8diHLZlywkGugbhRhzC7mtTlO9WPLWoim9xtU2s8agMIzr7eBPaKar6SnCWwGYLYapO8w2+6",
-	"QK67LoCAcAOLYbB4G+Hl9y3dyo+4Sez1o4xs23uHIdTd9rs5g/2bJt0hq2h3UtbdGSPB4JyQX+xJR9Uh",
-	"TT5543B/v1AsP7RpFP/384ODae3/D//8Yx1919N4lfok87hZaC6lHvWkgPhx3PT2gHk8aFe9t/0UN9JH",
-	"vpHiFvqYt9DTYHZ7T0Z7a+tpHRhE84QzpU+ctV9pkhcHL36YPH8x+eH5xYsfDv/818M///W/BqOHMHZy",
-	"1GEbNWVc5wCQWviJLrQff5f4byCqptdMrIFSzRMHOi2zL91rdwcM2JlDX5sUrHtvmF/TQTp0bKJj84/n",
-	"2HQrZWvPpvtuGjraY7fDbexyXH/s01M/zgZPn8HTZx7R6TNbcQJ1LVGnAWoDunke1rTEPVIBXpndgQvo",
-	"1WcNMmDrwMGh/uBayxu5LGVzW1rxPihiV+cgxFp7934cwd7oQoPrcQNYb3Ejjn2MOPZVz7FhzecbYJAN",
-	"9kf4g/DnDwR/7MoA2GPFbv5ls9xbp+xN++5hcXO/qVq3SCPtnvMHVp/SVMTVKTLVWditdqkpOePLK02E",
-	"/ES4/k7ZU1Wy2wjWAGS7TMl/yk/sxiXsO0I7U2OSLeElKlb2vA5S5Y2sN9x6w283mWhO4NuYZq/65O8P",
-	"G6mPQPAUJWNA5UVjdVRHlXhFpVzqQONoxWpn7AOh686b6AaNQFmVoVQPjnW2Um8LpqVAyKvWIz+krW/H",
-	"1Q82VdHMJSkTRXhqL0/RVwFLN+eaRzQJ04Lw5X9SdRWc5fD01CHY3YjBNWdjori/gLjL0xp6D1PBUXj4",
-	"Uej+YLqCw/K4hiX0io9W/wVi2AN7/fvmC0303IwJL8+7tQHxbFqd26aYthu+y0r+6M7InWYsj6SgkBXk",
-	"PivPzZ1o+ZGATVeG87l9sTsE7kjc04SKMwMXO+dvNJ5bK6o8Rcwb6bWXvKHqT+rzBk6nj9sc1ebk5OrV",
-	"2x8JNOhGKfjPTFy8P3l/SI7i2NlMhWKLIrF5bGpKKqg0JsZkHZOCx38b4KxpnVOQ0swfTEa1THm0yaeU",
-	"XdHQYTBufp2ap+1kT/ikd5b1BDIaK/RID/eDaZovme6Fjxf1xx6j+kQQLcmnK+5O4CsbWKUVuqbG02E8",
-	"oi+h1piuGJmIuVi2lmfTvN9iJYfznzbPdlx3j2ndPaI53EaSfYirQlphV7Lb07kglFz/h1pzdNd2bmVb",
-	"73p3cvXObm5kD4HRX/U4vcfOMYle48fkNX6V5zLAp8LPcGCCFJCy3jrYudfyCNXxc6lPHYHwWizk2vhQ",
-	"zwgZKQbOSYaHF+EA1/KoeDjFHa503eY21+Zx7/ak5fLo5MpN5PJPvJqcifqNoh9Gy+zFaDxaZj+MLre5",
-	"8LXecjZ8gZ3XPgveNtQ4B6gmvZCsLocM4Fn/+W6BUazrkh6vXSBeOyve8iThdcnZtNt6yPLocFTYBO3P",
-	"41HM1fW5y+Ad9oU9ruzlSrPB1QwJoC7Fc1T27/N4FNGMRlyvvtG+HvvudWacfzCujXdomlUHub92p7A4",
-	"z7o7nW7dGuh++5Iq9ivXVxBAEDi3rnasvfuiddF6x8VtL+B1If6XwQa/DKKuzXV9uUvd025btrqUun1l",
-	"cZam3RiT4fcjuyuNm4e53LWw1j3ILee/feT9JNV9phdvzvfPz98Q+NqfMzsK3pw8YMo2pt2O0xcOYByC",
-	"v57GRdtZmk5qc+5+xvwebvfvDuwdtMWAqWGzdms33d+LZhtv+/np27cDe+ju9d1dLZoqO7ue0RydH2nG",
-	"3WXptQvaM34NF5Dfz4wJ5wKVv+6gy5TNt6u1PE65uHOJQ7bf07dvu+I+z1g0VF/BDWj3NCkfdDJatNWY",
-	"jMEOKe9tGGQ7B2yOwKZX7sSdsjful+9fnxwf95zz/cq654l5x5/+lG+8s8pA09cBvAylwDHn7uhz9+pJ",
-	"EMIrVbD8l7M3PeWUrbFruwuyIpmFzH/42D0cblZ0MIrrY72dZZ0h0zFwfP2g4/B7wqBOZUyqV4l796sG",
-	"Q1X359+Dd2kmNriXHvwC/a8dD1WJc1eH0Ex0PUIz0XAJPbg07z8mKrBWNueDBD4KLJjFgpu+9inFo8Zz",
-	"O+DNk6X9KvUllWdMk5g5woZI0b4PrtuS2oVwgf7Ds/P/5015CrWvLdyY2gdVXkPAGT3sVtcNlZ289FR7",
-	"JuPQRVYyZl6OwcNb3GUw5r2aGCuNV131YTMq4oD0gOjJWXxSmHlWDfzrpZDlz69uWVSEz5C5qN0m7m5v",
-	"tWXCRSj+xm64X0RLaKpzxSmquVqs7KVaZevZrVncLsLL3y5TXnRmzzGFw2W5hjUfXUmp2ExQKwUo+YZL",
-	"UJr2XM+cpGbZloRDWb5N+qg+42om4KzBUiblKUmiutiWLMGcVkaNpKbUT4wvr7QaEz41OqK896AqOGVM",
-	"A4xv3b4OQ1Q7Wp888/puJpxuGpc3+LTHJyiyMWE6mu6N4R4UuAqIQjPnK8I1HJwI2jWXxdJ2hiWuar95",
-	"VXfF0dgswZmYjWwPZyO/I5kSXWwCdBIuVPN5IzK3/mZYv/DkVdW+/2WvWjFfPVN7lUyv+PLKi9RfKNEc",
-	"ijWnbB/5o5WrcasJWLM8LVsIY2Chrq2cp/YuIzeK5GAmnplxtGGXZlJNZLY3JUdEFOXMXleDkGUFriBT",
-	"q5JVWT1LkIko6BIACSuWQB4Y1DUmVCkZceB8SxE2BW+7062rPSChGj0/16y5MVHnK3gKRxjPWbLubr+j",
-	"/nKcGVD2rcEUWhNmTCi5Zquxi2ktuVZ3E6LN3LYz75qt4C1n+3S6fs1WYe0FXYDPyzOxyzbZ+4bAQgge",
-	"bOqaE7z9oAxLNWV/5044MUK/4pm9gBoifRaVtfYPmvC47KM9l/m1GJN3Upv/vLrlyiiaE8nUO6nhzyn5",
-	"SVvpvAmfHmsLD64aMNstXVJZYmpqj2av8dpckddwvY5th9XY5dHRpgx/V6eQYmLPeg4VYttvCqr3YF15",
-	"/WX9pE05b9xxofbjmah9fUVvQPtxsUxKPTd2tL2/TgqM6iyHY98osNbuyBYfjmULtEZ9QiMWkxj0sDVf",
-	"qWZLHpGU5TbcLbqaDodLa24t9EEKLUBl3SflnLvT7Ynd8CPT7L9DwMXOysDFbaAyQGWAyuDpKYM7hVFZ",
-	"S6M7pX61BlXbVAF14zF+02YxquHcrbULsHP8DbZwD9zzyfODg+mQU5pbkqrZV2Vz70d39tnmQ7GTm8ql",
-	"Jd9Qqz3op7wjLWWaUD0TdUuUp2zssZ6d186l4YFYTKRwVrwRtz13e/s2RIzaez7nzLRjJgyek6nL2vfL",
-	"wjSC+d6TZ2y6nJK48PeDWi/Lnm2vWinNUuvQMojNXR2h8xVQfDdM6IImyYqwGx7psovg5uHaQuAwgK7P",
-	"KBW+TRkuSCV9e50xuR1WhH/CALw/Ww9JLFwwUBCQSbfEAGCwdTTk7+6ctqDo6N0JOKXMWxcyk4lcruq9",
-	"s8cJlNeuwnZazN22YiT2riUOhAdoEaBFgBYBwgNUBqgMUBk8BDzYsRtdC+5y+1YEc2FlPIRaMUZmP7Ni",
-	"TdpITuDedMdSmk8ccFE0tXb2mPwmBbPeeTN5wFa2KS+ZjJ+pvT1kZpCZuX9m5ooqO8BWlfUTNbXlYJbZ",
-	"g/A0ZkzdkEBQRCV1266YWJ8Bi0+brXGGgw3ej2MWk4zlEzuKkiy4iAMNIa7xAb64Ufh6SNhY/7uSL2A8",
-	"eG0WtKbAuvhXwfIVgZPpym2/DLRwThGuSASJ1zJWFsQDYWVQ59g+bsvQjz20WUjzXN0FALbfsIaZtwNt",
-	"D4KGYADeVqh2nU3YX+YORiG8bBbzjkah+ai8ZuQBbMOyvfmDGYnQ6YaduI1t6Gwxm/P3ZKzEwQbbTDx9",
-	"+PYGnDDrTpIIXRvUXvO2FLvkUgqXFP1uVhaI+TPJKM+VUZnOiq4/c+ZQrZiZMEVwRYwAbmhiFrN1C7p9",
-	"zxTfVjXGIpfKLlS7G3JFZkZws9HY7lj1yTEbvRbmAXX7Q2M+lGoCTlqY2Wk8G21SUpty8QYl/Jdi+Jmt",
-	"AivqbeO513Ha3VNYqRkw26yGcfu73ep5kszEnNlzwA1IkeXlvjYrAvoIBZi+wRG2WpJEyusi81LyAXQz",
-	"wY3F4t251p9nhO0GYmLD9+zvUB6sF7c3fmxseR+N6fsRNKYgz+DDvY8zUfXCGnGygMlVpgbXDJiyg2RN",
-	"/6ylZxP1q6Z/Zy3zZ1Rovlfu6VMCMgaFHUvxnbbV+hnrC5iJqvMVILR2uBWnO/XVRTOaiQ2KxnprAQe4",
-	"nWIh8zmPYwZJ5GVlc+m5kWrgzbxtyG86E0eJkuP2i1EZuWimCVxZ1viOcGV6ppi+XwU2HqVcbZzN7Ve+",
-	"yQktpMY5HZzTneFfM6077369mV0mJG1lr1ubr53AV5qDQPzUTEErSfjVLAp4EHssV4jasU210uy8akNv",
-	"e7e7g8QK7PHqgr3a1/DydCaAn6rMUxG3GavqE7DtU0aF2VK9i+M7Vb0yG5kh9FF4ZaHPfv+814i8q8pE",
-	"4IHAA4EHAg8EHl8SeIhWJnpd0vUNxjl3bY4O1TyqaD7/Vv1MjXvb2eqbVs++Vt/8Olu039Z6N7Fym+t8",
-	"uml/u2frQrvwjZ/DPKNjEarzpEqKwRh7zszbM/001lHjodB8Ur1ROijByPSxV1ZLtQwpx1iUjv1Kdmb2",
-	"s7zRCK7KLHWqSF4I4bJ1rLN/Jux6sYajG2i7S0GLYKuqRFDzS1Nt8+VcyIwUzkiG44wXdqmVcwA6xcv6",
-	"pzPxCoa9XjRXICN3hsKAU5BrIxPShH3hbp+2Dndr+aHHBpjcS7hby7+NMW+PJeathnbrwW8zYaPfyE7B",
-	"bzPxq7ur3F0alxaJ5lnFZ6txefqa8iEbqjUnTXU0ugJo2GAPaO4IcAVLz1JqYNTbmDhv5VjqkK81rE/K",
-	"C6IqJ4Aiz4zCSVYOiDfWTUNTOdOZ35QnIi75DROVvnqm9vb8xtRWpDOxiyYdG722nSYkTUVY07yVJpwV",
-	"Bwc/RDXFAz+wzVpxJuyU8txlTZqVVkQWCsEggkEEgwgGEQwiC4UsFLJQyEIhC4UsFLJQCDwQeCDwQOCB",
-	"wANZKGShkIV6QizUzqlbLgNKaD44C6o+pn2pUPRG8phkhXbpLN9gOlRDDJgTNTgnqk9umBiFiVFISSEy",
-	"RGSIyBCRIVJSSEmh+x4pKaSkkJJCSgopKQQeCDwQeCDwQOCBlBRSUkhJYWLUN58Y1SBKvmZ21PYNwRQp",
-	"TJHCFCnkoxAWIixEWIiwEPko5KOQj0I+Cvko5KOQj0I+CoEHAg8EHgg8EHggH4V8FPJRjztFKpg0lcvb",
-	"wEw4NT/7Xb7kTCIpFnxZWGBAPC44eUns61nQsWvEOSQnC8Ten4/la8tkjFdL4dVS959B1Z8y1d6UHyRn",
-	"qkQx1bjVac86kQJjYK/YtZXzNEt4xLUbRXIwE8+ArANqxkyqicz2jKUCe9DmGqo7fIkryNSqZFVWzxKE",
-	"S6k3XoO5a3oV3uqLF3niRZ54kSfe6ovKAJUBKoPdb/XtC/b7detgv/YFv2NyT8F+lX2FB6A/lgPQRSOo",
-	"j9iYvpnYKagvCKCbV0avPcggvNdByJ7FijZ6zwzA+7MNPETLqdUpMQAYAu5EFwOX1vyK1kt34Vwe9d4R",
-	"Mz8B0XhnJFHF3G0rRmLvWuJAeIAWAVoEaBEgPEBlgMoAlcFDwIMdu9G14C63b0XfkXdDj7vbcNJdybF9",
-	"m6fcITPzdJkZPNsOz7bDXCIM6cOQPgzpw5A+zCXCXCLMJcJcIswlwlwizCXCXCIEHgg8EHgg8MBcIswl",
-	"wlwizCXCs+0w5g1PtMMT7fBEO2ShEAwiGEQwiGAQWShkoZCFQhYKWShkoZCFQhYKgQcCDwQeCDwQeCAL",
-	"hSwUslBP9UQ7mwElNB+cBdW4A6gnFYreSB6TrNAuneUbTIdqiAFzogbnRPXJDROjMDEKKSlEhogMERki",
-	"MkRKCikpdN8jJYWUFFJSSEkhJYXAA4EHAg8EHgg8kJJCSgopKUyM+uYToxpEydfMjtq+IZgihSlSmCKF",
-	"fBTCQoSFCAsRFiIfhXwU8lHIRyEfhXwU8lHIRyHwQOCBwAOBBwIP5KOQj0I+6nGnSA35ZTzKVBrPu3Pj",
-	"9PztyUu/75csSiTFgi8LCxWIRwr23ZOXJEoKpVkesCzsh+csv2EBE+C49nRgnScvif2KuM+yoJvZDO6Q",
-	"DDGYBP3ZYb7WTMZ40RVedHX/+Vz9CVxtE+FBMrhKTFWNW52ErdM6MAb2wl9bOU+zhEdcu1EkBzPxDKhD",
-	"IIrMpJrIbM/YTbAjbq6hulGYuIJMrUpWZfUsQbgie+OlnLsme+Edw3itKF4riteK4h3DqAxQGaAy2P2O",
-	"4b7Qw1+3Dj1sXzc8JvcUeljZV3gc+2M5jl00QgyJjTCciZ1CDIMAunmB9dpjFcJ7HQQQWqxoYwnNALw/",
-	"28CKtFxsnRIDgCHg3HQReWnNy2l9hhfOAVPvHTHzExCNd40SVczdtmIk9q4lDoQHaBGgRYAWAcIDVAao",
-	"DFAZPAQ82LEbXQvucvtW9B3AN/TwvQ3n7pWM37d55h4yM0+XmcGT9vCkPcxswgBDDDDEAEMMMMTMJsxs",
-	"wswmzGzCzCbMbMLMJsxsQuCBwAOBBwIPzGzCzCbMbMLMJjxpD2Pe8Hw9PF8Pz9dDFgrBIIJBBIMIBpGF",
-	"QhYKWShkoZCFQhYKWShkoRB4IPBA4IHAA4EHslDIQiEL9VTP17MZUELzwVlQjRuJelKh6I3kMckK7dJZ",
-	"vsF0qIYYMCdqcE5Un9wwMQoTo5CSQmSIyBCRISJDpKSQkkL3PVJSSEkhJYWUFFJSCDwQeCDwQOCBwAMp",
-	"KaSkkJLCxKhvPjGqQZR8zeyo7RuCKVKYIoUpUshHISxEWIiwEGEh8lHIRyEfhXwU8lHIRyEfhXwUAg8E",
-	"Hgg8EHgg8EA+Cvko5KMed4pUKGmKiSUXgXv6X8Hvfp8vWZNIigVfFhYaEI8MTl4S934W9O0aiQ5JywLJ",
-	"96dk+eoyGePtUni71P0nUfVnTbX35QdJmyqBTDVudeazzqXAGNhbdm3lPM0SHnHtRpEczMQz4OuAnTGT",
-	"aiKzPWOswDa0uYbqGl/iCjK1KlmV1bME4V7qjTdh7pphhRf74l2eeJcn3uWJF/uiMkBlgMpg94t9++L9",
-	"ft063q99x++Y3FO8X2Vf4Rnoj+UMdNGI6yM2rG8mdorrCwLo5q3Ra88yCO91ELVnsaIN4DMD8P5sAxXR",
-	"8mt1SgwAhoBH0YXBpTXXonXUXTivR713xMxPQDTeH0lUMXfbipHYu5Y4EB6gRYAWAVoECA9QGaAyQGXw",
-	"EPBgx250LbjL7VvRd+rd0BPvNhx2V9Js3+ZBd8jMPF1mBo+3w+PtMJ0Io/owqg+j+jCqD9OJMJ0I04kw",
-	"nQjTiTCdCNOJMJ0IgQcCDwQeCDwwnQjTiTCdCNOJ8Hg7jHnDQ+3wUDs81A5ZKASDCAYRDCIYRBYKWShk",
-	"oZCFQhYKWShkoZCFQuCBwAOBBwIPBB7IQiELhSzUUz3UzmZACc0HZ0E1rgHqSYWiN5LHJCu0S2f5BtOh",
-	"GmLAnKjBOVF9csPEKEyMQkoKkSEiQ0SGiAyRkkJKCt33SEkhJYWUFFJSSEkh8EDggcADgQcCD6SkkJJC",
-	"SgoTo775xKgGUfI1s6O2bwimSGGKFKZIIR+FsBBhIcJChIXIRyEfhXwU8lHIRyEfhXwU8lEIPBB4IPBA",
-	"4IHAA/ko5KOQj3rcKVLBpKlc3gZmwqn52e/yJWcSSbHgy8ICA+JxwclLYl/Pgo5dI84hOVkg9v58LF9b",
-	"JmO8Wgqvlrr/DKr+lKn2pvwgOVMliqnGrU571okUGAN7xa6tnKdZwiOu3SiSg5l4BmQdUDNmUk1ktmcs",
-	"FdiDNtdQ3eFLXEGmViWrsnqWIFxKvfEazF3Tq/BWX7zIEy/yxIs88VZfVAaoDFAZ7H6rb1+w369bB/u1",
-	"L/gdk3sK9qvsKzwA/bEcgC4aQX3ExvTNxE5BfUEA3bwyeu1BBuG9DkL2LFa00XtmAN6fbeAhWk6tTokB",
-	"wBBwJ7oYuLTmV7Reugvn8qj3jpj5CYjGOyOJKuZuWzESe9cSB8IDtAjQIkCLAOEBKgNUBqgMHgIe7NiN",
-	"rgV3uX0r+o68G3rc3YaT7kqO7ds85Q6ZmafLzODZdni2HeYSYUgfhvRhSB+G9GEuEeYSYS4R5hJhLhHm",
-	"EmEuEeYSIfBA4IHAA4EH5hJhLhHmEmEuEZ5thzFveKIdnmiHJ9ohC4VgEMEggkEEg8hCIQuFLBSyUMhC",
-	"IQuFLBSyUAg8EHgg8EDggcADWShkoZCFeqon2tkMKKH54Cyoxh1APalQ9EbymGSFduks32A6VEMMmBM1",
-	"OCeqT26YGIWJUUhJITJEZIjIEJEhUlJISaH7HikppKSQkkJKCikpBB4IPBB4IPBA4IGUFFJSSElhYtQ3",
-	"nxjVIEq+ZnbU9g3BFClMkcIUKeSjEBYiLERYiLAQ+Sjko5CPQj4K+Sjko5CPQj4KgQcCDwQeCDwQeCAf",
-	"hXwU8lGPO0VqyC/jUXYbdWfG6f977Pf8kkGJpFjwZWFhAvEowbx58pJESaE0ywM2BRNLLli3ilfw+8Ba",
-	"Tl4S934W9CabMRySCAZj3Z8E5qvLZIz3WeF9VvefttWfp9W2BB4kUauETtW41bnWOnsDY2Dv9bWV8zRL",
-	"eMS1G0VyMBPPgCEEPshMqonM9ox5BBvf5hqqi4OJK8jUqmRVVs8ShJuwN969uWtOF14ljLeH4u2heHso",
-	"XiWMygCVASqD3a8S7osw/HXrCMP2rcJjck8RhpV9haeuP5ZT10UjkpDYQMKZ2CmSMAigm/dUrz09IbzX",
-	"QZygxYo2ZNAMwPuzDeRHy5PWKTEAGAI+TBd4l9acmdY1eOH8LPXeETM/AdF4DyhRxdxtK0Zi71riQHiA",
-	"FgFaBGgRIDxAZYDKAJXBQ8CDHbvRteAut29F3zl7Q8/Y23C8XknsfZtH6yEz83SZGTxQDw/UwwQmjCPE",
-	"OEKMI8Q4QkxgwgQmTGDCBCZMYMIEJkxgwgQmBB4IPBB4IPDABCZMYMIEJkxgwgP1MOYNj9HDY/TwGD1k",
-	"oRAMIhhEMIhgEFkoZKGQhUIWClkoZKGQhUIWCoEHAg8EHgg8EHggC4UsFLJQT/UYPZsBJTQfnAXVuHio",
-	"JxWK3kgek6zQLp3lG0yHaogBc6IG50T1yQ0TozAxCikpRIaIDBEZIjJESgopKXTfIyWFlBRSUkhJISWF",
-	"wAOBBwIPBB4IPJCSQkoKKSlMjPrmE6MaRMnXzI7aviGYIoUpUpgihXwUwkKEhQgLERYiH4V8FPJRyEch",
-	"H4V8FPJRyEch8EDggcADgQcCD+SjkI9CPupxp0gFk6ZyeRuYCafmZ7/Ll5xJJMWCLwsLDIjHBScviX09",
-	"Czp2jTiH5GSB2PvzsXxtmYzxaim8Wur+M6j6U6bam/KD5EyVKKYatzrtWSdSYAzsFbu2cp5mCY+4dqNI",
-	"DmbiGZB1QM2YSTWR2Z6xVGAP2lxDdYcvcQWZWpWsyupZgnAp9cZrMHdNr8JbffEiT7zIEy/yxFt9URmg",
-	"MkBlsPutvn3Bfr9uHezXvuB3TO4p2K+yr/AA9MdyALpoBPURG9M3EzsF9QUBdPPK6LUHGYT3OgjZs1jR",
-	"Ru+ZAXh/toGHaDm1OiUGAEPAnehi4NKaX9F66S6cy6PeO2LmJyAa74wkqpi7bcVI7F1LHAgP0CJAiwAt",
-	"AoQHqAxQGaAyeAh4sGM3uhbc5fat6DvybuhxdxtOuis5tm/zlDtkZp4uM4Nn2+HZdphLhCF9GNKHIX0Y",
-	"0oe5RJhLhLlEmEuEuUSYS4S5RJhLhMADgQcCDwQemEuEuUSYS4S5RHi2Hca84Yl2eKIdnmiHLBSCQQSD",
-	"CAYRDCILhSwUslDIQiELhSwUslDIQiHwQOCBwAOBBwIPZKGQhUIW6qmeaGczoITmg7OgGncA9aRC0RvJ",
-	"Y5IV2qWzfIPpUA0xYE7U4JyoPrlhYhQmRiElhcgQkSEiQ0SGSEkhJYXue6SkkJJCSgopKaSkEHgg8EDg",
-	"gcADgQdSUkhJISWFiVHffGJUgyj5mtlR2zcEU6QwRQpTpJCPQliIsBBhIcJC5KOQj0I+Cvko5KOQj0I+",
-	"CvkoBB4IPBB4IPBA4IF8FPJRyEc97hSpu/0yHjGx5IJdwM/tKfOqfAY2gfmvXJCTl8R+1HDKJzxaGcPa",
-	"zKtqYRrJMFGkwGjdRsYGkUovc6b+lZg/VBrPawLpkV6tjSHhGW1SOOUD0ML8k4tfFBsdLmiiWGcDOJVx",
-	"RXmdQtvPoRA3/1xq0lyx/IbFoK6g64HvunaVq7nWGmhEuw2vzWt2+1kkdOnypETMI7DgXP6PEyxXFn/O",
-	"VzBnT16SKCmUZnlt6s2lTBgVRiIJVfq9a/1PTDi01x3gN8H3vAEImTg5i8z2tayelmKx2NGSAiGx1CnP",
-	"v/wYpjwHzNBA6W+4CpC3PS86W84W2DKqPYFWpbBVSLqeSgbDwENWNM34P1iuguI9On3tnjXm1Y39jdka",
-	"UlrmhpU2sRP0omr3lJwboefKq+9IihuWw/jIpeC/laUpvx8mNpUOWD5BE6s2rfmQ0hXJGcijELUSvH37",
-	"VgI9uJCH5ErrTB3u7y+5nl7/h5pyuR/JNC3MTrBv5JjzeWGM8f2Y3bBkX/HlhObRFdcs0kXO9mnGJ9BY",
-	"Abb9NI3/VNJOIcO83BDLf/xbzhajw9GfTMWZFMaK2Xd93Q+MeUeffh6PrrmIu+PzMxexw1w1+74aBs9X",
-	"nr06vyi5MjtUbjZVKKYaICNcLiBVE+wN5yEiTMSWWYYggoSbZaWKecq1Ii4lEYwccly6JyyrHE8Nujim",
-	"KUuOqWIPPjxGeGpiRBYcoJRpGlNNa0bLuuV7ziC/NmAcvrphuZmhy0TOqUHE7sX2EpM8jo6lWPDlptnw",
-	"/vXJsXuzvYfUCgntIeda5nTJjhOqAi2tPyVxmWkMCprmNGXaDLzRh5RE8BJgRvgIfrbmxqlZ+Eozof8h",
-	"k8JYum7DjFeCpjyCmIAslzfc6ofpTMxEvW6mXPiEmJSGVPy/SoO3JI5dzbYpNIpkXkYD6AimPBfkPXT+",
-	"LdN0+o6mgQvJwbqxLX11m1ERVnKht4yS+qSMgQdp0oE2mY/IDXxFmPksDu9k9cnWHhMqYprHbil+p4h/",
-	"98EXSNmoQfvXL7CIX9LousjcYJ6aSbMGwQYNBltCKchq4nUHLoqYUg4FdNavM1rftWBbljOwwkeHOi86",
-	"lb9pQzXljV8zqwrl1OK80cbh8ObzeDQvomumTavCOcdRIou47L19e99t5CyHhoW0VaOgQDMWMo/YKdVX",
-	"53qVsNortUmYs2Xf54pFOdN9oi7yJPj7Dcv5YnXx5jxUX3gOLXMaM3u0QH2ooyLPjT7pM0FAcvadytPk",
-	"DJCQuERQ/u9qyqX06AS+1jRfsvWNEexW+wa0i4SpZHtq0X4QV/UJ5zShYssl9b70JPpqM1NIJ9ecQTTV",
-	"UaS9D3mQbeLadUHVdWjCuyq3Lq9b1gahHGVmT6FJj09AyInMvEHjgYaxIXO+XDrtXY6QlxMHUO6VQWOo",
-	"Om0AAXRmbsqUMjoitD42z0KjfudUMY+DQrPRDZuvvgUWmIuQo+qaeBYsUKpHrzmjsYHmQuoz909jvNBc",
-	"j8qhtHg5jGe7wlEsP85ZbPYVmqiugDKq1CeZx2HNooxRn4bE11fZKctTXtEgzcqYoPOExWH9lzW/7Fro",
-	"G5V7Z7424b2tO2SX9eoSbzx6VWJ2+87CXRRJcizTlOtuK8ej28lSgmNloq55NpGZ1RoTsNJZbjfCz1Cm",
-	"ac67oLiHF3NTdeVuRbQjhGvNqkof1zsdkiiXYAfRjKc0ujJ752qaXS/ND2pqLJvpzfOp2e6NZRhwGbgn",
-	"NTPYm0PuAJqV0FdM86iKLqzurR8TLqKkgJWXlGTNDc25LBSxjhynisD5XoIuA6rg4nvwb8PhMwvye2XC",
-	"jolv2OeuIWusBC6KgErxT6B8xwc7z4tZYfA3JQlPuSbSsZ5FOme5qR6mP8mZLnLBYousKwdOjTQzuBAO",
-	"cYHTckBU9IbyxEx766EvuXCZ0X8VrATp8yrugCsFD+zJQw4weqxfw5ZmCKx/Biwy8G5oaZqZc3ZjD3uB",
-	"TdiRa2VLKrkfW6lY6ggCBgC0OMrWRTPPGcmkUtx8yRf1nkYAswrnLjL9jq6oWLK4PDBIX1GzfyzYJ5Jy",
-	"URhxweAalefDBPzQew+KZUi8tC1rX6jy5KZyJK0oy8gD0K8RTbyknKTtWC54Di4ulUmh2JgUImFKkZUs",
-	"bHtyFjFeilLLayYsnqeCsDwHBz/sYkGKMWcp5YKL5WvN0mNZiIDPqvuO975V80wVc2WG2zyDKedaD8Ph",
-	"HNkuqN6urhrbAe/4Dpaco/vVTiFvQ/uQGZk7WXu21waat2d/2XLfKEUKcS3kJ1EyVLYYPxQJW2hSCFhS",
-	"IiYy5VpXHKViOacJ/82F3tQbCqObZgnTjDxjHOb/nEXUoA6uvS8+uirENfAF1VMQQUlnK/fSXtUfF1ov",
-	"pJ2X7T7ZjpQZA3fqiXcLySQGY4oKcvN8+vzPJJbQblNKVYed+0brCzOMphPO4gnPlO+Z0jyFc6e+t2uQ",
-	"/wafmIWbmPGDRhyDu6l0Hpp6cwaKtK9smxcBOiJ3f7BbGulBjl2wjeqrNwTfc7t2qXaLFLjBSo18p2qu",
-	"yzpeqLxv1pdrXSjePR65nmpJYoOOUy6YVRZOvdmV7TTSlPwD9IGn0zUcQAb0k9PEtSLNWFsNRQqRytgl",
-	"ldDoujy4C1o+JacyKxJaRtMwYhNCpsSYjhOzhT24jyKSwuK+aDWBImQyoSKelOo8WoV0lmLJ4g0XAYPZ",
-	"P7EO01/O3rT9pOW4DOr/TMzEyavTs1fHRxevTsjPJd9lV5nSMiNmF6dLWpXvWHJBnk9fHJgZDCk8TXXD",
-	"FYA4YXfNOUxuecP8Z8/9Z9Nh4HKQuWTJo2Ojc4KOKv/QOvdi5iwBM/pmJZmpTeey0BBzknFXHllQnhR5",
-	"w2iKqGLKzucqH8jsRNYzyERkVi9zR7i1rGEjnzAqt6IrNU3p6aba7t/UWiFmDKC2sVkhBn/E9ug7Rf73",
-	"+ft3bdX3FjzhsCORWFplmUmlF/zWqCDbcYO9BAOKnmo705mx/QxUsJ36jeVywkXMbs2CJX+3x8gZO4Rm",
-	"GaN1m0KKyGLTWuwONF75pC13CN0VvTHibMlwSt470xvm56tbarYddTgThMwAlc5GZFKbbOWPTpF6V0t1",
-	"2KD5EDaTDweX0wElWJPENp4JnUNUsytiNgr740sg3Q41uypSKiYGuoKBV3vsx9ruk579MkKYEhtNZJvn",
-	"jFC30EEzTsAUIpSYchsMZN30oSrIiBG3irZu1Gun+ptRo24PBxOguZxK+/rel/kJ05Qn6p83L/rWunuj",
-	"EZJceaVItSrtCnt79P/5vdarS2tIa+kVRv3zgNaoWXhmNZ+B9KtFTcl5HVmVZOQnU3u16Er7RjFdmQyw",
-	"NdoAXr94XAywTeOkOrLBID50w8cJwEmdZekWHjn7gypVpE6/ULGq3vLzDQbX6L0bmvB4bGyQQhj7yVUS",
-	"wHiwysPa7dhqABcfZxWSB2NuqELHQVqheWFaXTwl72zcR+Op1UZ+rGyZLHaapxHls86/t/VWE3C0QEx4",
-	"WAo2XLwSdVvbh0TgEHm9r8H1HuZXIX6ei/geKiXvhTt4N3NxCFbmMV8sWF6RrA7UsLiq4mcu4q/NmYpe",
-	"WgN4u53lQ559qhCNVTs2bBGKtxjRc43ObxPv9Whuna+OFprl5yySpjuh3O8yoMvmIGuewrar7CdkzhbS",
-	"nStbjlct7sz6IuIpOTcj6swXS5tb70mdIgf9o+k1g009AUSgGaGAbMjE+W6lKgvSzd2rLPNKfiKJtDTo",
-	"J8p12Up67Yn+dvGDEvfHo4IHJv8vr0/aozntHaZyvPuGqj1/D/f3qxAxM4NjGan9QrF8six4zPZLTJWr",
-	"PxU8NCt33AbX7H+2a9ZV4zZsM0oRTZJGAol7w3q0vPcJI2weOsImknEIphTLpdWc/3lxcerHxrxbBXpZ",
-	"zTMmB4QvvPNi4BpxG+097oE1OwwjfO45wmcHROGd+N5V4/X/dFMs0c7ToiQtdgIgn65WrZa7eBnTudno",
-	"79YOnI1cR3dAJuTIW+pRQnMXGy/s8nNShOU3L4zCZNbNKW9Ynhsrk4fzWurBsAHN3GDcuTWsjNVxSGaj",
-	"8wLiRgwWzes9ffDpaKwJcE65xg/YqmzoRZFzvTo3c8RuFS8ZzVl+VOgr8xdMHqAy4eeqWNOH0WdTBrCG",
-	"HVn9iZgiLHFg0ySPkqS+golnH49OX/vsCvLRfCRz5/04JLYx5Wkg10zYc0A+kisAztagowQgjiMXuCBZ",
-	"QrmYaHarwQcBCQ/wzBkFcu689fOV4z8+MtuaSCfuVaOx9EdnTMAfdl+0T8ENk3MD7njJIKkoZ0w4Ip/r",
-	"hAFHnkdS0LK3djXWyMbD0fPpwfTApXwJmvHR4eiH6cHU7AEZ1VcwKvuOTZ94aS+Z7olFMPJc+ta6zyyg",
-	"9E6+RhyZWTAlfeWWqA+Mhp6U8/x1PDoc/cR05Wc8tu+9tryxB9DQ4BcHB542ZJa0gYh2Oxn2/9spFieN",
-	"DZorXCFMvvb+C6tvUSTV6jSC/fEeG/PKWMihyn8Rqqf6P3+J6l97C8o5Pph7cTxSRZrSfDU6HDnxeaJf",
-	"06UaHX4YVfIdXZoP9pvpSOunmjVy6gmftfi2lAq6tOvMLYDQnDKbTi2U7gFnUlkLRL8PnkENIb51fRL1",
-	"FntR2gwA62AaXX4ej24nTrNMvGk0sUC2nvPVlPn+7+W/P+/baMCJW7IDxsOFBCRJK5AQUEFX7o2YSgiD",
-	"rGIiDz+EwodslpnbBLvBiuY1o7ZGHrFXHR3Vgy9shGQ1bO3t6vIBp0Gz09vNBdQmfiFAPGtrktWWgou1",
-	"dVKGxZBJtW7qRvbqIkKJYJ9aJcNG/v33nk74/nsgFD5+/Gj+87v5H0JmpS08Gx36HyvWwdhn6ge/lGaj",
-	"cfMFl1Bo3nJLtnzl89hXYMysVuFm4vrCG4VW0bj2sf37eeOdMszYvmL//KdNX63eKiNkXT3wZ+ctG2Lr",
-	"elBMIiZ0TpPJ89mo3ovPpdzuJED6W5GzB5QhlL9WjGW88lpJuhb+k0bA5v3T9mCNTFvv14XbFlxHkR7D",
-	"xG1olcemScHufinj1b3pjkCnXUx+QJ9cdHpYBiAAwWyXftzp1+cvtQvgBnAHcxIGrTtz1+wA/eZQ29AZ",
-	"bhPZZ5/txpIwzdZsMfYFFVhx7TNOGfloiv3YNZtOoIytV/u2C32rNT5+VJbajyHXKK6ldWvJTqqt1tJA",
-	"F0Bomke8M8899rcnx34sp0JgAfzENM7+L45TcIfaflX9xPRWSwqO9FmzqCzVsNX2Qd6LZNU6wcPFgPhY",
-	"EU9gBCzLQOIjrrb7t2X780uH2bIwIGqbsUZL9ynpETs/vryl65PpJt79b791t/du4UxpZ+X5rnQOF6pv",
-	"/L1I98SV5vzJtvfb6KX64n/suiHc2R690Cfnrw52B/eiTxW8OHj+5Rtz7HIJnIKw7Xjx5dtxFEUsM0OG",
-	"OrGN/ntmfEc5blCKvZruDtrxrg6BvsXbY9oBn7pBX1pY9zj15Xib5HAnC4i7MTpsIQsRu4Dit85p/ME7",
-	"ii99KcGO+2CxhzJHXy+IYnrsklZKg5TFpMhs5ihEG7WsUzgruGpGlDAqiqxteXeaUR058ZBAcMuYQrTw",
-	"7up/2UqbDXTAPIBa+Ylp1CkPqFMuH7Mlhku2cu48JuvDlCxzdg/gzJV0P+jszBb2B4FnvrdD8ZkX9WMD",
-	"aGv68RUQ2prWfFmItqYhiNGGY7S81AleTXrBbqknS513F0V5bzjNL+L7BmqPRXVuZ1U5aexmVp019OJT",
-	"sKsQI30tjLRem9wVJd3Dou7CJFzRTxcp3cEkwpW7BiqtX7ZZoQcS4Q+xci3hhov3CyzepwHJHG+OkGx7",
-	"SLYoEtSFHS7/cWGirRJ72k1Xa+4I6su3as0m9TjcQ19mIWPCzw4JP53JV1swXs7ECXr7pJ/OqtxuZgcd",
-	"oH8Qz+fg/fWxuTofyYY6bCdNVg/s4UTX5k6uzU3aaPg+vt3+vf+73/7Bp7lfC9S767buuCy1NQ0U2N9f",
-	"uuY8Kei0G2Raj5Xqo/W4qWG0Vu7RWvFr6msQxB0dUSeM76wkfCHu6qzO8x2cMAE9cuabjIrkCSkSN2qo",
-	"Se5Tk+TVUvgaDoN7I0/vmzRF1YChrEjTPj6adhMyuitPe6/8LCqPp8DE4qq8Hwp2o+t0EAd7v0Z/kHnF",
-	"ZfnIOda7OX8fAamKquTeGMyv5/q07oyqm1scUOrvLaw+7g2kuFdD47hqLOq2J2By1MYLNcb9xH9F9SXw",
-	"dTVH8w7foWcbV1/13j58z0qj1k7UGk9Ba5QDhlrjvrRGYw3ck9qY1Eu9iwbJuM63UB2nkgs94WJywVO4",
-	"olbeMLgmbiG/kCo5NQ1GHfIEdAiMFGqPO2mPDWvtS9sdTCy5uCPf6r7dKRjjlav/jxBrafuKlON9UI6s",
-	"nDed5WLFPHS1+IK2WCz7RbbMacwmWULF0JWTMQFX7Vrhypy4QlTzlNF6LOdMHJX3cyarMeGa0ETJwP0S",
-	"vnB3fZ29ZhyuRBOsvLE4Y/lC5imLyUy4S+rMPk0XZi+yrbF385dC9m31bbHXHd88nz6fHozdJelGe6Wp",
-	"vVdcS1Lej256buyGTn+n9vYZe3G5+9G8bW/ljVmWs8jeli2qu9Frty3fPJ++mB6ELYpfbHGnZly+ZY1S",
-	"7yeqkjvtw37mZXaueC3y3k1X9aX0xz7Nslze0GTAGRmlyghsw+VC25D68AQW8hFIhD26xfwQh6yWXTzy",
-	"0yAwp89s1TAMlaJuIJL2JBhKYKDi2I5msLN8ndi/qCapIp62jVVwLb8fBO9MrqcB3plv7FNB3U66uNHv",
-	"5q4rx30dYrhDjvfuK6kZYPAHX0wPFxjQv44ed1wArv/7CgsYpALuZ6tOpeBamok94UJpKqLtvGzV96T8",
-	"3ljNtOMoCPrX3pafvy5r/2PcZBjoObrcdnC5hSZibQVV4t4+tTlQtEWooSfV1ekwyxT5aGbVR6efFdPT",
-	"mXhJFYuJtPjXP78yqNPszprfMHLNVvaK5EiKBV8W7jJuwVisGmWdF9EVoWpM+MIWdUiyNP04NgUK8tH8",
-	"Gwqrf2kgHDcA2l3C3KijPzu7O2W//bvyun22slh/ycjb/nnx9ZK3A8OHyuau2cuBld+vbfq36uD2u+V2",
-	"fdd8opDy2vIqvbtpBK8MwjL8Mhcdvd2m7j/WzXo/2rY+bPUhDSmktiEKjzEppzVZBV234Ad6uXZagT8x",
-	"vdvye/tHWn64jeLaDjvettrJt7lmcKfVbV0CuL9+bWvfjsN6az/dZO1/lasDUU99O3rKOQgfGnRkLE+5",
-	"UlyKAT7AUHhP+XkZi1soltsQH65IVOQ5EzpZkUQul0CvgyPl+1e3NM0Sdvj9TBwpVaQ2YX4hk0R+Mr09",
-	"e3l0TDKZ8Gg1BqbCFKvIR5rwyHMXczn/eDgTcM9/Nia5TNhhzG7GlQtSjUnOaDwm37feaDtMx+T7Mfl+",
-	"v/c1H7rYeG8u52tfWY4JNLcq0TXWqBAjUIg9sFJtdb8tWNdv39vfZ4KQ2aj21mx0SD6YX4n/j/m/2Qi+",
-	"m43G9d8q8bQeGFm1fvp+NrJ/Xo4Hlt4WbbfA5t/7O1ThZb5FHeY/lzPx2UnySMSbRF+fZsMFP5fzh2t1",
-	"MMRMsfy0tpwfMsqrVRU6le4W6WU0ZdYYMq/Zjwp9xYR2DSOz4uDgxV+I+VXm/DfbncvPoMFlPDEtiovE",
-	"qHdQmXw7RieTMamKIL4IH651XcxZLsCJ5NMLemKnT2V8XpZzCsp7k/V60iKrjdlnd49TGZOqNGKLM3uK",
-	"G7F5woiW056DVGxxF8aIrFuVTBSpkW92G5mWqTSejyw3sMyZ+lcyuhxvNn3PrMb2m2C4odCHK6oI1SRh",
-	"VGnynORFwvoafEXVWZEAg/GVTnsJjB7yUzvwUz3LqrbKgzNne7YqVNGqn9QJr9KHAFehmnoQVbAPX59B",
-	"GdgDXA+DKJTgIA9aD/3Qpm//W7M37v9ua57cjUUJT9U+P0/vUWx32Czrrp7wot8u7y/QhPW5fzW54V1S",
-	"f7RDyu6+egeSIzsvrJ+YxlWFG98jg3l3XzdDzxTbeeE4n/cfbe08dov3a8T24sK/T//9l7Z4/btbnc1D",
-	"MxpxvbJJtzeUJ+BbKYvya/PnQX6gn5iuXqzOtHatesCJu6ZWnL/bI7bq5Oxy6PykrSTtfJCKgQNzEJLi",
-	"4oYm3O5cr+wMh9//968XRMtrJvoR07mrZqdIqxd/fXgBX0hJUipWhGrN0kyrRzW0dam/kUtZ6K0dzxsd",
-	"VFypovRPlUMLfEoil0vLZ9oDsY1qqTXJJe+WAcvgJE8LpckVdcdqf0zkkouPoLjmPOF6jbOrPmceIE1W",
-	"NQ8a69nqoQ/Nw5jud0PPctN37fz+IOtgEIf/xVoZTyk64A+7bFlU5FyvRocfLtcsYi7uRB4ppjUXyy24",
-	"f8jfd195w8C3BUILksTmFIQMg3Nf3QOaAWUdgyf3GinXGuyF+xMTLKeJPRPJSvGG5X77Gy5E91FbhuY1",
-	"OwlCOu0f9qPX9jymB5Ohq2Y7EZZC81/3y6wp8d9HLxnNWW4mqBkAg82sCCziLPJkdDjav3k+Mk9cmW0Z",
-	"G/mt9JXZWHKWwOkOWrbN1toFms6WrpkyXZ6vv8z2CVi1EjuHY92p3Or0qXaxPudyh9aS2s1Arvjyhtpd",
-	"iq0uLnOluuuatin0ZTtdqFEU8VdiDC2yCnyqiqpFTQ0thjY1KgClhjotCx+ie7u11hdInrpK5rLQvfq1",
-	"qrGxuHaYbOR97awIV3b109CCy+ABuOIqSaQRhFiSk5dl+nImbVqakHF9Coah8OfLz/8nAAD//7IahPfG",
-	"BgUA",
+
+	"H4sIAAAAAAAC/+y9+3cbN5Yw+K/gsOectvORlJ2ke6f1/TBry07G3bGtT5I7uxNq22AVSGFUBVQDKNlM",
+	"Jv/7HjwLVYUii6Jky+4750zHYuF5ce/FfeO3ScbLijPClJwc/zaR2RUpsfnnc5xd19W54gKvif4B5zlV",
+	"lDNcnApeEaEokZPjFS4kmU5yIjNBK/19cuz6Imk7I8pWXJTYfJxOqqj3bxNcFPwDyd/gksgKZ/bHnFSC",
+	"ZFiRfHKsRN0b/ycqFeIrxEIv5MZBiqNaEqSuqETL1jIm0wlVpDQTqE1FJscTqQRl68nvU/8DFgJv9N/L",
+	"OrsmSq8q2by1nMT3FRcZOcXq6lxtCmK3tMJ1oQLAXJcl5wXBTPdhQ5OFXfa/TicfZ2s+0z/O5DWtZryy",
+	"RzSrOGWKCAu/36cTQdbJxY4fwfb7bUJYXU6Of5nI7ybTCf61FmRyOe2vuhZFcjc3RNDV5uKn8xZU7Cl3",
+	"gWLW/c+aCo0Iv1gItc7GdWnm58v/JpnS87TwV2qM0RMGDPg3QVaT48kfjhoCOHLYf9TqmsKOE1zhjKrN",
+	"aYGZ3UaMnacFzkhJmEFRjCrBZUUyRW8IyrHCSywJyopaKiIQZ0hdEZTVQugOjOdE9mikTaOjthCv8MR/",
+	"Tm1lRZXsb+FC1ATRFSI3RGyQIFVBM4wwy81qPV2vqJokMdns4jZLfcPzJMArnp9nVySvC8rWp7yg2cbT",
+	"Znvh+lcNdr3MiudIhl6oMt00d8jRigvbxB/VFJGyUhu9Z8YZQf677eMG1LhIpEJUmlEm01vTkmzY6ljQ",
+	"BHTsEIU5wGmMI83w/ihS9JHGkOPfhnCvD+u3zICasDVlZKrx/ONGAy7jbEXX50TcEDGZTshHXFaaCU5s",
+	"ywPAllX1a1oUNIGxJ6fv/PmQHC03CHu0beZjdbkkIp5vcjypKVN//l5DpCQlF5vnG0US4782Hw+dwrW/",
+	"HXGcucl69NHBiObIYoi19xctZRdyGJrs4YW+bzPNzQpyEp/KODhEvV+3oT6y/w2mRW/uPkYwrtpHpsmY",
+	"fKRSWY6Qy8l03ylfj0CTO5w3LRaMp5mqwIyR/GQP0rG8zqKHZZG5v6g0Pxm9dDf1633p6m7mTwsPSbwd",
+	"RMgkpg3gQgLUSRDsojdP5j2S81d1+sJN3IU8JzEsEY2gGV14VKGcE8n+qNo3+i1kTCx5QiA6M7+3lnIX",
+	"E6Yuwt3ANXiWENq2SWmKa8A5Uc2NpmWInqTme57Yjru4+4tO87Sks2uU00SXLnC6C9sFp0jv6/B9j/oD",
+	"9OwHQaFhELZueFGXRE5RSaXUfJCuUM2uGf/ARrOUYRoYgXxebr0rbHcsawASF1zhAkn6axBIHQBGb9at",
+	"96TAMjG+OyKU6c+dKabmD6ddIdlq6aRXDX3TqIvsHjqSKMQPEtf8fvucyexbr9k1QTy9mGZyPfJak0ha",
+	"/G1A2zmWJKILghVpaXmnWOBSHmbmqPQYRBHR1+BwlhEp/0Y2SZX4i7CBdJBbc8KC13nYvW19lHGmMGVE",
+	"IBYp6J/KdtJe5DMNBqHJgDKSIzuFWZfHt8ZCZf588ebcfrb2KnSlVCWPj46u6yURjCgi55Qf5TyTep8Z",
+	"qZQ84jdE3FDy4egDF9eUrWcfqLqaWWSTR+Z0jv6QMzkr8JIUM/NDSyvCH+QsJzcpUB1utJEkE0QNId7D",
+	"NOk0xBKvf4up50X/1m0jQqcBotIc97m5QMP95JmP4z0SPTt9Ne+TckX/ToSkqbvm2ekr980hnZ3nxv5G",
+	"rDmixBb7qESCVIJIwpSxjeqfMUN2X/MFsxq0RPKK10WOMs5uiFBIkIyvGf01DCc1wet5CqyMeYIpIhgu",
+	"0A0uajLVZpsFK/EGCaJHRjWLhjBt5HzBXnNhLbXHAe3XVM2v/93gfMbLsmZUbQyBC7qsFRfyKCc3pDiS",
+	"dD3DIruiimSqFuQIV3Rmlsv0vuS8zP8giOS1yOLbr0Gga8ryPjT/Rlmujwp7yjVrbYCmf9LbPnt5foH8",
+	"+BawFoZNUxmBU0OCshURtulK8NIMQ1huqMf8kRWUMIVkvSypkl490ZCeL9gJZowrtCSornLNoecL9oqh",
+	"E1yS4gRLcv/Q1BCUMw22JDxLorDG5ohaG2qRFcl2ksh5RbIWDudEappFUmFl2Genwzxt2X/HJF6RE2MR",
+	"qgVWabIZaIlWlBS5lVYUR4TJWugDxvaMDHPPMEOZuc9RFveVqGYrqgxxV4LndWZGrM3pLNiLcLseo8Hp",
+	"P9CiQO6kZV1VXCiS+7tiVevDQYIUBEsi50lLqL19+zt2koPjQ/6OrkhGVzRLO0oI08J0gkxe2g+WUlYF",
+	"XltY6R/dyDLe7xydmhUbESFfzvWsc9tu7qylRP5yOXfz6cEMkvICEZxdeYsqQZJogUeRYmMMw52hKqpE",
+	"aozTVxdnaVjpHgm97NXFmYdT64AbK6+mWX0otCSGOWqbdQ98y1jaS8s0z7tN/LyxxNBqhD5cEWF1bL9O",
+	"t+UFu+g1LmtpUMmia0AkiUs7hZHiELZzJsirR+a3Qgm90CT866rgOH/FFBE3uDhPMYl33SaIBUlekoyz",
+	"XKIlUR8IsVtbUlbwtUR2aLlbmvc7St3yATkTqpD/ZHdcONHY01XoGEm/yaN3Dbt06X9u4d/8E6HYyZnl",
+	"eBEzXjAvtxqjlV7Mw8U3M6WD4GS87D4EnP5Qsbis7B15wiuawpOzdoMwfkBid+KZ/aw4EkRhqpmx1Qgs",
+	"6n73bQKTGwQdxs/AyARnW3bSIYo+XjVHMfUSdBgtRTo9d0GvhZYWzo0AlRYN7LeAhNgIy8iJXPqOXXKu",
+	"pBK40lIZRox8iHT4JJ0MzPY8+tolRPujORZNAcQIb5+IDoPf0A4vPw3JVVhdJS5FrK78inULrwA4OK1o",
+	"QY5yKkimuNjMb4VgZuIULuVLt1678zR8XzzvNUpB+MXzYHVyS++fbR8kO+UEIxLMKJu1RII2+06ZbtO4",
+	"H1b+7uJEo71DQDOo1geQRgOts1bKYkiJ1TFaTL598uTPsydPZ0++vXj6p+Mn3x8/+dN/LSbJU/Z6eNCd",
+	"7Wq6Jp+LTRUWo7toMPrdzSfToMa7zlYdTGjyfQaQYgnOW5tg9vp3v45gsbPNdwix9ggSVmPzux/TDdU9",
+	"rx7YMjGoiZ+cuU+ItvUXp4t7DDw589Yybbaxl2vNciKKjWZkeu1YcaEVPG2sdrsj+dSERhCpZr6J1Ras",
+	"3c1RvJ/L0Xs02IK9eXvx8hi90/qj1WOpRA5WG1Rxo8ZLhYvC7N4orQXBRpTGhkSwUH4T2RYGEnucu5eh",
+	"/dK/BR38Q9fE7VdSRkuNbU9TN2Gj7CdmdZ8QFt47ZX9BBTW6tuaxRtNoL8MeAePGMD3t9dKj6Y+0rLiN",
+	"zehgXlXr/2C2ebuaHP/yW3/VPcPW5TThKHXAOjl91yzB8dLSRV9UWCkidIf/79Fi8b/+Z/b4Px49+uXJ",
+	"7C+X/+vRYjE3//rm8X88/p/w1/96/PjRo1/+9vrHi9OXl/Tx//zC6vLa/vU/j34hLy/Hj/P48X/8m7EP",
+	"NjbLmeaGXMzcvrxp0IYDHAwU58B1cLGDftmgSTHDKGAn7YVps67gcdl65WRpx86Jd9PEFmrT1vOqEL5E",
+	"hKRSEaacI0U3o8lbU/uhDj7rc+3M8guLHFvD6/hSDjwWhwyohsXo37bcyu74TcPmPq4+ZhoUXKq1IPKf",
+	"hf5DlvkybWSXRJwbq7dMy1bv2g2SSpL5jJwvxttJ9cjuU9JqeDN0mXauUrdJ33yXdNm4ngYN+CVnVHF7",
+	"Ir0AkfAt8Jjml+301TS08kUanq8TrbpAxag7Fjo5cxpAt//dKwGjrlOvmrUvRmcL9Qyj2cU8xY1omWZH",
+	"tJTGqNIARVrZ000+DT42yowEOPefbOfpghkbBhZOjzJBPlSi4C00MtGF/olKhBnCRXWFnf1XWxcdQjn7",
+	"msPoBXuxYbikmYfCs8IbRNCKYGOfXWNFmsHtgHqWsqxNtM8cvVLGiMxZsdGnJok1Goelyfmw3egs3iYS",
+	"ZEUEYfo0OCOIMKUvRoZ0gIYGSqu17J/AFkuIwakSq+yqhZetaSqezxPAR3ylwU/0MoLBMoaFPhEDhhJf",
+	"GwMTVg0WhTCOBaNM0pwgHJ1aGlsHQmzOmvDZsIfsikvCDMBxE1/rY4UcOHN7nVgJ0IQtWfF7o640JgQP",
+	"jmmlhy9xHq18iri6IuIDlWTBzDHb0aWOjWhccWbu3cqyOaSdRpbOraOJZ1bianZNNjIepd/KDVPiSg9q",
+	"pdvhuIS9L/QvRDjtxjoYGd/+uHQeqRJ/1CoIwiWvbay9jo2qVaNRhIiItENum1e/dbEclZjhNZmFcWcN",
+	"cziaJFDBuwv/1c/NUXzv5CjbeXKe5CzRh4GoRLykyllaYl40NXGM1oBiBGWHNCbMCRuuQz5qTZKqYoMa",
+	"RX7BAnfQvTDTKmRhNBZz+DN/tRnv87xZSma9wORjRkjuZvu0iDbOjlNhk6yQMCLWsmuzl4pXsUkh7agb",
+	"m4xxmm6YklgTTXueD2E8PPrYI7thxXNL5u7ex5ngUu40i5hkhWQU6Megy5o2bYPWHMU2CC2nVPoKFxQr",
+	"smCJDtYqtCS6YUGjzJM1vSHMidJz9GzBdEyAdVCjDDsdTxLVWIfCfR15U40QRD66eA8bOOONwd1Yuvkt",
+	"rXF2VzuNceRjxWXKXGh+bw9m2+6Q3qlzApxhtk6Jvq9O4+9+Au/7e3Xq3QXCfn908urFmT47M9vjBVPc",
+	"Xg8ebFqMaJ+vMsISlYjxWJoeFgdbS4qiT/RqcJ4LIqVeKUOttSBjPFRXvFbGc6JKLK+32ImbCL2+3djH",
+	"/my1HTvw695TI/suSRM0xAXyCBWpsNG44esYw/LtDJAWSz63/bG1CjA/gvnx85kfd1ueLLJ2DE8lZ2uu",
+	"N36FzfeJu/icDWq95DXLWtkA2yhZXmGRJ2005+6LX4xv2YmYQKfnr188n2kVbOAuckmDAzeS/Rrz1eHJ",
+	"kLSN3RXaD8kez5diMbVZxt5sqaNHbs2/2xlp4WUiumrDoIlASopupp0cOEDZCvhruLHrdNh2u0mhYZFu",
+	"9MuULBsP4NyRl0njPFa13B3TaJq1NsmXBk32Cms0OULnQ/6AZ/HnrhHfCtwsCK+PjBnYmJ4eJx2cnFnl",
+	"USZJwn3zOlBnS03n4G7v721AkAmDN2PnRGFa2OuRM4KwSZfyQ/sE9gBHI7I+O32F/IXbh2SBpboQmEkz",
+	"0wVNqRD9NkHQw1LZmD8XGugWrEJrklvTEDcOGXP2RsEz+t7cWQRdcPXSxPJZu1Pk/22Gza60TJfPkZYQ",
+	"vULJuEImf8nIilp497Z2s7AwooaDFd/dMLqzDRkwNsiYtnKsiAlcSAf2SplEO/fBjIuu6hIzJAjO9ego",
+	"fGO50UrYOhwmXmqh0yw4gM1DRructeLCXKagXYVea4k//kTYWl1Njr/79v/6878nFuqx8EfCyFDYb79N",
+	"l7XPfSDzfN20CfG/zeF8wNLYbTVy56iuzCZ+4ML60FlGpppRJkej0uNusUFPv52ipQPI3KLMvCGjXz5e",
+	"zhNrphL9ZdpZEJVIA5avTMDIgpngAkEsyTj9LEEyJCx43mG3f/4+ZrdP0kJvOgHO/t4QsqlIsRa4LLGi",
+	"GaI5YYquKBExgljB2HT0GmvY3R+lI74YZU5NjDURhtl4FTgmy01FLE5Z/quVEJKpkIFgrPwlwUxf1m5O",
+	"r/ROF0x//XBFNOXalArXSZh1SZoTQXKE0brGAjNFSG6yN6yHxjSOKB03ofoeq1v+Ab1KF/ZtUL+D80+f",
+	"fPu9OYzwQ0uy/OXZ7L/w7NfLR+4fT2Z/+cf0+PKb6M9LKwr2nbQDF5n9PfBaD9SpYW18hXS5jin6wWRH",
+	"oXc2pTIOCNLfJ9OJaTCZTlyLpPsxLWn6aKMIw6N8B2QoDa04n7u0pnnGy6Pwvcsznv65LYr/YsFy+eiX",
+	"mfvXN/6nx/9hROhtDR5/c2TE7wDey19mDajnWhCPvj3+t50W/sS91HDeQGfhtLb4Nbv6+j4BS+Ee70cs",
+	"GTHCxyuhVLhSOu/O8PyEmGQ/aLZwQ3Mi0aouCtTGubqSShBcBtEFG0ZSYMqQIh9VcsYrLlXap/Wf7ovf",
+	"rG8ZBdT7iZx9QmiVnOR7XYqvm0uRfFQCx4WooquvZ+vc7xp7m7wSrLdVmnQtwhSKrpxwsoHLJQSzHvPv",
+	"M/yKi1QiOxeqCYQUagxIRwQ3a2lik8yuzjd9A45p7etcjBpdmz8Jy0keCCE1Wb+VnzsaYTDGz9pwvGlP",
+	"/84IyY1U2ORy2euZyjDKkqy40J/XAuf+buwFBkaDUm2QthDAamhx821BOsNRN8oklTeAHg/iobvFaUVB",
+	"U2ndNEOUMc7z0EHr5wPJUMlm43I0XSz2583URHeYqIl25GmirzxNE91VlibqJ2miVo4m+tJTNF3mwb6J",
+	"mrbb/HNlTSQlE59SsCOZIJ6SC7qmmnZ69Sv0Ym6X89BexwGWJg+D/e1NQ6ejHeQFUSmT4In/FO6Ilu3h",
+	"v/nS6MdhhPHWBhfAlpjSfognlAqXVU9atFD+o7SxcO7aGzd5TqSibEDmetF89IswQms/GSaJcGtcJQ7x",
+	"R1zJRh32tlVBjJapu6CcKKuzugglk3SiMxyTxlbL5c+Isf4tC5K2cP2UaNXYuPQ3b+XCyktugarMAlzC",
+	"zGjIGtxLCwJhZo+WocQJViOIysD18vayga/SOYK4dFMXK2gHdQCKTaHeF9wqvtbjFxFnAvnhXuWHYGwe",
+	"VfowLT0mtGoQSz6JWDKCikMd0RMfttQvIDZY5jhomKnaXibfKS5b1NZshLumtljURjg4h3aTuCsafEWC",
+	"FOYyNGCLkLzn37QQuTUBJICbIIbR4I2/3Dl0GzviLrDHpYzs2gePIbXdXlvtZTzjRcHrZARyE/PbSTNE",
+	"ipSVPkgkbG+baZcoSNbJMRgyPr0UgovG92KnjMZO1l67whKtMC3Shi62s+TxcP20ZhTHd4aLPvdhw1fD",
+	"y10SErxjk7H1nvwaRlRzOhHEiGS46K+4iaRAAZ16ZMeIKf3yzhavaupu+Xyc46OjWhJxbDNj/u+nT57M",
+	"o/8//tP3332bAmOFpfzARd4eVHCuJgNZPf74drUewZpGCUp3JiKBbPTAZSOQih6yVHSaLFgwUKSgI020",
+	"qY5gUVAi1QusOpzk2yfffjd7+u3su6cX3353/Ke/HP/pL/81WiFMq8POG9xVhCuqhNF5OyoxXil//q6W",
+	"g7Y6KHxN2BbtuF1Eorcy2+hOtzviwM6cQr2Lwbp240zVTksHWzXYqv/1bNWOUvY2Vrt+82Sh7YPqFVly",
+	"3F7J60uvUAQFhaCg0AMqKLSXmyfmErFnJzrQ3XgYcYk79O54ZnYL984gP2v5d/aOBR1r4o9W3kpPCsvt",
+	"cMW78Pq7OUdprFHbu7Hte6ELBK6HrcB6iRv02Ieox74cqATX/r5DDbIWRVB/QP35F1J/LGUYtceCXf/L",
+	"Fi7oFE6cD72M6nC/zVr3yAzul240Up9UmOVNYaCmvHlnXXKOzuj6SiHGPyCq/ihtoZzqY2ZowCQwzdF/",
+	"8g/kxtVgcDEKlZyiam0aYbaxJVhQkwq0XXAbjKjeJaI5gO8jmr0cgr+vHxOfQLIwltTkVLeoI3r+6MY3",
+	"stkgMXBRczMOKaHbSoj044DMWI2gFMc7d3043RXMA0DQy84nf6SdvtPmh/D2o+K8kIiW9j0cddXfViao",
+	"ohku0p5e0/M/sbxKYrn5eopV+utevt4t5U4B3J8A3KEAxxC04RQ+wSn0f9BbgWN5WMeSauITEN6ZtITE",
+	"Xf+23aCtPbfD/P1YLseBzJtSfPYRuGLj4wLeu7LH84qIjDNsEr1ct1AKeab4e2RkuhCh6e7F/hG4Ksf2",
+	"bcZVoqRK67uVokJhOC+kR428oOqLL3oBp7fHfarvhfefzbxq/ypPox4JM/9ZsIu3L94eo2d57mSmWpJV",
+	"XdjURDlHjao0RVpknaKa5v8xmY6KtGnWaKrRuQZY8ZJmu2xK1RVO1fdx+HWqv3bzd02XQSwbiE0ViuTP",
+	"1Hg7mMJiTdSg+ngRf/Y6qs/tURx9uKLZVXuBTaaoW2o+H+dH9CNEi+mDkTCdRdQhz7Z4vwclp1PadmM7",
+	"0N1DorsHhMNdTXJI42o0rbQp2d3plCGMrv9dbqnGtp9Z2c673ZzctDnMjOxVYLBXPUzrsT1nsBo/KKux",
+	"PRQbiXvhgmpTrqhaGvNIO9K0azUOIYijixe+bI3nH+szd+SA3nGbR2pJsKQNlOVKT2V+C5HGcTaPSed/",
+	"VH3MpsgVBRKoqRj/+HbhwOkI53Eljlt7nHpwX448cM+fO0LHXlSeRKTUa1bx2u3IY5fpIsVtXPhh8eKJ",
+	"AmO68wHh/nEo+65t+8mGN+7upOZJ+fTV2anujyrT3txKTfH63l5tp60Ggt0n7VZ4VhekR4LhDQJbBHa5",
+	"6VOWxdPRDCqeLf0iKbGl5/0r6rEdFyd4yE7rfXoKP+y0U9ajooyRHJU83+/Vbrfcv+96zCFEC+nJ9HNt",
+	"PZardX7GAyZQ2VRNTl4TPGWGeBGJQLiz5eMFm+kfj/X/xEJSbD7v3QgW4LprVFbhGEWF3XvFFqRubQEa",
+	"NQyTaSHQJnV2Tm3BoiAYXBSTVqUKfeZmzJH1E01GyFCiiCCy4kySbQkmI+b4oSBEeZW8wGzPF+29qim9",
+	"1oAqrdsZj1VRNAxAJrW45vH6UQwvvHcfr3cXo4vmuRyx/2eVrmqDiz3hcBqe8rfUrnm8Fg49VBKuHB5A",
+	"50pQbwFWiT+ecGYLgHlm7AK1nnaX8ibU+mgGDDodwgph5Cwj22vitg+oLzO4kRUPmjpqXltoH75lUs6Q",
+	"4ZvbYlxB2TSkM5Zr7cLkKKFrn0MUfC2IvJ8jXFFG5dV+lqr+se86ptvRkdv3gDa/r30txJJ5Rkhz82ip",
+	"qBnTTaYTWWcZIZYjuuy1y92vAllJdAc9/y0YTpxY9Iqt+NZEMB/6pdWlxBs35uNFOjkxPPNlXuAyYLVT",
+	"+bezbYXe/usU1obQfqrLbAyFZ2+aC82pJN4eYu8Yn9nwy2Rd6XSzdfWdhsf4az9e+R64cx5128l7Y+il",
+	"YDXqAM+Ga3MnTjE2Ggy45xO5tlX9mhYFjSFnSybF6aaT40lti2tpqYnK63NXfWlcD1tq+vlGkdHTjEl+",
+	"DeB5FvanK3HgCmdUbb7SvZ747fUwzn+YRuedQrPmEa5XroKmE8JdZfFtNNDv+xxL8jNVVxqtTRXyNswb",
+	"k+h+w9qwFOtmvSbsjNy4Vyt2DXX6+vVFp8dginX7ONJ7cxTcHIi8ptWMV/ZinRmrFRGD9cj7RdjDLKGA",
+	"aeyYmCSCe6aTWhSO/08uf58OrHT7e3DpuZKK2JuO+DOOpUcyjxvHP8FoTH1lfy176Wpqp/0oMcMcvdUa",
+	"zOnr10Yl84FbUyQpy0hbVwoSjX4lRLezgxIlaCaH0rqlV5EEr9dWGdWTWQvmvKUTVWXZv+rHIpZFgePf",
+	"OqVUbzvYDRF0tbn46Typ89pP3qWtOCJM1oKgi5/Oj87Pf0Kmt3/lJZ3HPoLptBjHgQwowXjSFr9n9mVH",
+	"/06RBVz7PUhfjtyKHi/enNvPzix6Z560nMlZgZekMBxexoKNRpVZRCR3c+aNRer4t1sOcifsbQRq2JpZ",
+	"RrGUd3o37df99PXrkTu0ntw74OMvSEHuYufjl56bGVtLT65Mj9iTqDRP6/2IK/o3smknIeOKXpPNneFy",
+	"uqBE+PUALiuJ6Kw8LymbTO+KYhKi3enr1wn5pyLZWE56PuB2PnEFPW6oKUkfhzEl7kqEw1dTsrwigvKc",
+	"Zii7Itn1YLWQGydqd4xjttg2v54a/ynOruzrkqqQxoanf8a1uuLCuFUfKVOv9Zqwpk6KIDf8muSPp7r8",
+	"ORX9Vu7nx4gLRPSgLR7Krw9AAw2AE73tdB06/auHlYGO1V1D3HRcsj7rH8LoYgN6GcESeshezmvzbpff",
+	"zW1z//uoZx7lvyNOfa8c2nqLd7M56b1xo0wC/f4p0TXI072xd0q9oWuwNu9p2HsxaLdzUU3OmL/FYWVe",
+	"RsqXg3FPBt1f60Yvnofxk3UsSvOa0vZxNuf/56fto0SPg28d6tS22zFeCs8D1E8Fv6GSckbZ+q98eYBR",
+	"tYpGmlps1PhpvBklv9H/jhxlw4dxK1vqlkjFLVJhCNJzWndQZPxWQnEqkyzd/Hx5N8GKPWPqnlZUPQKp",
+	"bmEbjk/9XJFqvKNlEoNsGgy3dh2Xe2DaXlxoEF23MZS47VnzDPUe6O16GY9XjN7jELmFeMNhGfEAA+jJ",
+	"xXgINWxUwwZfk7cfGBHyiiZqrfv695g14W5hOeHpbPsMrXmm/aWN9x5X2a2NL1zsgR8GJw/x70jK1gVB",
+	"Gi070Qrtg/xvvuyd265on1Gp9oGVWL/YZDqhzC8xTd7TiZZyqjHukniFfs6tsP0/NVf4Xbo0ofnWBRJm",
+	"ees5TBleK+uibBt2/9SDJcoa23cxuxg/R89QSaU+LPt0pnnzRzaPOvnp/fNappENXk2+qNl/ItMOa94n",
+	"aZwP6J81ZspakvvBXqlKswnd47V7cbx5fmGomHDyBYbONLeaYGBkKq8ToRdUXt8CGs3DnMmXNvcdcIy2",
+	"2MZaWy/RYe7h3o7Bwx13Qmlofwr/ScsREjbeHi1aTWL5g7u/3OdQvJvGcpPuyiz9+xPbypPuJy7Fc/5h",
+	"FrUseHadJLhTF8OATYlQx4YYsWb+JUEVEVquI7l/BcZWt7dLcOGb5gnwG8POR0k2DgoXWF4PFfA9VIZw",
+	"V9CzTPUyxw9YWZ1KVRkx3h6i5ij08Xfq7aWFOFOiQSnKxiDTp1VW7kLFuBeBJAbQsDjy9tWLk5OhQG0r",
+	"WSLdxr89JnbU1LI+qFeJ0H4zinlk3z2875q+SIGISlkT8e7sp4FxwmqsbbsP4oxXRA50dh/3in5qR1m4",
+	"PcbrDHOmoJxwD+8IZRahZfNLVeCM5MbVZ+yRc3RGVC0Yya0/cLlx3M+WLNOcMnQKRkxbvMz8U/p0G524",
+	"4vIbnP0z1IioxdrHvPrM0kwVsSXXNJldk418Px8fGznekthXyO0SI1rYqo6brabrl+5hT+9HRiXPmee6",
+	"uE5e60C2kGJ9Koh2zKVKzrsWg6ZsnzsdUqa7rzQHy/NApsR4OJOPWVHnJH/D82QEov7Z1drLo5cuo6zv",
+	"PypUaCWFs7E3bQteL6MFJO/dWy7MoHdiYTvNoCaSffRd2tqLDlr3x76TnQQs8HvsHoZfyk6Ua4Fw5MMO",
+	"4zHEvqu5V52bdN6KH2jnfoZyIRKNBopbnfIcNU2Ra/tZS1wt2B3mDC7YjqTBBbvn3LTPXeWqAeehaX4L",
+	"1s/zW7BWot+9Q/PuK10laGV3ld9EpwTBrLSorTZD8uOz1nd74C3pMVCpHwlJopR5XzonPnqdszi3S2+6",
+	"v5JtzpnIIUNle7b0YqIOTbXaRIoxGSi61y62t2OyF899ARV9e/Un0ReCh2PylbVM0CWRSLeLwNhwPJuO",
+	"5aereMJQV5n0fUHyF7XGs+bgX60ZDz+//EiyOi26aonSTUkE+kD1O29mTKR4+GCvZ8XNUp3oKbGicrVZ",
+	"sBakyEdN3K5ul09ostJteHDcvAJPlaH57IpzSRYMWyiYkW8oN0zTPsAtUMlFk3TZjG+l4aYblQtmHgUO",
+	"MPHnqMcJqV9rQSxHlnWpR/1A6PpKySmic80jNLQJzq6igUtClAlR9IuIj8jekCVhSqJHnt8tmONNU9+g",
+	"dz5JkE0RUdn88XTBtGBRK6LZbF1q+FFlvERs3QodJIWbmq8iCNu6cLkmwQVbTOwOFxN/I+kRXcUZs8kS",
+	"q+zKVwPmwiZL6c72y8tmff9bt1kw3euRfNzA9IqurzxIsTPktI9iHktvHVbDbCJM0zgGsCKiDCs0Z2Cj",
+	"4uzktNQ6KVXuFNGTBXukz9EW09NINePVY20XZ3VRjJiB8TCBG0jPKnkz1gAJEpYlowcNhCUpSKY0HRNR",
+	"ThGWkmdU31ENCNuAt9uZJ3Kb2geSmtFXXWjP3ELU5cZ8/aNEJqBQbjud4XGcGBD21qr/YEWYqa5PQTa2",
+	"RAJmwS60YE7FtYSuAXBNNqaVk316W78mmzT3Mlsw3YOfI6zJ2CyIkRC2uQNTGn1TbFCP/Uf3FJkG+hWt",
+	"7PtVkhhAB2nt77igeZzoJAh6xaboDVf6Py+1T1BO0QtO5BuuzJ9z9KOy0Pkp/cy7HTxJNUZOt7kxjSQm",
+	"TWZZq1oJlbriARduHZZj28ZujLKWRnJinM0MLSYHsevXA8U72Dbe8Fg/Kj3OT+5db9t5waLeV/iGNBZD",
+	"x+emrhiLuaaWxArVlSCakrCpReI8Cb7Ilh2QysaYkhs+bMVXrMiaZqgkwhYxy67m45XMTuUZTXXd0jMd",
+	"DcrGMwacu9xVH2bEDFPLEX7QXP9wZuCq8QAzAGYAzODLYwa3Ko5lJY0+Sv1sfu+JKobdeB2/LbNo1nDu",
+	"aO3CyDkuvEFgtibo6Uw/+hdHslKm4tf/ghO4A6lIvgrLvRveOSSbj9WdHCoHSb7FVge0H8MHGFeoJAph",
+	"tWCxJEpLMvW6nsVrZ9JwjYy3wEnxGtzaxHGbNWQES+K8sCVRC4YVkrx0b7F4stCLIH736BGZr+cor00/",
+	"zJyV5bFdr9xIRUpr0NIaG96YlSux0a2JtpLUuCg2iNzQTIUtGjMPVVYFTivQMUbJFGu2R6hF/PRdp3RH",
+	"5znR/zQH8PZsu0pi1QUunGbSHzGhMNg5WvDnK8MPrVL07M0LY5TSrS54xQu+3sS7s4/EaI3G9da639Jd",
+	"KxpibzrgAPUAJAKQCEAiAPUAmAEwA2AG96EeHLiNvgR3uf8qki8c8HyMa0ULmcOeFSvSZnxW8MyG3VDb",
+	"xSkuEpdWzp6iXzkj1jqvkcfIyraQccXzR/LxY/DMgGfm7j0zV1jaA7asbNhRE5GDJrN78dPoM3VHojcV",
+	"Qd2uK0fWZkDy0/Zq7NbtFYfznOSoImJmT5GjFWV5YiHILb5PV+3Bt6uELfo/1PlihAfPzZLSlG6A/lkT",
+	"sUHmvdFw7Xv0k84oQiXKsHSOY6PEG4eV1jqn9nMXhv7szZoZ19/lbRTAbgsrmHk50O4gKQgm1NtGq90m",
+	"Ew6PeYBQaBprYj5QKNSdHC+6F9kwrFfcm5BoNt2SE/eRDe3vrpL7FyMljhbYFuzLV99+MkaYbeV/t1fY",
+	"jkexJFdik8b3m6YsA+bfUYWpkJplOik6/ubEoWgYbemr9FgaADe4IEw5s6C79/TwXVajJXIuLaHa25BK",
+	"tNCAW0ym9saKkWMxecX0B58718KHwCZM9PTCovFisotJ7SpgPOoZlwCGv5FNMs8s/u55nIGIvo4CmzFi",
+	"m+Uw7n63Vz0tigVbEqSTTY2SwvVuJc1dmrDdoxlA780kEiqOCs71k9wOSj6AbsGolli8OddMLjWw3UHM",
+	"THv3uxnP0IsPG29dee8Rlui94ZgMPTIdH79fsGYXVojjtUGukAEbCTBhg2jL/qykp8zzK83S/2gl80eY",
+	"Kfo43OlzZGBsGHbOdRSzmdZjrB9gwZrNh/mplcMtOEMdagMOKh2jsdZaowe4m2LFxZLmOWEa5mGyJfe+",
+	"kebgMXNTevjNF+xZIfm02zALkYuSaFQgrN0PUal3Jom6WwY2nZRU7sTmbpOvEqEZV4DTSZymcjxaU/lg",
+	"MDtkUO0lr1uZr1ucMIiDxvETiYIWkuZXKt2H3OtyNYsSgKLRLF51Ve8F89ccZyQumt7pbRrPF8z4pxrx",
+	"lOVdj1XTRY/lEsEXE2/i+GNUf3kx0Ufoo/DCoI9++/1xK/KuGRMUD1A8QPEAxQMUj0+peGx7ZCC+YJxx",
+	"1+boYEWzxs3nW8UF1O/sZosvrYF7Lb78ele0v9YGL7FwzfW67rrf7li6UC58429pP6NdQvRKYHAxaGHP",
+	"iXmmCiHjqv2RKTprWjTv1Wgh08deLVi4NRpBynksgmG/gZ3GfiJai6AyFLTFErkqWYibqm48Jwtm6cUK",
+	"jnwV3VJmReaqakAQ2aXtM2CYuZAZzpyQrH+x4yxYwAGzKRrmny/YS3Ps8dCuUI2rDz3ibfumb5ITDoW7",
+	"fdg73K1jh55qxeROwt3a40LM24OJeYu03Tj4bcFs9Bs6KPhtwX6+IgaBBLFqa10oWjX+bDkNb2pKH7Ih",
+	"Ozipp8PZ1YJ1kMgMaBzg0pCedanZh6FMTJyXcqzrkG4VrP2jU7ERQKJHmuGYwuZckjbdtDiVE53pTXjn",
+	"dk1vCGv4lfam+oupy0gXLGJie3PSqeZr+3FC1GaEEedtOOGifvLkuyxiPOYHspsrat+q3p73XUbQbLgi",
+	"eKFAGQRlEJRBUAZBGQQvFHihwAsFXijwQoEXCrxQoHiA4gGKBygeoHiAFwq8UOCF+oK8UAenbrkMKKbo",
+	"6Cyo+EyHUqHwDac5qmrl0lm+wnSoFhggJ2p0TtQQ3CAxChKjwCUFmiFohqAZgmYILilwSYH5HlxS4JIC",
+	"lxS4pMAlBYoHKB6geIDiAYoHuKTAJQUuKUiM+uoTo2JE/azZUfsvBFKkIEUKUqTAHwVqIaiFoBaCWgj+",
+	"KPBHgT8K/FHgjwJ/FPijwB8FigcoHqB4gOIBigf4o8AfBf6oh50ilUyaEvxjAhNO9c/+lvenqjnIiq5r",
+	"qxggrxe8eI5s8ypp2NXgHJOTpdtteZrKz1bxHJ6Wgqel7j6Dajhlqnsp30vOVNBiQuMYwK0Xds0ZGAp2",
+	"ThVaVgXNqHKniJ4s2CN9jtY1o5FqxqvHWlIxd9DuGZo3fJEbSM8qeTPWAAmaR6l3PoN5aHoVvOoLD3nC",
+	"Q57wkCe86gvMAJgBMIPDX/UdCvb7ee9gv+4Dv1N0R8F+jXwFBdAfSgF01grqQzamb8EOCupLKtDtJ6O3",
+	"FjJI33UmZM/qiuaf5gDenu3wQ3SMWr0REwpDwpzoYuDKyK5orXQXzuQR7w5p/DQajeuNkayX7lrREHvT",
+	"AQeoByARgEQAEgGoB8AMgBkAM7gP9eDAbfQluMv9VzFU8m5subsdle6Cj+3rrHIHnpkv1zMDte2gth3k",
+	"EkFIH4T0QUgfhPRBLhHkEkEuEeQSQS4R5BJBLhHkEoHiAYoHKB6geEAuEeQSQS4R5BJBbTuIeYOKdlDR",
+	"DiragRcKlEFQBkEZBGUQvFDghQIvFHihwAsFXijwQoEXChQPUDxA8QDFAxQP8EKBFwq8UF9qRTubAcUU",
+	"HZ0FFZ/pUCoUvuE0R1WtXDrLV5gO1QID5ESNzokaghskRkFiFLikQDMEzRA0Q9AMwSUFLikw34NLClxS",
+	"4JIClxS4pEDxAMUDFA9QPEDxAJcUuKTAJQWJUV99YlSMqJ81O2r/hUCKFKRIQYoU+KNALQS1ENRCUAvB",
+	"HwX+KPBHgT8K/FHgjwJ/FPijQPEAxQMUD1A8QPEAfxT4o8Af9bBTpMb8Mp1UssyXfdw4PX/94rm/9/05",
+	"a56youvaqgrIawq27YvnKCtqqYhISBa24zkRNyQhApxEX0fO+eI5sr2Q61Ylzcz6cMdkiOl2Wx7K8rNW",
+	"PIeHruChq7vP5xpO4OqKCPeSwRV0qtA4BnDrvV9zBoZ7OBcPLauCZlS5U0RPFuyRPkfrKNJINePVYy03",
+	"mRtx9wzNi8LIDaRnlbwZa4AEzRPZOx/lPDTZC94YhmdF4VlReFYU3hgGZgDMAJjB4W8MD4Ue/rx36GH3",
+	"ueEpuqPQw0a+gnLsD6UcO2uFGCIbYbhgB4UYJhXo9gPWW8sqpO86E0BodUXzT3MAb892eEU6JrbeiAmF",
+	"IWHcdBF5ZWTltDbDC2eAiXeHNH4ajcb1xkjWS3etaIi96YAD1AOQCEAiAIkA1ANgBsAMgBnch3pw4Db6",
+	"Etzl/qsYKsA3tvjejrp7weP3ddbcA8/Ml+uZgUp7UGkPMpsgwBACDCHAEAIMIbMJMpsgswkymyCzCTKb",
+	"ILMJMptA8QDFAxQPUDwgswkymyCzCTKboNIexLxBfT2orwf19cALBcogKIOgDIIyCF4o8EKBFwq8UOCF",
+	"Ai8UeKHACwWKBygeoHiA4gGKB3ihwAsFXqgvtb6ezYBiio7OgorPdCgVCt9wmqOqVi6d5StMh2qBAXKi",
+	"RudEDcENEqMgMQpcUqAZgmYImiFohuCSApcUmO/BJQUuKXBJgUsKXFKgeIDiAYoHKB6geIBLClxS4JKC",
+	"xKivPjEqRtTPmh21/0IgRQpSpCBFCvxRoBaCWghqIaiF4I8CfxT4o8AfBf4o8EeBPwr8UaB4gOIBigco",
+	"HqB4gD8K/FHgj3rYKVK/J0YlbE1Z4p3+l+Z3f8/7c9U8ZEXXtVUNkNcMXjxHrn2VtO1qiI5Jy9LttrxO",
+	"5aereA6vS8HrUnefRDWcNdW9l+8lbSooMqFxDODWI7vmDAwRO78KLauCZlS5U0RPFuyRPkfrndFINePV",
+	"Yy2smGto9wzNM77IDaRnlbwZa4AEzbvUO1/CPDTDCh72hbc84S1PeMsTHvYFZgDMAJjB4Q/7DsX7/bx3",
+	"vF/3jd8puqN4v0a+ghroD6UGOmvF9SEb1rdgB8X1JRXo9qvRW2sZpO86E7VndUXzT3MAb892uCI6dq3e",
+	"iAmFIWFRdGFwZWRatIa6C2f1iHeHNH4ajcb1xkjWS3etaIi96YAD1AOQCEAiAIkA1ANgBsAMgBnch3pw",
+	"4Db6Etzl/qsYqno3tuLdjmJ3wc32dRa6A8/Ml+uZgfJ2UN4O0okgqg+i+iCqD6L6IJ0I0okgnQjSiSCd",
+	"CNKJIJ0I0olA8QDFAxQPUDwgnQjSiSCdCNKJoLwdxLxBUTsoagdF7cALBcogKIOgDIIyCF4o8EKBFwq8",
+	"UOCFAi8UeKHACwWKBygeoHiA4gGKB3ihwAsFXqgvtaidzYBiio7OgorPdCgVCt9wmqOqVi6d5StMh2qB",
+	"AXKiRudEDcENEqMgMQpcUqAZgmYImiFohuCSApcUmO/BJQUuKXBJgUsKXFKgeIDiAYoHKB6geIBLClxS",
+	"4JKCxKivPjEqRtTPmh21/0IgRQpSpCBFCvxRoBaCWghqIaiF4I8CfxT4o8AfBf4o8EeBPwr8UaB4gOIB",
+	"igcoHqB4gD8K/FHgj3rYKVLJpCnBPyYw4VT/7G95f6qag6zouraKAfJ6wYvnyDavkoZdDc4xOVm63Zan",
+	"qfxsFc/haSl4WuruM6iGU6a6l/K95EwFLSY0jgHcemHXnIGhYOdUoWVV0Iwqd4royYI90udoXTMaqWa8",
+	"eqwlFXMH7Z6hecMXuYH0rJI3Yw2QoHmUeuczmIemV8GrvvCQJzzkCQ95wqu+wAyAGQAzOPxV36Fgv5/3",
+	"DvbrPvA7RXcU7NfIV1AA/aEUQGetoD5kY/oW7KCgvqQC3X4yemshg/RdZ0L2rK5o/mkO4O3ZDj9Ex6jV",
+	"GzGhMCTMiS4GrozsitZKd+FMHvHukMZPo9G43hjJeumuFQ2xNx1wgHoAEgFIBCARgHoAzACYATCD+1AP",
+	"DtxGX4K73H8VQyXvxpa721HpLvjYvs4qd+CZ+XI9M1DbDmrbQS4RhPRBSB+E9EFIH+QSQS4R5BJBLhHk",
+	"EkEuEeQSQS4RKB6geIDiAYoH5BJBLhHkEkEuEdS2g5g3qGgHFe2goh14oUAZBGUQlEFQBsELBV4o8EKB",
+	"Fwq8UOCFAi8UeKFA8QDFAxQPUDxA8QAvFHihwAv1pVa0sxlQTNHRWVDxmQ6lQuEbTnNU1cqls3yF6VAt",
+	"MEBO1OicqCG4QWIUJEaBSwo0Q9AMQTMEzRBcUuCSAvM9uKTAJQUuKXBJgUsKFA9QPEDxAMUDFA9wSYFL",
+	"ClxSkBj11SdGxYj6WbOj9l8IpEhBihSkSIE/CtRCUAtBLQS1EPxR4I8CfxT4o8AfBf4o8EeBPwoUD1A8",
+	"QPEAxQMUD/BHgT8K/FEPO0VqzC/TSfUx62PG6f9z4u98f8aan6zourZqAvJagm754jnKiloqIhIyBWFr",
+	"ykh/ipfm95GzvHiOXPsqaU3WZzgmEUy32/Ielp+u4jm8ZwXvWd192tZwnlZXEriXRK2gOoXGMYBbz/qa",
+	"MzBMwnlyaFkVNKPKnSJ6smCP9Dlaf5BGqhmvHmvxyFx8u2doHg5GbiA9q+TNWAMkaF7C3vn25qE5XfCU",
+	"MLweCq+Hwuuh8JQwMANgBsAMDn9KeCjC8Oe9Iwy7rwpP0R1FGDbyFVRdfyhV11krkhDZQMIFOyiSMKlA",
+	"t9+p3lo9IX3XmThBqyuaf5oDeHu2w/nRsaT1RkwoDAkbpgu8KyNjpjUNXjg7S7w7pPHTaDSuN0ayXrpr",
+	"RUPsTQccoB6ARAASAUgEoB4AMwBmAMzgPtSDA7fRl+Au91/FUJ29sTX2dpTXC469r7O0HnhmvlzPDBTU",
+	"g4J6kMAEcYQQRwhxhBBHCAlMkMAECUyQwAQJTJDABAlMkMAEigcoHqB4gOIBCUyQwAQJTJDABAX1IOYN",
+	"yuhBGT0oowdeKFAGQRkEZRCUQfBCgRcKvFDghQIvFHihwAsFXihQPEDxAMUDFA9QPMALBV4o8EJ9qWX0",
+	"bAYUU3R0FlR8pkOpUPiG0xxVtXLpLF9hOlQLDJATNTonaghukBgFiVHgkgLNEDRD0AxBMwSXFLikwHwP",
+	"LilwSYFLClxS4JICxQMUD1A8QPEAxQNcUuCSApcUJEZ99YlRMaJ+1uyo/RcCKVKQIgUpUuCPArUQ1EJQ",
+	"C0EtBH8U+KPAHwX+KPBHgT8K/FHgjwLFAxQPUDxA8QDFA/xR4I8Cf9TDTpFKJk0J/jGBCaf6Z3/L+1PV",
+	"HGRF17VVDJDXC148R7Z5lTTsanCOycnS7bY8TeVnq3gOT0vB01J3n0E1nDLVvZTvJWcqaDGhcQzg1gu7",
+	"5gwMBTunCi2rgmZUuVNETxbskT5H65rRSDXj1WMtqZg7aPcMzRu+yA2kZ5W8GWuABM2j1DufwTw0vQpe",
+	"9YWHPOEhT3jIE171BWYAzACYweGv+g4F+/28d7Bf94HfKbqjYL9GvoIC6A+lADprBfUhG9O3YAcF9SUV",
+	"6PaT0VsLGaTvOhOyZ3VF809zAG/PdvghOkat3ogJhSFhTnQxcGVkV7RWugtn8oh3hzR+Go3G9cZI1kt3",
+	"rWiIvemAA9QDkAhAIgCJANQDYAbADIAZ3Id6cOA2+hLc5f6rGCp5N7bc3Y5Kd8HH9nVWuQPPzJfrmYHa",
+	"dlDbDnKJIKQPQvogpA9C+iCXCHKJIJcIcokglwhyiSCXCHKJQPEAxQMUD1A8IJcIcokglwhyiaC2HcS8",
+	"QUU7qGgHFe3ACwXKICiDoAyCMgheKPBCgRcKvFDghQIvFHihwAsFigcoHqB4gOIBigd4ocALBV6oL7Wi",
+	"nc2AYoqOzoKKz3QoFQrfcJqjqlYuneUrTIdqgQFyokbnRA3BDRKjIDEKXFKgGYJmCJohaIbgkgKXFJjv",
+	"wSUFLilwSYFLClxSoHiA4gGKBygeoHiASwpcUuCSgsSorz4xquUo+ZzZUfsvBFKkIEUKUqTAHwVqIaiF",
+	"oBaCWgj+KPBHgT8K/FHgjwJ/FPijwB8FigcoHqB4gOIBigf4o8AfBf6oh50idbtfphPC1pSRC/NzF2Ve",
+	"hm96w7qrhtaL58h2ahnlC5ptUIaZxquGMDVkCKtL49H6mGkZhEu1FkT+s9B/yDJfTi53QS9aYwp4UmFV",
+	"O+ZjVAv9T8reSTI5XuFCkt4FcMrzxuV1atZ+bgZx+OdSk5aSiBuSG3Zltp7o15er3MzRaswiumt4pZvZ",
+	"62dV4LUFJmU5zYwE5/J/HGCptPrncmNw9sVzlBW1VEREqLfkvCCYaYgUWKq3bvU/Eua0vf4B/5Rs5wVA",
+	"k4kjSEaYQuvmawCL1R2pHAJL7PL88/dpl+cIDE2M/hOVCeftQEMny9kBO0K1d6A1KWyNJh2nkpljoCkp",
+	"Glf070TIJHifnb5y31p4dWN/I3aGEofcsCATO0CvmnXP0bkGupCefWec3RBhzoevGf01jCb9fVjYVDrj",
+	"5WO4sGzTig/aIymIgUfNohG8fPuaG/fgih+jK6UqeXx0tKZqfv3vck75UcbLstY3wZGGo6DLWnEhj3Jy",
+	"Q4ojSdczLLIrqkimakGOcEVnZrFMmczAMv9DcDulBPNwIYZ//Jsgq8nx5A964oozwpQ8cns9Spx5j5/+",
+	"Pp1cU5b3z+dvlOVO54rk++YYvL/y7OX5RfCV2aNy2BSayuaANHApM6maV7SxECHCcutZ1n9kBSVM6SeP",
+	"S6okcimJRshBJ8E8Yb3K+VxrFye4JMUJluTej0cDT840yJIHVBKFc6xwJLTsSb6ngtxQ8iGVsye1ZcgT",
+	"oz6OhhSSNLlBeK0p2UG1FkKD1bjCe6TaoM/t0OvEf/frTyBa+zptw07f62turvKZvKbVjFdWeZkZvCBi",
+	"cqxETbbcftN4D5d7AfvMYliSayagqjiq3C67YNwmMbzACi+xJEFC0DLDo+pjNkXmrkdcoEYCcM+px215",
+	"8tqjKyt6T6YT8hGXVaF3beWJ24E40lr6m3jjP/nV5H5X7tJtYlSaiBOTV67FUF6rvl2tkZY9r2fNJMLn",
+	"0Ubdoh3fdocWhrdkoLazhkQaPu5y2npf3n7xO/nIWV2QiIu0EbS12t8ijPFS+LyRrjXD1On9xndUyxnB",
+	"Us2e4scHwN3rQ294nljPxG0CLwuSyNu3oWN1Qcarhi120ZuNKORt/BZHfVtvHGpOzaK1rWxg22JBXCo2",
+	"Ya3r2sua46Fi90f1ttOrpCuEFSr0ASB9ILIDpxCKJGMY3Xo9Ksm/3jLiQ6l8mMs0DvGz/KtdiiLmSXHH",
+	"A1BoKDzRZta72zCkyLdB4aXrW942yt4z4ae22tLF7va5pm8kqd5Va4FzcoHltb3hd938+oqY1bYXUlhe",
+	"RyF4GpvD69ddztx3ABAp8ZokqciodfZGcxoqMVFzmvTYqeD6kjJbrLOMkNwAY4VpYf6hQVqRPKG8Tid6",
+	"xbv4bgSTvrVA/+jXNwKoshWomTaKJnXQUyxwSRQRchDysgF9D7hLjQvn9Ne2tvu0O8ubulwSc8l1j0t6",
+	"CVeTPjbxnxrFKKOlPpGnfZVxOvFjyC3SRxhecbd8W+TCHbDb2IoLY4XnJVUmBlLfwf0lGttSuycWJNgM",
+	"5wu2F6/+gKn6gYszgvNNC26aGrug+xnThn/3l2YYgTkFG8ea8ZIgoUdGS7LihntzjbumnoW32jHyUdle",
+	"CePB7yPQ7a98mZAjHcGMwSREjU0viGBdxNIMVF6R/JnhFMGOoFFl5pCkB+eWQNf7Kgx/2UfwT7GtxHFK",
+	"hYXab6U9vtNEdiZYzWXqGtE9ZzdYmE3rIRJHpC1a5CwMPdTiPJpyqM0PbildRhWfoN1VA+kU3zonptZS",
+	"gnRf3hBBpELrgi9xgaRv2MUMTvPshLMVXe86wbevXpy4lt1lR4MkV6m4wGtyUmCZkt+irygPVacMyjfs",
+	"1N5SmWlk/Iemk/nZmp5PiZBUKsLU33lRl0R642m+YbikmYkPrwS/odZWNF+wBYvnduKZdiiGY8j/d3B+",
+	"eDL0M9ul4CzjIkSGq8yYPyhDb83mXxOF51q6T5i5tKXbrvTlxwqztMEr1QrJK/5BR6UQI1gm1qQ7oRvT",
+	"CxHdLU9bNWPDQ/dMMMuxyJ1Z5o8S+bb3biwJixply4wP8BVb8UH08rC5wdSKwM6TMiTnjDufn6NDuHFo",
+	"l8QTh4v2NAxr6B9HZGwfnqU9bGTGTl9oXoHG7Y7J+ZlTTnv8taEaMXAXZAWm5WnQj28rplsQPqdGNHjN",
+	"87uz+Zi9tXcyTZ9xcw6XI/DNG85H3YHdzqnrz7U5I5L+Sk6uSHbdx4cfCJZ0SQujP5mkA/qrN636YzaS",
+	"zE5pfgjL+8jhLIFeOO3hwMqsqSDp3oJgmaYfp6Cb7XovYBjr9gqfs/+SfHDBcsyl1Fw4w2Yrhy5Gx6bp",
+	"hqbgHOOMHGLAidF5AHPjQ+rCIDqiFGK/M3rDc5xd15XbvFFm5J4akB0hoGFzg/dxL8uIlM613jse5wl+",
+	"04mFqAQxru20dP9TN/5Beo8yUlxzSmsqXLbWuJeysayza6LSZrwLc53wOg+7t62PnHeMCOTY0PZwmhR1",
+	"cZGRU6yuztVmmMbWQ90lyQRRQ6CuRZH8/YYIutpc/HSemu/3JA4ZSbdvQHR4OejXu4hcDSFCx3n1hlST",
+	"YTNqHAQ2SVoTxJpsX4zR6NwCukMaVPJqGLfaat/dPgSc0wLva1R4G8Lz/LRVgfvGA6dRP8uUD8wcdSm1",
+	"7CZ9hHdT7j1ef6wdQHlW6csZFwOBNozPeOW9hF7sURwpQddrJxuFE/JwamvFraPqreHC2ZjGm7t2Y2Hi",
+	"/u2N4o7NT9+xAURWkkGzRRQSYkwVk+mEcXXm/imI0agn4ShtEEo6SKQPHL37Z+u1IGunY/fMjNbzjMJt",
+	"YxziIbiifxdyYT4kTUO9A9PEaMY6OX1nBi5JycXGBvxTqa2W/tItiRI0k+jZ6Sv0AUvEiEnN9AJ/PFhO",
+	"5bUPyjAz2Z85awnyglRcqEa61k6Ogig5jWemastk/qJxso0JJbxm/AMLZ2lbSBe46Bt2Zw6A8hhuUblr",
+	"PNRDPd8osptG9alqQ4S50uwSUglOH7XZELFgbvRNU2bErKrPPAq8pkVB91tFVtXv5K166sMMM++/fd39",
+	"nbxNz7twTU8nV7wWCeA3Nl7TAGX8hogGI6RxkMg4KCjndUtmtsdm9XxNMwfAyA5wOyjtzyXnyMYHehpp",
+	"PLvYMyI5vytP9fZAudgeZw8qiegDIE7i5rRFqA35XQ5x3zPDDPZkvYaRKlq6shk9ZuFhfuI47/jrvX0h",
+	"JCQGHZ9zCyvzXS7BU8cWqqr1IJ6MQj6XwbcW2PqMTvGxu+sgkwGM6d+ssAWAaf9YBrHCkFfKbqeoVPoa",
+	"NAaAEGPVpD2FiTva2A0RTtAZwVEqgq9HNlVcpYS6c1uQ3in/8SI19ClD2og4MzQ3RaZISKlpLeOC2F+t",
+	"HKHcX2MYYVeHdjt2u/ErTUOciBNBcsIUxYXsi4kVlvIDF3lav5JEDNjWfh+Y7JQII2NwlpiMMC1i5Gkt",
+	"sGr37Af/7VRxe1J7O3bKzp2C0qBG5X0RXqHSxuO+i6ouihNellQdcptWguvlvEmCew9DZLOVO7HXxMtq",
+	"Rp/Gm05BlHJjVscVLbEOEiBiM6+u1/oHOS+JwvObp3Nt9NCOhkQ0svsSeVW8dd3FyGyYuiKKZoEGXaGa",
+	"K3xDpoiyrKiN/lGEPLAbLCivJbIx4k4hM3k9gYx1vKYewMq73EaA/NZ4RKbIL+z3eSKmkCnK6oTI4L+Y",
+	"8V2qqRP9NYWZvzEqaEmVl+QbydWgPxJE1YIZzzTLo9jwKB9P3DhznXmIw4CqkepN8k9Is+UV/mdNQvzv",
+	"sklpplKaDy70x17PPow4ClvFys6YW7tUQW0rQZSg5IY0zmWXtxdW0sD9xELFZqWZXGTjA7Nj+UJJS+M9",
+	"NsY/DzK308x47WoXia73nV3pmy8Pb5GoK8wQRivyAZWU1Rpc5nA1y/MZyP7ofXC2Tb7y0LYJwbUMj8KE",
+	"k7SgDEnNhr9muPCQcpC2Z7miQipkSzFJMkU1K4iUaMNrux5BMkIDKBXXF7oJFcYMESH0dqwun8xeFKTE",
+	"VDt2XylSnvCaJS7XfhuvQzZ4Juul1MfNlEM5t3pzHC5HxtXrstQVJVIVNNpgSGd0v1oU8pZEn43PhYO1",
+	"TyS1Nay62B9W7hclUc2sIurTtuww/igKslKoZoakWO4DOkKEGxEUF/RXl9UfL9ScrpYwFEGPCDX4vyQZ",
+	"riVBVHkDf3ZVs2s9Em++GhCETFnpGj1u9uOqdjFu8bK7J7sRKg/ZiY8450VuxCXM0M3T+dM/oZybdetR",
+	"mjks7lOmiHbpGfNykGhSmPINkYqW5kmbb0wzo+4bOS3jhT4/s4gTE8ke8hL0vIIYRjo0ti25ZniEcH+Q",
+	"jzhTo3JGppMO9aakSkGZT6gxRBqiBi0b+aOMsiJiq2kT2G86Oy+oNw5lbqeKo5woLbjot4v0cdtOjtM4",
+	"jjRHfzf8wGfqKkFspFPgxNGQ+qwth0I1K3nu6tXh7NozF7vyOTrlVV3gyNhia83NkTagzfQVdu8u74wz",
+	"a/3ONjMzBC9mmOWzwM4Tkb/Gql+sfqIsYTb0X2wuxruzn7opGOFcRu1fR0q8eHl69vLk2cXLF+hvIdjX",
+	"UplUvEL6Fsdr3IzvEnAZejr/9onGYIIl6bAbKo0pm9lbc2mQm98Q3+2p7zYfZ2IfJS7ZvLQTzXPS+pP7",
+	"aGNFcuIkAcosJWnUxkvjUmcIV9SNh3RwUS1aQlOGJZEWn5tSg0L4PHvCMk29xL0O1Q3YIkWe9k2YTz3d",
+	"ydCXub+xlUL0GZjZpppCGC7tCVMl0V/P377psr7XeOOWTlDOLbOsuFQr+hEx7hKotEWGOUuDsphOtOyn",
+	"VQW7qV+J4DPKcvJREyz6wb5QpeUQXFUExzIFZ5m10EdlAczipa8H6d63usI3GpwdGM7RWyd6G/x8aUOG",
+	"5fGCIbQwWvVigmYRsoUfHSP1DqfmHTPd0Vwmvzy5nI8YwYokdvGEKaEh6IdYTNKpPsGd0K1icVWXmM0E",
+	"wbkR8KLP/qztPen+MECYI1uowC7PCaGO0A1nnBlRyBgCcN5KboxFn7Rv/hlyVLT3ol451t8uSOPucCMC",
+	"tMkpyNd3TuYviMK0kP+4+XaI1l2LVrWjxjeHGqq0FPb62f/r79rlJrpHbH6PYRhx9wTXiCQ8Tc1nBvoN",
+	"UWN0HmtWIc/xg569Ibog30iiGpHBXI22NpAnHldeyFaItYGtNmbBSJE+Rcg8AhhGt+qRkz+wlHXp+Atm",
+	"m6aVxzdzuJrv3ehiIlPEBapZToSfJKHjGSpPczfDe0PpDcuQvDLmjir10pwFmgem5cVzXT3EOJrir5Yb",
+	"+bOyY5LccZ5WAYFtNsi9r5qEocWUm0pDwXyKQN3l9ikQOI083muS3tOpm3pW/eUOJkVvmXvTs3Ipzhbm",
+	"OV2tiGjyNxuzdZhCJ5B+7nRMNhjcob8cDh/06EOj0VDZ5F6Y4a2O6ENXnd0mfzzAuZXYPFspIs5JxvV2",
+	"UmWlQ62IaWPdpgxJ28XHtTf+cxdi6kpaWFtEPkfnvHQM3mfkWutJnH1r+I+2pZtLvTAagSIIG80GzZxH",
+	"h8swkGrfXmHMK/4BFdxG1er4/rBKfO0D3brDj6oJPp3UNIH871696J7mfPCYwnkPHVUXf4+Pjtr5cTnP",
+	"5FEtiZita5qTo6BTCfmHmqaw8sBrcMv9Z7dmTTXuwtanpMOlW7XpXAtr0fLWJ0jev+/k/cwFwXadJ+u1",
+	"5Zz/eXFx6s9Gt21qSFjOM0VPtMXPGS9G0oi7aO/wDozkMCgecMfFAw7QKLwR35tqPP+f7ypTcDBaBKfF",
+	"QQrIh6tNZ+Uu/UJvbjH5wcqBi4nb6AGaCXrmJfWswMKV3WKW/BwUDfnp175zTqyZU/szhZYyabpkXlxn",
+	"J8GZW3GH1ApWWuo4RouJSTKSUuuiIt7pvaOjrEhmjFNu8SOuKhuAWguqNjpXu7RXxXOCBRHPanWl/zLI",
+	"ozstzc/NsHoPk9/1GDSZ3vEHpIewjgNbgfVZUcQUjLz3UceFOZ8ceq87ceGsH8fILiY8NHBNmPkneY+u",
+	"jOJsBTqMjIrjnAuUaeMVZTNFPipjgzC11Mw3JxTwpbPWLzfO//Ge2NVkqnBNBZFEvXfChPnD3ov2qzHD",
+	"CMqURDR4kGQmCGEunJEqkyh9SkTGGQ67tdQYORuPJ0/nT+ZPXDVJhis6OZ58N38y13dAhdWVOZUjF4Mz",
+	"89BeEzUQkanhufardd2sQumNfK2UEzKcfGN3EvD8VT45nvxIVGNndOEQr6zf2CvQZsHfPnni3YbEOm1M",
+	"sSyLDEf/7RiLg8YOzpWe0CBf9/411Leqi4Y6NWC/v8PFvBSCi9Tk75gcmP5Pn2L6V16CcoYP4hrqXMuy",
+	"xGIzOZ448HlHv8Jrk1PZwNdmPh75cJeZDaWTRy5EdVa5KOnt2NeEg6XDf/Uo4U39ThHDEjO8tpTpSMaQ",
+	"8A9cWGNIaKrpzgpYspXy3J1NTlufnc5jbYGhOKIvjbUseHZNhHTPxPQ7Otm7EuZxG9PA78qIMEti2pqY",
+	"aZ8y3SOgHwpCVBx3fo+005sLyGZvsvmRqBbu2qTXVh2biJpCWsDk8neTwmzReOZF45k1ZEy6RDbZSXlH",
+	"ghcFr9VuCmwRRidf3WlceiyNq35jcdUCO3lcfzgTXEa1fOQIzD5zi/1EyO2nA/w+CL8digWsGUTsistt",
+	"GGiSG4wJ41A8W7Am2cw+UGBHytH7En88aXy075viGi78xe1FKl7JlnNowcIUlqGvjAW7ySCatn36rcw1",
+	"QZArijJfmNqW7398eYHGke57mxtj/N0RbabIySbekORlYaTo5zzf3BkCdacJaT8JnHKR04YN2k16G8Ae",
+	"JxvX3mmyQ1uM4ttPzSjOAsKYWhcPgEd8/+Qv9z/9Mx8Q57ZvdfXAAR4SqzrXJ7MnV7mruzng7iykq2vN",
+	"eq/L2F2+8QDov/ky+PrCHE0T7SDw1YO4CKEYPm6P6DlS/EObcgLnPI0m/Ctfyvu8lYcm1QuC63l/nNdw",
+	"SyGGw50YvwPoUQz7Pe7r1uAYMfIhmrmvm0UONV9VfJAMgymmGZDKGM299UFHg2lHK2vfr2nyO/ot/P77",
+	"8OXanbi1TSpRTqWJn9dbc3UD5uiV4YSEhS+USYUt32ndfA4YCxag8X42s/1m6VW/t6Ww+apleHLjv9ej",
+	"x7/bmcj7FJWHc34TpUTdh4CQpGpfbDWB2W8aAYs38P6kt/4QI0otN27yr3r/64uofff3XkB6UIwxnFmc",
+	"zhynBo5gi8MywMB1PywJxKzIctqCKLKb55ob3V/mzQsS78No761tqbGHXaRqCk079TNssG3JGVXc+O0M",
+	"e2EZ6Z+q0WnscvM0m44CQO+JRfc42wtSpXhbVLnk+Jdt6asxIlD9URvTJz6OpJVC2mZJ0wh3e06UVCAH",
+	"UdMOPKlE16RSU+N38vH1JnKCiMQ16ldonkJrlnhNSBVvvVlW98WFfhWQ0ev0J0tuCHNJKC6iP1kGgA4t",
+	"1pRC2W+Rlw+E959pEODC0iOw/YfO9s1xkQN4/sG205T6Nsi8B0ylgwj6uVnc5WdQDb8gtfD7J9/f//Rv",
+	"eB/HVvq67RdfeHCW5GFNdaSiWo/QU41BZLfV0WlUjeAyRKFNLScXPY3zvHlxLTSdNsJaM3Q0nY0vkXVl",
+	"68SkZSlvzPmEspStIheA/jYyjT0AZnOPymqz0wRGh48akO48+1ffQ9VY7ZmC0PJlCC3utPoiS9JOfW8K",
+	"qxxvrsb9ZysHwjO2m54/ja15P+Ny62heuz0lvfn2tTibMTQW/h13gYyZ9pFV0mdeSd99Hq7Gg04rauv3",
+	"8yTcW6VCRzH41hNA/RqcX4Jc2d40OBoOcDR0kCwiBQtk5KA8xqmQCYIVkc6R0B7ZyEbffOPzQ7/5xmSI",
+	"vn//Xv/nN/0/CC1CcPNicux/bNJIdcCt/M6T0mIybTdwj8/qVo5kQ5Pfp34CWZGsM7hGXD94a9CmyKz9",
+	"bP9+2moTqufaJvbPf9injptWofCrm8f82WtlK8e6HdSzjDAlcDF7upjEu/g9wO1WAMS/1oLcIwzN+FvB",
+	"GMrwboWkW+E/cGbSs/9hd7AFpp32MXC7gOsx0hODuC2u8tA46d0LzYlNu1LTCX5y0dthqChhKgZY0s9H",
+	"SM/3dAvABXCL+GBzaH3M3XIDDItDXUFnvExkv43zodgGMkFxka7vUsKNyv9+nvA06DH2pvZ9Cf0wR8Nn",
+	"ldS+T+W6AS1toyWLVHvR0sicjhSaZ7SH514fXlPt2YnNXSlzNGD/J9dT4Ia6nYF5H5KqzOtvw0RlbbF7",
+	"XR/oLSvsD00LV9TDF//wGamDlligtnuWZYefTRkny5oDkfucNUi6XxIfcebYTy7pZrjCGVWbkEm3y4Ki",
+	"X5pqHpXDqBJco6KiN4nnjFZUmYp/PLyL4OrBNQmd1h8liIG68WvrXW1QALB9C7nAmXl4wRTv0/9x2RMf",
+	"uLgmIrznTNLvYS+Yed5HTn1hELMmp4m7KiEFz/Tyo8IdwctuR9dVTWWr9kdcUX25WbDw1jgu7Fug7n1J",
+	"t9hyjrp7xdE+Q2U23DwVrXeDmaIz/975gom6sOEwstKT+EQGmwpsn9lGOS8xZWH9uoud27MTKu1Jkrz9",
+	"jL/DhgVrKt22HriyD07IqXk0mm2mIYXXvJThu/t5w/NXdGWfswjn/b7qv37+PizX18HF10SiSpCM5ITF",
+	"xcn9W/mpJ7TM68wSKT61B+Kf3XJ9us7D+Kn75Hg5J9IWNjHFITjCbqhk9GuB2Yt2bfYTB5Sv1bXp96e3",
+	"3gq//XS3T7wEiNzoxBGn+KF9PU+7+B9W/GyBWZ8As4aAkgnio/Jm3GBb78Ju41n04s1ejoXeFuxAu2I+",
+	"Bqy+HX5iJYGvlZukNzsgIw/B+bMbfkfvYogzffvk6adfjEW3HDl+Zdfx7adfx7MsI9XDCCF5aJbwAYzv",
+	"KQp7ssXA6W7BHW9rHB8i3gEzh5E0d/BLa+J8mPxyus/LVg4WpqiY5mE21NJWS33tHKi/eKfppR8luXFf",
+	"Ce++TDM+jt+G7QfjDMlRXZl92VyRjqWmE6ufFQSzuupaoXrL2Baqf3eEumfBRLB23NYXsRc3G+mMuAe2",
+	"8iNRwFPukadcPmRJDEi2cXQ8JOlDj8wFuQPlzI10N9rZmR3sX0Q987sdq595UD80BW3LPj6DhrZlNZ9W",
+	"RduyENDRxutoIvAEzyY9YPfkk4Hn3YZR3pme5on4rhW1h8I695OqHDQOE6vOWnzxS5CrQEf6XDrSdm5y",
+	"Wy3pDoi6ryYBRX+5mtItRCKg3C2q0nay3Z5jHAeF3Qfl2uATIN5PQLxfhkrmYshAJdtfJVvVBfDC4TTj",
+	"B6ET7ZXkmqw61DYUhamGco872CS/6poqnc1C8usBya895IsIxsMZOUDvnwDbo8r9MDtpAP0XsXyOvl8f",
+	"mqnzgVyo427SYnPPFk4wbR5k2tzFje4pME8e/eavf93K52sedK07X5bc2w2UuN+fu+V8UarTYSrTdl0p",
+	"Pq2H7RoGaeUOpRVPU5/DQdzjEbHD+NZMwg9iX9zpfz/ACJPgI2d+ycBIviBG4k4NOMldchLRkMLnMBjc",
+	"mfP0rp2mwBoglBXctA/PTbtLM7qtn/ZO/bPAPL4ETyxQ5d24YHeaTkf5YO9W6E96XoEsH7iP9XbG3wfg",
+	"VAVWcmcezM9n+rTmjGabe7y+foMF5bVsik7IwUCKOxU0TprFAm/7AkSO6LyAY9xN/FcWk8Dn5RyC5IQp",
+	"iot9WEfUK7z5cc9MI1oncI0vgWuEAwOucVdco0UDd8Q2ZvGot+EgFVViD9ZxyilTM8pmF7QkSJCMmwpf",
+	"+gWDT8RKTvWCgYd8ATzEnBRwj1txjx209rnlDmc316PTX8mYd19MrblQazCuRneg1rJgmaUuu5bwrpYr",
+	"Tax/05zFme9RhnUBuCVB8krU7NpWvdMjcF2Nc0nQWvAP/rHKTsU8W3gQ3fCiLgkiHyvMJOUsGU+nK/J1",
+	"6MEt4czCDFjYnTl7zkLlRn9eGsLIlF9syouhf9aYKao2U0Tm6zn605Mf6YDjxx3Qw+CqLbQxeAVM9RZR",
+	"bxpwCSbjEEZ4ovykbNW+KXi7MBbX96AYt5du/n+FEHa7V4jkuItIDhLwpkcuFsxjqcUPtAexHNXVWuCc",
+	"hCrHYyinIiw3xXvde3jIDSLbDxnEIfIL9izPqR4OF8VmiqhCuJA88YSdHxxnujWiipTSVgBmxMojS4Iq",
+	"IlZclCRHC7YkKy6IkTzwShG/GjNGA2S/Vr8W+6rnzdP50/mTqXv4W5CMlyVh7j3RWhKk/M61Otbbr3vg",
+	"nRd5mJbo1tI9014JkpnAbb04X4Y8eqD95un82/mTtKL2zg5nKrd+zRwl3iewklupNx7zKosrnos0L6p+",
+	"Iv5xhCv9niUuRpQeCiwjcQ23ngXeknfzBRDyMwMR8uCI+T7ecQhbfObRIPmCv5naHEPDqLe+DT3WLwyM",
+	"Yz/vrcXybWD/fJxEc4+Z/0Vheb3Hc7jxE9uekgqsNNbFwyIzLCIfSVZbUWNIeEldz6dc+iv6Qo/zV758",
+	"GJR9T9d0ar9QUL59vnw7fllrj7frP8zHr7U4sXUTnrAGucOOe18qLJz3oxnUEYUX/e2cA8azISVjumB0",
+	"TuatkiAnZ38nQuoZ9L0dRI7UbePsnCVltKzL5p2RGztAeJ+jvxwsQgSTXtoSq+yqUYV0nPRa6JP3b5zI",
+	"ulC2l7XjEosT9nGXnqlwwd5Jgt7/+PIC3RUnfW82K3B23WKVKUb30hwR6RL/1yrDdPf50mNo8v2IAIGA",
+	"v1sIZ4QQ8+2nZtZhe5YqH0Ri7/dP/nL/0z9j3DhOtosDhoaDIOHo+WGybUeniQ19aimuSQfaN5DfMeK7",
+	"cW87w9mX4RYifrFfikvaQRfMNYfFsoRz32b3vUUBtMMpqR19/y9OTPcXNT9MRw87aB7o/65i5kexgLu5",
+	"qm2TWcbZiq5nipSVMYqMdfoYjc0yFjsECkNs95omfaZ2dydmoIuwlK/ZgJLaMfhPD/CfDiBjREsW5MjC",
+	"HHmg71UNjA1MsytQYMFedLi3tO9kEpYRhJtxPlB1ZS9nR+PzioiMMzzPeDlAs/oKZ1wZsDvPRXuVjkaa",
+	"xUpUErE2Bgpn6Eh26N44C/bhirDkJz1m5spSGVO+fVvZ2DhucFETiSRRiA701i+YRg+YDhdRS5HN12p+",
+	"SO41pbcnUfKTSgJjlwqcbFT5MDJ0oiNY2bB0MHTj7y8k3LbKxwDzHHqwf8GeNY0CuzSt+nbXzDBBLQrb",
+	"GfPh0iAPkolsVWcGEeJ+TATffzH+00/iyUkz2Af6NrBF8YNYyHi/6p4EnTLRATF+Vp0DvLZfMK1r8+Eh",
+	"hD7emLj3za0F/+wKszWxzk0NQAOlJonE3+jax9u/z2umaKHbbUx/wYtC6xa1GjZQAisBtQQY3tfM8Jy9",
+	"9AvRj4400+KWx+6wMFnWKIdtMeFgFDcxsslYmAXbZoeyYfON2Ske1nHsvqmmmbdtokE6XxKpZJ/eulIs",
+	"+8zCBnj2ZxX/3CmcmTAkYI1fMGvUJ6nFowfHHF3I3KziBc02+8Xtdn3Ybixkx/IUOWx1v7givq3elaCZ",
+	"2jqwSy1ySc+1bATbYV4bpjSedBkCWcgK14UKIw9EqNjDcHGJpxZEX7/fq71fMBQfovm1aeKg8BFBqkKT",
+	"8R3Q3lYV7QGi+33pSTsx/eXAKX5qLQlI8k51k72ocue127pD6fZrt+SMKq5xe0aZVJhl+yXBN/1R6K8l",
+	"e9xLhUmGcrwO3V+F2UdQuL1B+SpEyddVt4D4Q7/aEjuHiI4DIjpSiBgRUgPu/R90SwxtE0hTX3ygncMy",
+	"id5rrHrvAu8k0RbJ51jLitxKhP67ze+oSKboDUHXZGPDO6wMXVuwmzR22RrrvM6uEJZTXSfHDHWMqrJ8",
+	"P9UDMvRe/9sMFvfUGZZU57eaGXB7juFwij7KPjRavft7ub9nCwuTwCGHoi9fD+PF53uyLnF8wGxuG3SR",
+	"oPxhbjN8Yyev3z2v69vGV6SY15CLxoRmnb5+jRS/JiHnLjWCyaC/4dc2oU13cQW89D9xXlLWKnqKRcOO",
+	"5gMRGLfjO1sWeV/Vr3rs7vU+cz9gnmfPYZjnpc/WWEQUd/iA1EgUmk9+hxiT7dOn7pcHHWDSOWaGt7HL",
+	"kclf+/CvlDHtMLby+itnKyCEAG2PMCvuJQdVOr19ZAzJQdRtrSsgN3xuucGew3ZdqdylK/n0AFCWgE8d",
+	"Ymv9TCrbP2uu8H6OTNNlq8/EeA+dyciszknbXS/jfMGMwqo3zQWSGS70P93Li+0YuiXZcJZHC6DSHKip",
+	"tZyOkP+RqMC7/o/u807i9V5c9otzSab2C2aUvWmyufIsrtUOcTw5/kgYEbiwJda3E2RDd4YMKyJKKo0X",
+	"fTzVxSVEQ/dQbqmWJnoJK5O4VgtBmCo2qOBrmxZnrMHfvPyIy6ogx98s2DMp69JqvCsdM/NBE93Z82cn",
+	"zsNja53rYSV6jwvqKfr9ki/fHy/Y+/fvF6yaIsELcpyTm2lDJ3KKBMH5FH3TadFN552ib6bom6PBZp7u",
+	"W+2WfLm1yXqKzHKbEd1i9U2uAWrqG1qodrbfBazbt9/tbwuG0GIStVpMjtEv+lfk/6P/bzEx/RaTafxb",
+	"A57OBw2rzk/fLCb2z8vpyNG7oO0P2P776IApQsTI+Dn0fy4X7HcHyWcs3wX6GM3GA37Jl/e36mQZW0nE",
+	"abOuyX1Wku1MBSz9dtVkJRExukUc/VmtrghTbmFoUT958u2fkf6VC/qr+XFy+bvh4Dyf6RXltRZWGjf2",
+	"Hm7piueoGQL5Ibx8dN08RdDUSrNMrOEkXvDxVWCxD2bBgpipGnlLV4zFM0m02KNIvmDJhGw33qyZIk7G",
+	"njYTaLccrxWiCpV4E4LLKEOYbVrC3UV/8nQyuAswm624GJg/KtXQNNBzfrii2dWCqSY6jspudkZfmuQr",
+	"RJUMFfI2VXB6he3hcBu+/+Y9kgqzXC6YZlD6CKNFNB3CjzMnFmc+dG6owP4pz88DIoyLIXrRLe+nF2+u",
+	"/1Oeo2Y0dNoGR4aXBUGKzwfesrDDXWhhNZZeCatLTSDVx0yvTJa5qQbKpVoLIv9ZTC6nYx7eMFeul2LS",
+	"CzV7uMISYYUKgqVCT5GoCzK04Cssz+qCyNZyey+tH7CWQdTphEoK0iHGoRXH+sLnqnXawzeI6zggrmOA",
+	"k0cXSxK/9o/ySE20GQ6GSPOV+yks2Z9pwJaW3MPnjzwYuQOgh1GhB8lDHkUPwzr0kMi1RRw7qgS5oeTD",
+	"iHwlorN+gmUfr1aUUbUxV4/h9ngAcfEaUybtNeHU7gX7wMU1EYhx8wAAy3XfcGf0BbtGeKiqYuPrxQfq",
+	"XrCX1JTzfG9/emOq3Ok1MUQ+UqliOgqt3vcCsdAPoQxvOPYFaycBOTmCIE9oscjFfXHh0B1lvC5yVOg9",
+	"avHQ9sRSa2hGGrN1SB0ghK6JnBV17h8acO+7EZxdGUgjKpHEisoV1XLK3Oc75MOvVVMlSbFCOdcPtRlo",
+	"oA1RUyRdSq45P0kKkikH2HLBHpknvWSlNW/3qwkVpxl2hx12+Ngu22FH3jrvCPh6haxI8uJTi4OflRm7",
+	"NbjHCfZjzYojT0afmSG7XYCXoxPZkjy2h+nncEf4YC6I3+zMs9uFp6UJZriGwEDw2C30v9g/kZYK93uD",
+	"MbGE7e8wRnB7MH4PyufX/y7nuKIlzq4oI2Izr67X+gc5L4nC85un83OFVS3/cfMtiHe3DpW6PfWOjJs6",
+	"mLDMCyZAVaAZPbCXR25LN+MK2+DDCceFw/yr0c5DN4l8jmrYQPh3GdrzqSVe31bu8VZFhiucabOHeWz0",
+	"BtPCuAvCUJ42/5byTaXu4Kahexj5LKzqHhF3y6yAv/ub9CwMGyyIkLaBtPOLSmKcqqM0KcpucEHtzfXS",
+	"Yrj5/a8/X9gEjGGN6dxNc1ASxref4CWeC85RqT2iWClSVko+rGd1Iqj/xNe8Vns7w3d6MKiUdXBghKM1",
+	"MR46OMmGOqKV4KVhLdGSvPnPZ4Iax31ZS+0fvLFWyvcFX1P23jCuJS2o2uINiXHmHp4HlUScNIlFQ1e9",
+	"2UOUgHTnF3ol9N6Vi0UwsE7Gd/tfrJTxJZnU/mXJlmS1oGozOf7lcgsRU3argBZJlDZl7/kMqe/lBQO/",
+	"FhN1XBQ2WTslGJz76e5RDAhzjEbuLVCOFjwQ/2mgaJPGZ1mBpST7AtN2Rq5zJID5EJ7IXaR/oMIyR0k5",
+	"I2K6YN6hYouM6mgEdMMLHeZJPlaYhZci2+0EadV3ai1jKGTl3DY6cfu8z1OMZnrFVhwiFW5315+3sWub",
+	"EGcDnffC3ZPTd1NUkpKLzRTlVF4bPGuXUkDu3nXevx3FyCoiOpXI9C99/1/8WKqiJUECs7V3HdoXSE30",
+	"03otyNq48IKsYfaJpAmJlqYeJNOTUJ7TDBfFRq/OcTQ33snpO7MUu1M3ALW+v+a5U9rTkkqiBM28QNRQ",
+	"9nwoqBSvyZkZbpfZ5VxhoTz7jfaPXlhyNg7g756gHG90+sSKO2onLE/0GghZ0hBrRSutuCixsg80kZke",
+	"YDIiAOwly3etNHKjmzZDK1L8Dtbztjm1CB96+SkPNo4rRhPgiPsXmHQKrTt34ent8PwOV91qPxbqOnVF",
+	"Kd3MbiLFLFyRM30x3ucl7KbZT5IKgPa9h0WntuD12+Q5wYIILadqOUwTkQWB5YC1KCbHk6Obp5PfL8OY",
+	"PWajA13UldYvBSkM41e8y5edbUM2VN18nPw+HT9miMftj9j9dLtxX7oH8PrD2i8HrRadEam4iId3vxw2",
+	"7HNz/0ej2h/2GvR5txxTayjkxJrRQzapkc1QUV7l2GFwW7Ey9tKWVhUGH6OC9WeNCUSUtite8loNqlnN",
+	"jHHfQ5ANNQ8qh7Gbn8YOHPIaXNA8z2yq54vnQYYz4VOK2zCxZq60RXyfDQlSS6NAdUt/tqqJRVMOVBIe",
+	"jRW5CSfT2CBIyW98bFlzPWirAl5bwdedYjN7k3B46tU6g5OXv///AwAtvkr9rsgFAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -19,6 +19,7 @@ package config
 import (
 	"crypto/aes"
 	"path/filepath"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -60,6 +61,14 @@ type EverestConfig struct {
 	// TLSCertsPath contains the path to the directory with the TLS certificates.
 	// Setting this will enable HTTPS on ListenPort.
 	TLSCertsPath string `envconfig:"TLS_CERTS_PATH"`
+	// MonitoringCheckInterval is the interval between the connectivity checks of the monitoring instances.
+	MonitoringCheckInterval time.Duration `default:"5m" envconfig:"MONITORING_CHECK_INTERVAL"`
+	// UsageSampleInterval is the interval between the resource usage samples of the database clusters.
+	UsageSampleInterval time.Duration `default:"1h" envconfig:"USAGE_SAMPLE_INTERVAL"`
+	// UsageRetention is the duration the resource usage samples are kept for.
+	UsageRetention time.Duration `default:"9600h" envconfig:"USAGE_RETENTION"`
+	// StorageAutoscalingInterval is the interval between the storage autoscaling evaluations of the database clusters.
+	StorageAutoscalingInterval time.Duration `default:"5m" envconfig:"STORAGE_AUTOSCALING_INTERVAL"`
 }
 
 // ParseConfig parses env vars and fills EverestConfig.
@@ -70,6 +70,11 @@ func main() {
 		}
 	}()
 
+	go server.RunMonitoringCheckJob(tCtx)
+	go server.RunUsageSamplingJob(tCtx)
+	go server.RunStorageAutoscalingJob(tCtx)
+	go server.RunFleetUpgradeJob(tCtx)
+
 	if !c.DisableTelemetry {
 		// To prevent leaking test data to prod,
 		// the prod TelemetryURL is set for the release builds during the build time.
@@ -0,0 +1,71 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package commands ...
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/configbackup"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	backupConfigCmd = &cobra.Command{
+		Use:  "backup-config [flags]",
+		Args: cobra.NoArgs,
+		Long: "Save the Everest configuration to a file: the accounts, the settings, the RBAC policy, " +
+			"the database namespaces and their operators, the backup storages and the monitoring instances with their credentials, " +
+			"the pod scheduling policies and the database cluster specs. Use restore-config to restore it on another installation.",
+		Short:   "Save the Everest configuration to a file",
+		Example: fmt.Sprintf("everestctl backup-config --%s everest-config.yaml --%s", cli.FlagConfigBackupOutput, cli.FlagConfigBackupIncludeJWTKeys),
+		PreRun:  backupConfigPreRun,
+		Run:     backupConfigRun,
+	}
+	backupConfigCfg = &configbackup.BackupConfig{}
+)
+
+func init() {
+	rootCmd.AddCommand(backupConfigCmd)
+
+	// local command flags
+	backupConfigCmd.Flags().StringVar(&backupConfigCfg.Output, cli.FlagConfigBackupOutput, "everest-config.yaml", "Path of the file to save the configuration to")
+	backupConfigCmd.Flags().BoolVar(&backupConfigCfg.IncludeJWTKeys, cli.FlagConfigBackupIncludeJWTKeys, false, "Save the keys signing the user sessions, so that the sessions stay valid after the restore")
+}
+
+func backupConfigPreRun(_ *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	backupConfigCfg.Pretty = rootCmdFlags.Pretty
+	backupConfigCfg.KubeconfigPath = rootCmdFlags.KubeconfigPath
+}
+
+func backupConfigRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	op, err := configbackup.NewBackup(*backupConfigCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), backupConfigCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := op.Run(cmd.Context()); err != nil {
+		output.PrintError(err, logger.GetLogger(), backupConfigCfg.Pretty)
+		os.Exit(1)
+	}
+}
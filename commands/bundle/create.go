@@ -0,0 +1,92 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle holds commands for bundle command.
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/cli"
+	bundlecli "github.com/percona/everest/pkg/cli/bundle"
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	createCmd = &cobra.Command{
+		Use:  "create [flags]",
+		Args: cobra.NoArgs,
+		Long: "Download the Helm charts, the version metadata and the list of images of an Everest version into a bundle. " +
+			"Copy the bundle into the air-gapped environment, mirror the listed images into a private registry " +
+			"and use the bundle with the install, upgrade and namespaces commands.",
+		Short: "Create an offline bundle",
+		Example: fmt.Sprintf("everestctl bundle create --%s 1.5.0 && everestctl install --%s %s --%s registry.example.com",
+			cli.FlagVersion, cli.FlagBundle, bundlecli.DefaultOutput("1.5.0"), cli.FlagImageRegistry,
+		),
+		Run: createRun,
+	}
+	createCfg = bundlecli.CreateConfig{}
+)
+
+func init() {
+	createCmd.Flags().StringVar(&createCfg.Version, cli.FlagVersion, "", "Everest version to bundle")
+	_ = createCmd.MarkFlagRequired(cli.FlagVersion)
+	createCmd.Flags().StringVar(&createCfg.Output, cli.FlagBundleOutput, "", "Path of the bundle to create. Defaults to everest-bundle-<version>.tar.gz")
+	createCmd.Flags().StringVar(&createCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, "https://check.percona.com", "URL to retrieve version metadata information from")
+	createCmd.Flags().StringVar(&createCfg.RepoURL, helm.FlagRepository, helm.DefaultHelmRepoURL, "Helm chart repository to download the Everest charts from")
+}
+
+func createRun(cmd *cobra.Command, _ []string) {
+	pretty := !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	l := logger.GetLogger()
+	if pretty {
+		l = zap.NewNop().Sugar()
+	}
+	manifest, err := bundlecli.Create(cmd.Context(), createCfg, l)
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), pretty)
+		os.Exit(1)
+	}
+
+	if cmd.Flag(cli.FlagJSON).Changed {
+		if err := json.NewEncoder(os.Stdout).Encode(manifest); err != nil {
+			output.PrintError(err, logger.GetLogger(), pretty)
+			os.Exit(1)
+		}
+		return
+	}
+
+	out := createCfg.Output
+	if out == "" {
+		out = bundlecli.DefaultOutput(createCfg.Version)
+	}
+	_, _ = fmt.Fprint(os.Stdout, output.Success("Created bundle %s of Everest %s", out, manifest.Version))
+	_, _ = fmt.Fprintln(os.Stdout, "\nMirror the following images into your private registry:")
+	for _, image := range manifest.Images {
+		_, _ = fmt.Fprintf(os.Stdout, "  %s\n", image)
+	}
+}
+
+// GetCreateCmd returns the command to create a bundle.
+func GetCreateCmd() *cobra.Command {
+	return createCmd
+}
@@ -0,0 +1,78 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package commands ...
+package commands
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/doctor"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	doctorCmd = &cobra.Command{
+		Use:  "doctor [flags]",
+		Args: cobra.NoArgs,
+		Long: "Diagnose the Everest installation: the Everest deployments, the Helm releases, the CRDs, " +
+			"the database namespaces and their operators, the OLM leftovers of legacy installations, the catalog source, " +
+			"the TLS certificate, the RBAC policy, the OIDC provider and the access to the backup storages. " +
+			"The backup storages and the OIDC provider are accessed from the host running the command.",
+		Short:  "Diagnose the Everest installation",
+		PreRun: doctorPreRun,
+		Run:    doctorRun,
+	}
+	doctorCfg = &doctor.Config{}
+)
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
+
+func doctorPreRun(_ *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	doctorCfg.Pretty = rootCmdFlags.Pretty
+	doctorCfg.KubeconfigPath = rootCmdFlags.KubeconfigPath
+}
+
+func doctorRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	op, err := doctor.NewDoctor(*doctorCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), doctorCfg.Pretty)
+		os.Exit(1)
+	}
+
+	report := op.Run(cmd.Context())
+	if cmd.Flag(cli.FlagJSON).Changed {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			output.PrintError(err, logger.GetLogger(), doctorCfg.Pretty)
+			os.Exit(1)
+		}
+	} else {
+		doctor.WriteReport(os.Stdout, report)
+	}
+
+	if report.Failed() {
+		os.Exit(1)
+	}
+}
@@ -19,15 +19,16 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/percona/everest/pkg/cli"
 	"github.com/percona/everest/pkg/cli/helm"
 	"github.com/percona/everest/pkg/cli/install"
-	"github.com/percona/everest/pkg/cli/namespaces"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/namespaces"
 	"github.com/percona/everest/pkg/output"
 )
 
@@ -42,14 +43,18 @@ var (
 		Args:  cobra.NoArgs,
 		Short: "Install Percona Everest using Helm",
 		Long:  "Install Percona Everest using Helm",
-		Example: fmt.Sprintf("everestctl install --%s dev,staging,prod --%s=true --%s=false --%s=false --%s",
+		Example: fmt.Sprintf("everestctl install --%s dev,staging,prod --%s=true --%s=false --%s=false --%s\n"+
+			"everestctl install --%s %s --%s everest.yaml",
 			cli.FlagNamespaces, cli.FlagOperatorMongoDB, cli.FlagOperatorPostgresql, cli.FlagOperatorMySQL, cli.FlagSkipWizard,
+			cli.FlagInstallProfile, install.ProfileProduction, cli.FlagInstallConfig,
 		),
 		PreRun: installPreRun,
 		Run:    installRun,
 	}
-	installCfg      = install.NewInstallConfig()
-	namespacesToAdd string
+	installCfg        = install.NewInstallConfig()
+	namespacesToAdd   string
+	installProfile    string
+	installConfigFile string
 )
 
 func init() {
@@ -64,10 +69,17 @@ func init() {
 	_ = installCmd.Flags().MarkHidden(cli.FlagDisableTelemetry)
 	installCmd.Flags().BoolVar(&installCfg.SkipEnvDetection, cli.FlagSkipEnvDetection, false, "Skip detecting Kubernetes environment where Everest is installed")
 	installCmd.Flags().BoolVar(&installCfg.SkipDBNamespace, cli.FlagInstallSkipDBNamespace, false, "Skip creating a database namespace with install")
+	installCmd.Flags().BoolVar(&installCfg.EnableNamespaceProvisioning, cli.FlagEnableNamespaceProvisioning, false, "Allow the Everest API to provision database namespaces. It grants the Everest API server the permissions to create roles and bindings in any namespace")
 
 	// --namespaces and --skip-db-namespace flags are mutually exclusive
 	installCmd.MarkFlagsMutuallyExclusive(cli.FlagNamespaces, cli.FlagInstallSkipDBNamespace)
 
+	installCmd.Flags().StringVar(&installProfile, cli.FlagInstallProfile, "", fmt.Sprintf("Installation profile to use: %s. The %s profile is not highly available, it runs a single Everest server replica", strings.Join(install.Profiles(), ", "), install.ProfileProduction))
+	installCmd.Flags().StringVar(&installConfigFile, cli.FlagInstallConfig, "", "Path to the installation configuration file describing namespaces, operators, TLS, OIDC, RBAC, monitoring and resources")
+
+	installCmd.Flags().StringVar(&installCfg.Bundle, cli.FlagBundle, "", "Path to the offline bundle created with everestctl bundle create to use instead of the version service and the Helm repository")
+	installCmd.Flags().StringVar(&installCfg.HelmConfig.ImageRegistry, cli.FlagImageRegistry, "", "Registry to pull all the images from instead of their original registries, such as a private mirror")
+
 	// --helm.* flags
 	installCmd.Flags().StringVar(&installCfg.HelmConfig.ChartDir, helm.FlagChartDir, "", "Path to the chart directory. If not set, the chart will be downloaded from the repository")
 	_ = installCmd.Flags().MarkHidden(helm.FlagChartDir)
@@ -89,6 +101,12 @@ func installPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
 	installCfg.KubeconfigPath = rootCmdFlags.KubeconfigPath
 	installCfg.NamespaceAddConfig.KubeconfigPath = rootCmdFlags.KubeconfigPath
 
+	// Validate the installation profile and configuration file before touching the cluster.
+	if err := applyInstallConfig(cmd); err != nil {
+		output.PrintError(err, logger.GetLogger(), installCfg.Pretty)
+		os.Exit(1)
+	}
+
 	// Check if Everest is already installed.
 	if err := install.CheckEverestAlreadyinstalled(cmd.Context(), logger.GetLogger(), installCfg.KubeconfigPath); err != nil {
 		output.PrintError(err, logger.GetLogger(), installCfg.Pretty)
@@ -103,10 +121,53 @@ func installPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
 	}
 }
 
+// applyInstallConfig reads, validates and applies the installation profile and configuration file into installCfg.
+// The configuration file takes precedence over the profile, and the flags set explicitly take precedence over both.
+func applyInstallConfig(cmd *cobra.Command) error {
+	if installProfile == "" && installConfigFile == "" {
+		return nil
+	}
+
+	c := &install.ConfigFile{}
+	if installConfigFile != "" {
+		var err error
+		if c, err = install.ReadConfigFile(installConfigFile); err != nil {
+			return err
+		}
+	}
+	if installProfile != "" {
+		c.Profile = install.Profile(installProfile)
+	}
+	if c.Profile != "" {
+		profile, err := install.NewProfileConfig(c.Profile)
+		if err != nil {
+			return err
+		}
+		c = c.Merge(profile)
+	}
+	if err := c.Validate(cmd.Context()); err != nil {
+		return err
+	}
+
+	if cmd.Flags().Lookup(cli.FlagNamespaces).Changed || installCfg.SkipDBNamespace {
+		c.Namespaces = nil
+	}
+	return installCfg.ApplyConfigFile(c)
+}
+
 // checkDBNamespaceParameters checks, validates and sets the database namespace parameters into installCfg.
 // If the user doesn't pass '--namespaces' or '--operators.*' flags,
 // it will ask the user to provide them in interactive mode (if it is enabled).
 func checkDBNamespaceParameters(cmd *cobra.Command) error {
+	if len(installCfg.DBNamespaces) > 0 {
+		// Namespaces and their operators are provided by the configuration file.
+		nsList := make([]string, 0, len(installCfg.DBNamespaces))
+		for _, ns := range installCfg.DBNamespaces {
+			nsList = append(nsList, ns.Name)
+		}
+		return installCfg.NamespaceAddConfig.ValidateNamespaces(cmd.Context(), nsList)
+	}
+
 	// Check DB namespaces parameters
 	// If user doesn't pass --namespaces flag - need to ask explicitly.
 	askNamespaces := !(cmd.Flags().Lookup(cli.FlagNamespaces).Changed ||
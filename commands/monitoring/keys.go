@@ -0,0 +1,193 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package monitoring holds commands for monitoring command.
+package monitoring
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	monitoringcli "github.com/percona/everest/pkg/cli/monitoring"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+const keysLongHelp = "The API keys (PMM 2) and service accounts (PMM 3) created by Everest are orphaned " +
+	"if no monitoring instance of the Kubernetes cluster uses them. " +
+	"Note that the keys used by other Everest installations connected to the same PMM instance are reported as orphaned too."
+
+var (
+	listKeysCmd = &cobra.Command{
+		Use:   "list-keys [flags]",
+		Args:  cobra.NoArgs,
+		Long:  "List the orphaned PMM keys created by Everest. " + keysLongHelp,
+		Short: "List the orphaned PMM keys created by Everest",
+		Example: fmt.Sprintf("everestctl monitoring list-keys --%s https://pmm.example.com --%s admin --%s <password>",
+			cli.FlagMonitoringPMMURL, cli.FlagMonitoringPMMUser, cli.FlagMonitoringPMMPassword,
+		),
+		PreRun: keysPreRun,
+		Run:    listKeysRun,
+	}
+	purgeKeysCmd = &cobra.Command{
+		Use:   "purge-keys [flags]",
+		Args:  cobra.NoArgs,
+		Long:  "Revoke the orphaned PMM keys created by Everest. " + keysLongHelp,
+		Short: "Revoke the orphaned PMM keys created by Everest",
+		Example: fmt.Sprintf("everestctl monitoring purge-keys --%s https://pmm.example.com --%s admin --%s <password>",
+			cli.FlagMonitoringPMMURL, cli.FlagMonitoringPMMUser, cli.FlagMonitoringPMMPassword,
+		),
+		PreRun: keysPreRun,
+		Run:    purgeKeysRun,
+	}
+	keysCfg = &monitoringcli.KeysConfig{}
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{listKeysCmd, purgeKeysCmd} {
+		cmd.Flags().StringVar(&keysCfg.PMMURL, cli.FlagMonitoringPMMURL, "", "URL of the PMM instance")
+		cmd.Flags().StringVar(&keysCfg.PMMUser, cli.FlagMonitoringPMMUser, "", "Name of a PMM admin user")
+		cmd.Flags().StringVar(&keysCfg.PMMPassword, cli.FlagMonitoringPMMPassword, "", "Password of the PMM admin user")
+		cmd.Flags().BoolVar(&keysCfg.SkipTLSVerify, cli.FlagMonitoringSkipTLSVerify, false, "Skip the verification of the PMM TLS certificate")
+		_ = cmd.MarkFlagRequired(cli.FlagMonitoringPMMURL)
+		_ = cmd.MarkFlagRequired(cli.FlagMonitoringPMMUser)
+		_ = cmd.MarkFlagRequired(cli.FlagMonitoringPMMPassword)
+	}
+}
+
+func keysPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	keysCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	keysCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func listKeysRun(cmd *cobra.Command, _ []string) {
+	op, err := monitoringcli.NewKeys(*keysCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), keysCfg.Pretty)
+		os.Exit(1)
+	}
+
+	tokens, err := op.ListOrphaned(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), keysCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if cmd.Flag(cli.FlagJSON).Changed {
+		printJSON(tokens)
+		return
+	}
+	results := make([]monitoringcli.PurgeResult, 0, len(tokens))
+	for _, t := range tokens {
+		results = append(results, monitoringcli.PurgeResult{Token: t})
+	}
+	printKeysTable(results, false)
+}
+
+func purgeKeysRun(cmd *cobra.Command, _ []string) {
+	op, err := monitoringcli.NewKeys(*keysCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), keysCfg.Pretty)
+		os.Exit(1)
+	}
+
+	results, err := op.Purge(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), keysCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if cmd.Flag(cli.FlagJSON).Changed {
+		printJSON(results)
+	} else {
+		printKeysTable(results, true)
+	}
+
+	for _, r := range results {
+		if r.Error != "" {
+			output.PrintError(errors.New("one or more PMM keys could not be revoked"), logger.GetLogger(), keysCfg.Pretty)
+			os.Exit(1)
+		}
+	}
+}
+
+// GetListKeysCmd returns the command to list the orphaned PMM keys.
+func GetListKeysCmd() *cobra.Command {
+	return listKeysCmd
+}
+
+// GetPurgeKeysCmd returns the command to purge the orphaned PMM keys.
+func GetPurgeKeysCmd() *cobra.Command {
+	return purgeKeysCmd
+}
+
+func printJSON(v any) {
+	if err := json.NewEncoder(os.Stdout).Encode(v); err != nil {
+		output.PrintError(err, logger.GetLogger(), keysCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+const (
+	// columnID is the column name for the key ID.
+	columnID = "id"
+	// columnName is the column name for the key name.
+	columnName = "name"
+	// columnType is the column name for the key type.
+	columnType = "type"
+	// columnResult is the column name for the purge result.
+	columnResult = "result"
+)
+
+// Print PMM keys to console.
+func printKeysTable(results []monitoringcli.PurgeResult, purged bool) {
+	// Prepare table headings.
+	headings := []interface{}{columnID, columnName, columnType}
+	if purged {
+		headings = append(headings, columnResult)
+	}
+	// Prepare table header.
+	tbl := table.New(headings...)
+	tbl.WithHeaderFormatter(func(format string, vals ...interface{}) string {
+		// Print all in caps.
+		return strings.ToUpper(fmt.Sprintf(format, vals...))
+	})
+
+	for _, r := range results {
+		keyType := "api key"
+		if r.IsServiceToken() {
+			keyType = "service account"
+		}
+		row := []any{r.ID, r.Name, keyType}
+		if purged {
+			result := "revoked"
+			if r.Error != "" {
+				result = r.Error
+			}
+			row = append(row, result)
+		}
+		tbl.AddRow(row...)
+	}
+
+	tbl.Print()
+}
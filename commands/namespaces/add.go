@@ -25,8 +25,8 @@ import (
 
 	"github.com/percona/everest/pkg/cli"
 	"github.com/percona/everest/pkg/cli/helm"
-	"github.com/percona/everest/pkg/cli/namespaces"
 	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/namespaces"
 	"github.com/percona/everest/pkg/output"
 )
 
@@ -39,8 +39,10 @@ var (
 		Args:  cobra.ExactArgs(1),
 		Long:  "Add a new namespace and make managed by Everest",
 		Short: "Add a new namespace and make managed by Everest",
-		Example: fmt.Sprintf("everestctl namespaces add ns-1,ns-2 --%s --%s=true --%s=false --%s=false",
+		Example: fmt.Sprintf("everestctl namespaces add ns-1,ns-2 --%s --%s=true --%s=false --%s=false\n"+
+			"everestctl namespaces add prod --%s --%s=1.18.0",
 			cli.FlagSkipWizard, cli.FlagOperatorMySQL, cli.FlagOperatorPostgresql, cli.FlagOperatorMongoDB,
+			cli.FlagSkipWizard, cli.FlagOperatorMongoDBVersion,
 		),
 		PreRun: namespacesAddPreRun,
 		Run:    namespacesAddRun,
@@ -55,6 +57,10 @@ func init() {
 	namespacesAddCmd.Flags().BoolVar(&namespacesAddCfg.SkipWizard, cli.FlagSkipWizard, false, "Skip installation wizard")
 	namespacesAddCmd.Flags().BoolVar(&namespacesAddCfg.TakeOwnership, cli.FlagTakeNamespaceOwnership, false, "If the specified namespace already exists, take ownership of it")
 	namespacesAddCmd.Flags().BoolVar(&namespacesAddCfg.SkipEnvDetection, cli.FlagSkipEnvDetection, false, "Skip detecting Kubernetes environment where Everest is installed")
+	namespacesAddCmd.Flags().StringVar(&namespacesAddCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, "https://check.percona.com", "URL to retrieve version metadata information from to check the pinned operator versions")
+
+	namespacesAddCmd.Flags().StringVar(&namespacesAddCfg.Bundle, cli.FlagBundle, "", "Path to the offline bundle created with everestctl bundle create to load the Helm charts from instead of the Helm repository")
+	namespacesAddCmd.Flags().StringVar(&namespacesAddCfg.HelmConfig.ImageRegistry, cli.FlagImageRegistry, "", "Registry to pull all the images from instead of their original registries, such as a private mirror")
 
 	// --helm.* flags
 	namespacesAddCmd.Flags().StringVar(&namespacesAddCfg.HelmConfig.ChartDir, helm.FlagChartDir, "", "Path to the chart directory. If not set, the chart will be downloaded from the repository")
@@ -69,12 +75,14 @@ func init() {
 	namespacesAddCmd.Flags().BoolVar(&namespacesAddCfg.Operators.PXC, cli.FlagOperatorXtraDBCluster, true, "Install XtraDB Cluster operator")
 	_ = namespacesAddCmd.Flags().MarkDeprecated(cli.FlagOperatorXtraDBCluster, fmt.Sprintf("please use --%s instead", cli.FlagOperatorMySQL))
 	namespacesAddCmd.Flags().BoolVar(&namespacesAddCfg.Operators.PXC, cli.FlagOperatorMySQL, true, "Install MySQL operator")
+	addOperatorVersionFlags(namespacesAddCmd)
 }
 
 func namespacesAddPreRun(cmd *cobra.Command, args []string) { //nolint:revive
 	// Copy global flags to config
 	namespacesAddCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
 	namespacesAddCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+	namespacesAddCfg.OperatorVersions = operatorVersionUpdate(cmd)
 
 	{
 		// Parse and validate provided namespaces
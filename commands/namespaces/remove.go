@@ -24,8 +24,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/percona/everest/pkg/cli"
-	"github.com/percona/everest/pkg/cli/namespaces"
 	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/namespaces"
 	"github.com/percona/everest/pkg/output"
 )
 
@@ -26,8 +26,8 @@ import (
 
 	"github.com/percona/everest/pkg/cli"
 	"github.com/percona/everest/pkg/cli/helm"
-	"github.com/percona/everest/pkg/cli/namespaces"
 	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/namespaces"
 	"github.com/percona/everest/pkg/output"
 )
 
@@ -37,13 +37,22 @@ var (
 		Args:  cobra.ExactArgs(1),
 		Long:  "Add database operator to existing namespace managed by Everest",
 		Short: "Add database operator to existing namespace managed by Everest",
-		Example: fmt.Sprintf("everestctl namespaces update ns-1,ns-2 --%s --%s=true --%s=false --%s=false",
+		Example: fmt.Sprintf("everestctl namespaces update ns-1,ns-2 --%s --%s=true --%s=false --%s=false\n"+
+			"everestctl namespaces update ns-1 --%s=8 --%s=16Gi --%s=%s\n"+
+			"everestctl namespaces update prod --%s=1.18.0 --%s=%s",
 			cli.FlagSkipWizard, cli.FlagOperatorMySQL, cli.FlagOperatorPostgresql, cli.FlagOperatorMongoDB,
+			cli.FlagQuotaCPU, cli.FlagQuotaMemory, cli.FlagQuotaDisk, namespaces.QuotaUnlimited,
+			cli.FlagOperatorMongoDBVersion, cli.FlagOperatorMySQLVersion, namespaces.OperatorVersionUnpinned,
 		),
 		PreRun: namespacesUpdatePreRun,
 		Run:    namespacesUpdateRun,
 	}
 	namespacesUpdateCfg = namespaces.NewNamespaceAddConfig()
+
+	// namespacesUpdateQuota contains the quota changes requested with the --quota-* flags.
+	namespacesUpdateQuota namespaces.QuotaUpdate
+	// namespacesUpdateQuotaOnly is set if only the quota shall be updated.
+	namespacesUpdateQuotaOnly bool
 )
 
 func init() {
@@ -54,6 +63,10 @@ func init() {
 	_ = namespacesUpdateCmd.Flags().MarkHidden(cli.FlagDisableTelemetry) //nolint:errcheck,gosec
 	namespacesUpdateCmd.Flags().BoolVar(&namespacesUpdateCfg.SkipWizard, cli.FlagSkipWizard, false, "Skip installation wizard")
 	namespacesUpdateCmd.Flags().BoolVar(&namespacesUpdateCfg.SkipEnvDetection, cli.FlagSkipEnvDetection, false, "Skip detecting Kubernetes environment where Everest is installed")
+	namespacesUpdateCmd.Flags().StringVar(&namespacesUpdateCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, "https://check.percona.com", "URL to retrieve version metadata information from to check the pinned operator versions")
+
+	namespacesUpdateCmd.Flags().StringVar(&namespacesUpdateCfg.Bundle, cli.FlagBundle, "", "Path to the offline bundle created with everestctl bundle create to load the Helm charts from instead of the Helm repository")
+	namespacesUpdateCmd.Flags().StringVar(&namespacesUpdateCfg.HelmConfig.ImageRegistry, cli.FlagImageRegistry, "", "Registry to pull all the images from instead of their original registries, such as a private mirror")
 
 	// --helm.* flags
 	namespacesUpdateCmd.Flags().StringVar(&namespacesUpdateCfg.HelmConfig.ChartDir, helm.FlagChartDir, "", "Path to the chart directory. If not set, the chart will be downloaded from the repository")
@@ -68,6 +81,15 @@ func init() {
 	namespacesUpdateCmd.Flags().BoolVar(&namespacesUpdateCfg.Operators.PXC, cli.FlagOperatorXtraDBCluster, true, "Install XtraDB Cluster operator")
 	_ = namespacesUpdateCmd.Flags().MarkDeprecated(cli.FlagOperatorXtraDBCluster, fmt.Sprintf("please use --%s instead", cli.FlagOperatorMySQL))
 	namespacesUpdateCmd.Flags().BoolVar(&namespacesUpdateCfg.Operators.PXC, cli.FlagOperatorMySQL, true, "Install MySQL operator")
+	addOperatorVersionFlags(namespacesUpdateCmd)
+
+	// --quota-* flags
+	quotaHint := fmt.Sprintf(" (use '%s' to remove the limit)", namespaces.QuotaUnlimited)
+	namespacesUpdateCmd.Flags().String(cli.FlagQuotaCPU, "", "Limit the CPU requested by the database clusters in the namespace, e.g. 8 or 8000m"+quotaHint)
+	namespacesUpdateCmd.Flags().String(cli.FlagQuotaMemory, "", "Limit the memory requested by the database clusters in the namespace, e.g. 16Gi"+quotaHint)
+	namespacesUpdateCmd.Flags().String(cli.FlagQuotaDisk, "", "Limit the disk used by the database clusters in the namespace, e.g. 500Gi"+quotaHint)
+	namespacesUpdateCmd.Flags().String(cli.FlagQuotaDatabaseClusters, "", "Limit the number of database clusters in the namespace"+quotaHint)
+	namespacesUpdateCmd.Flags().String(cli.FlagQuotaBackups, "", "Limit the number of database cluster backups in the namespace"+quotaHint)
 }
 
 func namespacesUpdatePreRun(cmd *cobra.Command, args []string) { //nolint:revive
@@ -86,13 +108,27 @@ func namespacesUpdatePreRun(cmd *cobra.Command, args []string) { //nolint:revive
 		namespacesUpdateCfg.NamespaceList = nsList
 	}
 
+	namespacesUpdateQuota = namespaces.QuotaUpdate{
+		CPU:                    changedFlagValue(cmd, cli.FlagQuotaCPU),
+		Memory:                 changedFlagValue(cmd, cli.FlagQuotaMemory),
+		Disk:                   changedFlagValue(cmd, cli.FlagQuotaDisk),
+		DatabaseClusters:       changedFlagValue(cmd, cli.FlagQuotaDatabaseClusters),
+		DatabaseClusterBackups: changedFlagValue(cmd, cli.FlagQuotaBackups),
+	}
+
 	// If user doesn't pass any --operator.* flags - need to ask explicitly.
 	askOperators := !(cmd.Flags().Lookup(cli.FlagOperatorMongoDB).Changed ||
 		cmd.Flags().Lookup(cli.FlagOperatorPostgresql).Changed ||
 		cmd.Flags().Lookup(cli.FlagOperatorXtraDBCluster).Changed ||
 		cmd.Flags().Lookup(cli.FlagOperatorMySQL).Changed)
 
-	if askOperators {
+	namespacesUpdateCfg.OperatorVersions = operatorVersionUpdate(cmd)
+	// Passing only --operator.*-version flags updates the pinned versions and leaves the installed operators as is.
+	namespacesUpdateCfg.KeepInstalledOperators = askOperators && !namespacesUpdateCfg.OperatorVersions.IsEmpty()
+	// Passing only --quota-* flags updates the quota and leaves the operators as is.
+	namespacesUpdateQuotaOnly = askOperators && namespacesUpdateCfg.OperatorVersions.IsEmpty() && !namespacesUpdateQuota.IsEmpty()
+
+	if askOperators && !namespacesUpdateQuotaOnly && !namespacesUpdateCfg.KeepInstalledOperators {
 		// need to ask user to provide operators to be installed in interactive mode.
 		if err := namespacesUpdateCfg.PopulateOperators(cmd.Context()); err != nil {
 			output.PrintError(err, logger.GetLogger(), namespacesUpdateCfg.Pretty)
@@ -102,6 +138,21 @@ func namespacesUpdatePreRun(cmd *cobra.Command, args []string) { //nolint:revive
 }
 
 func namespacesUpdateRun(cmd *cobra.Command, _ []string) {
+	if !namespacesUpdateQuotaOnly {
+		namespacesUpdateOperators(cmd)
+	}
+
+	if !namespacesUpdateQuota.IsEmpty() {
+		if err := namespaces.UpdateNamespaceQuotas(cmd.Context(), namespacesUpdateCfg.KubeconfigPath,
+			namespacesUpdateCfg.NamespaceList, namespacesUpdateQuota, logger.GetLogger(), namespacesUpdateCfg.Pretty,
+		); err != nil {
+			output.PrintError(err, logger.GetLogger(), namespacesUpdateCfg.Pretty)
+			os.Exit(1)
+		}
+	}
+}
+
+func namespacesUpdateOperators(cmd *cobra.Command) {
 	op, err := namespaces.NewNamespaceAdd(namespacesUpdateCfg, logger.GetLogger())
 	if err != nil {
 		output.PrintError(err, logger.GetLogger(), namespacesUpdateCfg.Pretty)
@@ -122,6 +173,33 @@ func namespacesUpdateRun(cmd *cobra.Command, _ []string) {
 	}
 }
 
+// changedFlagValue returns the value of the flag if it has been set by the user, nil otherwise.
+func changedFlagValue(cmd *cobra.Command, name string) *string {
+	f := cmd.Flags().Lookup(name)
+	if !f.Changed {
+		return nil
+	}
+	v := f.Value.String()
+	return &v
+}
+
+// addOperatorVersionFlags adds the --operator.*-version flags to the command.
+func addOperatorVersionFlags(cmd *cobra.Command) {
+	hint := fmt.Sprintf(". The version must be supported by the installed Everest version (use '%s' to remove the pinned version)", namespaces.OperatorVersionUnpinned)
+	cmd.Flags().String(cli.FlagOperatorMongoDBVersion, "", "Pin the version of the MongoDB operator, e.g. 1.18.0"+hint)
+	cmd.Flags().String(cli.FlagOperatorPostgresqlVersion, "", "Pin the version of the PostgreSQL operator, e.g. 2.5.0"+hint)
+	cmd.Flags().String(cli.FlagOperatorMySQLVersion, "", "Pin the version of the MySQL operator, e.g. 1.15.1"+hint)
+}
+
+// operatorVersionUpdate returns the operator versions pinned with the --operator.*-version flags.
+func operatorVersionUpdate(cmd *cobra.Command) namespaces.OperatorVersionUpdate {
+	return namespaces.OperatorVersionUpdate{
+		PG:    changedFlagValue(cmd, cli.FlagOperatorPostgresqlVersion),
+		PSMDB: changedFlagValue(cmd, cli.FlagOperatorMongoDBVersion),
+		PXC:   changedFlagValue(cmd, cli.FlagOperatorMySQLVersion),
+	}
+}
+
 // GetNamespacesUpdateCmd returns the command to update namespaces.
 func GetNamespacesUpdateCmd() *cobra.Command {
 	return namespacesUpdateCmd
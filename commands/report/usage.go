@@ -0,0 +1,178 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package report holds commands for report command.
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/cli"
+	reportcli "github.com/percona/everest/pkg/cli/report"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	usageCmd = &cobra.Command{
+		Use:  "usage [flags]",
+		Args: cobra.NoArgs,
+		Long: "Report the CPU, memory, disk and backup storage requested and used by the database clusters " +
+			"per database cluster or per namespace in a time range. " +
+			"The report is aggregated from the usage samples taken periodically by the Everest server. " +
+			"The used CPU and memory are only reported if the Kubernetes metrics API is available.",
+		Short: "Report the resource usage of the database clusters",
+		Example: fmt.Sprintf("everestctl report usage --%s 2025-01-01 --%s 2025-02-01 --%s %s --%s",
+			cli.FlagReportFrom, cli.FlagReportTo, cli.FlagReportGroupBy, reportcli.GroupByNamespace, cli.FlagReportCSV,
+		),
+		PreRun: usagePreRun,
+		Run:    usageRun,
+	}
+	usageCfg     = &reportcli.UsageConfig{}
+	usageGroupBy string
+	usageCSV     bool
+)
+
+func init() {
+	usageCmd.Flags().StringVar(&usageCfg.From, cli.FlagReportFrom, "", "Start of the time range as a date (YYYY-MM-DD) or an RFC 3339 time. Defaults to 30 days before the end")
+	usageCmd.Flags().StringVar(&usageCfg.To, cli.FlagReportTo, "", "End of the time range as a date (YYYY-MM-DD) or an RFC 3339 time. Defaults to now")
+	usageCmd.Flags().StringVarP(&usageCfg.Namespace, cli.FlagReportNamespace, "n", "", "Only report the usage in the namespace")
+	usageCmd.Flags().StringVar(&usageGroupBy, cli.FlagReportGroupBy, reportcli.GroupByDatabaseCluster,
+		fmt.Sprintf("Group the usage by %s or %s", reportcli.GroupByDatabaseCluster, reportcli.GroupByNamespace))
+	usageCmd.Flags().BoolVar(&usageCSV, cli.FlagReportCSV, false, "Set output type to CSV")
+	usageCmd.MarkFlagsMutuallyExclusive(cli.FlagReportCSV, cli.FlagJSON)
+}
+
+func usagePreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	usageCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed || usageCSV)
+	usageCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func usageRun(cmd *cobra.Command, _ []string) {
+	if usageGroupBy != reportcli.GroupByDatabaseCluster && usageGroupBy != reportcli.GroupByNamespace {
+		output.PrintError(fmt.Errorf("invalid --%s value %q", cli.FlagReportGroupBy, usageGroupBy), logger.GetLogger(), usageCfg.Pretty)
+		os.Exit(1)
+	}
+
+	op, err := reportcli.NewUsage(*usageCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), usageCfg.Pretty)
+		os.Exit(1)
+	}
+
+	report, err := op.Run(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), usageCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if cmd.Flag(cli.FlagJSON).Changed {
+		if err := json.NewEncoder(os.Stdout).Encode(report); err != nil {
+			output.PrintError(err, logger.GetLogger(), usageCfg.Pretty)
+			os.Exit(1)
+		}
+		return
+	}
+
+	aggregates := report.DatabaseClusters
+	if usageGroupBy == reportcli.GroupByNamespace {
+		aggregates = report.Namespaces
+	}
+	if usageCSV {
+		if err := reportcli.WriteCSV(os.Stdout, aggregates); err != nil {
+			output.PrintError(err, logger.GetLogger(), usageCfg.Pretty)
+			os.Exit(1)
+		}
+		return
+	}
+	printUsageTable(aggregates, usageGroupBy == reportcli.GroupByNamespace)
+}
+
+// GetUsageCmd returns the command to report the resource usage.
+func GetUsageCmd() *cobra.Command {
+	return usageCmd
+}
+
+const (
+	// columnNamespace is the column name for the namespace.
+	columnNamespace = "namespace"
+	// columnName is the column name for the database cluster name.
+	columnName = "name"
+	// columnHours is the column name for the hours covered by the samples.
+	columnHours = "hours"
+	// columnCPURequested is the column name for the average requested CPU.
+	columnCPURequested = "cpu requested"
+	// columnCPUUsed is the column name for the average used CPU.
+	columnCPUUsed = "cpu used"
+	// columnMemoryRequested is the column name for the average requested memory.
+	columnMemoryRequested = "memory requested"
+	// columnMemoryUsed is the column name for the average used memory.
+	columnMemoryUsed = "memory used"
+	// columnDiskUsed is the column name for the average provisioned disk.
+	columnDiskUsed = "disk"
+	// columnBackups is the column name for the average backup size.
+	columnBackups = "backups"
+)
+
+// Print the average usage to console.
+func printUsageTable(aggregates []api.UsageAggregate, byNamespace bool) {
+	// Prepare table headings.
+	headings := []interface{}{columnNamespace}
+	if !byNamespace {
+		headings = append(headings, columnName)
+	}
+	headings = append(headings, columnHours, columnCPURequested, columnCPUUsed,
+		columnMemoryRequested, columnMemoryUsed, columnDiskUsed, columnBackups)
+	// Prepare table header.
+	tbl := table.New(headings...)
+	tbl.WithHeaderFormatter(func(format string, vals ...interface{}) string {
+		// Print all in caps.
+		return strings.ToUpper(fmt.Sprintf(format, vals...))
+	})
+
+	// Used CPU and memory are unknown without the metrics API, used disk without the kubelet stats.
+	average := func(s *api.UsageStat, format func(float64) string) string {
+		if s == nil {
+			return "-"
+		}
+		return format(s.Average)
+	}
+	for _, a := range aggregates {
+		row := []any{a.Namespace}
+		if !byNamespace {
+			row = append(row, a.Name)
+		}
+		row = append(row,
+			fmt.Sprintf("%.1f", a.Hours),
+			reportcli.FormatCores(a.CpuRequestedMillis.Average),
+			average(a.CpuUsedMillis, reportcli.FormatCores),
+			reportcli.FormatGiB(a.MemoryRequestedBytes.Average),
+			average(a.MemoryUsedBytes, reportcli.FormatGiB),
+			average(a.DiskUsedBytes, reportcli.FormatGiB),
+			fmt.Sprintf("%d (%s)", a.Backups, reportcli.FormatGiB(a.BackupBytes.Average)),
+		)
+		tbl.AddRow(row...)
+	}
+
+	tbl.Print()
+}
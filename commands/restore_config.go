@@ -0,0 +1,104 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package commands ...
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/configbackup"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	restoreConfigCmd = &cobra.Command{
+		Use:  "restore-config <file> [flags]",
+		Args: cobra.ExactArgs(1),
+		Long: "Restore the Everest configuration saved with backup-config. The missing database namespaces are added " +
+			"and the missing objects are created. The objects that exist with a different content are handled " +
+			"according to the on-conflict flag.",
+		Short:   "Restore the Everest configuration from a file",
+		Example: fmt.Sprintf("everestctl restore-config everest-config.yaml --%s --%s %s", cli.FlagConfigRestoreDryRun, cli.FlagConfigRestoreOnConflict, configbackup.ConflictOverwrite),
+		PreRun:  restoreConfigPreRun,
+		Run:     restoreConfigRun,
+	}
+	restoreConfigCfg    = &configbackup.RestoreConfig{}
+	restoreConfigDryRun bool
+)
+
+func init() {
+	rootCmd.AddCommand(restoreConfigCmd)
+
+	// local command flags
+	restoreConfigCmd.Flags().StringVar((*string)(&restoreConfigCfg.OnConflict), cli.FlagConfigRestoreOnConflict, string(configbackup.ConflictFail),
+		fmt.Sprintf("How to handle the objects that exist with a different content: %s, %s or %s",
+			configbackup.ConflictFail, configbackup.ConflictSkip, configbackup.ConflictOverwrite))
+	restoreConfigCmd.Flags().BoolVar(&restoreConfigDryRun, cli.FlagConfigRestoreDryRun, false, "If set, only prints the changes without applying them")
+}
+
+func restoreConfigPreRun(_ *cobra.Command, args []string) { //nolint:revive
+	// Copy global flags to config
+	restoreConfigCfg.Pretty = rootCmdFlags.Pretty
+	restoreConfigCfg.KubeconfigPath = rootCmdFlags.KubeconfigPath
+	restoreConfigCfg.Input = args[0]
+}
+
+func restoreConfigRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	op, err := configbackup.NewRestore(*restoreConfigCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), restoreConfigCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if restoreConfigDryRun {
+		restoreConfigPlan(cmd, op)
+		return
+	}
+
+	if err := op.Run(cmd.Context()); err != nil {
+		output.PrintError(err, logger.GetLogger(), restoreConfigCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+func restoreConfigPlan(cmd *cobra.Command, op *configbackup.Restore) {
+	plan, err := op.Plan(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), restoreConfigCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if cmd.Flag(cli.FlagJSON).Changed {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(plan); err != nil {
+			output.PrintError(err, logger.GetLogger(), restoreConfigCfg.Pretty)
+			os.Exit(1)
+		}
+	} else {
+		configbackup.WritePlan(os.Stdout, plan)
+	}
+
+	if len(plan.Conflicts()) > 0 {
+		os.Exit(1)
+	}
+}
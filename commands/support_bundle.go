@@ -0,0 +1,73 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package commands ...
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/supportbundle"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	supportBundleCmd = &cobra.Command{
+		Use:  "support-bundle [flags]",
+		Args: cobra.NoArgs,
+		Long: "Collect the diagnostic data of Everest into an archive to attach to a support request: " +
+			"the version information, the logs and the events of the Everest and the database namespaces, " +
+			"the Everest resources, the Helm values and the Everest settings. " +
+			"Passwords, keys, tokens and the credentials of connection URLs are redacted and secrets are not collected.",
+		Short:   "Collect a support bundle",
+		Example: fmt.Sprintf("everestctl support-bundle --%s ns-1,ns-2 --%s 1000", cli.FlagNamespaces, cli.FlagSupportBundleLogLines),
+		PreRun:  supportBundlePreRun,
+		Run:     supportBundleRun,
+	}
+	supportBundleCfg = &supportbundle.Config{}
+)
+
+func init() {
+	rootCmd.AddCommand(supportBundleCmd)
+
+	// local command flags
+	supportBundleCmd.Flags().StringVar(&supportBundleCfg.Output, cli.FlagSupportBundleOutput, "", "Path of the support bundle. Defaults to everest-support-bundle-<timestamp>.tar.gz")
+	supportBundleCmd.Flags().StringSliceVar(&supportBundleCfg.Namespaces, cli.FlagNamespaces, nil, "Comma-separated list of the database namespaces to collect. All the database namespaces are collected by default")
+	supportBundleCmd.Flags().Int64Var(&supportBundleCfg.LogLines, cli.FlagSupportBundleLogLines, supportbundle.DefaultLogLines, "Number of lines to collect from the logs of each container")
+}
+
+func supportBundlePreRun(_ *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	supportBundleCfg.Pretty = rootCmdFlags.Pretty
+	supportBundleCfg.KubeconfigPath = rootCmdFlags.KubeconfigPath
+}
+
+func supportBundleRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	op, err := supportbundle.NewSupportBundle(*supportBundleCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), supportBundleCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := op.Run(cmd.Context()); err != nil {
+		output.PrintError(err, logger.GetLogger(), supportBundleCfg.Pretty)
+		os.Exit(1)
+	}
+}
@@ -46,6 +46,11 @@ func init() {
 	uninstallCmd.Flags().BoolVarP(&uninstallCfg.AssumeYes, "assume-yes", "y", false, "Assume yes to all questions")
 	uninstallCmd.Flags().BoolVarP(&uninstallCfg.Force, "force", "f", false, "Force removal in case there are database clusters running")
 	uninstallCmd.Flags().BoolVar(&uninstallCfg.SkipEnvDetection, cli.FlagSkipEnvDetection, false, "Skip detecting Kubernetes environment where Everest is installed")
+	uninstallCmd.Flags().StringVar(&uninstallCfg.BackupStorage, cli.FlagUninstallBackupStorage, "", "Take a final backup of every database cluster to the backup storage with this name before uninstalling. The backups are kept in the storage")
+	uninstallCmd.Flags().DurationVar(&uninstallCfg.BackupTimeout, cli.FlagUninstallBackupTimeout, uninstall.DefaultBackupTimeout, "Time to wait for the final backups to complete")
+	uninstallCmd.Flags().StringVar(&uninstallCfg.ExportPath, cli.FlagUninstallExport, "", "Export the Everest resources, settings and RBAC policy to this archive before uninstalling. Secrets are not exported")
+	uninstallCmd.Flags().BoolVar(&uninstallCfg.KeepDatabases, cli.FlagUninstallKeepDatabases, false, "Keep the database clusters, the database operators and the database namespaces running, unmanaged by Everest")
+	uninstallCmd.MarkFlagsMutuallyExclusive(cli.FlagUninstallKeepDatabases, "force")
 }
 
 func uninstallPreRun(_ *cobra.Command, _ []string) { //nolint:revive
@@ -17,10 +17,12 @@
 package commands
 
 import (
+	"encoding/json"
 	"os"
 
 	"github.com/spf13/cobra"
 
+	upgradecmd "github.com/percona/everest/commands/upgrade"
 	"github.com/percona/everest/pkg/cli"
 	"github.com/percona/everest/pkg/cli/helm"
 	"github.com/percona/everest/pkg/cli/upgrade"
@@ -43,14 +45,22 @@ var (
 
 func init() {
 	rootCmd.AddCommand(upgradeCmd)
+	upgradeCmd.AddCommand(upgradecmd.GetPostUpgradeTasksCmd())
 
 	// local command flags
 	upgradeCmd.Flags().StringVar(&upgradeCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, "https://check.percona.com", "URL to retrieve version metadata information from")
 	upgradeCmd.Flags().BoolVar(&upgradeCfg.SkipEnvDetection, cli.FlagSkipEnvDetection, false, "Skip detecting Kubernetes environment where Everest is installed")
-	upgradeCmd.Flags().BoolVar(&upgradeCfg.DryRun, cli.FlagUpgradeDryRun, false, "If set, only executes the pre-upgrade checks")
+	upgradeCmd.Flags().BoolVar(&upgradeCfg.DryRun, cli.FlagUpgradeDryRun, false, "If set, only prints the upgrade plan without performing the upgrade")
+	upgradeCmd.Flags().BoolVar(&upgradeCfg.Rollback, cli.FlagUpgradeRollback, false, "If set, rolls back the last upgrade using the snapshot taken before it")
+	upgradeCmd.Flags().BoolVar(&upgradeCfg.NoAutoRollback, cli.FlagUpgradeNoAutoRollback, false, "If set, a failed upgrade is not rolled back automatically")
+	upgradeCmd.Flags().BoolVar(&upgradeCfg.EnableNamespaceProvisioning, cli.FlagEnableNamespaceProvisioning, false, "Allow the Everest API to provision database namespaces. It grants the Everest API server the permissions to create roles and bindings in any namespace")
+	upgradeCmd.MarkFlagsMutuallyExclusive(cli.FlagUpgradeRollback, cli.FlagUpgradeDryRun)
 	upgradeCmd.Flags().BoolVar(&upgradeCfg.InCluster, cli.FlagUpgradeInCluster, false, "If set, uses the in-cluster Kubernetes client configuration")
 	_ = upgradeCmd.Flags().MarkHidden(cli.FlagUpgradeInCluster)
 
+	upgradeCmd.Flags().StringVar(&upgradeCfg.Bundle, cli.FlagBundle, "", "Path to the offline bundle created with everestctl bundle create to use instead of the version service and the Helm repository")
+	upgradeCmd.Flags().StringVar(&upgradeCfg.ImageRegistry, cli.FlagImageRegistry, "", "Registry to pull all the images from instead of their original registries, such as a private mirror")
+
 	// --helm.* flags
 	upgradeCmd.Flags().StringVar(&upgradeCfg.ChartDir, helm.FlagChartDir, "", "Path to the chart directory. If not set, the chart will be downloaded from the repository")
 	_ = upgradeCmd.Flags().MarkHidden(helm.FlagChartDir)
@@ -75,8 +85,36 @@ func upgradeRun(cmd *cobra.Command, _ []string) { //nolint:revive
 		os.Exit(1)
 	}
 
+	if upgradeCfg.DryRun {
+		upgradePlan(cmd, op)
+		return
+	}
+
 	if err := op.Run(cmd.Context()); err != nil {
 		output.PrintError(err, logger.GetLogger(), upgradeCfg.Pretty)
 		os.Exit(1)
 	}
 }
+
+func upgradePlan(cmd *cobra.Command, op *upgrade.Upgrade) {
+	plan, err := op.Plan(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), upgradeCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if cmd.Flag(cli.FlagJSON).Changed {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(plan); err != nil {
+			output.PrintError(err, logger.GetLogger(), upgradeCfg.Pretty)
+			os.Exit(1)
+		}
+	} else {
+		upgrade.WritePlan(os.Stdout, plan)
+	}
+
+	if !plan.RequirementsMet() {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,143 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package upgrade provides the upgrade CLI sub-commands.
+package upgrade
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/upgrade"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	postUpgradeTasksCmd = &cobra.Command{
+		Use:     "post-upgrade-tasks <namespace> [flags]",
+		Args:    cobra.ExactArgs(1),
+		Long:    "Execute the pending post-upgrade tasks of the database clusters in the namespace",
+		Short:   "Execute the pending post-upgrade tasks of the database clusters in the namespace",
+		Example: fmt.Sprintf("everestctl upgrade post-upgrade-tasks ns-1 --%s db-1,db-2 --%s 2", cli.FlagUpgradeDatabases, cli.FlagUpgradeBatchSize),
+		PreRun:  postUpgradeTasksPreRun,
+		Run:     postUpgradeTasksRun,
+	}
+	postUpgradeTasksCfg = &upgrade.PostUpgradeTasksConfig{}
+)
+
+func init() {
+	// local command flags
+	postUpgradeTasksCmd.Flags().StringVar(&postUpgradeTasksCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, "https://check.percona.com", "URL to retrieve version metadata information from")
+	postUpgradeTasksCmd.Flags().StringSliceVar(&postUpgradeTasksCfg.Databases, cli.FlagUpgradeDatabases, []string{}, "Database clusters to execute the pending tasks for. If not set, all database clusters with pending tasks are selected")
+	postUpgradeTasksCmd.Flags().IntVar(&postUpgradeTasksCfg.BatchSize, cli.FlagUpgradeBatchSize, 1, "Number of database clusters updated at a time")
+	postUpgradeTasksCmd.Flags().BoolVar(&postUpgradeTasksCfg.NoWait, cli.FlagUpgradeNoWait, false, "Do not wait for the database clusters of a batch to become ready before proceeding with the next batch")
+}
+
+func postUpgradeTasksPreRun(cmd *cobra.Command, args []string) { //nolint:revive
+	// Copy global flags to config
+	postUpgradeTasksCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	postUpgradeTasksCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+	postUpgradeTasksCfg.Namespace = args[0]
+}
+
+func postUpgradeTasksRun(cmd *cobra.Command, _ []string) {
+	op, err := upgrade.NewPostUpgradeTasks(postUpgradeTasksCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), postUpgradeTasksCfg.Pretty)
+		os.Exit(1)
+	}
+
+	result, err := op.Run(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), postUpgradeTasksCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if cmd.Flag(cli.FlagJSON).Changed {
+		if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+			output.PrintError(err, logger.GetLogger(), postUpgradeTasksCfg.Pretty)
+			os.Exit(1)
+		}
+	} else {
+		printPostUpgradeTasksTable(result.Results)
+	}
+
+	if result.State == api.PostUpgradeTasksJobStateFailed {
+		output.PrintError(errors.New("one or more post-upgrade tasks failed"), logger.GetLogger(), postUpgradeTasksCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetPostUpgradeTasksCmd returns the command to execute the post-upgrade tasks.
+func GetPostUpgradeTasksCmd() *cobra.Command {
+	return postUpgradeTasksCmd
+}
+
+const (
+	// columnDatabase is the column name for the database cluster.
+	columnDatabase = "database"
+	// columnTask is the column name for the executed task.
+	columnTask = "task"
+	// columnState is the column name for the task execution state.
+	columnState = "state"
+	// columnMessage is the column name for the task execution message.
+	columnMessage = "message"
+)
+
+// Print post-upgrade tasks results to console.
+func printPostUpgradeTasksTable(results []api.PostUpgradeTaskResult) {
+	// Prepare table headings.
+	headings := []interface{}{columnDatabase, columnTask, columnState, columnMessage}
+	// Prepare table header.
+	tbl := table.New(headings...)
+	tbl.WithHeaderFormatter(func(format string, vals ...interface{}) string {
+		// Print all in caps.
+		return strings.ToUpper(fmt.Sprintf(format, vals...))
+	})
+
+	// Return a table row for the given result.
+	row := func(r api.PostUpgradeTaskResult) []any {
+		var row []any
+		for _, heading := range headings {
+			switch heading {
+			case columnDatabase:
+				row = append(row, pointer.Get(r.Task.Name))
+			case columnTask:
+				row = append(row, pointer.Get(r.Task.PendingTask))
+			case columnState:
+				row = append(row, r.State)
+			case columnMessage:
+				row = append(row, pointer.Get(r.Message))
+			}
+		}
+		return row
+	}
+
+	for _, r := range results {
+		tbl.AddRow(row(r)...)
+	}
+
+	tbl.Print()
+}
@@ -22,3 +22,16 @@ import "embed"
 //
 //go:embed rbac/*
 var RBAC embed.FS
+
+// EverestServerRBAC stores the Kubernetes RBAC manifest with the permissions of the
+// Everest API server that are not granted by the Everest Helm chart.
+//
+//go:embed everest-server-rbac.yaml
+var EverestServerRBAC []byte
+
+// EverestServerNamespaceProvisioningRBAC stores the Kubernetes RBAC manifest with the permissions
+// of the Everest API server to provision the DB namespaces. It is applied only when the namespace
+// provisioning is enabled.
+//
+//go:embed everest-server-namespace-provisioning-rbac.yaml
+var EverestServerNamespaceProvisioningRBAC []byte
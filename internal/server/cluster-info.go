@@ -15,3 +15,13 @@ func (e *EverestServer) GetKubernetesClusterInfo(ctx echo.Context) error {
 	}
 	return ctx.JSON(http.StatusOK, result)
 }
+
+// ListStorageClasses returns the storage classes of a kubernetes cluster.
+func (e *EverestServer) ListStorageClasses(ctx echo.Context) error {
+	result, err := e.handler.ListStorageClasses(ctx.Request().Context())
+	if err != nil {
+		e.l.Errorf("ListStorageClasses failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
@@ -26,6 +26,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/autoscaling"
 )
 
 var (
@@ -33,6 +34,18 @@ var (
 	errFailedToReadRequestBody = errors.New("failed to read request body")
 )
 
+// storageAutoscalerLease is the name of the lease held by the replica that autoscales the storage.
+const storageAutoscalerLease = "everest-storage-autoscaler"
+
+// RunStorageAutoscalingJob periodically grows the storage of the database clusters with storage autoscaling enabled.
+// Only the replica of the Everest server that holds the storage autoscaler lease grows the storage.
+func (e *EverestServer) RunStorageAutoscalingJob(ctx context.Context) {
+	autoscaler := autoscaling.NewAutoscaler(e.kubeConnector, e.systemHandler, e.l, e.config.StorageAutoscalingInterval)
+	if err := e.kubeConnector.RunWithLeaderElection(ctx, storageAutoscalerLease, autoscaler.Run); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not run the storage autoscaling job")))
+	}
+}
+
 // CreateDatabaseCluster creates a new db cluster inside the given k8s cluster.
 func (e *EverestServer) CreateDatabaseCluster(c echo.Context, namespace string) error {
 	dbc := &everestv1alpha1.DatabaseCluster{}
@@ -140,3 +153,28 @@ func (e *EverestServer) GetDatabaseClusterPitr(c echo.Context, namespace, name s
 	}
 	return c.JSON(http.StatusOK, result)
 }
+
+// PlanDatabaseClusterCapacity checks whether a prospective database cluster fits into the kubernetes cluster.
+func (e *EverestServer) PlanDatabaseClusterCapacity(c echo.Context, namespace string) error {
+	req := &api.CapacityPlanRequest{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.handler.PlanDatabaseClusterCapacity(c.Request().Context(), namespace, req)
+	if err != nil {
+		e.l.Errorf("PlanDatabaseClusterCapacity failed: %w", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// CheckDatabaseClusterStorageResize checks whether the storage of a database cluster can be resized.
+func (e *EverestServer) CheckDatabaseClusterStorageResize(c echo.Context, namespace, name string, params api.CheckDatabaseClusterStorageResizeParams) error {
+	result, err := e.handler.CheckDatabaseClusterStorageResize(c.Request().Context(), namespace, name, &params)
+	if err != nil {
+		e.l.Errorf("CheckDatabaseClusterStorageResize failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
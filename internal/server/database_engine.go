@@ -17,14 +17,30 @@
 package server
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
 )
 
+// fleetUpgradeLease is the name of the lease held by the replica that runs the fleet upgrade rollouts.
+const fleetUpgradeLease = "everest-fleet-upgrade"
+
+// RunFleetUpgradeJob runs the fleet upgrade rollouts approved through the API.
+// Only the replica of the Everest server that holds the fleet upgrade lease runs them,
+// and it resumes a rollout interrupted by a restart of the previous holder.
+func (e *EverestServer) RunFleetUpgradeJob(ctx context.Context) {
+	job := k8shandler.NewFleetUpgradeJob(e.l, e.kubeConnector, e.config.VersionServiceURL)
+	if err := e.kubeConnector.RunWithLeaderElection(ctx, fleetUpgradeLease, job.Run); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not run the fleet upgrade job")))
+	}
+}
+
 // ListDatabaseEngines List of the available database engines on the specified namespace.
 func (e *EverestServer) ListDatabaseEngines(ctx echo.Context, namespace string) error {
 	result, err := e.handler.ListDatabaseEngines(ctx.Request().Context(), namespace)
@@ -85,3 +101,61 @@ func (e *EverestServer) ApproveUpgradePlan(ctx echo.Context, namespace string) e
 	}
 	return nil
 }
+
+// GetFleetUpgradePlan gets the upgrade plan for all the namespaces managed by Everest.
+func (e *EverestServer) GetFleetUpgradePlan(ctx echo.Context) error {
+	result, err := e.handler.GetFleetUpgradePlan(ctx.Request().Context())
+	if err != nil {
+		e.l.Errorf("GetFleetUpgradePlan failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// ApproveFleetUpgradePlan starts a rolling upgrade of operators across namespaces.
+func (e *EverestServer) ApproveFleetUpgradePlan(ctx echo.Context) error {
+	req := &api.FleetUpgradePlanApproval{}
+	if err := e.getBodyFromContext(ctx, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	result, err := e.handler.ApproveFleetUpgradePlan(ctx.Request().Context(), req)
+	if err != nil {
+		e.l.Errorf("ApproveFleetUpgradePlan failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusAccepted, result)
+}
+
+// GetFleetUpgradeRollout gets the progress of the latest rolling upgrade of operators.
+func (e *EverestServer) GetFleetUpgradeRollout(ctx echo.Context) error {
+	result, err := e.handler.GetFleetUpgradeRollout(ctx.Request().Context())
+	if err != nil {
+		e.l.Errorf("GetFleetUpgradeRollout failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// ExecutePostUpgradeTasks starts the execution of the pending tasks of the database clusters in the provided namespace.
+func (e *EverestServer) ExecutePostUpgradeTasks(ctx echo.Context, namespace string) error {
+	req := &api.PostUpgradeTasksExecution{}
+	if err := e.getBodyFromContext(ctx, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	result, err := e.handler.ExecutePostUpgradeTasks(ctx.Request().Context(), namespace, req)
+	if err != nil {
+		e.l.Errorf("ExecutePostUpgradeTasks failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusAccepted, result)
+}
+
+// GetPostUpgradeTasksJob returns the progress of the latest post-upgrade tasks execution in the provided namespace.
+func (e *EverestServer) GetPostUpgradeTasksJob(ctx echo.Context, namespace string) error {
+	result, err := e.handler.GetPostUpgradeTasksJob(ctx.Request().Context(), namespace)
+	if err != nil {
+		e.l.Errorf("GetPostUpgradeTasksJob failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
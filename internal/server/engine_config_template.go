@@ -0,0 +1,95 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+)
+
+// ListEngineConfigTemplates lists the engine config templates in the namespace.
+func (e *EverestServer) ListEngineConfigTemplates(c echo.Context, namespace string) error {
+	list, err := e.handler.ListEngineConfigTemplates(c.Request().Context(), namespace)
+	if err != nil {
+		e.l.Errorf("ListEngineConfigTemplates failed: %w", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, list)
+}
+
+// CreateEngineConfigTemplate creates a new engine config template.
+func (e *EverestServer) CreateEngineConfigTemplate(c echo.Context, namespace string) error {
+	template := &api.EngineConfigTemplate{}
+	if err := e.getBodyFromContext(c, template); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.handler.CreateEngineConfigTemplate(c.Request().Context(), namespace, template)
+	if err != nil {
+		e.l.Errorf("CreateEngineConfigTemplate failed: %w", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetEngineConfigTemplate retrieves an engine config template by name.
+func (e *EverestServer) GetEngineConfigTemplate(c echo.Context, namespace, name string) error {
+	result, err := e.handler.GetEngineConfigTemplate(c.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Errorf("GetEngineConfigTemplate failed: %w", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// UpdateEngineConfigTemplate updates an existing engine config template.
+func (e *EverestServer) UpdateEngineConfigTemplate(c echo.Context, namespace, name string) error {
+	template := &api.EngineConfigTemplate{}
+	if err := e.getBodyFromContext(c, template); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	template.Name = name
+
+	result, err := e.handler.UpdateEngineConfigTemplate(c.Request().Context(), namespace, template)
+	if err != nil {
+		e.l.Errorf("UpdateEngineConfigTemplate failed: %w", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// DeleteEngineConfigTemplate deletes an engine config template.
+func (e *EverestServer) DeleteEngineConfigTemplate(c echo.Context, namespace, name string) error {
+	if err := e.handler.DeleteEngineConfigTemplate(c.Request().Context(), namespace, name); err != nil {
+		e.l.Errorf("DeleteEngineConfigTemplate failed: %w", err)
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RolloutEngineConfigTemplate applies an engine config template to the referencing database clusters.
+func (e *EverestServer) RolloutEngineConfigTemplate(c echo.Context, namespace, name string) error {
+	result, err := e.handler.RolloutEngineConfigTemplate(c.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Errorf("RolloutEngineConfigTemplate failed: %w", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
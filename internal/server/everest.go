@@ -65,6 +65,9 @@ type EverestServer struct {
 	sessionMgr    *session.Manager
 	attemptsStore *RateLimiterMemoryStore
 	handler       handlers.Handler
+	// systemHandler is the handler chain used by the background jobs. The jobs act on behalf
+	// of Everest rather than a user, so the chain has no RBAC handler.
+	systemHandler handlers.Handler
 	oidcProvider  *oidc.ProviderConfig
 }
 
@@ -178,6 +181,9 @@ func (e *EverestServer) initHTTPServer(ctx context.Context) error {
 	staticFilesHandler := http.FileServer(http.FS(fsys))
 	e.echo.GET("/static/*", echo.WrapHandler(staticFilesHandler), e.securityHeaders())
 
+	// Serve the version service responses of the offline bundle.
+	e.echo.GET(common.VersionServicePath+"/*", e.getBundledVersionService)
+
 	// Middlewares
 	e.echo.Use(echomiddleware.LoggerWithConfig(echomiddleware.LoggerConfig{
 		Format:           echomiddleware.DefaultLoggerConfig.Format,
@@ -235,7 +241,10 @@ func (e *EverestServer) setupHandlers(
 	kubeConnector kubernetes.KubernetesConnector,
 	vsURL string,
 ) error {
-	k8sH := k8shandler.New(log, kubeConnector, vsURL)
+	e.systemHandler = newHandlerChain(valhandler.New(log, kubeConnector), k8shandler.New(log, kubeConnector, vsURL))
+	// The background jobs started through the API update the database clusters with the system handler,
+	// so the updates are validated even though the jobs outlive the requests.
+	k8sH := k8shandler.New(log, kubeConnector, vsURL, k8shandler.WithDatabaseClusterUpdater(e.systemHandler))
 	valH := valhandler.New(log, kubeConnector)
 	rbacH, err := rbachandler.New(ctx, log, kubeConnector)
 	if err != nil {
@@ -441,13 +450,29 @@ func everestErrorHandler(next echo.HTTPErrorHandler) echo.HTTPErrorHandler {
 			err = &echo.HTTPError{
 				Code: http.StatusConflict,
 			}
+		case errors.Is(err, k8shandler.ErrNamespaceProvisioningJobNotFound),
+			errors.Is(err, k8shandler.ErrPostUpgradeTasksJobNotFound):
+			err = &echo.HTTPError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}
+		case errors.Is(err, k8shandler.ErrFleetUpgradeInProgress),
+			errors.Is(err, k8shandler.ErrNamespaceProvisioningInProgress),
+			errors.Is(err, k8shandler.ErrPostUpgradeTasksInProgress):
+			err = &echo.HTTPError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}
 		case errors.Is(err, rbachandler.ErrInsufficientPermissions):
 			err = &echo.HTTPError{
 				Code:    http.StatusForbidden,
 				Message: rbachandler.ErrInsufficientPermissions.Error(),
 			}
 		case errors.Is(err, valhandler.ErrInvalidRequest),
-			errors.Is(err, errFailedToReadRequestBody):
+			errors.Is(err, errFailedToReadRequestBody),
+			errors.Is(err, k8shandler.ErrFleetUpgradeNotAllowed),
+			errors.Is(err, k8shandler.ErrOperatorVersionPinned),
+			errors.Is(err, k8shandler.ErrNamespaceProvisioningNotAllowed):
 			err = &echo.HTTPError{
 				Code:    http.StatusBadRequest,
 				Message: err.Error(),
@@ -8,6 +8,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
 )
 
 // Handler provides an abstraction for the core business logic of the Everest API.
@@ -28,9 +29,11 @@ type Handler interface {
 	BackupStorageHandler
 	MonitoringInstanceHandler
 	PodSchedulingPolicyHandler
+	EngineConfigTemplateHandler
 
 	GetKubernetesClusterResources(ctx context.Context) (*api.KubernetesClusterResources, error)
 	GetKubernetesClusterInfo(ctx context.Context) (*api.KubernetesClusterInfo, error)
+	ListStorageClasses(ctx context.Context) (api.StorageClassInfoList, error)
 	GetUserPermissions(ctx context.Context) (*api.UserPermissions, error)
 	GetSettings(ctx context.Context) (*api.Settings, error)
 }
@@ -45,11 +48,20 @@ type DatabaseClusterHandler interface {
 	GetDatabaseClusterCredentials(ctx context.Context, namespace, name string) (*api.DatabaseClusterCredential, error)
 	GetDatabaseClusterComponents(ctx context.Context, namespace, name string) ([]api.DatabaseClusterComponent, error)
 	GetDatabaseClusterPitr(ctx context.Context, namespace, name string) (*api.DatabaseClusterPitr, error)
+	PlanDatabaseClusterCapacity(ctx context.Context, namespace string, req *api.CapacityPlanRequest) (*api.CapacityPlan, error)
+	CheckDatabaseClusterStorageResize(ctx context.Context, namespace, name string, params *api.CheckDatabaseClusterStorageResizeParams) (*api.StorageResizeCheck, error)
 }
 
 // NamespacesHandler provides methods for handling operations on namespaces.
 type NamespacesHandler interface {
 	ListNamespaces(ctx context.Context) ([]string, error)
+	GetNamespaceQuotaUsage(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error)
+	GetUsageReport(ctx context.Context, params *api.GetUsageReportParams) (*api.UsageReport, error)
+	ListNamespaceProvisioningJobs(ctx context.Context) (api.NamespaceProvisioningJobList, error)
+	GetNamespaceProvisioningJob(ctx context.Context, namespace string) (*api.NamespaceProvisioningJob, error)
+	ProvisionNamespace(ctx context.Context, req *api.NamespaceProvisioningRequest) (*api.NamespaceProvisioningJob, error)
+	UpdateNamespaceOperators(ctx context.Context, namespace string, req *api.NamespaceOperators) (*api.NamespaceProvisioningJob, error)
+	DeprovisionNamespace(ctx context.Context, namespace string, params *api.DeprovisionNamespaceParams) (*api.NamespaceProvisioningJob, error)
 }
 
 // DatabaseClusterBackupHandler provides methods for handling operations on database cluster backups.
@@ -76,6 +88,13 @@ type DatabaseEngineHandler interface {
 	GetDatabaseEngine(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseEngine, error)
 	GetUpgradePlan(ctx context.Context, namespace string) (*api.UpgradePlan, error)
 	ApproveUpgradePlan(ctx context.Context, namespace string) error
+	GetFleetUpgradePlan(ctx context.Context) (*api.FleetUpgradePlan, error)
+	ApproveFleetUpgradePlan(ctx context.Context, req *api.FleetUpgradePlanApproval) (*api.FleetUpgradeRollout, error)
+	GetFleetUpgradeRollout(ctx context.Context) (*api.FleetUpgradeRollout, error)
+	ExecutePostUpgradeTasks(ctx context.Context, namespace string, req *api.PostUpgradeTasksExecution) (*api.PostUpgradeTasksJob, error)
+	GetPostUpgradeTasksJob(ctx context.Context, namespace string) (*api.PostUpgradeTasksJob, error)
+	GetEngineVersionPolicy(ctx context.Context, namespace string) (*common.EngineVersionPolicy, error)
+	UpdateEngineVersionPolicy(ctx context.Context, namespace string, policy *common.EngineVersionPolicy) (*common.EngineVersionPolicy, error)
 }
 
 // BackupStorageHandler provides methods for handling operations on backup storages.
@@ -90,10 +109,10 @@ type BackupStorageHandler interface {
 // MonitoringInstanceHandler provides methods for handling operations on monitoring instances.
 type MonitoringInstanceHandler interface {
 	CreateMonitoringInstance(ctx context.Context, namespace string, req *api.CreateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error)
-	UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error)
+	UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*api.MonitoringInstance, error)
 	ListMonitoringInstances(ctx context.Context, namespaces string) (*everestv1alpha1.MonitoringConfigList, error)
 	GetMonitoringInstance(ctx context.Context, namespace, name string) (*everestv1alpha1.MonitoringConfig, error)
-	DeleteMonitoringInstance(ctx context.Context, namespace, name string) error
+	DeleteMonitoringInstance(ctx context.Context, namespace, name string, req *api.DeleteMonitoringInstanceJSONRequestBody) error
 }
 
 // PodSchedulingPolicyHandler provides methods for handling operations on pod scheduling policies.
@@ -103,4 +122,24 @@ type PodSchedulingPolicyHandler interface {
 	ListPodSchedulingPolicies(ctx context.Context, params *api.ListPodSchedulingPolicyParams) (*everestv1alpha1.PodSchedulingPolicyList, error)
 	DeletePodSchedulingPolicy(ctx context.Context, name string) error
 	GetPodSchedulingPolicy(ctx context.Context, name string) (*everestv1alpha1.PodSchedulingPolicy, error)
+	PreviewPodSchedulingPolicy(ctx context.Context, req *PodSchedulingPolicyPreviewRequest) (*api.PodSchedulingPolicyPreview, error)
+}
+
+// PodSchedulingPolicyPreviewRequest is the request to preview a pod scheduling policy.
+// It's the same as api.PodSchedulingPolicyPreviewRequest, but holds the policy as the custom resource.
+type PodSchedulingPolicyPreviewRequest struct {
+	EngineType everestv1alpha1.EngineType           `json:"engineType,omitempty"`
+	Namespace  string                               `json:"namespace,omitempty"`
+	PolicyName string                               `json:"policyName,omitempty"`
+	Policy     *everestv1alpha1.PodSchedulingPolicy `json:"policy,omitempty"`
+}
+
+// EngineConfigTemplateHandler provides methods for handling operations on engine config templates.
+type EngineConfigTemplateHandler interface {
+	ListEngineConfigTemplates(ctx context.Context, namespace string) (*api.EngineConfigTemplateList, error)
+	GetEngineConfigTemplate(ctx context.Context, namespace, name string) (*api.EngineConfigTemplate, error)
+	CreateEngineConfigTemplate(ctx context.Context, namespace string, template *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error)
+	UpdateEngineConfigTemplate(ctx context.Context, namespace string, template *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error)
+	DeleteEngineConfigTemplate(ctx context.Context, namespace, name string) error
+	RolloutEngineConfigTemplate(ctx context.Context, namespace, name string) (*api.EngineConfigTemplateRolloutResult, error)
 }
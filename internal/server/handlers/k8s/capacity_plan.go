@@ -0,0 +1,371 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func (h *k8sHandler) PlanDatabaseClusterCapacity(ctx context.Context, namespace string, req *api.CapacityPlanRequest) (*api.CapacityPlan, error) {
+	db, psp, err := req.ToCR(namespace)
+	if err != nil {
+		return nil, err
+	}
+	result := &api.CapacityPlan{
+		Fits:       true,
+		Components: []api.CapacityPlanComponent{},
+		Nodes:      []api.CapacityPlanNode{},
+	}
+
+	if psp == nil {
+		if psp, err = h.databaseClusterPodSchedulingPolicy(ctx, db); err != nil {
+			return nil, err
+		}
+		if psp != nil {
+			result.PodSchedulingPolicyName = psp.GetName()
+		}
+	}
+	var affinityConfig *everestv1alpha1.AffinityConfig
+	if psp != nil {
+		affinityConfig = psp.Spec.AffinityConfig
+	}
+
+	p, err := h.loadSchedulingPreview(ctx, db.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	nodesResources, err := kubernetes.GetNodesResources(p.nodes, p.pods)
+	if err != nil {
+		return nil, err
+	}
+	plan := newCapacityPlanner(p, nodesResources)
+	for _, c := range capacityComponents(db, affinityConfig) {
+		component := plan.place(c)
+		for _, r := range component.Replicas {
+			result.Fits = result.Fits && r.Fits
+		}
+		result.Components = append(result.Components, component)
+	}
+	result.Nodes = plan.nodes()
+
+	if result.Storage, err = h.planStorage(ctx, db); err != nil {
+		return nil, err
+	}
+	result.Fits = result.Fits && result.Storage.Fits
+	return result, nil
+}
+
+// databaseClusterPodSchedulingPolicy returns the policy the DB cluster refers to or the default policy
+// that would be applied to it, nil if there is none.
+func (h *k8sHandler) databaseClusterPodSchedulingPolicy(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+) (*everestv1alpha1.PodSchedulingPolicy, error) {
+	if name := db.Spec.PodSchedulingPolicyName; name != "" {
+		return h.kubeConnector.GetPodSchedulingPolicy(ctx, types.NamespacedName{Name: name})
+	}
+	pspList, err := h.kubeConnector.ListPodSchedulingPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pod scheduling policies: %w", err)
+	}
+	return common.DefaultPodSchedulingPolicy(pspList.Items, db.Spec.Engine.Type, db.GetNamespace()), nil
+}
+
+// capacityComponent is a component of a DB cluster whose replicas need to be placed.
+type capacityComponent struct {
+	name     string
+	affinity *corev1.Affinity
+	// groups is the number of the replica sets of the component, e.g. shards.
+	// The replicas of a group are spread according to the pod anti-affinity rules of the component.
+	groups      int
+	replicas    int
+	cpuMillis   uint64
+	memoryBytes uint64
+}
+
+// capacityComponents returns the components of the DB cluster that have replicas.
+// The config servers of a sharded cluster request as much as the engine.
+func capacityComponents(db *everestv1alpha1.DatabaseCluster, cfg *everestv1alpha1.AffinityConfig) []capacityComponent {
+	engineReplicas := int(db.Spec.Engine.Replicas)
+	sharding := db.Spec.Sharding
+	sharded := sharding != nil && sharding.Enabled
+	proxyReplicas := 0
+	if db.Spec.Engine.Type != everestv1alpha1.DatabaseEnginePSMDB || sharded {
+		proxyReplicas = int(pointer.Get(db.Spec.Proxy.Replicas))
+		if db.Spec.Proxy.Replicas == nil {
+			proxyReplicas = engineReplicas
+		}
+	}
+
+	var result []capacityComponent
+	for _, c := range pspComponents(db.Spec.Engine.Type, cfg) {
+		component := capacityComponent{name: c.name, affinity: c.affinity, groups: 1}
+		switch c.name {
+		case pspComponentEngine:
+			component.replicas = engineReplicas
+			if sharded {
+				component.groups = int(sharding.Shards)
+			}
+			component.cpuMillis, component.memoryBytes = resourceRequests(db.Spec.Engine.Resources)
+		case pspComponentProxy:
+			component.replicas = proxyReplicas
+			component.cpuMillis, component.memoryBytes = resourceRequests(db.Spec.Proxy.Resources)
+		case pspComponentConfigServer:
+			if sharded {
+				component.replicas = int(sharding.ConfigServer.Replicas)
+			}
+			component.cpuMillis, component.memoryBytes = resourceRequests(db.Spec.Engine.Resources)
+		}
+		if component.replicas > 0 {
+			result = append(result, component)
+		}
+	}
+	return result
+}
+
+func resourceRequests(r everestv1alpha1.Resources) (uint64, uint64) {
+	return uint64(max(r.CPU.MilliValue(), 0)), uint64(max(r.Memory.Value(), 0))
+}
+
+// capacityPlanner places the replicas on the nodes one by one, like the scheduler
+// that prefers the least allocated nodes.
+type capacityPlanner struct {
+	preview   *schedulingPreview
+	resources []kubernetes.NodeResources
+	// planned holds the CPU and memory requested by the placed replicas per node index.
+	planned [][2]uint64
+	index   map[string]int
+}
+
+func newCapacityPlanner(p *schedulingPreview, resources []kubernetes.NodeResources) *capacityPlanner {
+	c := &capacityPlanner{
+		preview:   p,
+		resources: resources,
+		planned:   make([][2]uint64, len(resources)),
+		index:     make(map[string]int, len(resources)),
+	}
+	for i, r := range resources {
+		c.index[r.Name] = i
+	}
+	return c
+}
+
+func (c *capacityPlanner) freeCPUMillis(i int) uint64 {
+	return saturatingSub(c.resources[i].AvailableCPUMillis(), c.planned[i][0])
+}
+
+func (c *capacityPlanner) freeMemoryBytes(i int) uint64 {
+	return saturatingSub(c.resources[i].AvailableMemoryBytes(), c.planned[i][1])
+}
+
+func (c *capacityPlanner) place(component capacityComponent) api.CapacityPlanComponent {
+	result := api.CapacityPlanComponent{
+		Component:   component.name,
+		CpuMillis:   component.cpuMillis,
+		MemoryBytes: component.memoryBytes,
+		Replicas:    []api.CapacityPlanReplica{},
+	}
+	eligible := c.preview.component(component.name, component.affinity).Nodes
+	spreadKeys := requiredAntiAffinityTopologyKeys(component.affinity)
+
+	for range component.groups {
+		// topology domains taken by the replicas of the group per topology key
+		taken := make(map[string]map[string]bool, len(spreadKeys))
+		for _, key := range spreadKeys {
+			taken[key] = map[string]bool{}
+		}
+		for range component.replicas {
+			replica := c.placeReplica(component, eligible, spreadKeys, taken)
+			result.Replicas = append(result.Replicas, replica)
+		}
+	}
+	return result
+}
+
+func (c *capacityPlanner) placeReplica(
+	component capacityComponent,
+	eligible []string,
+	spreadKeys []string,
+	taken map[string]map[string]bool,
+) api.CapacityPlanReplica {
+	if len(eligible) == 0 {
+		return api.CapacityPlanReplica{Reason: "no node satisfies the required rules of the pod scheduling policy"}
+	}
+
+	best, spread := -1, false
+	for _, name := range eligible {
+		node := c.preview.nodeByName[name]
+		if slices.ContainsFunc(spreadKeys, func(key string) bool {
+			domain, ok := node.GetLabels()[key]
+			return ok && taken[key][domain]
+		}) {
+			continue
+		}
+		spread = true
+		i := c.index[name]
+		if c.freeCPUMillis(i) < component.cpuMillis || c.freeMemoryBytes(i) < component.memoryBytes {
+			continue
+		}
+		if best == -1 || c.freeMemoryBytes(i) > c.freeMemoryBytes(best) ||
+			(c.freeMemoryBytes(i) == c.freeMemoryBytes(best) && c.freeCPUMillis(i) > c.freeCPUMillis(best)) {
+			best = i
+		}
+	}
+	if !spread {
+		return api.CapacityPlanReplica{
+			Reason: fmt.Sprintf("no node left in a topology domain without another replica (%s)", strings.Join(spreadKeys, ", ")),
+		}
+	}
+	if best == -1 {
+		return api.CapacityPlanReplica{
+			Reason: fmt.Sprintf("no node has %s CPU and %s memory available",
+				resource.NewMilliQuantity(int64(component.cpuMillis), resource.DecimalSI), //nolint:gosec
+				resource.NewQuantity(int64(component.memoryBytes), resource.BinarySI),     //nolint:gosec
+			),
+		}
+	}
+
+	c.planned[best][0] += component.cpuMillis
+	c.planned[best][1] += component.memoryBytes
+	node := c.preview.nodeByName[c.resources[best].Name]
+	for _, key := range spreadKeys {
+		if domain, ok := node.GetLabels()[key]; ok {
+			taken[key][domain] = true
+		}
+	}
+	return api.CapacityPlanReplica{Fits: true, Node: node.GetName()}
+}
+
+func (c *capacityPlanner) nodes() []api.CapacityPlanNode {
+	result := make([]api.CapacityPlanNode, 0, len(c.resources))
+	for i, r := range c.resources {
+		result = append(result, api.CapacityPlanNode{
+			Name:                   r.Name,
+			AllocatableCpuMillis:   r.AllocatableCPUMillis,
+			AllocatableMemoryBytes: r.AllocatableMemoryBytes,
+			AvailableCpuMillis:     r.AvailableCPUMillis(),
+			AvailableMemoryBytes:   r.AvailableMemoryBytes(),
+			PlannedCpuMillis:       c.planned[i][0],
+			PlannedMemoryBytes:     c.planned[i][1],
+		})
+	}
+	return result
+}
+
+// requiredAntiAffinityTopologyKeys returns the topology keys of the required pod anti-affinity rules.
+// The rules of the policies select the pods of the component itself, so its replicas can't share a topology domain.
+func requiredAntiAffinityTopologyKeys(affinity *corev1.Affinity) []string {
+	if affinity == nil || affinity.PodAntiAffinity == nil {
+		return nil
+	}
+	var keys []string
+	for _, term := range affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+		if !slices.Contains(keys, term.TopologyKey) {
+			keys = append(keys, term.TopologyKey)
+		}
+	}
+	return keys
+}
+
+// planStorage checks the volumes of the DB cluster against the capacity reported for the storage class,
+// or the disk capacity of the cluster if the storage class reports none.
+func (h *k8sHandler) planStorage(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (api.CapacityPlanStorage, error) {
+	volumes := int(db.Spec.Engine.Replicas)
+	if sharding := db.Spec.Sharding; sharding != nil && sharding.Enabled {
+		volumes = volumes*int(sharding.Shards) + int(sharding.ConfigServer.Replicas)
+	}
+	result := api.CapacityPlanStorage{
+		Fits:           true,
+		Volumes:        volumes,
+		RequestedBytes: uint64(max(db.Spec.Engine.Storage.Size.Value(), 0)) * uint64(volumes),
+		StorageClass:   pointer.Get(db.Spec.Engine.Storage.Class),
+	}
+
+	classes, err := h.kubeConnector.ListStorageClasses(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to list storage classes: %w", err)
+	}
+	sc := kubernetes.FindStorageClass(classes.Items, result.StorageClass)
+	if sc == nil {
+		result.Fits = false
+		result.Reason = fmt.Sprintf("storage class '%s' not found", result.StorageClass)
+		if result.StorageClass == "" {
+			result.Reason = "no storage class is set and there is no default storage class"
+		}
+		return result, nil
+	}
+	result.StorageClass = sc.GetName()
+
+	// The storage capacity tracking may not be available in the cluster or to the Everest server,
+	// in which case the storage is planned like for the storage classes that report no capacity.
+	capacities, err := h.kubeConnector.ListCSIStorageCapacities(ctx)
+	if err != nil {
+		if !k8serrors.IsForbidden(err) && !k8serrors.IsNotFound(err) {
+			return result, fmt.Errorf("failed to list CSI storage capacities: %w", err)
+		}
+		h.log.Debugf("CSI storage capacities are not available: %v", err)
+		capacities = &storagev1.CSIStorageCapacityList{}
+	}
+	var reported bool
+	var available uint64
+	for _, c := range capacities.Items {
+		if c.StorageClassName != result.StorageClass || c.Capacity == nil {
+			continue
+		}
+		reported = true
+		available += uint64(max(c.Capacity.Value(), 0))
+	}
+	if reported {
+		result.AvailableBytes = pointer.ToUint64(available)
+	} else {
+		resources, err := h.GetKubernetesClusterResources(ctx)
+		if err != nil {
+			return result, err
+		}
+		result.AvailableBytes = resources.Available.DiskSize
+	}
+
+	if result.AvailableBytes != nil && result.RequestedBytes > *result.AvailableBytes {
+		result.Fits = false
+		result.Reason = fmt.Sprintf("%s requested but only %s available",
+			resource.NewQuantity(int64(result.RequestedBytes), resource.BinarySI),  //nolint:gosec
+			resource.NewQuantity(int64(*result.AvailableBytes), resource.BinarySI), //nolint:gosec
+		)
+	}
+	return result, nil
+}
+
+func saturatingSub(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
@@ -0,0 +1,213 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestPlanDatabaseClusterCapacity(t *testing.T) {
+	t.Parallel()
+
+	node := func(name string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{corev1.LabelHostname: name}},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			}},
+		}
+	}
+	busy := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "busy", Namespace: "apps"},
+		Spec: corev1.PodSpec{
+			NodeName: "node-1",
+			Containers: []corev1.Container{{
+				Name:      "busy",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3")}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	storageClass := &storagev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "standard", Annotations: map[string]string{annotationStorageClassDefault: "true"}},
+		Provisioner: "csi.example.com",
+	}
+	storageCapacity := &storagev1.CSIStorageCapacity{
+		ObjectMeta:       metav1.ObjectMeta{Name: "standard-capacity", Namespace: "kube-system"},
+		StorageClassName: "standard",
+		Capacity:         pointer.To(resource.MustParse("100Gi")),
+	}
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(node("node-1"), node("node-2"), node("node-3"), busy, storageClass, storageCapacity, getDefaultPXCPolicy()).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	h := New(zap.NewNop().Sugar(), k, "")
+
+	db := func(replicas int32, size string) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test-ns"},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{
+					Type:     everestv1alpha1.DatabaseEnginePXC,
+					Replicas: replicas,
+					Storage:  everestv1alpha1.Storage{Size: resource.MustParse(size)},
+					Resources: everestv1alpha1.Resources{
+						CPU:    resource.MustParse("1"),
+						Memory: resource.MustParse("4Gi"),
+					},
+				},
+				Proxy: everestv1alpha1.Proxy{
+					Replicas: pointer.To(int32(2)),
+					Resources: everestv1alpha1.Resources{
+						CPU:    resource.MustParse("500m"),
+						Memory: resource.MustParse("1Gi"),
+					},
+				},
+				PodSchedulingPolicyName: "everest-default-mysql",
+			},
+		}
+	}
+
+	t.Run("fits", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := h.PlanDatabaseClusterCapacity(context.Background(), "test-ns", capacityPlanRequest(t, db(3, "20Gi"), nil))
+		require.NoError(t, err)
+		assert.True(t, plan.Fits)
+		assert.Equal(t, "everest-default-mysql", plan.PodSchedulingPolicyName)
+		require.Len(t, plan.Components, 2)
+		// The replicas are spread across the hosts and prefer the least allocated nodes.
+		assert.Equal(t, []api.CapacityPlanReplica{
+			{Fits: true, Node: "node-2"},
+			{Fits: true, Node: "node-3"},
+			{Fits: true, Node: "node-1"},
+		}, plan.Components[0].Replicas)
+		assert.Equal(t, []api.CapacityPlanReplica{
+			{Fits: true, Node: "node-2"},
+			{Fits: true, Node: "node-3"},
+		}, plan.Components[1].Replicas)
+		assert.Equal(t, api.CapacityPlanNode{
+			Name:                   "node-1",
+			AllocatableCpuMillis:   4000,
+			AllocatableMemoryBytes: 16 << 30,
+			AvailableCpuMillis:     1000,
+			AvailableMemoryBytes:   16 << 30,
+			PlannedCpuMillis:       1000,
+			PlannedMemoryBytes:     4 << 30,
+		}, plan.Nodes[0])
+		assert.Equal(t, api.CapacityPlanStorage{
+			Fits:           true,
+			StorageClass:   "standard",
+			Volumes:        3,
+			RequestedBytes: 60 << 30,
+			AvailableBytes: pointer.ToUint64(100 << 30),
+		}, plan.Storage)
+	})
+
+	t.Run("doesn't fit", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := h.PlanDatabaseClusterCapacity(context.Background(), "test-ns", capacityPlanRequest(t, db(5, "25Gi"), nil))
+		require.NoError(t, err)
+		assert.False(t, plan.Fits)
+		replicas := plan.Components[0].Replicas
+		require.Len(t, replicas, 5)
+		assert.False(t, replicas[3].Fits)
+		assert.Equal(t, "no node left in a topology domain without another replica (kubernetes.io/hostname)", replicas[3].Reason)
+		assert.False(t, plan.Storage.Fits)
+		assert.Equal(t, "125Gi requested but only 100Gi available", plan.Storage.Reason)
+	})
+
+	t.Run("resources", func(t *testing.T) {
+		t.Parallel()
+
+		// Without the policy, the replicas are limited by the resources only.
+		big := db(3, "1Gi")
+		big.Spec.Engine.Resources.CPU = resource.MustParse("3")
+		plan, err := h.PlanDatabaseClusterCapacity(context.Background(), "test-ns", capacityPlanRequest(t, big,
+			&everestv1alpha1.PodSchedulingPolicy{Spec: everestv1alpha1.PodSchedulingPolicySpec{EngineType: everestv1alpha1.DatabaseEnginePXC}},
+		))
+		require.NoError(t, err)
+		assert.False(t, plan.Fits)
+		assert.Empty(t, plan.PodSchedulingPolicyName)
+		assert.Equal(t, []api.CapacityPlanReplica{
+			{Fits: true, Node: "node-2"},
+			{Fits: true, Node: "node-3"},
+			{Reason: "no node has 3 CPU and 4Gi memory available"},
+		}, plan.Components[0].Replicas)
+	})
+
+	t.Run("no storage capacity tracking", func(t *testing.T) {
+		t.Parallel()
+
+		// The CPU and memory are still planned if the CSI storage capacities can't be listed.
+		forbidden := fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.CreateScheme()).
+			WithObjects(node("node-1"), node("node-2"), node("node-3"), busy, storageClass, getDefaultPXCPolicy()).
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, c ctrlclient.WithWatch, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+					if _, ok := list.(*storagev1.CSIStorageCapacityList); ok {
+						return k8serrors.NewForbidden(storagev1.Resource("csistoragecapacities"), "", errors.New("forbidden"))
+					}
+					return c.List(ctx, list, opts...)
+				},
+			}).
+			Build()
+		h := New(zap.NewNop().Sugar(), kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(forbidden), "")
+
+		plan, err := h.PlanDatabaseClusterCapacity(context.Background(), "test-ns", capacityPlanRequest(t, db(3, "20Gi"), nil))
+		require.NoError(t, err)
+		assert.True(t, plan.Fits)
+		require.Len(t, plan.Components, 2)
+		assert.True(t, plan.Storage.Fits)
+		assert.Equal(t, "standard", plan.Storage.StorageClass)
+	})
+}
+
+// capacityPlanRequest returns the request to plan the capacity for the database cluster and the policy.
+func capacityPlanRequest(
+	t *testing.T, db *everestv1alpha1.DatabaseCluster, psp *everestv1alpha1.PodSchedulingPolicy,
+) *api.CapacityPlanRequest {
+	t.Helper()
+	data, err := json.Marshal(map[string]any{"databaseCluster": db, "podSchedulingPolicy": psp})
+	require.NoError(t, err)
+	req := &api.CapacityPlanRequest{}
+	require.NoError(t, json.Unmarshal(data, req))
+	return req
+}
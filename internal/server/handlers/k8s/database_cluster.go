@@ -37,6 +37,15 @@ const (
 )
 
 func (h *k8sHandler) CreateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	if err := h.applyDefaultEngineVersion(ctx, db); err != nil {
+		return nil, err
+	}
+	if err := h.applyEngineConfigTemplate(ctx, db); err != nil {
+		return nil, err
+	}
+	if err := h.applyDefaultPodSchedulingPolicy(ctx, db); err != nil {
+		return nil, err
+	}
 	return h.kubeConnector.CreateDatabaseCluster(ctx, db)
 }
 
@@ -86,6 +95,9 @@ func (h *k8sHandler) DeleteDatabaseCluster(ctx context.Context, namespace, name
 }
 
 func (h *k8sHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	if err := h.applyEngineConfigTemplate(ctx, db); err != nil {
+		return nil, err
+	}
 	return h.kubeConnector.UpdateDatabaseCluster(ctx, db)
 }
 
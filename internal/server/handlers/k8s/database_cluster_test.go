@@ -14,6 +14,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 )
 
@@ -400,3 +401,35 @@ func TestConnectionURL(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateDatabaseClusterDefaultEngineVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	_, err := k.UpdateEngineVersionPolicy(ctx, "prod", &common.EngineVersionPolicy{
+		Engines: map[everestv1alpha1.EngineType]common.EngineVersionRule{
+			everestv1alpha1.DatabaseEnginePXC: {
+				Mode:            common.EngineVersionPolicyModePinned,
+				AllowedVersions: []string{"8.0.36", "8.0.39"},
+				DefaultVersion:  "8.0.36",
+			},
+		},
+	})
+	require.NoError(t, err)
+	k8sH := New(zap.NewNop().Sugar(), k, "")
+
+	create := func(name, version string) string {
+		db, err := k8sH.CreateDatabaseCluster(ctx, &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "prod"},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC, Version: version},
+			},
+		})
+		require.NoError(t, err)
+		return db.Spec.Engine.Version
+	}
+	require.Equal(t, "8.0.36", create("db-1", ""))
+	require.Equal(t, "8.0.39", create("db-2", "8.0.39"))
+}
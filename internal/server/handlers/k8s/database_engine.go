@@ -5,31 +5,50 @@ import (
 	"errors"
 	"fmt"
 	"slices"
-	"sort"
 	"strings"
 
 	"github.com/AlekSi/pointer"
 	"github.com/cenkalti/backoff"
-	goversion "github.com/hashicorp/go-version"
 	"k8s.io/apimachinery/pkg/types"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/dbupgrade"
 	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
-var (
-	errDBEngineUpgradeUnavailable   = errors.New("provided target version is not available for upgrade")
-	errDBEngineInvalidTargetVersion = errors.New("invalid target version provided for upgrade")
-)
+// ErrOperatorVersionPinned is returned when an upgrade would install an operator
+// at a version other than the one it is pinned to.
+var ErrOperatorVersionPinned = errors.New("operator version is pinned")
 
 func (h *k8sHandler) ListDatabaseEngines(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseEngineList, error) {
-	return h.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
+	list, err := h.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	// Hide the versions that are not allowed by the engine version policy of the namespace.
+	policy, err := h.kubeConnector.GetEngineVersionPolicy(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get engine version policy: %w", err)
+	}
+	for i := range list.Items {
+		policy.ApplyTo(&list.Items[i])
+	}
+	return list, nil
 }
 
 func (h *k8sHandler) GetDatabaseEngine(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseEngine, error) {
-	return h.kubeConnector.GetDatabaseEngine(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	engine, err := h.kubeConnector.GetDatabaseEngine(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	policy, err := h.kubeConnector.GetEngineVersionPolicy(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get engine version policy: %w", err)
+	}
+	policy.ApplyTo(engine)
+	return engine, nil
 }
 
 func (h *k8sHandler) UpdateDatabaseEngine(ctx context.Context, req *everestv1alpha1.DatabaseEngine) (*everestv1alpha1.DatabaseEngine, error) {
@@ -37,36 +56,15 @@ func (h *k8sHandler) UpdateDatabaseEngine(ctx context.Context, req *everestv1alp
 }
 
 func (h *k8sHandler) GetUpgradePlan(ctx context.Context, namespace string) (*api.UpgradePlan, error) {
-	result, err := h.getUpgradePlan(ctx, namespace)
+	result, err := dbupgrade.GetUpgradePlan(ctx, h.kubeConnector, versionservice.New(h.versionServiceURL), namespace, dbupgrade.NextUpgradeVersion)
 	if err != nil {
 		return nil, fmt.Errorf("failed to getUpgradePlan: %w", err)
 	}
-	// No upgrades available, so we will check if our clusters are ready for current version.
-	if len(pointer.Get(result.Upgrades)) == 0 {
-		result.PendingActions = pointer.To([]api.UpgradeTask{})
-		engines, err := h.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
-		if err != nil {
-			return nil, err
-		}
-		for _, engine := range engines.Items {
-			tasks, err := h.getDBPostUpgradeTasks(ctx, &engine)
-			if err != nil {
-				return nil, err
-			}
-			for _, t := range tasks {
-				*result.PendingActions = append(*result.PendingActions, api.UpgradeTask{
-					Name:        t.Name,
-					PendingTask: t.PendingTask,
-					Message:     t.Message,
-				})
-			}
-		}
-	}
 	return result, nil
 }
 
 func (h *k8sHandler) ApproveUpgradePlan(ctx context.Context, namespace string) error {
-	up, err := h.getUpgradePlan(ctx, namespace)
+	up, err := dbupgrade.GetOperatorUpgradePlan(ctx, h.kubeConnector, versionservice.New(h.versionServiceURL), namespace, dbupgrade.NextUpgradeVersion)
 	if err != nil {
 		return err
 	}
@@ -112,252 +110,6 @@ func (h *k8sHandler) setLockDBEnginesForUpgrade(
 	)
 }
 
-func (h *k8sHandler) getUpgradePlan(
-	ctx context.Context,
-	namespace string,
-) (*api.UpgradePlan, error) {
-	engines, err := h.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
-	if err != nil {
-		return nil, err
-	}
-
-	result := &api.UpgradePlan{
-		Upgrades:       pointer.To([]api.Upgrade{}),
-		PendingActions: pointer.To([]api.UpgradeTask{}),
-	}
-
-	for _, engine := range engines.Items {
-		nextVersion := engine.Status.GetNextUpgradeVersion()
-		if nextVersion == "" {
-			continue
-		}
-
-		upgrade := &api.Upgrade{
-			CurrentVersion: pointer.To(engine.Status.OperatorVersion),
-			Name:           pointer.To(engine.GetName()),
-			TargetVersion:  pointer.To(nextVersion),
-		}
-		*result.Upgrades = append(*result.Upgrades, *upgrade)
-		pf, err := h.getOperatorUpgradePreflight(ctx, nextVersion, &engine)
-		if err != nil {
-			return nil, err
-		}
-		*result.PendingActions = append(*result.PendingActions, pf.databases...)
-	}
-	return result, nil
-}
-
-type operatorUpgradePreflight struct {
-	currentVersion string
-	databases      []api.UpgradeTask
-}
-
-type upgradePreflightCheckArgs struct {
-	targetVersion  string
-	engine         *everestv1alpha1.DatabaseEngine
-	versionService versionservice.Interface
-}
-
-func (h *k8sHandler) getOperatorUpgradePreflight(
-	ctx context.Context,
-	targetVersion string,
-	engine *everestv1alpha1.DatabaseEngine,
-) (*operatorUpgradePreflight, error) {
-	namespace := engine.GetNamespace()
-	// Get all database clusters in the namespace.
-	databases, err := h.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
-	if err != nil {
-		return nil, err
-	}
-	// Filter out databases not using this engine type.
-	databases.Items = slices.DeleteFunc(databases.Items, func(db everestv1alpha1.DatabaseCluster) bool {
-		return db.Spec.Engine.Type != engine.Spec.Type
-	})
-
-	if err := validateOperatorUpgradeVersion(engine.Status.OperatorVersion, targetVersion); err != nil {
-		return nil, err
-	}
-
-	args := upgradePreflightCheckArgs{
-		targetVersion:  targetVersion,
-		engine:         engine,
-		versionService: versionservice.New(h.versionServiceURL),
-	}
-	result, err := getUpgradePreflightChecksResult(ctx, databases.Items, args)
-	if err != nil {
-		return nil, errors.Join(err, errors.New("failed to run preflight checks"))
-	}
-	return result, nil
-}
-
-func getUpgradePreflightChecksResult(
-	ctx context.Context,
-	dbs []everestv1alpha1.DatabaseCluster,
-	args upgradePreflightCheckArgs,
-) (*operatorUpgradePreflight, error) {
-	// Check that this version is available for upgrade.
-	if u := args.engine.Status.GetPendingUpgrade(args.targetVersion); u == nil {
-		return nil, errDBEngineUpgradeUnavailable
-	}
-
-	// Perform checks for each given DB.
-	dbResults := make([]api.UpgradeTask, 0, len(dbs))
-	for _, db := range dbs {
-		result, err := getUpgradePreflightCheckResultForDatabase(ctx, db, args)
-		if err != nil {
-			return nil, err
-		}
-		dbResults = append(dbResults, result)
-	}
-
-	// Sort by name.
-	sort.Slice(dbResults, func(i, j int) bool {
-		return strings.Compare(
-			pointer.Get(dbResults[i].Name),
-			pointer.Get(dbResults[j].Name),
-		) < 0
-	})
-
-	return &operatorUpgradePreflight{
-		databases:      dbResults,
-		currentVersion: args.engine.Status.OperatorVersion,
-	}, nil
-}
-
-func validateOperatorUpgradeVersion(currentVersion, targetVersion string) error {
-	targetsv, err := goversion.NewSemver(targetVersion)
-	if err != nil {
-		return err
-	}
-	currentsv, err := goversion.NewSemver(currentVersion)
-	if err != nil {
-		return err
-	}
-	if targetsv.LessThanOrEqual(currentsv) {
-		return errors.Join(errDBEngineInvalidTargetVersion, errors.New("target version must be greater than the current version"))
-	}
-	return nil
-}
-
-func getUpgradePreflightCheckResultForDatabase(
-	ctx context.Context,
-	database everestv1alpha1.DatabaseCluster,
-	args upgradePreflightCheckArgs,
-) (api.UpgradeTask, error) {
-	// Check that the database engine is at the desired version.
-	if valid, minReqVer, err := preflightCheckDBEngineVersion(ctx, database, args); err != nil {
-		return api.UpgradeTask{},
-			errors.Join(err, errors.New("failed to validate database engine version for operator upgrade"))
-	} else if !valid {
-		return api.UpgradeTask{
-			Name:        pointer.To(database.GetName()),
-			PendingTask: pointer.To(api.UpgradeEngine),
-			Message: pointer.ToString(
-				fmt.Sprintf("Upgrade DB version to %s or higher", minReqVer)),
-		}, nil
-	}
-
-	// Check that DB is at recommended CRVersion.
-	if recCRVersion := database.Status.RecommendedCRVersion; recCRVersion != nil {
-		return api.UpgradeTask{
-			Name:        pointer.To(database.GetName()),
-			PendingTask: pointer.To(api.Restart),
-			Message: pointer.ToString(
-				fmt.Sprintf("Update CRVersion to %s", *recCRVersion)),
-		}, nil
-	}
-
-	// Check that DB is running.
-	if database.Status.Status != everestv1alpha1.AppStateReady {
-		return api.UpgradeTask{
-			Name:        pointer.To(database.GetName()),
-			PendingTask: pointer.To(api.NotReady),
-			Message:     pointer.ToString("Database is not ready"),
-		}, nil
-	}
-
-	// Database is in desired state for performing operator upgrade.
-	return api.UpgradeTask{
-		Name:        pointer.To(database.GetName()),
-		PendingTask: pointer.To(api.Ready),
-	}, nil
-}
-
-// preflightCheckDBEngineVersion checks that the current database engine version is
-// greater than or equal to the minimum supported version for the target operator version.
-func preflightCheckDBEngineVersion(
-	ctx context.Context,
-	database everestv1alpha1.DatabaseCluster,
-	args upgradePreflightCheckArgs,
-) (bool, string, error) {
-	engineType := args.engine.Spec.Type
-	operator, found := versionservice.EngineTypeToOperatorName[engineType]
-	if !found {
-		return false, "", fmt.Errorf("unsupported engine type %s", engineType)
-	}
-
-	allSupportedVersions, err := args.versionService.GetSupportedEngineVersions(ctx, operator, args.targetVersion)
-	if err != nil {
-		return false, "", errors.Join(err, errors.New("failed to get supported engine versions"))
-	}
-
-	currentVersion, err := goversion.NewVersion(database.Spec.Engine.Version)
-	if err != nil {
-		return false, "", err
-	}
-
-	// We search for the smallest available version greater than or equal to the current major version.
-	var minSupportedMajVersion *goversion.Version
-	for _, supportedVersion := range allSupportedVersions {
-		ver, err := goversion.NewVersion(supportedVersion)
-		if err != nil {
-			return false, "", err
-		}
-		if currentVersion.Segments()[0] > ver.Segments()[0] {
-			continue // ignore if major version is less than the current major version.
-		}
-		if minSupportedMajVersion == nil || ver.LessThan(minSupportedMajVersion) {
-			minSupportedMajVersion = ver
-		}
-	}
-
-	if minSupportedMajVersion == nil {
-		return false, "", fmt.Errorf("no minimum supported versions found for %s", operator)
-	}
-
-	return currentVersion.GreaterThanOrEqual(minSupportedMajVersion), minSupportedMajVersion.Original(), nil
-}
-
-func (h *k8sHandler) getDBPostUpgradeTasks(
-	ctx context.Context,
-	engine *everestv1alpha1.DatabaseEngine,
-) ([]api.UpgradeTask, error) {
-	namespace := engine.GetNamespace()
-	// List all clusters in this namespace.
-	clusters, err := h.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
-	if err != nil {
-		return nil, err
-	}
-
-	// Check that every cluster is using the recommended CRVersion.
-	checks := []api.UpgradeTask{}
-	for _, cluster := range clusters.Items {
-		if cluster.Spec.Engine.Type != engine.Spec.Type {
-			continue
-		}
-		check := api.UpgradeTask{
-			Name: pointer.To(cluster.Name),
-		}
-		check.PendingTask = pointer.To(api.Ready)
-		if recVer := cluster.Status.RecommendedCRVersion; recVer != nil {
-			check.PendingTask = pointer.To(api.Restart)
-			check.Message = pointer.To(fmt.Sprintf("Database needs restart to use CRVersion '%s'", *recVer))
-		}
-		checks = append(checks, check)
-	}
-	return checks, nil
-}
-
 // startOperatorUpgradeWithRetry wraps the startOperatorUpgrade function with a retry mechanism.
 // This is done to reduce the chances of failures due to resource conflicts.
 func (h *k8sHandler) startOperatorUpgradeWithRetry(ctx context.Context, namespace string) error {
@@ -369,11 +121,36 @@ func (h *k8sHandler) startOperatorUpgradeWithRetry(ctx context.Context, namespac
 }
 
 func (h *k8sHandler) startOperatorUpgrade(ctx context.Context, namespace string) error {
-	engines, err := h.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
+	installPlans, err := h.getOperatorUpgradeInstallPlans(ctx, namespace)
 	if err != nil {
+		if errors.Is(err, ErrOperatorVersionPinned) {
+			return backoff.Permanent(err)
+		}
 		return err
 	}
 
+	// approve install plans.
+	for _, plan := range installPlans {
+		if err := backoff.Retry(func() error {
+			_, err := h.kubeConnector.ApproveInstallPlan(ctx, types.NamespacedName{Namespace: namespace, Name: plan})
+			return err
+		}, backoff.WithContext(everestAPIConstantBackoff, ctx),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getOperatorUpgradeInstallPlans returns the names of the install plans that upgrade the operators
+// in the given namespace to their next versions. Returns ErrOperatorVersionPinned if any of the
+// install plans installs an operator at a version other than the one it is pinned to.
+func (h *k8sHandler) getOperatorUpgradeInstallPlans(ctx context.Context, namespace string) ([]string, error) {
+	engines, err := h.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+
 	// gather install plans to approve.
 	installPlans := []string{}
 	for _, engine := range engines.Items {
@@ -392,14 +169,35 @@ func (h *k8sHandler) startOperatorUpgrade(ctx context.Context, namespace string)
 	slices.Sort(installPlans)
 	installPlans = slices.Compact(installPlans)
 
-	// approve install plans.
+	pins, err := h.kubeConnector.GetPinnedOperatorVersions(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pinned operator versions: %w", err)
+	}
+	if len(pins) == 0 {
+		return installPlans, nil
+	}
 	for _, plan := range installPlans {
-		if err := backoff.Retry(func() error {
-			_, err := h.kubeConnector.ApproveInstallPlan(ctx, types.NamespacedName{Namespace: namespace, Name: plan})
-			return err
-		}, backoff.WithContext(everestAPIConstantBackoff, ctx),
-		); err != nil {
-			return err
+		ip, err := h.kubeConnector.GetInstallPlan(ctx, types.NamespacedName{Namespace: namespace, Name: plan})
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPinnedOperatorVersions(ip.Spec.ClusterServiceVersionNames, pins); err != nil {
+			return nil, err
+		}
+	}
+	return installPlans, nil
+}
+
+// checkPinnedOperatorVersions checks that the given cluster service versions, named <operator>.v<version>,
+// install the pinned operators at the versions they are pinned to.
+func checkPinnedOperatorVersions(csvNames []string, pins map[string]string) error {
+	for _, csv := range csvNames {
+		operator, version, found := strings.Cut(csv, ".v")
+		if !found {
+			continue
+		}
+		if pinned, ok := pins[operator]; ok && pinned != version {
+			return fmt.Errorf("%w: %s is pinned to version %s, refusing to install %s", ErrOperatorVersionPinned, operator, pinned, csv)
 		}
 	}
 	return nil
@@ -0,0 +1,190 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/cenkalti/backoff"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/engineconfig"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+var (
+	errEngineConfigTemplateEngineMismatch = errors.New("engine config template cannot be used with the database engine")
+	errDatabaseClusterUpdateNotAllowed    = errors.New("db operations are not allowed in current db state")
+)
+
+func (h *k8sHandler) ListEngineConfigTemplates(ctx context.Context, namespace string) (*api.EngineConfigTemplateList, error) {
+	list, err := h.kubeConnector.ListEngineConfigTemplates(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	result := &api.EngineConfigTemplateList{Items: make([]api.EngineConfigTemplate, 0, len(list.Items))}
+	for _, t := range list.Items {
+		result.Items = append(result.Items, *engineConfigTemplateToAPI(&t))
+	}
+	return result, nil
+}
+
+func (h *k8sHandler) GetEngineConfigTemplate(ctx context.Context, namespace, name string) (*api.EngineConfigTemplate, error) {
+	template, err := h.kubeConnector.GetEngineConfigTemplate(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return engineConfigTemplateToAPI(template), nil
+}
+
+func (h *k8sHandler) CreateEngineConfigTemplate(ctx context.Context, namespace string, template *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error) {
+	created, err := h.kubeConnector.CreateEngineConfigTemplate(ctx, namespace, engineConfigTemplateFromAPI(template))
+	if err != nil {
+		return nil, err
+	}
+	return engineConfigTemplateToAPI(created), nil
+}
+
+func (h *k8sHandler) UpdateEngineConfigTemplate(ctx context.Context, namespace string, template *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error) {
+	updated, err := h.kubeConnector.UpdateEngineConfigTemplate(ctx, namespace, engineConfigTemplateFromAPI(template))
+	if err != nil {
+		return nil, err
+	}
+	return engineConfigTemplateToAPI(updated), nil
+}
+
+func (h *k8sHandler) DeleteEngineConfigTemplate(ctx context.Context, namespace, name string) error {
+	return h.kubeConnector.DeleteEngineConfigTemplate(ctx, namespace, name)
+}
+
+func (h *k8sHandler) RolloutEngineConfigTemplate(ctx context.Context, namespace, name string) (*api.EngineConfigTemplateRolloutResult, error) {
+	template, err := h.kubeConnector.GetEngineConfigTemplate(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	databases, err := h.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(databases.Items, func(a, b everestv1alpha1.DatabaseCluster) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	result := &api.EngineConfigTemplateRolloutResult{Results: []api.DatabaseClusterConfigRollout{}}
+	for _, db := range databases.Items {
+		if db.GetAnnotations()[common.EngineConfigTemplateAnnotation] != name {
+			continue
+		}
+		rollout := api.DatabaseClusterConfigRollout{Name: db.GetName()}
+		updated, err := h.rolloutEngineConfig(ctx, types.NamespacedName{Namespace: namespace, Name: db.GetName()}, template)
+		if err != nil {
+			rollout.Message = pointer.ToString(err.Error())
+		}
+		rollout.Updated = updated
+		result.Results = append(result.Results, rollout)
+	}
+	return result, nil
+}
+
+// rolloutEngineConfig merges the engine config of the template into the engine config of the database cluster
+// and updates the database cluster through the validation chain. Returns true if the database cluster has been updated.
+func (h *k8sHandler) rolloutEngineConfig(ctx context.Context, key types.NamespacedName, template *common.EngineConfigTemplate) (bool, error) {
+	updated := false
+	// We wrap this logic in a retry loop to reduce the chances of resource conflicts.
+	err := backoff.Retry(func() error {
+		db, err := h.kubeConnector.GetDatabaseCluster(ctx, key)
+		if err != nil {
+			return err
+		}
+		merged, err := mergeEngineConfigTemplate(db, template)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if merged == db.Spec.Engine.Config {
+			return nil
+		}
+		if !kubernetes.IsDatabaseClusterUpdateAllowed(db) {
+			return backoff.Permanent(fmt.Errorf("%w: %s", errDatabaseClusterUpdateNotAllowed, db.Status.Status))
+		}
+		db.Spec.Engine.Config = merged
+		if _, err := h.dbUpdater.UpdateDatabaseCluster(ctx, db); err != nil {
+			return err
+		}
+		updated = true
+		return nil
+	}, backoff.WithContext(everestAPIConstantBackoff, ctx),
+	)
+	return updated, err
+}
+
+// applyEngineConfigTemplate merges the engine config of the template referenced by the database cluster
+// into its engine config.
+func (h *k8sHandler) applyEngineConfigTemplate(ctx context.Context, db *everestv1alpha1.DatabaseCluster) error {
+	name, ok := db.GetAnnotations()[common.EngineConfigTemplateAnnotation]
+	if !ok || name == "" {
+		return nil
+	}
+	template, err := h.kubeConnector.GetEngineConfigTemplate(ctx, db.GetNamespace(), name)
+	if err != nil {
+		return fmt.Errorf("failed to get engine config template: %w", err)
+	}
+	merged, err := mergeEngineConfigTemplate(db, template)
+	if err != nil {
+		return fmt.Errorf("failed to merge engine config template: %w", err)
+	}
+	db.Spec.Engine.Config = merged
+	return nil
+}
+
+// mergeEngineConfigTemplate returns the engine config of the database cluster merged with the engine config
+// of the template. The values set in the template take precedence, so that rolling out a template results
+// in the same engine config as creating the database cluster with it.
+func mergeEngineConfigTemplate(db *everestv1alpha1.DatabaseCluster, template *common.EngineConfigTemplate) (string, error) {
+	if db.Spec.Engine.Type != template.EngineType {
+		return "", fmt.Errorf("%w: %s", errEngineConfigTemplateEngineMismatch, db.Spec.Engine.Type)
+	}
+	return engineconfig.Merge(template.EngineType, db.Spec.Engine.Config, template.Config)
+}
+
+func engineConfigTemplateToAPI(template *common.EngineConfigTemplate) *api.EngineConfigTemplate {
+	result := &api.EngineConfigTemplate{
+		Name:       template.Name,
+		EngineType: string(template.EngineType),
+		Config:     template.Config,
+	}
+	if template.Description != "" {
+		result.Description = pointer.ToString(template.Description)
+	}
+	return result
+}
+
+func engineConfigTemplateFromAPI(template *api.EngineConfigTemplate) *common.EngineConfigTemplate {
+	return &common.EngineConfigTemplate{
+		Name:        template.Name,
+		EngineType:  everestv1alpha1.EngineType(template.EngineType),
+		Description: pointer.Get(template.Description),
+		Config:      template.Config,
+	}
+}
@@ -0,0 +1,122 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestEngineConfigTemplate(t *testing.T) {
+	t.Parallel()
+
+	const ns = "test-ns"
+	newDB := func(name, template, config string, state everestv1alpha1.AppState) *everestv1alpha1.DatabaseCluster {
+		db := &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+			},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{
+					Type:   everestv1alpha1.DatabaseEnginePXC,
+					Config: config,
+				},
+			},
+			Status: everestv1alpha1.DatabaseClusterStatus{
+				Status: state,
+			},
+		}
+		if template != "" {
+			db.SetAnnotations(map[string]string{common.EngineConfigTemplateAnnotation: template})
+		}
+		return db
+	}
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			newDB("db-1", "tuned", "[mysqld]\nmax_connections = 100\n", everestv1alpha1.AppStateReady),
+			newDB("db-2", "tuned", "[mysqld]\nmax_connections = 250\n", everestv1alpha1.AppStateReady),
+			newDB("db-3", "tuned", "", everestv1alpha1.AppStateRestoring),
+			newDB("db-4", "", "[mysqld]\nmax_connections = 100\n", everestv1alpha1.AppStateReady),
+		).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	k8sH := New(zap.NewNop().Sugar(), k, "")
+	ctx := context.Background()
+
+	_, err := k8sH.CreateEngineConfigTemplate(ctx, ns, &api.EngineConfigTemplate{
+		Name:       "tuned",
+		EngineType: string(everestv1alpha1.DatabaseEnginePXC),
+		Config:     "[mysqld]\nmax_connections = 250\n",
+	})
+	require.NoError(t, err)
+
+	t.Run("merge on create", func(t *testing.T) {
+		db, err := k8sH.CreateDatabaseCluster(ctx, newDB("db-5", "tuned", "[mysqld]\nwsrep_debug = 1\n", ""))
+		require.NoError(t, err)
+		assert.Equal(t, "[mysqld]\nwsrep_debug = 1\nmax_connections = 250\n", db.Spec.Engine.Config)
+	})
+
+	t.Run("template takes precedence on create", func(t *testing.T) {
+		db, err := k8sH.CreateDatabaseCluster(ctx, newDB("db-6", "tuned", "[mysqld]\nmax_connections = 100\n", ""))
+		require.NoError(t, err)
+		assert.Equal(t, "[mysqld]\nmax_connections = 250\n", db.Spec.Engine.Config)
+	})
+
+	t.Run("rollout", func(t *testing.T) {
+		result, err := k8sH.RolloutEngineConfigTemplate(ctx, ns, "tuned")
+		require.NoError(t, err)
+
+		got := make(map[string]bool, len(result.Results))
+		for _, r := range result.Results {
+			got[r.Name] = r.Updated
+			if r.Name == "db-3" {
+				assert.NotNil(t, r.Message)
+			}
+		}
+		// The database clusters created with the template already have its config.
+		assert.Equal(t, map[string]bool{"db-1": true, "db-2": false, "db-3": false, "db-5": false, "db-6": false}, got)
+
+		for name, config := range map[string]string{
+			"db-1": "[mysqld]\nmax_connections = 250\n",
+			"db-4": "[mysqld]\nmax_connections = 100\n",
+		} {
+			db, err := k.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: ns, Name: name})
+			require.NoError(t, err)
+			assert.Equal(t, config, db.Spec.Engine.Config)
+		}
+	})
+
+	t.Run("template not found", func(t *testing.T) {
+		_, err := k8sH.RolloutEngineConfigTemplate(ctx, ns, "missing")
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+}
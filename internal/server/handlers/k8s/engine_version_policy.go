@@ -0,0 +1,46 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+)
+
+func (h *k8sHandler) GetEngineVersionPolicy(ctx context.Context, namespace string) (*common.EngineVersionPolicy, error) {
+	return h.kubeConnector.GetEngineVersionPolicy(ctx, namespace)
+}
+
+func (h *k8sHandler) UpdateEngineVersionPolicy(ctx context.Context, namespace string, policy *common.EngineVersionPolicy) (*common.EngineVersionPolicy, error) {
+	return h.kubeConnector.UpdateEngineVersionPolicy(ctx, namespace, policy)
+}
+
+// applyDefaultEngineVersion sets the default version of the engine version policy of the namespace
+// if the DB cluster doesn't request a version.
+func (h *k8sHandler) applyDefaultEngineVersion(ctx context.Context, db *everestv1alpha1.DatabaseCluster) error {
+	if db.Spec.Engine.Version != "" {
+		return nil
+	}
+	policy, err := h.kubeConnector.GetEngineVersionPolicy(ctx, db.GetNamespace())
+	if err != nil {
+		return fmt.Errorf("failed to get engine version policy: %w", err)
+	}
+	db.Spec.Engine.Version = policy.Rule(db.Spec.Engine.Type).DefaultVersion
+	return nil
+}
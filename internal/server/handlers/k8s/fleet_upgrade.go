@@ -0,0 +1,477 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const (
+	fleetUpgradePollInterval = 10 * time.Second
+	fleetUpgradeTimeout      = 30 * time.Minute
+
+	fleetUpgradeRolloutKey = "rollout"
+	fleetUpgradeTargetsKey = "upgrades"
+)
+
+var (
+	// ErrFleetUpgradeInProgress is returned when a fleet upgrade rollout is requested while another one is running.
+	ErrFleetUpgradeInProgress = errors.New("another upgrade rollout is in progress")
+
+	// ErrFleetUpgradeNotAllowed is returned when the requested namespaces cannot be upgraded.
+	ErrFleetUpgradeNotAllowed = errors.New("fleet upgrade is not allowed")
+
+	errFleetUpgradeNothingToUpgrade = errors.Join(ErrFleetUpgradeNotAllowed,
+		errors.New("there are no pending operator upgrades in the requested namespaces"))
+)
+
+// fleetUpgradeRollout keeps track of the latest rolling upgrade of database
+// engine operators across namespaces.
+// The rollout is stored in a ConfigMap in the Everest system namespace together with the target
+// versions of the operators, so that it is shared by the replicas of the Everest server.
+// It is run by the replica that holds the fleet upgrade lease, see FleetUpgradeJob,
+// so that another replica resumes it if that replica restarts.
+type fleetUpgradeRollout struct {
+	kubeConnector kubernetes.KubernetesConnector
+	l             *zap.SugaredLogger
+
+	pollInterval time.Duration
+	timeout      time.Duration
+}
+
+func newFleetUpgradeRollout(kubeConnector kubernetes.KubernetesConnector, l *zap.SugaredLogger) *fleetUpgradeRollout {
+	return &fleetUpgradeRollout{
+		kubeConnector: kubeConnector,
+		l:             l,
+		pollInterval:  fleetUpgradePollInterval,
+		timeout:       fleetUpgradeTimeout,
+	}
+}
+
+// load returns the stored rollout, the operator upgrades of its namespaces and the ConfigMap holding them.
+// The ConfigMap is nil if no rollout has been started yet.
+func (r *fleetUpgradeRollout) load(ctx context.Context) (*api.FleetUpgradeRollout, map[string][]api.Upgrade, *corev1.ConfigMap, error) {
+	cm, err := r.kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.EverestFleetUpgradeConfigMapName,
+	})
+	if k8serrors.IsNotFound(err) {
+		return &api.FleetUpgradeRollout{
+			State:      api.FleetUpgradeRolloutStateIdle,
+			Namespaces: []api.NamespaceUpgradeProgress{},
+		}, nil, nil, nil
+	} else if err != nil {
+		return nil, nil, nil, err
+	}
+	status := &api.FleetUpgradeRollout{}
+	if err := json.Unmarshal([]byte(cm.Data[fleetUpgradeRolloutKey]), status); err != nil {
+		return nil, nil, nil, errors.Join(err, errors.New("failed to decode the fleet upgrade rollout"))
+	}
+	upgrades := make(map[string][]api.Upgrade)
+	if err := json.Unmarshal([]byte(cm.Data[fleetUpgradeTargetsKey]), &upgrades); err != nil {
+		return nil, nil, nil, errors.Join(err, errors.New("failed to decode the fleet upgrade targets"))
+	}
+	return status, upgrades, cm, nil
+}
+
+// snapshot returns the current rollout status.
+func (r *fleetUpgradeRollout) snapshot(ctx context.Context) (*api.FleetUpgradeRollout, error) {
+	status, _, _, err := r.load(ctx)
+	return status, err
+}
+
+// start stores a new rollout of the given operator upgrades, keyed by namespace.
+// The namespaces are upgraded in alphabetical order.
+// Returns ErrFleetUpgradeInProgress if the previous rollout has not finished yet.
+func (r *fleetUpgradeRollout) start(
+	ctx context.Context,
+	upgrades map[string][]api.Upgrade,
+	maxConcurrency int,
+) (*api.FleetUpgradeRollout, error) {
+	latest, _, cm, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if latest.State == api.FleetUpgradeRolloutStateRunning {
+		return nil, ErrFleetUpgradeInProgress
+	}
+
+	namespaces := slices.Sorted(maps.Keys(upgrades))
+	progress := make([]api.NamespaceUpgradeProgress, 0, len(namespaces))
+	for _, ns := range namespaces {
+		progress = append(progress, api.NamespaceUpgradeProgress{
+			Namespace: ns,
+			State:     api.NamespaceUpgradeProgressStatePending,
+		})
+	}
+	status := &api.FleetUpgradeRollout{
+		State:          api.FleetUpgradeRolloutStateRunning,
+		MaxConcurrency: pointer.ToInt(maxConcurrency),
+		StartedAt:      pointer.To(time.Now().UTC()),
+		Namespaces:     progress,
+	}
+	statusData, err := json.Marshal(status)
+	if err != nil {
+		return nil, err
+	}
+	upgradesData, err := json.Marshal(upgrades)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]string{
+		fleetUpgradeRolloutKey: string(statusData),
+		fleetUpgradeTargetsKey: string(upgradesData),
+	}
+
+	if cm == nil {
+		_, err = r.kubeConnector.CreateConfigMap(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.EverestFleetUpgradeConfigMapName,
+				Namespace: common.SystemNamespace,
+			},
+			Data: data,
+		})
+	} else {
+		// The update fails with a conflict if another replica has started a rollout in the meantime.
+		cm.Data = data
+		_, err = r.kubeConnector.UpdateConfigMap(ctx, cm)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// update applies the change to the stored rollout.
+func (r *fleetUpgradeRollout) update(ctx context.Context, change func(status *api.FleetUpgradeRollout)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		status, _, cm, err := r.load(ctx)
+		if err != nil {
+			return err
+		}
+		if cm == nil {
+			return errors.New("no fleet upgrade rollout is stored")
+		}
+		change(status)
+		data, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+		cm.Data[fleetUpgradeRolloutKey] = string(data)
+		_, err = r.kubeConnector.UpdateConfigMap(ctx, cm)
+		return err
+	})
+}
+
+func (r *fleetUpgradeRollout) setNamespaceState(ctx context.Context, namespace string, state api.NamespaceUpgradeProgressState, err error) {
+	if uErr := r.update(ctx, func(status *api.FleetUpgradeRollout) {
+		idx := slices.IndexFunc(status.Namespaces, func(p api.NamespaceUpgradeProgress) bool {
+			return p.Namespace == namespace
+		})
+		if idx < 0 {
+			return
+		}
+		now := time.Now().UTC()
+		p := &status.Namespaces[idx]
+		p.State = state
+		switch state {
+		case api.NamespaceUpgradeProgressStateInProgress:
+			p.StartedAt = &now
+		case api.NamespaceUpgradeProgressStateSucceeded,
+			api.NamespaceUpgradeProgressStateFailed:
+			p.FinishedAt = &now
+		case api.NamespaceUpgradeProgressStatePending,
+			api.NamespaceUpgradeProgressStateSkipped:
+		}
+		if err != nil {
+			p.Message = pointer.ToString(err.Error())
+		}
+	}); uErr != nil {
+		r.l.Errorf("failed to update the fleet upgrade progress of namespace '%s': %v", namespace, uErr)
+	}
+}
+
+func (r *fleetUpgradeRollout) finish(ctx context.Context, state api.FleetUpgradeRolloutState) {
+	if err := r.update(ctx, func(status *api.FleetUpgradeRollout) {
+		status.State = state
+		status.FinishedAt = pointer.To(time.Now().UTC())
+		for i := range status.Namespaces {
+			if status.Namespaces[i].State == api.NamespaceUpgradeProgressStatePending {
+				status.Namespaces[i].State = api.NamespaceUpgradeProgressStateSkipped
+			}
+		}
+	}); err != nil {
+		r.l.Errorf("failed to finish the fleet upgrade rollout: %v", err)
+	}
+}
+
+// run upgrades the namespaces of the stored rollout that have not been upgraded yet,
+// in batches of the rollout max concurrency. The namespaces that were being upgraded
+// when the rollout was interrupted are upgraded again.
+// The rollout stops after the first batch that contains a failed namespace.
+// If the context is canceled, the rollout is left running, so that it can be resumed.
+func (r *fleetUpgradeRollout) run(
+	ctx context.Context,
+	upgradeNamespace func(ctx context.Context, namespace string, upgrades []api.Upgrade) error,
+) error {
+	status, upgrades, _, err := r.load(ctx)
+	if err != nil {
+		return err
+	}
+	if status.State != api.FleetUpgradeRolloutStateRunning {
+		return nil
+	}
+	namespaces := []string{}
+	for _, p := range status.Namespaces {
+		if p.State == api.NamespaceUpgradeProgressStatePending ||
+			p.State == api.NamespaceUpgradeProgressStateInProgress {
+			namespaces = append(namespaces, p.Namespace)
+		}
+	}
+
+	for batch := range slices.Chunk(namespaces, max(pointer.Get(status.MaxConcurrency), 1)) {
+		var (
+			wg     sync.WaitGroup
+			failed bool
+			mu     sync.Mutex
+		)
+		for _, ns := range batch {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r.setNamespaceState(ctx, ns, api.NamespaceUpgradeProgressStateInProgress, nil)
+				if err := upgradeNamespace(ctx, ns, upgrades[ns]); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					r.setNamespaceState(ctx, ns, api.NamespaceUpgradeProgressStateFailed, err)
+					mu.Lock()
+					failed = true
+					mu.Unlock()
+					return
+				}
+				r.setNamespaceState(ctx, ns, api.NamespaceUpgradeProgressStateSucceeded, nil)
+			}()
+		}
+		wg.Wait()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if failed {
+			r.finish(ctx, api.FleetUpgradeRolloutStateFailed)
+			return nil
+		}
+	}
+	r.finish(ctx, api.FleetUpgradeRolloutStateSucceeded)
+	return nil
+}
+
+// FleetUpgradeJob runs the fleet upgrade rollouts approved through the API.
+type FleetUpgradeJob struct {
+	h *k8sHandler
+}
+
+// NewFleetUpgradeJob returns a new FleetUpgradeJob.
+func NewFleetUpgradeJob(log *zap.SugaredLogger, kubeConnector kubernetes.KubernetesConnector, vsURL string) *FleetUpgradeJob {
+	return &FleetUpgradeJob{h: newK8sHandler(log, kubeConnector, vsURL)}
+}
+
+// Run checks for a running rollout every poll interval and runs it until the context is canceled.
+// It is meant to run on a single replica of the Everest server at a time.
+func (j *FleetUpgradeJob) Run(ctx context.Context) {
+	r := j.h.fleetUpgrade
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := r.run(ctx, j.h.upgradeNamespaceOperators); err != nil && ctx.Err() == nil {
+			r.l.Errorf("failed to run the fleet upgrade rollout: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// upgradeNamespaceOperators approves the upgrade plan of the namespace and waits until its operators
+// are upgraded. The plan is not approved again if the operators have already been upgraded.
+func (h *k8sHandler) upgradeNamespaceOperators(ctx context.Context, namespace string, upgrades []api.Upgrade) error {
+	engines, err := h.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	if operatorUpgradesCompleted(engines.Items, upgrades) {
+		return nil
+	}
+	if err := h.ApproveUpgradePlan(ctx, namespace); err != nil {
+		return err
+	}
+	return h.waitForOperatorUpgrades(ctx, namespace, upgrades)
+}
+
+func (h *k8sHandler) GetFleetUpgradePlan(ctx context.Context) (*api.FleetUpgradePlan, error) {
+	namespaces, err := h.ListNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(namespaces)
+
+	result := &api.FleetUpgradePlan{
+		Namespaces: make([]api.NamespaceUpgradePlan, 0, len(namespaces)),
+	}
+	for _, ns := range namespaces {
+		plan, err := h.GetUpgradePlan(ctx, ns)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get upgrade plan for namespace '%s': %w", ns, err)
+		}
+		result.Namespaces = append(result.Namespaces, newNamespaceUpgradePlan(ns, plan))
+	}
+	return result, nil
+}
+
+// newNamespaceUpgradePlan converts the upgrade plan of a single namespace to its fleet representation.
+func newNamespaceUpgradePlan(namespace string, plan *api.UpgradePlan) api.NamespaceUpgradePlan {
+	blockers := []api.UpgradeTask{}
+	// Pending actions block the upgrade only if there is an upgrade to perform.
+	// Otherwise, these are the post-upgrade tasks.
+	if len(pointer.Get(plan.Upgrades)) > 0 {
+		for _, task := range pointer.Get(plan.PendingActions) {
+			if pointer.Get(task.PendingTask) != api.Ready {
+				blockers = append(blockers, task)
+			}
+		}
+	}
+	return api.NamespaceUpgradePlan{
+		Namespace:      namespace,
+		Upgrades:       pointer.To(pointer.Get(plan.Upgrades)),
+		PendingActions: pointer.To(pointer.Get(plan.PendingActions)),
+		Blockers:       &blockers,
+	}
+}
+
+func (h *k8sHandler) ApproveFleetUpgradePlan(ctx context.Context, req *api.FleetUpgradePlanApproval) (*api.FleetUpgradeRollout, error) {
+	plan, err := h.GetFleetUpgradePlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// A nil list of namespaces selects all the namespaces with pending upgrades.
+	requested := req.Namespaces
+	upgrades := make(map[string][]api.Upgrade)
+	blocked := []string{}
+	for _, nsPlan := range plan.Namespaces {
+		if requested != nil && !slices.Contains(*requested, nsPlan.Namespace) {
+			continue
+		}
+		if len(pointer.Get(nsPlan.Upgrades)) == 0 {
+			continue
+		}
+		if len(pointer.Get(nsPlan.Blockers)) > 0 {
+			blocked = append(blocked, nsPlan.Namespace)
+			continue
+		}
+		upgrades[nsPlan.Namespace] = pointer.Get(nsPlan.Upgrades)
+	}
+	if len(blocked) > 0 {
+		return nil, errors.Join(ErrFleetUpgradeNotAllowed,
+			fmt.Errorf("one or more database clusters are not ready for upgrade in namespaces: %s", strings.Join(blocked, ", ")))
+	}
+	if len(upgrades) == 0 {
+		return nil, errFleetUpgradeNothingToUpgrade
+	}
+
+	// Refuse the rollout upfront rather than failing it midway on a namespace with pinned operators.
+	pinned := []string{}
+	for ns := range upgrades {
+		if _, err := h.getOperatorUpgradeInstallPlans(ctx, ns); err != nil {
+			if !errors.Is(err, ErrOperatorVersionPinned) {
+				return nil, err
+			}
+			pinned = append(pinned, ns)
+		}
+	}
+	if len(pinned) > 0 {
+		slices.Sort(pinned)
+		return nil, errors.Join(ErrFleetUpgradeNotAllowed,
+			fmt.Errorf("the operator versions are pinned in namespaces: %s", strings.Join(pinned, ", ")))
+	}
+
+	// The rollout is run by the FleetUpgradeJob.
+	return h.fleetUpgrade.start(ctx, upgrades, max(pointer.Get(req.MaxConcurrency), 1))
+}
+
+func (h *k8sHandler) GetFleetUpgradeRollout(ctx context.Context) (*api.FleetUpgradeRollout, error) {
+	return h.fleetUpgrade.snapshot(ctx)
+}
+
+// waitForOperatorUpgrades waits until all the operators in the given namespace
+// are installed at the target versions of the provided upgrades.
+func (h *k8sHandler) waitForOperatorUpgrades(ctx context.Context, namespace string, upgrades []api.Upgrade) error {
+	err := wait.PollUntilContextTimeout(ctx, h.fleetUpgrade.pollInterval, h.fleetUpgrade.timeout, false,
+		func(ctx context.Context) (bool, error) {
+			engines, err := h.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
+			if err != nil {
+				return false, err
+			}
+			return operatorUpgradesCompleted(engines.Items, upgrades), nil
+		},
+	)
+	if err != nil {
+		return errors.Join(err, errors.New("operator upgrade did not complete"))
+	}
+	return nil
+}
+
+func operatorUpgradesCompleted(engines []everestv1alpha1.DatabaseEngine, upgrades []api.Upgrade) bool {
+	for _, upg := range upgrades {
+		idx := slices.IndexFunc(engines, func(e everestv1alpha1.DatabaseEngine) bool {
+			return e.GetName() == pointer.Get(upg.Name)
+		})
+		if idx < 0 {
+			return false
+		}
+		engine := engines[idx]
+		if engine.Status.OperatorVersion != pointer.Get(upg.TargetVersion) ||
+			engine.Status.State != everestv1alpha1.DBEngineStateInstalled {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func newTestFleetUpgradeRollout(namespaces []string) (*fleetUpgradeRollout, map[string][]api.Upgrade) {
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).
+		WithKubernetesClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).Build())
+	upgrades := make(map[string][]api.Upgrade, len(namespaces))
+	for _, ns := range namespaces {
+		upgrades[ns] = []api.Upgrade{{Name: pointer.ToString("percona-xtradb-cluster-operator"), TargetVersion: pointer.ToString("1.2.0")}}
+	}
+	return newFleetUpgradeRollout(k, zap.NewNop().Sugar()), upgrades
+}
+
+func TestFleetUpgradeRollout(t *testing.T) {
+	t.Parallel()
+
+	namespaces := []string{"ns-1", "ns-2", "ns-3", "ns-4", "ns-5"}
+	testCases := []struct {
+		name           string
+		maxConcurrency int
+		failNamespace  string
+		wantState      api.FleetUpgradeRolloutState
+		wantNamespaces map[string]api.NamespaceUpgradeProgressState
+	}{
+		{
+			name:           "all succeeded",
+			maxConcurrency: 2,
+			wantState:      api.FleetUpgradeRolloutStateSucceeded,
+			wantNamespaces: map[string]api.NamespaceUpgradeProgressState{
+				"ns-1": api.NamespaceUpgradeProgressStateSucceeded,
+				"ns-2": api.NamespaceUpgradeProgressStateSucceeded,
+				"ns-3": api.NamespaceUpgradeProgressStateSucceeded,
+				"ns-4": api.NamespaceUpgradeProgressStateSucceeded,
+				"ns-5": api.NamespaceUpgradeProgressStateSucceeded,
+			},
+		},
+		{
+			name:           "stop on first failed batch",
+			maxConcurrency: 2,
+			failNamespace:  "ns-3",
+			wantState:      api.FleetUpgradeRolloutStateFailed,
+			wantNamespaces: map[string]api.NamespaceUpgradeProgressState{
+				"ns-1": api.NamespaceUpgradeProgressStateSucceeded,
+				"ns-2": api.NamespaceUpgradeProgressStateSucceeded,
+				"ns-3": api.NamespaceUpgradeProgressStateFailed,
+				"ns-4": api.NamespaceUpgradeProgressStateSucceeded,
+				"ns-5": api.NamespaceUpgradeProgressStateSkipped,
+			},
+		},
+		{
+			name:           "one namespace at a time",
+			maxConcurrency: 1,
+			failNamespace:  "ns-1",
+			wantState:      api.FleetUpgradeRolloutStateFailed,
+			wantNamespaces: map[string]api.NamespaceUpgradeProgressState{
+				"ns-1": api.NamespaceUpgradeProgressStateFailed,
+				"ns-2": api.NamespaceUpgradeProgressStateSkipped,
+				"ns-3": api.NamespaceUpgradeProgressStateSkipped,
+				"ns-4": api.NamespaceUpgradeProgressStateSkipped,
+				"ns-5": api.NamespaceUpgradeProgressStateSkipped,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			r, upgrades := newTestFleetUpgradeRollout(namespaces)
+			_, err := r.start(ctx, upgrades, tc.maxConcurrency)
+			require.NoError(t, err)
+			_, err = r.start(ctx, upgrades, tc.maxConcurrency)
+			require.ErrorIs(t, err, ErrFleetUpgradeInProgress)
+
+			require.NoError(t, r.run(ctx, func(_ context.Context, ns string, nsUpgrades []api.Upgrade) error {
+				assert.Equal(t, upgrades[ns], nsUpgrades)
+				if ns == tc.failNamespace {
+					return errors.New("upgrade failed")
+				}
+				return nil
+			}))
+
+			status, err := r.snapshot(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantState, status.State)
+			assert.NotNil(t, status.FinishedAt)
+			got := make(map[string]api.NamespaceUpgradeProgressState, len(status.Namespaces))
+			for _, p := range status.Namespaces {
+				got[p.Namespace] = p.State
+			}
+			assert.Equal(t, tc.wantNamespaces, got)
+		})
+	}
+}
+
+func TestFleetUpgradeRolloutResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r, upgrades := newTestFleetUpgradeRollout([]string{"ns-1", "ns-2", "ns-3"})
+	status, err := r.snapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, api.FleetUpgradeRolloutStateIdle, status.State)
+	_, err = r.start(ctx, upgrades, 1)
+	require.NoError(t, err)
+
+	// The replica running the rollout loses the lease while upgrading ns-2.
+	runCtx, cancel := context.WithCancel(ctx)
+	require.ErrorIs(t, r.run(runCtx, func(ctx context.Context, ns string, _ []api.Upgrade) error {
+		if ns == "ns-2" {
+			cancel()
+			return ctx.Err()
+		}
+		return nil
+	}), context.Canceled)
+	status, err = r.snapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, api.FleetUpgradeRolloutStateRunning, status.State)
+
+	// Another replica resumes the rollout from the interrupted namespace.
+	upgraded := []string{}
+	require.NoError(t, r.run(ctx, func(_ context.Context, ns string, _ []api.Upgrade) error {
+		upgraded = append(upgraded, ns)
+		return nil
+	}))
+	assert.Equal(t, []string{"ns-2", "ns-3"}, upgraded)
+	status, err = r.snapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, api.FleetUpgradeRolloutStateSucceeded, status.State)
+}
@@ -4,26 +4,63 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/dbupgrade"
 	"github.com/percona/everest/pkg/kubernetes"
 )
 
 // k8sHandler is usually the last handler in the chain, so it does not have a next handler.
 type k8sHandler struct {
-	kubeConnector     kubernetes.KubernetesConnector
-	log               *zap.SugaredLogger
-	versionServiceURL string
+	kubeConnector         kubernetes.KubernetesConnector
+	log                   *zap.SugaredLogger
+	versionServiceURL     string
+	fleetUpgrade          *fleetUpgradeRollout
+	namespaceProvisioning *namespaceProvisioningJobs
+	postUpgradeTasks      *postUpgradeTasksJobs
+	// getRelease returns the deployed Helm release.
+	getRelease func(name, namespace string) (helm.ReleaseInfo, error)
+	// dbUpdater updates the database clusters on behalf of the background jobs.
+	dbUpdater dbupgrade.DatabaseClusterUpdater
+}
+
+// Option configures the k8s handler.
+type Option func(h *k8sHandler)
+
+// WithDatabaseClusterUpdater sets the updater used by the background jobs to update the database clusters,
+// so that the updates can be validated the same way as the updates made through the API.
+// By default, the database clusters are updated by the handler itself.
+func WithDatabaseClusterUpdater(updater dbupgrade.DatabaseClusterUpdater) Option {
+	return func(h *k8sHandler) {
+		h.dbUpdater = updater
+	}
 }
 
 // New returns a new RBAC handler.
 //
 //nolint:ireturn
-func New(log *zap.SugaredLogger, kubeConnector kubernetes.KubernetesConnector, vsURL string) handlers.Handler {
+func New(log *zap.SugaredLogger, kubeConnector kubernetes.KubernetesConnector, vsURL string, opts ...Option) handlers.Handler {
+	h := newK8sHandler(log, kubeConnector, vsURL)
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func newK8sHandler(log *zap.SugaredLogger, kubeConnector kubernetes.KubernetesConnector, vsURL string) *k8sHandler {
 	l := log.With("handler", "k8s")
-	return &k8sHandler{
-		kubeConnector:     kubeConnector,
-		log:               l,
-		versionServiceURL: vsURL,
+	h := &k8sHandler{
+		kubeConnector:         kubeConnector,
+		log:                   l,
+		versionServiceURL:     vsURL,
+		fleetUpgrade:          newFleetUpgradeRollout(kubeConnector, l),
+		namespaceProvisioning: newNamespaceProvisioningJobs(kubeConnector, l),
+		postUpgradeTasks:      newPostUpgradeTasksJobs(kubeConnector, l),
+		getRelease: func(name, namespace string) (helm.ReleaseInfo, error) {
+			return helm.GetReleaseInfo(name, namespace, "")
+		},
 	}
+	h.dbUpdater = h
+	return h
 }
 
 // SetNext sets the next handler to call in the chain.
@@ -2,10 +2,11 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/AlekSi/pointer"
-	"github.com/google/uuid"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,9 +16,13 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/monitoring"
 	"github.com/percona/everest/pkg/pmm"
 )
 
+// monitoringCheckTimeout limits the connectivity check done when a monitoring instance is created or updated.
+const monitoringCheckTimeout = 10 * time.Second
+
 func (h *k8sHandler) ListMonitoringInstances(ctx context.Context, namespace string) (*everestv1alpha1.MonitoringConfigList, error) {
 	return h.kubeConnector.ListMonitoringConfigs(ctx, ctrlclient.InNamespace(namespace))
 }
@@ -34,14 +39,36 @@ func (h *k8sHandler) CreateMonitoringInstance(ctx context.Context, namespace str
 		}, req.Name,
 		)
 	}
-	apiKey, err := h.getPMMApiKey(ctx, req)
+	token, err := h.getPMMToken(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return h.createMonitoringK8sResources(ctx, namespace, req, apiKey)
+	return h.createMonitoringK8sResources(ctx, namespace, req, token)
 }
 
-func (h *k8sHandler) DeleteMonitoringInstance(ctx context.Context, namespace, name string) error {
+func (h *k8sHandler) DeleteMonitoringInstance(
+	ctx context.Context, namespace, name string, req *api.DeleteMonitoringInstanceJSONRequestBody,
+) error {
+	// Keep the PMM token to revoke it once the monitoring instance is deleted.
+	var token *pmm.Token
+	secretName := name
+	m, err := h.kubeConnector.GetMonitoringConfig(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		if m.Spec.CredentialsSecretName != "" {
+			secretName = m.Spec.CredentialsSecretName
+		}
+		secret, err := h.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: namespace, Name: secretName})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			token = monitoring.TokenFromSecret(secret)
+		}
+	}
+
 	delMCObj := &everestv1alpha1.MonitoringConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
@@ -54,78 +81,141 @@ func (h *k8sHandler) DeleteMonitoringInstance(ctx context.Context, namespace, na
 
 	delSecObj := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
+			Name:      secretName,
 			Namespace: namespace,
 		},
 	}
-	return h.kubeConnector.DeleteSecret(ctx, delSecObj)
+	if err := h.kubeConnector.DeleteSecret(ctx, delSecObj); err != nil {
+		return err
+	}
+
+	if token != nil {
+		creds := pointer.Get(pointer.Get(req).Pmm)
+		h.revokePMMToken(ctx, m, token, creds.User, creds.Password)
+	}
+	return nil
 }
 
 func (h *k8sHandler) GetMonitoringInstance(ctx context.Context, namespace, name string) (*everestv1alpha1.MonitoringConfig, error) {
 	return h.kubeConnector.GetMonitoringConfig(ctx, types.NamespacedName{Namespace: namespace, Name: name})
 }
 
-func (h *k8sHandler) UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error) {
+func (h *k8sHandler) UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*api.MonitoringInstance, error) {
 	m, err := h.kubeConnector.GetMonitoringConfig(ctx, types.NamespacedName{Namespace: namespace, Name: name})
 	if err != nil {
 		return nil, err
 	}
-	var apiKey string
-	if req.Pmm != nil && req.Pmm.ApiKey != "" {
-		apiKey = req.Pmm.ApiKey
+	var (
+		token      *pmm.Token
+		revocation *api.PMMTokenRevocation
+	)
+	// The token is replaced before the URL changes, so the replaced token is revoked in the PMM it belongs to.
+	if token, revocation, err = h.updatePMMToken(ctx, m, req); err != nil {
+		return nil, err
+	}
+	if req.Url != "" {
+		m.Spec.PMM.URL = req.Url
+	}
+	if req.AllowedNamespaces != nil {
+		m.Spec.AllowedNamespaces = *req.AllowedNamespaces
+	}
+	if req.VerifyTLS != nil {
+		m.Spec.VerifyTLS = req.VerifyTLS
+	}
+	// Refresh the connectivity status right away instead of waiting for the next periodic check.
+	checkCtx, cancel := context.WithTimeout(ctx, monitoringCheckTimeout)
+	defer cancel()
+	if token != nil {
+		monitoring.Check(checkCtx, m, token, time.Now())
+	} else {
+		monitoring.CheckWithSecret(checkCtx, h.kubeConnector, m, time.Now())
+	}
+	updated, err := h.kubeConnector.UpdateMonitoringConfig(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	result := &api.MonitoringInstance{}
+	result.FromCR(updated)
+	result.TokenRevocation = revocation
+	return result, nil
+}
+
+// updatePMMToken stores the new PMM token of the monitoring instance, if any, and revokes the replaced one.
+// Returns the result of the revocation if the token has been replaced.
+// The monitoring instance is not updated in Kubernetes.
+func (h *k8sHandler) updatePMMToken(
+	ctx context.Context, m *everestv1alpha1.MonitoringConfig, req *api.UpdateMonitoringInstanceJSONRequestBody,
+) (*pmm.Token, *api.PMMTokenRevocation, error) {
+	name, namespace := m.GetName(), m.GetNamespace()
+	creds := pointer.Get(req.Pmm)
+	var token *pmm.Token
+	var err error
+	if creds.ApiKey != "" {
+		token = pmm.TokenFromKey(creds.ApiKey)
 	}
 	skipVerifyTLS := !pointer.Get(req.VerifyTLS)
-	if req.Pmm != nil && req.Pmm.User != "" && req.Pmm.Password != "" {
-		apiKey, err = pmm.CreatePMMApiKey(
-			ctx, req.Url, fmt.Sprintf("everest-%s-%s", name, uuid.NewString()),
-			req.Pmm.User, req.Pmm.Password,
+	if creds.User != "" && creds.Password != "" {
+		url := req.Url
+		if url == "" {
+			url = m.Spec.PMM.URL
+		}
+		token, err = pmm.CreateToken(
+			ctx, url, pmm.EverestTokenName(name),
+			creds.User, creds.Password,
 			skipVerifyTLS,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	if apiKey != "" {
-		_, err := h.kubeConnector.UpdateSecret(ctx, &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      name,
-				Namespace: namespace,
-			},
-			Type:       corev1.SecretTypeOpaque,
-			StringData: h.monitoringConfigSecretData(apiKey),
-		})
-		if err != nil {
-			return nil, fmt.Errorf("could not update k8s secret %s", name)
-		}
+	if token == nil {
+		return nil, nil, nil
 	}
-	if req.Url != "" {
-		m.Spec.PMM.URL = req.Url
+
+	var oldToken *pmm.Token
+	secretName := m.Spec.CredentialsSecretName
+	oldSecret, err := h.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: namespace, Name: secretName})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, nil, err
 	}
-	if req.AllowedNamespaces != nil {
-		m.Spec.AllowedNamespaces = *req.AllowedNamespaces
+	if err == nil {
+		oldToken = monitoring.TokenFromSecret(oldSecret)
 	}
-	if req.VerifyTLS != nil {
-		m.Spec.VerifyTLS = req.VerifyTLS
+
+	_, err = h.kubeConnector.UpdateSecret(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: namespace,
+		},
+		Type:       corev1.SecretTypeOpaque,
+		StringData: monitoring.SecretData(token),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not update k8s secret %s", secretName)
 	}
-	return h.kubeConnector.UpdateMonitoringConfig(ctx, m)
+
+	if oldToken == nil || oldToken.Key == token.Key {
+		return token, nil, nil
+	}
+	return token, h.revokePMMToken(ctx, m, oldToken, creds.User, creds.Password), nil
 }
 
-func (h *k8sHandler) getPMMApiKey(ctx context.Context, params *api.CreateMonitoringInstanceJSONRequestBody) (string, error) {
+func (h *k8sHandler) getPMMToken(ctx context.Context, params *api.CreateMonitoringInstanceJSONRequestBody) (*pmm.Token, error) {
 	if params.Pmm != nil && params.Pmm.ApiKey != "" {
-		return params.Pmm.ApiKey, nil
+		return pmm.TokenFromKey(params.Pmm.ApiKey), nil
 	}
 
-	h.log.Debug("Getting PMM API key by username and password")
+	h.log.Debug("Getting PMM token by username and password")
 	skipVerifyTLS := !pointer.Get(params.VerifyTLS)
-	return pmm.CreatePMMApiKey(
-		ctx, params.Url, fmt.Sprintf("everest-%s-%s", params.Name, uuid.NewString()),
+	return pmm.CreateToken(
+		ctx, params.Url, pmm.EverestTokenName(params.Name),
 		params.Pmm.User, params.Pmm.Password,
 		skipVerifyTLS,
 	)
 }
 
 func (h *k8sHandler) createMonitoringK8sResources(
-	c context.Context, namespace string, params *api.CreateMonitoringInstanceJSONRequestBody, apiKey string,
+	c context.Context, namespace string, params *api.CreateMonitoringInstanceJSONRequestBody, token *pmm.Token,
 ) (*everestv1alpha1.MonitoringConfig, error) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -133,7 +223,7 @@ func (h *k8sHandler) createMonitoringK8sResources(
 			Namespace: namespace,
 		},
 		Type:       corev1.SecretTypeOpaque,
-		StringData: h.monitoringConfigSecretData(apiKey),
+		StringData: monitoring.SecretData(token),
 	}
 	if _, err := h.kubeConnector.CreateSecret(c, secret); err != nil {
 		if k8serrors.IsAlreadyExists(err) {
@@ -145,7 +235,7 @@ func (h *k8sHandler) createMonitoringK8sResources(
 			return nil, fmt.Errorf("failed creating secret in the Kubernetes cluster")
 		}
 	}
-	created, err := h.kubeConnector.CreateMonitoringConfig(c, &everestv1alpha1.MonitoringConfig{
+	mc := &everestv1alpha1.MonitoringConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      params.Name,
 			Namespace: namespace,
@@ -158,7 +248,11 @@ func (h *k8sHandler) createMonitoringK8sResources(
 			CredentialsSecretName: params.Name,
 			VerifyTLS:             params.VerifyTLS,
 		},
-	})
+	}
+	checkCtx, cancel := context.WithTimeout(c, monitoringCheckTimeout)
+	defer cancel()
+	monitoring.Check(checkCtx, mc, token, time.Now())
+	created, err := h.kubeConnector.CreateMonitoringConfig(c, mc)
 	if err != nil {
 		delObj := &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
@@ -175,9 +269,37 @@ func (h *k8sHandler) createMonitoringK8sResources(
 	return created, nil
 }
 
-func (h *k8sHandler) monitoringConfigSecretData(apiKey string) map[string]string {
-	return map[string]string{
-		"apiKey":   apiKey,
-		"username": "api_key",
+// revokePMMToken revokes the token of the monitoring instance in PMM with the PMM admin credentials.
+// The revocation is best-effort, the orphaned tokens can be purged with everestctl later.
+func (h *k8sHandler) revokePMMToken(
+	ctx context.Context, m *everestv1alpha1.MonitoringConfig, token *pmm.Token, user, password string,
+) *api.PMMTokenRevocation {
+	result := &api.PMMTokenRevocation{
+		State:     api.PMMTokenRevocationStateRevoked,
+		TokenName: token.Name,
+	}
+	err := pmm.RevokeToken(ctx, m.Spec.PMM.URL, user, password, token, !pointer.Get(m.Spec.VerifyTLS))
+	switch {
+	case errors.Is(err, pmm.ErrUnknownTokenID):
+		result.State = api.PMMTokenRevocationStateSkipped
+		result.Message = "The PMM token is not tracked by Everest"
+		h.log.Infof("The PMM token of monitoring instance %s/%s is not tracked, skipping its revocation", m.GetNamespace(), m.GetName())
+	case errors.Is(err, pmm.ErrAdminCredentialsRequired):
+		result.State = api.PMMTokenRevocationStateSkipped
+		result.Message = "The PMM admin credentials are required to revoke the PMM token, " +
+			"use 'everestctl monitoring purge-keys' to remove it"
+		h.log.Infof("No PMM admin credentials to revoke the PMM token %q (ID %d) of monitoring instance %s/%s",
+			token.Name, token.ID, m.GetNamespace(), m.GetName(),
+		)
+	case err != nil:
+		result.State = api.PMMTokenRevocationStateFailed
+		result.Message = fmt.Sprintf("Could not revoke the PMM token, use 'everestctl monitoring purge-keys' to remove it: %s", err)
+		h.log.Warnf("Could not revoke the PMM token %q (ID %d) of monitoring instance %s/%s, "+
+			"use 'everestctl monitoring purge-keys' to remove it: %v",
+			token.Name, token.ID, m.GetNamespace(), m.GetName(), err,
+		)
+	default:
+		h.log.Infof("Revoked the PMM token (ID %d) of monitoring instance %s/%s", token.ID, m.GetNamespace(), m.GetName())
 	}
+	return result
 }
@@ -0,0 +1,111 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/monitoring"
+	"github.com/percona/everest/pkg/pmm"
+	"github.com/percona/everest/pkg/pmm/pmmtest"
+)
+
+func TestMonitoringInstancePMMToken(t *testing.T) {
+	t.Parallel()
+
+	const ns = "test-ns"
+	for _, tc := range []struct {
+		pmmVersion string
+		username   string
+	}{
+		{pmmVersion: "2.44.0", username: pmm.APIKeyUsername},
+		{pmmVersion: "3.1.0", username: pmm.ServiceTokenUsername},
+	} {
+		t.Run(tc.pmmVersion, func(t *testing.T) {
+			t.Parallel()
+			pmmServer := pmmtest.NewServer(tc.pmmVersion, "admin", "secret")
+			defer pmmServer.Close()
+
+			mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).Build()
+			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+			k8sH := New(zap.NewNop().Sugar(), k, "")
+			ctx := context.Background()
+			creds := &api.PMMMonitoringInstanceSpec{User: "admin", Password: "secret"}
+
+			mc, err := k8sH.CreateMonitoringInstance(ctx, ns, &api.CreateMonitoringInstanceJSONRequestBody{
+				Name:      "pmm",
+				Type:      api.MonitoringInstanceCreateParamsTypePmm,
+				Url:       pmmServer.URL,
+				Pmm:       creds,
+				VerifyTLS: pointer.ToBool(true),
+			})
+			require.NoError(t, err)
+			secret, err := k.GetSecret(ctx, types.NamespacedName{Namespace: ns, Name: "pmm"})
+			require.NoError(t, err)
+			assert.Equal(t, tc.username, secret.StringData["username"])
+			assert.NotEmpty(t, secret.StringData["apiKey"])
+			assert.Equal(t, monitoring.StatusOK, mc.GetAnnotations()[common.MonitoringStatusAnnotation])
+
+			tokenCount := func() int { return len(pmmServer.APIKeys()) + len(pmmServer.ServiceAccounts()) }
+			assert.Equal(t, 1, tokenCount())
+
+			// The token is re-minted when the credentials are provided and the replaced one is revoked with them.
+			// The URL of the monitoring instance is used when the request doesn't change it.
+			pmmServer.RevokeTokens()
+			updated, err := k8sH.UpdateMonitoringInstance(ctx, ns, "pmm", &api.UpdateMonitoringInstanceJSONRequestBody{Pmm: creds})
+			require.NoError(t, err)
+			newSecret, err := k.GetSecret(ctx, types.NamespacedName{Namespace: ns, Name: "pmm"})
+			require.NoError(t, err)
+			assert.Equal(t, tc.username, newSecret.StringData["username"])
+			assert.NotEqual(t, secret.StringData["apiKey"], newSecret.StringData["apiKey"])
+			require.NotNil(t, updated.Status)
+			assert.Equal(t, monitoring.StatusOK, updated.Status.Connectivity)
+			require.NotNil(t, updated.TokenRevocation)
+			assert.Equal(t, api.PMMTokenRevocationStateRevoked, updated.TokenRevocation.State)
+			assert.Equal(t, 1, tokenCount())
+
+			// Without the PMM admin credentials the replaced token is not revoked.
+			updated, err = k8sH.UpdateMonitoringInstance(ctx, ns, "pmm", &api.UpdateMonitoringInstanceJSONRequestBody{
+				Pmm: &api.PMMMonitoringInstanceSpec{ApiKey: "user-provided-key"},
+			})
+			require.NoError(t, err)
+			require.NotNil(t, updated.TokenRevocation)
+			assert.Equal(t, api.PMMTokenRevocationStateSkipped, updated.TokenRevocation.State)
+			assert.Equal(t, 1, tokenCount())
+
+			// The token is revoked when the instance is deleted with the PMM admin credentials.
+			_, err = k8sH.UpdateMonitoringInstance(ctx, ns, "pmm", &api.UpdateMonitoringInstanceJSONRequestBody{Pmm: creds})
+			require.NoError(t, err)
+			assert.Equal(t, 2, tokenCount())
+			require.NoError(t, k8sH.DeleteMonitoringInstance(ctx, ns, "pmm", &api.DeleteMonitoringInstanceJSONRequestBody{Pmm: creds}))
+			assert.Equal(t, 1, tokenCount())
+			_, err = k.GetSecret(ctx, types.NamespacedName{Namespace: ns, Name: "pmm"})
+			assert.True(t, k8serrors.IsNotFound(err))
+		})
+	}
+}
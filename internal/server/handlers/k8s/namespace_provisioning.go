@@ -0,0 +1,443 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/cli/steps"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/namespaces"
+)
+
+var (
+	// ErrNamespaceProvisioningInProgress is returned when a namespace provisioning job is requested
+	// while another job is running in the same namespace.
+	ErrNamespaceProvisioningInProgress = errors.New("another provisioning job is in progress in the namespace")
+
+	// ErrNamespaceProvisioningNotAllowed is returned when the namespace cannot be provisioned, updated or removed.
+	ErrNamespaceProvisioningNotAllowed = errors.New("namespace provisioning is not allowed")
+
+	// ErrNamespaceProvisioningJobNotFound is returned when there is no provisioning job for the namespace.
+	ErrNamespaceProvisioningJobNotFound = errors.New("namespace provisioning job not found")
+)
+
+const (
+	namespaceProvisioningJobKey = "job"
+	// namespaceProvisioningJobTimeout bounds the duration of a job, so that a job left running
+	// by a restarted replica of the Everest server does not block the namespace forever.
+	namespaceProvisioningJobTimeout = 30 * time.Minute
+)
+
+// namespaceProvisioningJobs keeps track of the latest provisioning job of each namespace.
+// The jobs are stored in ConfigMaps in the Everest system namespace, one per namespace,
+// so that they are shared by the replicas of the Everest server and survive their restarts.
+type namespaceProvisioningJobs struct {
+	kubeConnector kubernetes.KubernetesConnector
+	l             *zap.SugaredLogger
+	timeout       time.Duration
+}
+
+func newNamespaceProvisioningJobs(kubeConnector kubernetes.KubernetesConnector, l *zap.SugaredLogger) *namespaceProvisioningJobs {
+	return &namespaceProvisioningJobs{
+		kubeConnector: kubeConnector,
+		l:             l,
+		timeout:       namespaceProvisioningJobTimeout,
+	}
+}
+
+func namespaceProvisioningConfigMapName(namespace string) string {
+	return common.EverestNamespaceProvisioningConfigMapPrefix + namespace
+}
+
+// decode returns the job stored in the ConfigMap.
+// A job that is still running after the job timeout is reported as failed.
+func (j *namespaceProvisioningJobs) decode(cm *corev1.ConfigMap) (*api.NamespaceProvisioningJob, error) {
+	job := &api.NamespaceProvisioningJob{}
+	if err := json.Unmarshal([]byte(cm.Data[namespaceProvisioningJobKey]), job); err != nil {
+		return nil, errors.Join(err, fmt.Errorf("failed to decode namespace provisioning job %s", cm.GetName()))
+	}
+	if job.State == api.NamespaceProvisioningJobStateRunning &&
+		time.Since(pointer.Get(job.StartedAt)) > j.timeout {
+		finishNamespaceProvisioningJob(job, api.NamespaceProvisioningJobStateFailed,
+			errors.New("the job was interrupted"))
+	}
+	return job, nil
+}
+
+// get returns the latest job of the namespace.
+func (j *namespaceProvisioningJobs) get(ctx context.Context, namespace string) (*api.NamespaceProvisioningJob, bool, error) {
+	cm, err := j.kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      namespaceProvisioningConfigMapName(namespace),
+	})
+	if k8serrors.IsNotFound(err) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	job, err := j.decode(cm)
+	if err != nil {
+		return nil, false, err
+	}
+	return job, true, nil
+}
+
+// list returns the latest jobs of all the namespaces sorted by namespace.
+func (j *namespaceProvisioningJobs) list(ctx context.Context) (api.NamespaceProvisioningJobList, error) {
+	cms, err := j.kubeConnector.ListConfigMaps(ctx,
+		ctrlclient.InNamespace(common.SystemNamespace),
+		ctrlclient.HasLabels{common.EverestNamespaceProvisioningLabel},
+	)
+	if err != nil {
+		return nil, err
+	}
+	result := make(api.NamespaceProvisioningJobList, 0, len(cms.Items))
+	for _, cm := range cms.Items {
+		job, err := j.decode(&cm)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *job)
+	}
+	slices.SortFunc(result, func(a, b api.NamespaceProvisioningJob) int {
+		return strings.Compare(a.Namespace, b.Namespace)
+	})
+	return result, nil
+}
+
+// start replaces the latest job of the namespace with a new one consisting of the given steps.
+// Returns ErrNamespaceProvisioningInProgress if the latest job of the namespace has not finished yet.
+func (j *namespaceProvisioningJobs) start(
+	ctx context.Context,
+	namespace string,
+	operation api.NamespaceProvisioningJobOperation,
+	jobSteps []steps.Step,
+) (*api.NamespaceProvisioningJob, error) {
+	progress := make([]api.NamespaceProvisioningStep, 0, len(jobSteps))
+	for _, step := range jobSteps {
+		progress = append(progress, api.NamespaceProvisioningStep{
+			Description: step.Desc,
+			State:       api.NamespaceProvisioningStepStatePending,
+		})
+	}
+	job := &api.NamespaceProvisioningJob{
+		Namespace: namespace,
+		Operation: operation,
+		State:     api.NamespaceProvisioningJobStateRunning,
+		StartedAt: pointer.To(time.Now().UTC()),
+		Steps:     progress,
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := j.kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      namespaceProvisioningConfigMapName(namespace),
+	})
+	if k8serrors.IsNotFound(err) {
+		_, err = j.kubeConnector.CreateConfigMap(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      namespaceProvisioningConfigMapName(namespace),
+				Namespace: common.SystemNamespace,
+				Labels:    map[string]string{common.EverestNamespaceProvisioningLabel: "true"},
+			},
+			Data: map[string]string{namespaceProvisioningJobKey: string(data)},
+		})
+		return job, err
+	} else if err != nil {
+		return nil, err
+	}
+	latest, err := j.decode(cm)
+	if err != nil {
+		return nil, err
+	}
+	if latest.State == api.NamespaceProvisioningJobStateRunning {
+		return nil, ErrNamespaceProvisioningInProgress
+	}
+	// The update fails with a conflict if another replica has started a job in the meantime.
+	cm.Data = map[string]string{namespaceProvisioningJobKey: string(data)}
+	if _, err := j.kubeConnector.UpdateConfigMap(ctx, cm); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// update applies the change to the latest job of the namespace.
+func (j *namespaceProvisioningJobs) update(ctx context.Context, namespace string, change func(job *api.NamespaceProvisioningJob)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := j.kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+			Namespace: common.SystemNamespace,
+			Name:      namespaceProvisioningConfigMapName(namespace),
+		})
+		if err != nil {
+			return err
+		}
+		job, err := j.decode(cm)
+		if err != nil {
+			return err
+		}
+		change(job)
+		data, err := json.Marshal(job)
+		if err != nil {
+			return err
+		}
+		cm.Data = map[string]string{namespaceProvisioningJobKey: string(data)}
+		_, err = j.kubeConnector.UpdateConfigMap(ctx, cm)
+		return err
+	})
+}
+
+func (j *namespaceProvisioningJobs) setStepState(
+	ctx context.Context,
+	namespace string,
+	idx int,
+	state api.NamespaceProvisioningStepState,
+	err error,
+) {
+	if uErr := j.update(ctx, namespace, func(job *api.NamespaceProvisioningJob) {
+		if idx >= len(job.Steps) {
+			return
+		}
+		job.Steps[idx].State = state
+		if err != nil {
+			job.Steps[idx].Message = pointer.ToString(err.Error())
+		}
+	}); uErr != nil {
+		j.l.Errorf("failed to update the provisioning job of namespace '%s': %v", namespace, uErr)
+	}
+}
+
+func (j *namespaceProvisioningJobs) finish(ctx context.Context, namespace string, state api.NamespaceProvisioningJobState, err error) {
+	if uErr := j.update(ctx, namespace, func(job *api.NamespaceProvisioningJob) {
+		finishNamespaceProvisioningJob(job, state, err)
+	}); uErr != nil {
+		j.l.Errorf("failed to update the provisioning job of namespace '%s': %v", namespace, uErr)
+	}
+}
+
+func finishNamespaceProvisioningJob(job *api.NamespaceProvisioningJob, state api.NamespaceProvisioningJobState, err error) {
+	job.State = state
+	job.FinishedAt = pointer.To(time.Now().UTC())
+	if err != nil {
+		job.Message = pointer.ToString(err.Error())
+	}
+	for i := range job.Steps {
+		if job.Steps[i].State == api.NamespaceProvisioningStepStatePending {
+			job.Steps[i].State = api.NamespaceProvisioningStepStateSkipped
+		}
+	}
+}
+
+// run executes the steps of the namespace job one by one.
+// The job stops on the first failed step, the remaining steps are skipped.
+func (j *namespaceProvisioningJobs) run(ctx context.Context, namespace string, jobSteps []steps.Step) {
+	// The job state is still recorded after the timeout.
+	stepsCtx, cancel := context.WithTimeout(ctx, j.timeout)
+	defer cancel()
+	for i, step := range jobSteps {
+		j.setStepState(ctx, namespace, i, api.NamespaceProvisioningStepStateInProgress, nil)
+		if err := step.F(stepsCtx); err != nil {
+			j.setStepState(ctx, namespace, i, api.NamespaceProvisioningStepStateFailed, err)
+			j.finish(ctx, namespace, api.NamespaceProvisioningJobStateFailed, err)
+			return
+		}
+		j.setStepState(ctx, namespace, i, api.NamespaceProvisioningStepStateSucceeded, nil)
+	}
+	j.finish(ctx, namespace, api.NamespaceProvisioningJobStateSucceeded, nil)
+}
+
+func (h *k8sHandler) ListNamespaceProvisioningJobs(ctx context.Context) (api.NamespaceProvisioningJobList, error) {
+	return h.namespaceProvisioning.list(ctx)
+}
+
+func (h *k8sHandler) GetNamespaceProvisioningJob(ctx context.Context, namespace string) (*api.NamespaceProvisioningJob, error) {
+	job, ok, err := h.namespaceProvisioning.get(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("namespace '%s': %w", namespace, ErrNamespaceProvisioningJobNotFound)
+	}
+	return job, nil
+}
+
+func (h *k8sHandler) ProvisionNamespace(ctx context.Context, req *api.NamespaceProvisioningRequest) (*api.NamespaceProvisioningJob, error) {
+	if err := h.checkNamespaceProvisioningEnabled(ctx); err != nil {
+		return nil, err
+	}
+	cfg, err := h.newNamespaceAddConfig(req.Namespace, req.Operators)
+	if err != nil {
+		return nil, err
+	}
+	cfg.TakeOwnership = pointer.Get(req.TakeOwnership)
+	return h.startNamespaceAdd(ctx, api.Provision, cfg)
+}
+
+func (h *k8sHandler) UpdateNamespaceOperators(ctx context.Context, namespace string, req *api.NamespaceOperators) (*api.NamespaceProvisioningJob, error) {
+	if err := h.checkNamespaceProvisioningEnabled(ctx); err != nil {
+		return nil, err
+	}
+	cfg, err := h.newNamespaceAddConfig(namespace, *req)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Update = true
+	return h.startNamespaceAdd(ctx, api.Update, cfg)
+}
+
+func (h *k8sHandler) DeprovisionNamespace(ctx context.Context, namespace string, params *api.DeprovisionNamespaceParams) (*api.NamespaceProvisioningJob, error) {
+	if err := h.checkNamespaceProvisioningEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := cliutils.CheckHelmInstallation(ctx, h.kubeConnector); err != nil {
+		return nil, err
+	}
+	remover := namespaces.NewNamespaceRemoveWithClient(namespaces.NamespaceRemoveConfig{
+		NamespaceList: []string{namespace},
+		Force:         pointer.Get(params.Force),
+		KeepNamespace: pointer.Get(params.KeepNamespace),
+	}, h.kubeConnector, h.log)
+	if err := remover.Validate(ctx); err != nil {
+		return nil, namespaceProvisioningError(err)
+	}
+	return h.startNamespaceProvisioning(ctx, namespace, api.Deprovision, remover.Steps())
+}
+
+// checkNamespaceProvisioningEnabled returns ErrNamespaceProvisioningNotAllowed if the Everest API server
+// has not been granted the permissions to provision the DB namespaces.
+// They are granted by everestctl only when the namespace provisioning is enabled.
+func (h *k8sHandler) checkNamespaceProvisioningEnabled(ctx context.Context) error {
+	allowed, err := h.kubeConnector.IsAllowed(ctx, "create", rbacv1.GroupName, "rolebindings")
+	if err != nil {
+		return fmt.Errorf("failed to check the namespace provisioning permissions: %w", err)
+	}
+	if !allowed {
+		return fmt.Errorf("%w: namespace provisioning is disabled, enable it by installing or upgrading Everest with --%s",
+			ErrNamespaceProvisioningNotAllowed, cli.FlagEnableNamespaceProvisioning)
+	}
+	return nil
+}
+
+// newNamespaceAddConfig returns the configuration for adding the namespace with the requested operators.
+// The DB namespace chart is installed from the Helm repository Everest is installed from.
+func (h *k8sHandler) newNamespaceAddConfig(namespace string, operators api.NamespaceOperators) (namespaces.NamespaceAddConfig, error) {
+	rel, err := h.getRelease(common.SystemNamespace, common.SystemNamespace)
+	if err != nil {
+		return namespaces.NamespaceAddConfig{}, fmt.Errorf("could not get Everest Helm release: %w", err)
+	}
+	cfg := namespaces.NewNamespaceAddConfig()
+	cfg.NamespaceList = []string{namespace}
+	cfg.SkipWizard = true
+	cfg.Pretty = false
+	cfg.HelmConfig.RepoURL = helm.RepoURLFromValues(rel.Values)
+	cfg.Operators = namespaces.OperatorConfig{
+		PG:    pointer.Get(operators.Postgresql),
+		PSMDB: pointer.Get(operators.Mongodb),
+		PXC:   pointer.Get(operators.Mysql),
+	}
+	return cfg, nil
+}
+
+// startNamespaceAdd validates the namespace against the cluster and starts
+// the job that installs the DB namespace Helm chart in it.
+func (h *k8sHandler) startNamespaceAdd(
+	ctx context.Context,
+	operation api.NamespaceProvisioningJobOperation,
+	cfg namespaces.NamespaceAddConfig,
+) (*api.NamespaceProvisioningJob, error) {
+	dbNSChartVersion, err := cliutils.CheckHelmInstallation(ctx, h.kubeConnector)
+	if err != nil {
+		return nil, err
+	}
+	clusterType, err := h.kubeConnector.GetClusterType(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect cluster type: %w", err)
+	}
+	cfg.ClusterType = clusterType
+	cfg.SkipEnvDetection = true
+	cfg.VersionMetadataURL = h.versionServiceURL
+
+	adder, err := namespaces.NewNamespaceAddWithClient(cfg, h.kubeConnector, h.log)
+	if err != nil {
+		return nil, namespaceProvisioningError(err)
+	}
+	if err := adder.Validate(ctx); err != nil {
+		return nil, namespaceProvisioningError(err)
+	}
+	installSteps, err := adder.GetNamespaceInstallSteps(ctx, dbNSChartVersion)
+	if err != nil {
+		return nil, err
+	}
+	return h.startNamespaceProvisioning(ctx, cfg.NamespaceList[0], operation, installSteps)
+}
+
+func (h *k8sHandler) startNamespaceProvisioning(
+	ctx context.Context,
+	namespace string,
+	operation api.NamespaceProvisioningJobOperation,
+	jobSteps []steps.Step,
+) (*api.NamespaceProvisioningJob, error) {
+	job, err := h.namespaceProvisioning.start(ctx, namespace, operation, jobSteps)
+	if err != nil {
+		return nil, err
+	}
+	// The job outlives the request, so we must not inherit its cancellation.
+	go h.namespaceProvisioning.run(context.WithoutCancel(ctx), namespace, jobSteps)
+	return job, nil
+}
+
+// namespaceProvisioningError marks the errors caused by the state of the namespace as ErrNamespaceProvisioningNotAllowed.
+func namespaceProvisioningError(err error) error {
+	for _, target := range []error{
+		namespaces.ErrNamespaceNotExist,
+		namespaces.ErrNamespaceAlreadyExists,
+		namespaces.ErrNamespaceNotManagedByEverest,
+		namespaces.ErrNamespaceAlreadyManagedByEverest,
+		namespaces.ErrNamespaceListEmpty,
+		namespaces.ErrOperatorsNotSelected,
+		namespaces.ErrCannotRemoveOperators,
+		namespaces.ErrNamespaceNotEmpty,
+	} {
+		if errors.Is(err, target) {
+			return errors.Join(ErrNamespaceProvisioningNotAllowed, err)
+		}
+	}
+	return err
+}
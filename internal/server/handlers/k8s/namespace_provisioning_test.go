@@ -0,0 +1,170 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/cli/steps"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestNamespaceProvisioningJobs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		failStep  int
+		wantState api.NamespaceProvisioningJobState
+		wantSteps []api.NamespaceProvisioningStepState
+	}{
+		{
+			name:      "all succeeded",
+			failStep:  -1,
+			wantState: api.NamespaceProvisioningJobStateSucceeded,
+			wantSteps: []api.NamespaceProvisioningStepState{
+				api.NamespaceProvisioningStepStateSucceeded,
+				api.NamespaceProvisioningStepStateSucceeded,
+				api.NamespaceProvisioningStepStateSucceeded,
+			},
+		},
+		{
+			name:      "stop on first failed step",
+			failStep:  1,
+			wantState: api.NamespaceProvisioningJobStateFailed,
+			wantSteps: []api.NamespaceProvisioningStepState{
+				api.NamespaceProvisioningStepStateSucceeded,
+				api.NamespaceProvisioningStepStateFailed,
+				api.NamespaceProvisioningStepStateSkipped,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			jobSteps := make([]steps.Step, 0, len(tc.wantSteps))
+			for i := range tc.wantSteps {
+				jobSteps = append(jobSteps, steps.Step{
+					Desc: "step",
+					F: func(_ context.Context) error {
+						if i == tc.failStep {
+							return errors.New("step failed")
+						}
+						return nil
+					},
+				})
+			}
+
+			ctx := context.Background()
+			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).
+				WithKubernetesClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).Build())
+			j := newNamespaceProvisioningJobs(k, zap.NewNop().Sugar())
+			_, err := j.start(ctx, "ns-1", api.Provision, jobSteps)
+			require.NoError(t, err)
+			_, err = j.start(ctx, "ns-1", api.Deprovision, jobSteps)
+			require.ErrorIs(t, err, ErrNamespaceProvisioningInProgress)
+			_, err = j.start(ctx, "ns-2", api.Update, nil)
+			require.NoError(t, err)
+
+			j.run(ctx, "ns-1", jobSteps)
+
+			job, ok, err := j.get(ctx, "ns-1")
+			require.NoError(t, err)
+			require.True(t, ok)
+			assert.Equal(t, api.Provision, job.Operation)
+			assert.Equal(t, tc.wantState, job.State)
+			assert.NotNil(t, job.FinishedAt)
+			got := make([]api.NamespaceProvisioningStepState, 0, len(job.Steps))
+			for _, s := range job.Steps {
+				got = append(got, s.State)
+			}
+			assert.Equal(t, tc.wantSteps, got)
+			if tc.failStep >= 0 {
+				assert.Equal(t, "step failed", *job.Message)
+				assert.Equal(t, "step failed", *job.Steps[tc.failStep].Message)
+			}
+
+			// A new job may start once the previous one has finished.
+			_, err = j.start(ctx, "ns-1", api.Deprovision, jobSteps)
+			require.NoError(t, err)
+
+			jobs, err := j.list(ctx)
+			require.NoError(t, err)
+			require.Len(t, jobs, 2)
+			assert.Equal(t, "ns-1", jobs[0].Namespace)
+			assert.Equal(t, "ns-2", jobs[1].Namespace)
+		})
+	}
+}
+
+func TestNamespaceProvisioningJobsInterrupted(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).
+		WithKubernetesClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).Build())
+	j := newNamespaceProvisioningJobs(k, zap.NewNop().Sugar())
+	jobSteps := []steps.Step{{Desc: "step"}}
+	_, err := j.start(ctx, "ns-1", api.Provision, jobSteps)
+	require.NoError(t, err)
+
+	// The job is left running, e.g. by a restarted replica of the Everest server.
+	j.timeout = 0
+	job, ok, err := j.get(ctx, "ns-1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, api.NamespaceProvisioningJobStateFailed, job.State)
+	assert.Equal(t, api.NamespaceProvisioningStepStateSkipped, job.Steps[0].State)
+
+	_, err = j.start(ctx, "ns-1", api.Deprovision, jobSteps)
+	require.NoError(t, err)
+}
+
+func TestCheckNamespaceProvisioningEnabled(t *testing.T) {
+	t.Parallel()
+
+	newHandler := func(allowed bool) *k8sHandler {
+		c := fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.CreateScheme()).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ ctrlclient.WithWatch, obj ctrlclient.Object, _ ...ctrlclient.CreateOption) error {
+					review, ok := obj.(*authorizationv1.SelfSubjectAccessReview)
+					require.True(t, ok)
+					assert.Equal(t, "rolebindings", review.Spec.ResourceAttributes.Resource)
+					review.Status.Allowed = allowed
+					return nil
+				},
+			}).
+			Build()
+		return &k8sHandler{kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(c)}
+	}
+
+	require.NoError(t, newHandler(true).checkNamespaceProvisioningEnabled(context.Background()))
+	err := newHandler(false).checkNamespaceProvisioningEnabled(context.Background())
+	assert.ErrorIs(t, err, ErrNamespaceProvisioningNotAllowed)
+}
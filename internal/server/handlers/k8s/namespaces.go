@@ -3,6 +3,13 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/usage"
 )
 
 func (h *k8sHandler) ListNamespaces(ctx context.Context) ([]string, error) {
@@ -17,3 +24,53 @@ func (h *k8sHandler) ListNamespaces(ctx context.Context) ([]string, error) {
 	}
 	return result, nil
 }
+
+func (h *k8sHandler) GetNamespaceQuotaUsage(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error) {
+	quota, err := h.kubeConnector.GetNamespaceQuota(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetNamespaceQuota: %w", err)
+	}
+	usage, err := h.kubeConnector.GetNamespaceResourceUsage(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetNamespaceResourceUsage: %w", err)
+	}
+
+	quantityToString := func(q *resource.Quantity) *string {
+		if q == nil {
+			return nil
+		}
+		return pointer.ToString(q.String())
+	}
+	return &api.NamespaceQuotaUsage{
+		Quota: api.NamespaceQuota{
+			Cpu:                    quantityToString(quota.CPU),
+			Memory:                 quantityToString(quota.Memory),
+			Disk:                   quantityToString(quota.Disk),
+			DatabaseClusters:       quota.DatabaseClusters,
+			DatabaseClusterBackups: quota.DatabaseClusterBackups,
+		},
+		Usage: api.NamespaceResourceUsage{
+			CpuMillis:              usage.CPUMillis,
+			MemoryBytes:            usage.MemoryBytes,
+			DiskSize:               usage.DiskBytes,
+			DatabaseClusters:       usage.DatabaseClusters,
+			DatabaseClusterBackups: usage.DatabaseClusterBackups,
+		},
+	}, nil
+}
+
+func (h *k8sHandler) GetUsageReport(ctx context.Context, params *api.GetUsageReportParams) (*api.UsageReport, error) {
+	to := time.Now().UTC()
+	if params.To != nil {
+		to = *params.To
+	}
+	from := to.Add(-usage.DefaultReportRange)
+	if params.From != nil {
+		from = *params.From
+	}
+	report, err := usage.GetReport(ctx, h.kubeConnector, from, to, pointer.GetString(params.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage report: %w", err)
+	}
+	return report, nil
+}
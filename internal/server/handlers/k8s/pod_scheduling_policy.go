@@ -17,6 +17,7 @@ package k8s
 
 import (
 	"context"
+	"fmt"
 	"slices"
 
 	"github.com/AlekSi/pointer"
@@ -25,6 +26,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
 )
 
 func (h *k8sHandler) CreatePodSchedulingPolicy(ctx context.Context, psp *everestv1alpha1.PodSchedulingPolicy) (*everestv1alpha1.PodSchedulingPolicy, error) {
@@ -55,6 +57,13 @@ func (h *k8sHandler) ListPodSchedulingPolicies(ctx context.Context, params *api.
 				return !psp.HasRules()
 			})
 		}
+
+		if namespace := pointer.Get(params.Namespace); namespace != "" {
+			// filter out PodSchedulingPolicies that are not allowed in a requested namespace
+			pspList.Items = slices.DeleteFunc(pspList.Items, func(psp everestv1alpha1.PodSchedulingPolicy) bool {
+				return !common.IsPodSchedulingPolicyAllowed(&psp, namespace)
+			})
+		}
 	}
 	return pspList, err
 }
@@ -71,3 +80,19 @@ func (h *k8sHandler) DeletePodSchedulingPolicy(ctx context.Context, name string)
 func (h *k8sHandler) GetPodSchedulingPolicy(ctx context.Context, name string) (*everestv1alpha1.PodSchedulingPolicy, error) {
 	return h.kubeConnector.GetPodSchedulingPolicy(ctx, types.NamespacedName{Name: name})
 }
+
+// applyDefaultPodSchedulingPolicy sets the default pod scheduling policy of the engine type
+// in the namespace of the DB cluster if the DB cluster doesn't reference a policy.
+func (h *k8sHandler) applyDefaultPodSchedulingPolicy(ctx context.Context, db *everestv1alpha1.DatabaseCluster) error {
+	if db.Spec.PodSchedulingPolicyName != "" {
+		return nil
+	}
+	pspList, err := h.kubeConnector.ListPodSchedulingPolicies(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list pod scheduling policies: %w", err)
+	}
+	if psp := common.DefaultPodSchedulingPolicy(pspList.Items, db.Spec.Engine.Type, db.GetNamespace()); psp != nil {
+		db.Spec.PodSchedulingPolicyName = psp.GetName()
+	}
+	return nil
+}
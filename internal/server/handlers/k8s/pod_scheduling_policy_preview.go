@@ -0,0 +1,428 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+)
+
+// Components of the database clusters that the pod scheduling policies apply to.
+const (
+	pspComponentEngine       = "engine"
+	pspComponentProxy        = "proxy"
+	pspComponentConfigServer = "configServer"
+)
+
+// Types of the pod scheduling rules.
+const (
+	pspRuleNodeAffinity    = "nodeAffinity"
+	pspRulePodAffinity     = "podAffinity"
+	pspRulePodAntiAffinity = "podAntiAffinity"
+)
+
+//nolint:gochecknoglobals
+var nodeSelectorOperators = map[corev1.NodeSelectorOperator]selection.Operator{
+	corev1.NodeSelectorOpIn:           selection.In,
+	corev1.NodeSelectorOpNotIn:        selection.NotIn,
+	corev1.NodeSelectorOpExists:       selection.Exists,
+	corev1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+	corev1.NodeSelectorOpGt:           selection.GreaterThan,
+	corev1.NodeSelectorOpLt:           selection.LessThan,
+}
+
+func (h *k8sHandler) PreviewPodSchedulingPolicy(ctx context.Context, req *handlers.PodSchedulingPolicyPreviewRequest) (*api.PodSchedulingPolicyPreview, error) {
+	psp := req.Policy
+	if psp == nil {
+		var err error
+		if psp, err = h.kubeConnector.GetPodSchedulingPolicy(ctx, types.NamespacedName{Name: req.PolicyName}); err != nil {
+			return nil, err
+		}
+	}
+	engineType := req.EngineType
+	if engineType == "" {
+		engineType = psp.Spec.EngineType
+	}
+
+	p, err := h.loadSchedulingPreview(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	result := &api.PodSchedulingPolicyPreview{
+		EngineType: string(engineType),
+		Components: []api.PodSchedulingComponentPreview{},
+	}
+	for _, c := range pspComponents(engineType, psp.Spec.AffinityConfig) {
+		result.Components = append(result.Components, p.component(c.name, c.affinity))
+	}
+	return result, nil
+}
+
+type pspComponent struct {
+	name     string
+	affinity *corev1.Affinity
+}
+
+// pspComponents returns the affinity of every component of the engine type.
+func pspComponents(engineType everestv1alpha1.EngineType, cfg *everestv1alpha1.AffinityConfig) []pspComponent {
+	if cfg == nil {
+		cfg = &everestv1alpha1.AffinityConfig{}
+	}
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC:
+		c := cfg.PXC
+		if c == nil {
+			c = &everestv1alpha1.PXCAffinityConfig{}
+		}
+		return []pspComponent{{pspComponentEngine, c.Engine}, {pspComponentProxy, c.Proxy}}
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		c := cfg.PSMDB
+		if c == nil {
+			c = &everestv1alpha1.PSMDBAffinityConfig{}
+		}
+		return []pspComponent{{pspComponentEngine, c.Engine}, {pspComponentProxy, c.Proxy}, {pspComponentConfigServer, c.ConfigServer}}
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		c := cfg.PostgreSQL
+		if c == nil {
+			c = &everestv1alpha1.PostgreSQLAffinityConfig{}
+		}
+		return []pspComponent{{pspComponentEngine, c.Engine}, {pspComponentProxy, c.Proxy}}
+	}
+	return nil
+}
+
+// schedulingPreview evaluates the affinity rules against a snapshot of the cluster.
+// Unlike the scheduler, it doesn't account for the resources and for the pods
+// of the database cluster itself, since they don't exist yet.
+type schedulingPreview struct {
+	nodes      []corev1.Node
+	nodeByName map[string]*corev1.Node
+	pods       []corev1.Pod
+	namespaces []corev1.Namespace
+	// namespace is the namespace of the database cluster, empty if unknown.
+	namespace string
+}
+
+// loadSchedulingPreview takes a snapshot of the nodes, pods and namespaces of the cluster
+// to evaluate the scheduling of a database cluster in the namespace.
+func (h *k8sHandler) loadSchedulingPreview(ctx context.Context, namespace string) (*schedulingPreview, error) {
+	nodes, err := h.kubeConnector.ListWorkerNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	pods, err := h.kubeConnector.ListPods(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	namespaces, err := h.kubeConnector.ListNamespaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	return newSchedulingPreview(nodes.Items, pods.Items, namespaces.Items, namespace), nil
+}
+
+func newSchedulingPreview(nodes []corev1.Node, pods []corev1.Pod, namespaces []corev1.Namespace, namespace string) *schedulingPreview {
+	slices.SortFunc(nodes, func(a, b corev1.Node) int { return strings.Compare(a.GetName(), b.GetName()) })
+	p := &schedulingPreview{
+		nodes:      nodes,
+		nodeByName: make(map[string]*corev1.Node, len(nodes)),
+		namespaces: namespaces,
+		namespace:  namespace,
+	}
+	for i := range nodes {
+		p.nodeByName[nodes[i].GetName()] = &nodes[i]
+	}
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		p.pods = append(p.pods, pod)
+	}
+	return p
+}
+
+// previewRule is a rule being evaluated with the function that checks it against a node.
+type previewRule struct {
+	api.PodSchedulingRulePreview
+
+	satisfiedBy func(node *corev1.Node) bool
+}
+
+func (p *schedulingPreview) component(name string, affinity *corev1.Affinity) api.PodSchedulingComponentPreview {
+	rules := p.rules(affinity)
+	result := api.PodSchedulingComponentPreview{
+		Component:     name,
+		Nodes:         []string{},
+		ExcludedNodes: []api.PodSchedulingExcludedNode{},
+		Rules:         make([]api.PodSchedulingRulePreview, 0, len(rules)),
+	}
+
+	// Only the nodes that the taints don't exclude count as matching the rules.
+	schedulable := make([]*corev1.Node, 0, len(p.nodes))
+	for i := range p.nodes {
+		node := &p.nodes[i]
+		reasons := taintReasons(node)
+		if len(reasons) == 0 {
+			schedulable = append(schedulable, node)
+		}
+		for _, r := range rules {
+			if r.Required && !r.satisfiedBy(node) {
+				reasons = append(reasons, fmt.Sprintf("%s rule not satisfied: %s", r.Type, r.Description))
+			}
+		}
+		if len(reasons) > 0 {
+			result.ExcludedNodes = append(result.ExcludedNodes, api.PodSchedulingExcludedNode{Name: node.GetName(), Reasons: reasons})
+			continue
+		}
+		result.Nodes = append(result.Nodes, node.GetName())
+	}
+
+	for _, r := range rules {
+		r.MatchingNodes = []string{}
+		for _, node := range schedulable {
+			if r.satisfiedBy(node) {
+				r.MatchingNodes = append(r.MatchingNodes, node.GetName())
+			}
+		}
+		r.Satisfiable = len(r.MatchingNodes) > 0
+		result.Rules = append(result.Rules, r.PodSchedulingRulePreview)
+	}
+	return result
+}
+
+func (p *schedulingPreview) rules(affinity *corev1.Affinity) []previewRule {
+	if affinity == nil {
+		return nil
+	}
+	var rules []previewRule
+	if na := affinity.NodeAffinity; na != nil {
+		if ns := na.RequiredDuringSchedulingIgnoredDuringExecution; ns != nil {
+			rules = append(rules, previewRule{
+				PodSchedulingRulePreview: api.PodSchedulingRulePreview{
+					Type:        pspRuleNodeAffinity,
+					Required:    true,
+					Description: nodeSelectorString(ns.NodeSelectorTerms),
+				},
+				satisfiedBy: func(node *corev1.Node) bool {
+					return slices.ContainsFunc(ns.NodeSelectorTerms, func(t corev1.NodeSelectorTerm) bool {
+						return nodeSelectorTermMatches(t, node)
+					})
+				},
+			})
+		}
+		for _, t := range na.PreferredDuringSchedulingIgnoredDuringExecution {
+			rules = append(rules, previewRule{
+				PodSchedulingRulePreview: api.PodSchedulingRulePreview{
+					Type:        pspRuleNodeAffinity,
+					Weight:      int(t.Weight),
+					Description: nodeSelectorTermString(t.Preference),
+				},
+				satisfiedBy: func(node *corev1.Node) bool { return nodeSelectorTermMatches(t.Preference, node) },
+			})
+		}
+	}
+	if pa := affinity.PodAffinity; pa != nil {
+		rules = append(rules, p.podRules(pspRulePodAffinity, pa.RequiredDuringSchedulingIgnoredDuringExecution, pa.PreferredDuringSchedulingIgnoredDuringExecution)...)
+	}
+	if paa := affinity.PodAntiAffinity; paa != nil {
+		rules = append(rules, p.podRules(pspRulePodAntiAffinity, paa.RequiredDuringSchedulingIgnoredDuringExecution, paa.PreferredDuringSchedulingIgnoredDuringExecution)...)
+	}
+	return rules
+}
+
+func (p *schedulingPreview) podRules(ruleType string, required []corev1.PodAffinityTerm, preferred []corev1.WeightedPodAffinityTerm) []previewRule {
+	anti := ruleType == pspRulePodAntiAffinity
+	newRule := func(term corev1.PodAffinityTerm, weight int32) previewRule {
+		return previewRule{
+			PodSchedulingRulePreview: api.PodSchedulingRulePreview{
+				Type:        ruleType,
+				Required:    weight == 0,
+				Weight:      int(weight),
+				Description: p.podAffinityTermString(term, anti),
+			},
+			satisfiedBy: func(node *corev1.Node) bool { return p.podAffinityTermMatches(term, node) != anti },
+		}
+	}
+	rules := make([]previewRule, 0, len(required)+len(preferred))
+	for _, t := range required {
+		rules = append(rules, newRule(t, 0))
+	}
+	for _, t := range preferred {
+		rules = append(rules, newRule(t.PodAffinityTerm, t.Weight))
+	}
+	return rules
+}
+
+// podAffinityTermMatches returns true if a pod selected by the term runs in the topology domain of the node.
+func (p *schedulingPreview) podAffinityTermMatches(term corev1.PodAffinityTerm, node *corev1.Node) bool {
+	domain, ok := node.GetLabels()[term.TopologyKey]
+	if !ok {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+	if err != nil {
+		return false
+	}
+	namespaces := p.podAffinityTermNamespaces(term)
+	for _, pod := range p.pods {
+		if namespaces != nil && !slices.Contains(namespaces, pod.GetNamespace()) {
+			continue
+		}
+		if !selector.Matches(labels.Set(pod.GetLabels())) {
+			continue
+		}
+		if podNode, ok := p.nodeByName[pod.Spec.NodeName]; ok && podNode.GetLabels()[term.TopologyKey] == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// podAffinityTermNamespaces returns the namespaces of the pods the term applies to, nil means all namespaces.
+func (p *schedulingPreview) podAffinityTermNamespaces(term corev1.PodAffinityTerm) []string {
+	if len(term.Namespaces) == 0 && term.NamespaceSelector == nil {
+		if p.namespace == "" {
+			return nil
+		}
+		return []string{p.namespace}
+	}
+	namespaces := slices.Clone(term.Namespaces)
+	if term.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(term.NamespaceSelector)
+		if err != nil {
+			return namespaces
+		}
+		for _, ns := range p.namespaces {
+			if selector.Matches(labels.Set(ns.GetLabels())) {
+				namespaces = append(namespaces, ns.GetName())
+			}
+		}
+	}
+	if namespaces == nil {
+		namespaces = []string{}
+	}
+	return namespaces
+}
+
+func (p *schedulingPreview) podAffinityTermString(term corev1.PodAffinityTerm, anti bool) string {
+	var namespaces string
+	switch {
+	case len(term.Namespaces) == 0 && term.NamespaceSelector == nil && p.namespace == "":
+		namespaces = "any namespace"
+	case len(term.Namespaces) == 0 && term.NamespaceSelector == nil:
+		namespaces = "namespace " + p.namespace
+	case term.NamespaceSelector == nil:
+		namespaces = "namespaces (" + strings.Join(term.Namespaces, ", ") + ")"
+	default:
+		namespaces = fmt.Sprintf("namespaces matching '%s'", metav1.FormatLabelSelector(term.NamespaceSelector))
+		if len(term.Namespaces) > 0 {
+			namespaces += " or (" + strings.Join(term.Namespaces, ", ") + ")"
+		}
+	}
+	prefix := "pods"
+	if anti {
+		prefix = "no pods"
+	}
+	return fmt.Sprintf("%s matching '%s' in %s within the same %s",
+		prefix, metav1.FormatLabelSelector(term.LabelSelector), namespaces, term.TopologyKey,
+	)
+}
+
+// taintReasons returns the reasons the taints of the node exclude it.
+// The pods of the database clusters have no tolerations for the custom taints.
+func taintReasons(node *corev1.Node) []string {
+	var reasons []string
+	if node.Spec.Unschedulable {
+		reasons = append(reasons, "node is cordoned")
+	}
+	for _, t := range node.Spec.Taints {
+		if t.Effect == corev1.TaintEffectNoSchedule || t.Effect == corev1.TaintEffectNoExecute {
+			reasons = append(reasons, "untolerated taint "+t.ToString())
+		}
+	}
+	return reasons
+}
+
+// nodeSelectorTermMatches returns true if the node matches all the requirements of the term.
+// An empty term matches no nodes.
+func nodeSelectorTermMatches(term corev1.NodeSelectorTerm, node *corev1.Node) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	for _, r := range term.MatchExpressions {
+		if !nodeSelectorRequirementMatches(r, node.GetLabels()) {
+			return false
+		}
+	}
+	for _, r := range term.MatchFields {
+		// metadata.name is the only field supported by Kubernetes.
+		if r.Key != "metadata.name" || !nodeSelectorRequirementMatches(r, map[string]string{r.Key: node.GetName()}) {
+			return false
+		}
+	}
+	return true
+}
+
+func nodeSelectorRequirementMatches(r corev1.NodeSelectorRequirement, values map[string]string) bool {
+	op, ok := nodeSelectorOperators[r.Operator]
+	if !ok {
+		return false
+	}
+	req, err := labels.NewRequirement(r.Key, op, r.Values)
+	if err != nil {
+		return false
+	}
+	return req.Matches(labels.Set(values))
+}
+
+func nodeSelectorString(terms []corev1.NodeSelectorTerm) string {
+	if len(terms) == 1 {
+		return nodeSelectorTermString(terms[0])
+	}
+	parts := make([]string, 0, len(terms))
+	for _, t := range terms {
+		parts = append(parts, "("+nodeSelectorTermString(t)+")")
+	}
+	return strings.Join(parts, " or ")
+}
+
+func nodeSelectorTermString(term corev1.NodeSelectorTerm) string {
+	parts := make([]string, 0, len(term.MatchExpressions)+len(term.MatchFields))
+	for _, r := range slices.Concat(term.MatchExpressions, term.MatchFields) {
+		switch r.Operator {
+		case corev1.NodeSelectorOpExists, corev1.NodeSelectorOpDoesNotExist:
+			parts = append(parts, fmt.Sprintf("%s %s", r.Key, r.Operator))
+		default:
+			parts = append(parts, fmt.Sprintf("%s %s (%s)", r.Key, r.Operator, strings.Join(r.Values, ", ")))
+		}
+	}
+	if len(parts) == 0 {
+		return "<empty term>"
+	}
+	return strings.Join(parts, " and ")
+}
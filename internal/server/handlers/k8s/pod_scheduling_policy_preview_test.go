@@ -0,0 +1,175 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestPreviewPodSchedulingPolicy(t *testing.T) {
+	t.Parallel()
+
+	const zoneKey = "topology.kubernetes.io/zone"
+	node := func(name, zone string, taints ...corev1.Taint) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{zoneKey: zone, corev1.LabelHostname: name},
+			},
+			Spec: corev1.NodeSpec{Taints: taints},
+		}
+	}
+	cache := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "apps", Labels: map[string]string{"app": "cache"}},
+		Spec:       corev1.PodSpec{NodeName: "node-2"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	cacheSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "cache"}}
+	policy := &everestv1alpha1.PodSchedulingPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "zone-b"},
+		Spec: everestv1alpha1.PodSchedulingPolicySpec{
+			EngineType: everestv1alpha1.DatabaseEnginePXC,
+			AffinityConfig: &everestv1alpha1.AffinityConfig{
+				PXC: &everestv1alpha1.PXCAffinityConfig{
+					Engine: &corev1.Affinity{
+						NodeAffinity: &corev1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+								NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+									MatchExpressions: []corev1.NodeSelectorRequirement{
+										{Key: zoneKey, Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}},
+									},
+								}},
+							},
+						},
+						PodAntiAffinity: &corev1.PodAntiAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+								{LabelSelector: cacheSelector, TopologyKey: corev1.LabelHostname},
+							},
+						},
+					},
+					Proxy: &corev1.Affinity{
+						PodAffinity: &corev1.PodAffinity{
+							PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+								Weight:          50,
+								PodAffinityTerm: corev1.PodAffinityTerm{LabelSelector: cacheSelector, TopologyKey: zoneKey},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			node("node-1", "a"),
+			node("node-2", "b"),
+			node("node-3", "b", corev1.Taint{Key: "dedicated", Value: "cache", Effect: corev1.TaintEffectNoSchedule}),
+			cache,
+			policy,
+		).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	h := New(zap.NewNop().Sugar(), k, "")
+
+	t.Run("existing policy", func(t *testing.T) {
+		t.Parallel()
+
+		preview, err := h.PreviewPodSchedulingPolicy(context.Background(), &handlers.PodSchedulingPolicyPreviewRequest{PolicyName: "zone-b"})
+		require.NoError(t, err)
+		assert.Equal(t, &api.PodSchedulingPolicyPreview{
+			EngineType: "pxc",
+			Components: []api.PodSchedulingComponentPreview{
+				{
+					Component: "engine",
+					Nodes:     []string{},
+					ExcludedNodes: []api.PodSchedulingExcludedNode{
+						{Name: "node-1", Reasons: []string{"nodeAffinity rule not satisfied: topology.kubernetes.io/zone In (b)"}},
+						{Name: "node-2", Reasons: []string{"podAntiAffinity rule not satisfied: no pods matching 'app=cache' in any namespace within the same kubernetes.io/hostname"}},
+						{Name: "node-3", Reasons: []string{"untolerated taint dedicated=cache:NoSchedule"}},
+					},
+					Rules: []api.PodSchedulingRulePreview{
+						{
+							Type:          "nodeAffinity",
+							Required:      true,
+							Description:   "topology.kubernetes.io/zone In (b)",
+							MatchingNodes: []string{"node-2"},
+							Satisfiable:   true,
+						},
+						{
+							Type:          "podAntiAffinity",
+							Required:      true,
+							Description:   "no pods matching 'app=cache' in any namespace within the same kubernetes.io/hostname",
+							MatchingNodes: []string{"node-1"},
+							Satisfiable:   true,
+						},
+					},
+				},
+				{
+					Component: "proxy",
+					Nodes:     []string{"node-1", "node-2"},
+					ExcludedNodes: []api.PodSchedulingExcludedNode{
+						{Name: "node-3", Reasons: []string{"untolerated taint dedicated=cache:NoSchedule"}},
+					},
+					Rules: []api.PodSchedulingRulePreview{
+						{
+							Type:          "podAffinity",
+							Weight:        50,
+							Description:   "pods matching 'app=cache' in any namespace within the same topology.kubernetes.io/zone",
+							MatchingNodes: []string{"node-2"},
+							Satisfiable:   true,
+						},
+					},
+				},
+			},
+		}, preview)
+	})
+
+	t.Run("new policy", func(t *testing.T) {
+		t.Parallel()
+
+		// The cache pod is in another namespace, so it's not selected.
+		psp := policy.DeepCopy()
+		psp.SetName("")
+		psp.Spec.AffinityConfig.PXC.Engine.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Values = []string{"c"}
+		preview, err := h.PreviewPodSchedulingPolicy(context.Background(), &handlers.PodSchedulingPolicyPreviewRequest{
+			Namespace: "db",
+			Policy:    psp,
+		})
+		require.NoError(t, err)
+		engine := preview.Components[0]
+		assert.Empty(t, engine.Nodes)
+		assert.False(t, engine.Rules[0].Satisfiable)
+		assert.Equal(t, []string{"node-1", "node-2"}, engine.Rules[1].MatchingNodes)
+		proxy := preview.Components[1]
+		assert.Equal(t, []string{"node-1", "node-2"}, proxy.Nodes)
+		assert.Empty(t, proxy.Rules[0].MatchingNodes)
+	})
+}
@@ -31,6 +31,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 )
 
@@ -625,6 +626,28 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				return len(list.Items) == 0
 			},
 		},
+		// namespace filter
+		{
+			name: "namespace filter",
+			objs: []ctrlclient.Object{
+				getDefaultPXCPolicy(),
+				&everestv1alpha1.PodSchedulingPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "test-pxc",
+						Annotations: map[string]string{common.PodSchedulingPolicyAllowedNamespacesAnnotation: "prod"},
+					},
+					Spec: everestv1alpha1.PodSchedulingPolicySpec{
+						EngineType: everestv1alpha1.DatabaseEnginePXC,
+					},
+				},
+			},
+			listParams: &api.ListPodSchedulingPolicyParams{
+				Namespace: pointer.To("dev"),
+			},
+			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
+				return len(list.Items) == 1 && list.Items[0].GetName() == "everest-default-mysql"
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -647,3 +670,36 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateDatabaseClusterDefaultPodSchedulingPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := func(name, defaults string) *everestv1alpha1.PodSchedulingPolicy {
+		psp := getDefaultPXCPolicy()
+		psp.SetName(name)
+		psp.SetAnnotations(map[string]string{common.PodSchedulingPolicyDefaultNamespacesAnnotation: defaults})
+		return psp
+	}
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(policy("pxc-all", common.AllNamespaces), policy("pxc-prod", "prod")).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	k8sH := New(zap.NewNop().Sugar(), k, "")
+
+	create := func(namespace, pspName string, engineType everestv1alpha1.EngineType) string {
+		db, err := k8sH.CreateDatabaseCluster(context.Background(), &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: namespace},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine:                  everestv1alpha1.Engine{Type: engineType, Version: "8.0.36"},
+				PodSchedulingPolicyName: pspName,
+			},
+		})
+		require.NoError(t, err)
+		return db.Spec.PodSchedulingPolicyName
+	}
+	assert.Equal(t, "pxc-prod", create("prod", "", everestv1alpha1.DatabaseEnginePXC))
+	assert.Equal(t, "pxc-all", create("dev", "", everestv1alpha1.DatabaseEnginePXC))
+	assert.Equal(t, "everest-default-mysql", create("test", "everest-default-mysql", everestv1alpha1.DatabaseEnginePXC))
+	assert.Empty(t, create("other", "", everestv1alpha1.DatabaseEnginePSMDB))
+}
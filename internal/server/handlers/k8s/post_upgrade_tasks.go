@@ -0,0 +1,277 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/dbupgrade"
+	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+var (
+	// ErrPostUpgradeTasksInProgress is returned when the post-upgrade tasks are executed
+	// in a namespace while the previous execution in the namespace is running.
+	ErrPostUpgradeTasksInProgress = errors.New("post-upgrade tasks are already being executed in the namespace")
+
+	// ErrPostUpgradeTasksJobNotFound is returned when no post-upgrade tasks have been executed in the namespace.
+	ErrPostUpgradeTasksJobNotFound = errors.New("no post-upgrade tasks execution found for the namespace")
+)
+
+const (
+	postUpgradeTasksJobKey = "job"
+	// postUpgradeTasksJobTimeout bounds the duration of a job, so that a job left running
+	// by a restarted replica of the Everest server does not block the namespace forever.
+	postUpgradeTasksJobTimeout = 2 * time.Hour
+)
+
+// postUpgradeTasksJobs keeps track of the latest post-upgrade tasks execution of every namespace.
+// The jobs are stored in ConfigMaps in the Everest system namespace, one per namespace,
+// so that they are shared by the replicas of the Everest server and survive their restarts.
+type postUpgradeTasksJobs struct {
+	kubeConnector kubernetes.KubernetesConnector
+	l             *zap.SugaredLogger
+	timeout       time.Duration
+}
+
+func newPostUpgradeTasksJobs(kubeConnector kubernetes.KubernetesConnector, l *zap.SugaredLogger) *postUpgradeTasksJobs {
+	return &postUpgradeTasksJobs{
+		kubeConnector: kubeConnector,
+		l:             l,
+		timeout:       postUpgradeTasksJobTimeout,
+	}
+}
+
+func postUpgradeTasksConfigMapName(namespace string) string {
+	return common.EverestPostUpgradeTasksConfigMapPrefix + namespace
+}
+
+// decode returns the job stored in the ConfigMap.
+// A job that is still running after the job timeout is reported as failed.
+func (j *postUpgradeTasksJobs) decode(cm *corev1.ConfigMap) (*api.PostUpgradeTasksJob, error) {
+	job := &api.PostUpgradeTasksJob{}
+	if err := json.Unmarshal([]byte(cm.Data[postUpgradeTasksJobKey]), job); err != nil {
+		return nil, errors.Join(err, fmt.Errorf("failed to decode post-upgrade tasks job %s", cm.GetName()))
+	}
+	if job.State == api.PostUpgradeTasksJobStateRunning &&
+		time.Since(pointer.Get(job.StartedAt)) > j.timeout {
+		for i := range job.Results {
+			switch job.Results[i].State {
+			case api.PostUpgradeTaskResultStatePending:
+				job.Results[i].State = api.PostUpgradeTaskResultStateSkipped
+			case api.PostUpgradeTaskResultStateInProgress:
+				job.Results[i].State = api.PostUpgradeTaskResultStateFailed
+				job.Results[i].Message = pointer.ToString("the job was interrupted")
+			case api.PostUpgradeTaskResultStateFailed,
+				api.PostUpgradeTaskResultStateSkipped,
+				api.PostUpgradeTaskResultStateSucceeded:
+			}
+		}
+		finishPostUpgradeTasksJob(job)
+		job.State = api.PostUpgradeTasksJobStateFailed
+	}
+	return job, nil
+}
+
+// get returns the latest job of the given namespace.
+func (j *postUpgradeTasksJobs) get(ctx context.Context, namespace string) (*api.PostUpgradeTasksJob, error) {
+	cm, err := j.kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      postUpgradeTasksConfigMapName(namespace),
+	})
+	if k8serrors.IsNotFound(err) {
+		return nil, ErrPostUpgradeTasksJobNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return j.decode(cm)
+}
+
+// start creates a new job for the given namespace with the tasks pending and the skipped results.
+// Returns ErrPostUpgradeTasksInProgress if the previous job of the namespace has not finished yet.
+func (j *postUpgradeTasksJobs) start(
+	ctx context.Context,
+	namespace string,
+	tasks []dbupgrade.Task,
+	skipped []api.PostUpgradeTaskResult,
+) (*api.PostUpgradeTasksJob, error) {
+	results := make([]api.PostUpgradeTaskResult, 0, len(tasks)+len(skipped))
+	for _, task := range tasks {
+		results = append(results, api.PostUpgradeTaskResult{
+			Task:  task.UpgradeTask,
+			State: api.PostUpgradeTaskResultStatePending,
+		})
+	}
+	results = append(results, skipped...)
+	job := &api.PostUpgradeTasksJob{
+		Namespace: namespace,
+		State:     api.PostUpgradeTasksJobStateRunning,
+		StartedAt: pointer.To(time.Now().UTC()),
+		Results:   results,
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := j.kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      postUpgradeTasksConfigMapName(namespace),
+	})
+	if k8serrors.IsNotFound(err) {
+		_, err = j.kubeConnector.CreateConfigMap(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      postUpgradeTasksConfigMapName(namespace),
+				Namespace: common.SystemNamespace,
+				Labels:    map[string]string{common.EverestPostUpgradeTasksLabel: "true"},
+			},
+			Data: map[string]string{postUpgradeTasksJobKey: string(data)},
+		})
+		return job, err
+	} else if err != nil {
+		return nil, err
+	}
+	latest, err := j.decode(cm)
+	if err != nil {
+		return nil, err
+	}
+	if latest.State == api.PostUpgradeTasksJobStateRunning {
+		return nil, ErrPostUpgradeTasksInProgress
+	}
+	// The update fails with a conflict if another replica has started a job in the meantime.
+	cm.Data = map[string]string{postUpgradeTasksJobKey: string(data)}
+	if _, err := j.kubeConnector.UpdateConfigMap(ctx, cm); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// update applies the change to the latest job of the namespace.
+func (j *postUpgradeTasksJobs) update(ctx context.Context, namespace string, change func(job *api.PostUpgradeTasksJob)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := j.kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+			Namespace: common.SystemNamespace,
+			Name:      postUpgradeTasksConfigMapName(namespace),
+		})
+		if err != nil {
+			return err
+		}
+		job, err := j.decode(cm)
+		if err != nil {
+			return err
+		}
+		change(job)
+		data, err := json.Marshal(job)
+		if err != nil {
+			return err
+		}
+		cm.Data = map[string]string{postUpgradeTasksJobKey: string(data)}
+		_, err = j.kubeConnector.UpdateConfigMap(ctx, cm)
+		return err
+	})
+}
+
+func (j *postUpgradeTasksJobs) setResult(ctx context.Context, namespace string, result api.PostUpgradeTaskResult) {
+	if err := j.update(ctx, namespace, func(job *api.PostUpgradeTasksJob) {
+		idx := slices.IndexFunc(job.Results, func(r api.PostUpgradeTaskResult) bool {
+			return pointer.Get(r.Task.Name) == pointer.Get(result.Task.Name)
+		})
+		if idx < 0 {
+			return
+		}
+		job.Results[idx] = result
+	}); err != nil {
+		j.l.Errorf("failed to update the post-upgrade tasks job of namespace '%s': %v", namespace, err)
+	}
+}
+
+// finish completes the job of the given namespace. The job fails if any of its tasks failed.
+func (j *postUpgradeTasksJobs) finish(ctx context.Context, namespace string) {
+	if err := j.update(ctx, namespace, finishPostUpgradeTasksJob); err != nil {
+		j.l.Errorf("failed to update the post-upgrade tasks job of namespace '%s': %v", namespace, err)
+	}
+}
+
+func finishPostUpgradeTasksJob(job *api.PostUpgradeTasksJob) {
+	job.State = api.PostUpgradeTasksJobStateSucceeded
+	if slices.ContainsFunc(job.Results, func(r api.PostUpgradeTaskResult) bool {
+		return r.State == api.PostUpgradeTaskResultStateFailed
+	}) {
+		job.State = api.PostUpgradeTasksJobStateFailed
+	}
+	job.FinishedAt = pointer.To(time.Now().UTC())
+}
+
+// run executes the tasks of the given namespace with the executor and finishes the job.
+func (j *postUpgradeTasksJobs) run(
+	ctx context.Context,
+	executor *dbupgrade.Executor,
+	namespace string,
+	tasks []dbupgrade.Task,
+	req *api.PostUpgradeTasksExecution,
+) {
+	// The job state is still recorded after the timeout.
+	tasksCtx, cancel := context.WithTimeout(ctx, j.timeout)
+	defer cancel()
+	waitForReady := req.WaitForReady == nil || *req.WaitForReady
+	executor.Execute(tasksCtx, namespace, tasks, pointer.Get(req.BatchSize), waitForReady,
+		func(result api.PostUpgradeTaskResult) {
+			j.setResult(ctx, namespace, result)
+		},
+	)
+	j.finish(ctx, namespace)
+}
+
+func (h *k8sHandler) ExecutePostUpgradeTasks(
+	ctx context.Context,
+	namespace string,
+	req *api.PostUpgradeTasksExecution,
+) (*api.PostUpgradeTasksJob, error) {
+	tasks, err := dbupgrade.PendingTasks(ctx, h.kubeConnector, versionservice.New(h.versionServiceURL), namespace)
+	if err != nil {
+		return nil, err
+	}
+	selected, skipped := dbupgrade.SelectTasks(tasks, req.Databases)
+	job, err := h.postUpgradeTasks.start(ctx, namespace, selected, skipped)
+	if err != nil {
+		return nil, err
+	}
+
+	// The execution outlives the request, so we must not inherit its cancellation.
+	go h.postUpgradeTasks.run(context.WithoutCancel(ctx), dbupgrade.NewExecutor(h.kubeConnector, h.dbUpdater),
+		namespace, selected, req)
+	return job, nil
+}
+
+func (h *k8sHandler) GetPostUpgradeTasksJob(ctx context.Context, namespace string) (*api.PostUpgradeTasksJob, error) {
+	return h.postUpgradeTasks.get(ctx, namespace)
+}
@@ -0,0 +1,130 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/dbupgrade"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func newTestPostUpgradeTasksJobs() *postUpgradeTasksJobs {
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).
+		WithKubernetesClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).Build())
+	return newPostUpgradeTasksJobs(k, zap.NewNop().Sugar())
+}
+
+func newTestUpgradeTask(name string) dbupgrade.Task {
+	return dbupgrade.Task{UpgradeTask: api.UpgradeTask{
+		Name:        pointer.ToString(name),
+		PendingTask: pointer.To(api.Restart),
+	}}
+}
+
+func TestPostUpgradeTasksJobs(t *testing.T) {
+	t.Parallel()
+
+	const ns = "test-ns"
+	ctx := context.Background()
+	newTask := newTestUpgradeTask
+	skipped := []api.PostUpgradeTaskResult{{
+		Task:  api.UpgradeTask{Name: pointer.ToString("db-3")},
+		State: api.PostUpgradeTaskResultStateSkipped,
+	}}
+
+	j := newTestPostUpgradeTasksJobs()
+	_, err := j.get(ctx, ns)
+	require.ErrorIs(t, err, ErrPostUpgradeTasksJobNotFound)
+
+	_, err = j.start(ctx, ns, []dbupgrade.Task{newTask("db-1"), newTask("db-2")}, skipped)
+	require.NoError(t, err)
+	_, err = j.start(ctx, ns, nil, nil)
+	require.ErrorIs(t, err, ErrPostUpgradeTasksInProgress)
+	// Jobs in other namespaces are independent.
+	_, err = j.start(ctx, "other-ns", nil, nil)
+	require.NoError(t, err)
+
+	job, err := j.get(ctx, ns)
+	require.NoError(t, err)
+	assert.Equal(t, api.PostUpgradeTasksJobStateRunning, job.State)
+	assert.Equal(t, []api.PostUpgradeTaskResultState{
+		api.PostUpgradeTaskResultStatePending,
+		api.PostUpgradeTaskResultStatePending,
+		api.PostUpgradeTaskResultStateSkipped,
+	}, resultStates(job))
+
+	j.setResult(ctx, ns, api.PostUpgradeTaskResult{Task: newTask("db-1").UpgradeTask, State: api.PostUpgradeTaskResultStateSucceeded})
+	j.setResult(ctx, ns, api.PostUpgradeTaskResult{Task: newTask("db-2").UpgradeTask, State: api.PostUpgradeTaskResultStateFailed})
+	j.finish(ctx, ns)
+
+	job, err = j.get(ctx, ns)
+	require.NoError(t, err)
+	assert.Equal(t, api.PostUpgradeTasksJobStateFailed, job.State)
+	assert.NotNil(t, job.FinishedAt)
+	assert.Equal(t, []api.PostUpgradeTaskResultState{
+		api.PostUpgradeTaskResultStateSucceeded,
+		api.PostUpgradeTaskResultStateFailed,
+		api.PostUpgradeTaskResultStateSkipped,
+	}, resultStates(job))
+
+	// A finished job can be replaced.
+	_, err = j.start(ctx, ns, nil, nil)
+	require.NoError(t, err)
+}
+
+func TestPostUpgradeTasksJobsInterrupted(t *testing.T) {
+	t.Parallel()
+
+	const ns = "test-ns"
+	ctx := context.Background()
+	j := newTestPostUpgradeTasksJobs()
+	_, err := j.start(ctx, ns, []dbupgrade.Task{newTestUpgradeTask("db-1"), newTestUpgradeTask("db-2")}, nil)
+	require.NoError(t, err)
+	j.setResult(ctx, ns, api.PostUpgradeTaskResult{
+		Task:  newTestUpgradeTask("db-1").UpgradeTask,
+		State: api.PostUpgradeTaskResultStateInProgress,
+	})
+
+	// The job is left running, e.g. by a restarted replica of the Everest server.
+	j.timeout = 0
+	job, err := j.get(ctx, ns)
+	require.NoError(t, err)
+	assert.Equal(t, api.PostUpgradeTasksJobStateFailed, job.State)
+	assert.Equal(t, []api.PostUpgradeTaskResultState{
+		api.PostUpgradeTaskResultStateFailed,
+		api.PostUpgradeTaskResultStateSkipped,
+	}, resultStates(job))
+
+	_, err = j.start(ctx, ns, nil, nil)
+	require.NoError(t, err)
+}
+
+func resultStates(job *api.PostUpgradeTasksJob) []api.PostUpgradeTaskResultState {
+	states := make([]api.PostUpgradeTaskResultState, 0, len(job.Results))
+	for _, r := range job.Results {
+		states = append(states, r.State)
+	}
+	return states
+}
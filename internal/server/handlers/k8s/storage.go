@@ -0,0 +1,99 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/AlekSi/pointer"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func (h *k8sHandler) ListStorageClasses(ctx context.Context) (api.StorageClassInfoList, error) {
+	classes, err := h.kubeConnector.ListStorageClasses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListStorageClasses: %w", err)
+	}
+	defaultClass := kubernetes.FindStorageClass(classes.Items, "")
+	result := make(api.StorageClassInfoList, 0, len(classes.Items))
+	for _, sc := range classes.Items {
+		info := api.StorageClassInfo{
+			Name:                 sc.GetName(),
+			Provisioner:          sc.Provisioner,
+			AllowVolumeExpansion: pointer.Get(sc.AllowVolumeExpansion),
+			Default:              defaultClass != nil && defaultClass.GetName() == sc.GetName(),
+		}
+		if sc.ReclaimPolicy != nil {
+			info.ReclaimPolicy = string(*sc.ReclaimPolicy)
+		}
+		if sc.VolumeBindingMode != nil {
+			info.VolumeBindingMode = string(*sc.VolumeBindingMode)
+		}
+		result = append(result, info)
+	}
+	slices.SortFunc(result, func(a, b api.StorageClassInfo) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return result, nil
+}
+
+// CheckDatabaseClusterStorageResize checks whether the storage of a database cluster can be resized.
+func (h *k8sHandler) CheckDatabaseClusterStorageResize(
+	ctx context.Context, namespace, name string, params *api.CheckDatabaseClusterStorageResizeParams,
+) (*api.StorageResizeCheck, error) {
+	size, err := resource.ParseQuantity(params.Size)
+	if err != nil {
+		return nil, err
+	}
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	current := db.Spec.Engine.Storage.Size
+	result := &api.StorageResizeCheck{
+		StorageClass:  pointer.Get(db.Spec.Engine.Storage.Class),
+		CurrentSize:   current.String(),
+		RequestedSize: size.String(),
+	}
+
+	classes, err := h.kubeConnector.ListStorageClasses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListStorageClasses: %w", err)
+	}
+	sc := kubernetes.FindStorageClass(classes.Items, result.StorageClass)
+	if sc == nil {
+		result.Reason = fmt.Sprintf("storage class '%s' not found", result.StorageClass)
+		if result.StorageClass == "" {
+			result.Reason = "the database cluster has no storage class and there is no default storage class"
+		}
+		return result, nil
+	}
+	result.StorageClass = sc.GetName()
+	result.AllowVolumeExpansion = pointer.Get(sc.AllowVolumeExpansion)
+	if err := kubernetes.CheckStorageResize(sc, current, size); err != nil {
+		result.Reason = err.Error()
+		return result, nil
+	}
+	result.Feasible = true
+	return result, nil
+}
@@ -0,0 +1,176 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func newStorageTestHandler(t *testing.T) handlers.Handler {
+	t.Helper()
+
+	reclaimPolicy := corev1.PersistentVolumeReclaimDelete
+	standard := &storagev1.StorageClass{
+		ObjectMeta:    metav1.ObjectMeta{Name: "standard", Annotations: map[string]string{annotationStorageClassDefault: "true"}},
+		Provisioner:   "csi.example.com",
+		ReclaimPolicy: &reclaimPolicy,
+	}
+	expandable := &storagev1.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: "expandable"},
+		Provisioner:          "ebs.csi.aws.com",
+		AllowVolumeExpansion: pointer.To(true),
+	}
+	db := func(name, class string) *everestv1alpha1.DatabaseCluster {
+		cluster := &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{
+					Type:    everestv1alpha1.DatabaseEnginePXC,
+					Storage: everestv1alpha1.Storage{Size: resource.MustParse("10Gi")},
+				},
+			},
+		}
+		if class != "" {
+			cluster.Spec.Engine.Storage.Class = pointer.To(class)
+		}
+		return cluster
+	}
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(standard, expandable, db("db-default", ""), db("db-expandable", "expandable"), db("db-absent", "absent")).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	return New(zap.NewNop().Sugar(), k, "")
+}
+
+func TestListStorageClasses(t *testing.T) {
+	t.Parallel()
+
+	h := newStorageTestHandler(t)
+	classes, err := h.ListStorageClasses(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, api.StorageClassInfoList{
+		{
+			Name:                 "expandable",
+			Provisioner:          "ebs.csi.aws.com",
+			AllowVolumeExpansion: true,
+		},
+		{
+			Name:          "standard",
+			Provisioner:   "csi.example.com",
+			Default:       true,
+			ReclaimPolicy: "Delete",
+		},
+	}, classes)
+}
+
+func TestCheckDatabaseClusterStorageResize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		db   string
+		size string
+		want *api.StorageResizeCheck
+	}{
+		{
+			name: "grow expandable storage class",
+			db:   "db-expandable",
+			size: "20Gi",
+			want: &api.StorageResizeCheck{
+				StorageClass:         "expandable",
+				AllowVolumeExpansion: true,
+				CurrentSize:          "10Gi",
+				RequestedSize:        "20Gi",
+				Feasible:             true,
+			},
+		},
+		{
+			name: "grow default storage class without volume expansion",
+			db:   "db-default",
+			size: "20Gi",
+			want: &api.StorageResizeCheck{
+				StorageClass:  "standard",
+				CurrentSize:   "10Gi",
+				RequestedSize: "20Gi",
+				Reason:        "storage class 'standard' does not allow volume expansion",
+			},
+		},
+		{
+			name: "shrink",
+			db:   "db-expandable",
+			size: "5Gi",
+			want: &api.StorageResizeCheck{
+				StorageClass:         "expandable",
+				AllowVolumeExpansion: true,
+				CurrentSize:          "10Gi",
+				RequestedSize:        "5Gi",
+				Reason:               "cannot shrink storage size",
+			},
+		},
+		{
+			name: "same size",
+			db:   "db-default",
+			size: "10Gi",
+			want: &api.StorageResizeCheck{
+				StorageClass:  "standard",
+				CurrentSize:   "10Gi",
+				RequestedSize: "10Gi",
+				Feasible:      true,
+			},
+		},
+		{
+			name: "absent storage class",
+			db:   "db-absent",
+			size: "20Gi",
+			want: &api.StorageResizeCheck{
+				StorageClass:  "absent",
+				CurrentSize:   "10Gi",
+				RequestedSize: "20Gi",
+				Reason:        "storage class 'absent' not found",
+			},
+		},
+	}
+
+	h := newStorageTestHandler(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := h.CheckDatabaseClusterStorageResize(context.Background(), "test-ns", tc.db,
+				&api.CheckDatabaseClusterStorageResizeParams{Size: tc.size})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	v1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	api "github.com/percona/everest/api"
+	common "github.com/percona/everest/pkg/common"
 )
 
 // MockHandler is an autogenerated mock type for the Handler type
@@ -16,6 +17,36 @@ type MockHandler struct {
 	mock.Mock
 }
 
+// ApproveFleetUpgradePlan provides a mock function with given fields: ctx, req
+func (_m *MockHandler) ApproveFleetUpgradePlan(ctx context.Context, req *api.FleetUpgradePlanApproval) (*api.FleetUpgradeRollout, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveFleetUpgradePlan")
+	}
+
+	var r0 *api.FleetUpgradeRollout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.FleetUpgradePlanApproval) (*api.FleetUpgradeRollout, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.FleetUpgradePlanApproval) *api.FleetUpgradeRollout); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.FleetUpgradeRollout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.FleetUpgradePlanApproval) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ApproveUpgradePlan provides a mock function with given fields: ctx, namespace
 func (_m *MockHandler) ApproveUpgradePlan(ctx context.Context, namespace string) error {
 	ret := _m.Called(ctx, namespace)
@@ -34,6 +65,36 @@ func (_m *MockHandler) ApproveUpgradePlan(ctx context.Context, namespace string)
 	return r0
 }
 
+// CheckDatabaseClusterStorageResize provides a mock function with given fields: ctx, namespace, name, params
+func (_m *MockHandler) CheckDatabaseClusterStorageResize(ctx context.Context, namespace string, name string, params *api.CheckDatabaseClusterStorageResizeParams) (*api.StorageResizeCheck, error) {
+	ret := _m.Called(ctx, namespace, name, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckDatabaseClusterStorageResize")
+	}
+
+	var r0 *api.StorageResizeCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.CheckDatabaseClusterStorageResizeParams) (*api.StorageResizeCheck, error)); ok {
+		return rf(ctx, namespace, name, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.CheckDatabaseClusterStorageResizeParams) *api.StorageResizeCheck); ok {
+		r0 = rf(ctx, namespace, name, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.StorageResizeCheck)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *api.CheckDatabaseClusterStorageResizeParams) error); ok {
+		r1 = rf(ctx, namespace, name, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateBackupStorage provides a mock function with given fields: ctx, namespace, req
 func (_m *MockHandler) CreateBackupStorage(ctx context.Context, namespace string, req *api.CreateBackupStorageParams) (*v1alpha1.BackupStorage, error) {
 	ret := _m.Called(ctx, namespace, req)
@@ -154,6 +215,36 @@ func (_m *MockHandler) CreateDatabaseClusterRestore(ctx context.Context, req *v1
 	return r0, r1
 }
 
+// CreateEngineConfigTemplate provides a mock function with given fields: ctx, namespace, template
+func (_m *MockHandler) CreateEngineConfigTemplate(ctx context.Context, namespace string, template *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error) {
+	ret := _m.Called(ctx, namespace, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEngineConfigTemplate")
+	}
+
+	var r0 *api.EngineConfigTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error)); ok {
+		return rf(ctx, namespace, template)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.EngineConfigTemplate) *api.EngineConfigTemplate); ok {
+		r0 = rf(ctx, namespace, template)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.EngineConfigTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.EngineConfigTemplate) error); ok {
+		r1 = rf(ctx, namespace, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateMonitoringInstance provides a mock function with given fields: ctx, namespace, req
 func (_m *MockHandler) CreateMonitoringInstance(ctx context.Context, namespace string, req *api.MonitoringInstanceCreateParams) (*v1alpha1.MonitoringConfig, error) {
 	ret := _m.Called(ctx, namespace, req)
@@ -286,12 +377,12 @@ func (_m *MockHandler) DeleteDatabaseClusterRestore(ctx context.Context, namespa
 	return r0
 }
 
-// DeleteMonitoringInstance provides a mock function with given fields: ctx, namespace, name
-func (_m *MockHandler) DeleteMonitoringInstance(ctx context.Context, namespace string, name string) error {
+// DeleteEngineConfigTemplate provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) DeleteEngineConfigTemplate(ctx context.Context, namespace string, name string) error {
 	ret := _m.Called(ctx, namespace, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteMonitoringInstance")
+		panic("no return value specified for DeleteEngineConfigTemplate")
 	}
 
 	var r0 error
@@ -304,6 +395,24 @@ func (_m *MockHandler) DeleteMonitoringInstance(ctx context.Context, namespace s
 	return r0
 }
 
+// DeleteMonitoringInstance provides a mock function with given fields: ctx, namespace, name, req
+func (_m *MockHandler) DeleteMonitoringInstance(ctx context.Context, namespace string, name string, req *api.MonitoringInstancePMM) error {
+	ret := _m.Called(ctx, namespace, name, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMonitoringInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.MonitoringInstancePMM) error); ok {
+		r0 = rf(ctx, namespace, name, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeletePodSchedulingPolicy provides a mock function with given fields: ctx, name
 func (_m *MockHandler) DeletePodSchedulingPolicy(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
@@ -322,6 +431,66 @@ func (_m *MockHandler) DeletePodSchedulingPolicy(ctx context.Context, name strin
 	return r0
 }
 
+// DeprovisionNamespace provides a mock function with given fields: ctx, namespace, params
+func (_m *MockHandler) DeprovisionNamespace(ctx context.Context, namespace string, params *api.DeprovisionNamespaceParams) (*api.NamespaceProvisioningJob, error) {
+	ret := _m.Called(ctx, namespace, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeprovisionNamespace")
+	}
+
+	var r0 *api.NamespaceProvisioningJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.DeprovisionNamespaceParams) (*api.NamespaceProvisioningJob, error)); ok {
+		return rf(ctx, namespace, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.DeprovisionNamespaceParams) *api.NamespaceProvisioningJob); ok {
+		r0 = rf(ctx, namespace, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.NamespaceProvisioningJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.DeprovisionNamespaceParams) error); ok {
+		r1 = rf(ctx, namespace, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecutePostUpgradeTasks provides a mock function with given fields: ctx, namespace, req
+func (_m *MockHandler) ExecutePostUpgradeTasks(ctx context.Context, namespace string, req *api.PostUpgradeTasksExecution) (*api.PostUpgradeTasksJob, error) {
+	ret := _m.Called(ctx, namespace, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecutePostUpgradeTasks")
+	}
+
+	var r0 *api.PostUpgradeTasksJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.PostUpgradeTasksExecution) (*api.PostUpgradeTasksJob, error)); ok {
+		return rf(ctx, namespace, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.PostUpgradeTasksExecution) *api.PostUpgradeTasksJob); ok {
+		r0 = rf(ctx, namespace, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.PostUpgradeTasksJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.PostUpgradeTasksExecution) error); ok {
+		r1 = rf(ctx, namespace, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupStorage provides a mock function with given fields: ctx, namespace, name
 func (_m *MockHandler) GetBackupStorage(ctx context.Context, namespace string, name string) (*v1alpha1.BackupStorage, error) {
 	ret := _m.Called(ctx, namespace, name)
@@ -562,29 +731,29 @@ func (_m *MockHandler) GetDatabaseEngine(ctx context.Context, namespace string,
 	return r0, r1
 }
 
-// GetKubernetesClusterInfo provides a mock function with given fields: ctx
-func (_m *MockHandler) GetKubernetesClusterInfo(ctx context.Context) (*api.KubernetesClusterInfo, error) {
-	ret := _m.Called(ctx)
+// GetEngineConfigTemplate provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) GetEngineConfigTemplate(ctx context.Context, namespace string, name string) (*api.EngineConfigTemplate, error) {
+	ret := _m.Called(ctx, namespace, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetKubernetesClusterInfo")
+		panic("no return value specified for GetEngineConfigTemplate")
 	}
 
-	var r0 *api.KubernetesClusterInfo
+	var r0 *api.EngineConfigTemplate
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*api.KubernetesClusterInfo, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*api.EngineConfigTemplate, error)); ok {
+		return rf(ctx, namespace, name)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *api.KubernetesClusterInfo); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *api.EngineConfigTemplate); ok {
+		r0 = rf(ctx, namespace, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*api.KubernetesClusterInfo)
+			r0 = ret.Get(0).(*api.EngineConfigTemplate)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -592,29 +761,29 @@ func (_m *MockHandler) GetKubernetesClusterInfo(ctx context.Context) (*api.Kuber
 	return r0, r1
 }
 
-// GetKubernetesClusterResources provides a mock function with given fields: ctx
-func (_m *MockHandler) GetKubernetesClusterResources(ctx context.Context) (*api.KubernetesClusterResources, error) {
-	ret := _m.Called(ctx)
+// GetEngineVersionPolicy provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) GetEngineVersionPolicy(ctx context.Context, namespace string) (*common.EngineVersionPolicy, error) {
+	ret := _m.Called(ctx, namespace)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetKubernetesClusterResources")
+		panic("no return value specified for GetEngineVersionPolicy")
 	}
 
-	var r0 *api.KubernetesClusterResources
+	var r0 *common.EngineVersionPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*api.KubernetesClusterResources, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*common.EngineVersionPolicy, error)); ok {
+		return rf(ctx, namespace)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *api.KubernetesClusterResources); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *common.EngineVersionPolicy); ok {
+		r0 = rf(ctx, namespace)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*api.KubernetesClusterResources)
+			r0 = ret.Get(0).(*common.EngineVersionPolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -622,29 +791,29 @@ func (_m *MockHandler) GetKubernetesClusterResources(ctx context.Context) (*api.
 	return r0, r1
 }
 
-// GetMonitoringInstance provides a mock function with given fields: ctx, namespace, name
-func (_m *MockHandler) GetMonitoringInstance(ctx context.Context, namespace string, name string) (*v1alpha1.MonitoringConfig, error) {
-	ret := _m.Called(ctx, namespace, name)
+// GetFleetUpgradePlan provides a mock function with given fields: ctx
+func (_m *MockHandler) GetFleetUpgradePlan(ctx context.Context) (*api.FleetUpgradePlan, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMonitoringInstance")
+		panic("no return value specified for GetFleetUpgradePlan")
 	}
 
-	var r0 *v1alpha1.MonitoringConfig
+	var r0 *api.FleetUpgradePlan
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.MonitoringConfig, error)); ok {
-		return rf(ctx, namespace, name)
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.FleetUpgradePlan, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.MonitoringConfig); ok {
-		r0 = rf(ctx, namespace, name)
+	if rf, ok := ret.Get(0).(func(context.Context) *api.FleetUpgradePlan); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.MonitoringConfig)
+			r0 = ret.Get(0).(*api.FleetUpgradePlan)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, namespace, name)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -652,29 +821,29 @@ func (_m *MockHandler) GetMonitoringInstance(ctx context.Context, namespace stri
 	return r0, r1
 }
 
-// GetPodSchedulingPolicy provides a mock function with given fields: ctx, name
-func (_m *MockHandler) GetPodSchedulingPolicy(ctx context.Context, name string) (*v1alpha1.PodSchedulingPolicy, error) {
-	ret := _m.Called(ctx, name)
+// GetFleetUpgradeRollout provides a mock function with given fields: ctx
+func (_m *MockHandler) GetFleetUpgradeRollout(ctx context.Context) (*api.FleetUpgradeRollout, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPodSchedulingPolicy")
+		panic("no return value specified for GetFleetUpgradeRollout")
 	}
 
-	var r0 *v1alpha1.PodSchedulingPolicy
+	var r0 *api.FleetUpgradeRollout
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.PodSchedulingPolicy, error)); ok {
-		return rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.FleetUpgradeRollout, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.PodSchedulingPolicy); ok {
-		r0 = rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context) *api.FleetUpgradeRollout); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.PodSchedulingPolicy)
+			r0 = ret.Get(0).(*api.FleetUpgradeRollout)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, name)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -682,24 +851,24 @@ func (_m *MockHandler) GetPodSchedulingPolicy(ctx context.Context, name string)
 	return r0, r1
 }
 
-// GetSettings provides a mock function with given fields: ctx
-func (_m *MockHandler) GetSettings(ctx context.Context) (*api.Settings, error) {
+// GetKubernetesClusterInfo provides a mock function with given fields: ctx
+func (_m *MockHandler) GetKubernetesClusterInfo(ctx context.Context) (*api.KubernetesClusterInfo, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSettings")
+		panic("no return value specified for GetKubernetesClusterInfo")
 	}
 
-	var r0 *api.Settings
+	var r0 *api.KubernetesClusterInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*api.Settings, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.KubernetesClusterInfo, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *api.Settings); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) *api.KubernetesClusterInfo); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*api.Settings)
+			r0 = ret.Get(0).(*api.KubernetesClusterInfo)
 		}
 	}
 
@@ -712,29 +881,29 @@ func (_m *MockHandler) GetSettings(ctx context.Context) (*api.Settings, error) {
 	return r0, r1
 }
 
-// GetUpgradePlan provides a mock function with given fields: ctx, namespace
-func (_m *MockHandler) GetUpgradePlan(ctx context.Context, namespace string) (*api.UpgradePlan, error) {
-	ret := _m.Called(ctx, namespace)
+// GetKubernetesClusterResources provides a mock function with given fields: ctx
+func (_m *MockHandler) GetKubernetesClusterResources(ctx context.Context) (*api.KubernetesClusterResources, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUpgradePlan")
+		panic("no return value specified for GetKubernetesClusterResources")
 	}
 
-	var r0 *api.UpgradePlan
+	var r0 *api.KubernetesClusterResources
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.UpgradePlan, error)); ok {
-		return rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.KubernetesClusterResources, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *api.UpgradePlan); ok {
-		r0 = rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context) *api.KubernetesClusterResources); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*api.UpgradePlan)
+			r0 = ret.Get(0).(*api.KubernetesClusterResources)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, namespace)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -742,29 +911,29 @@ func (_m *MockHandler) GetUpgradePlan(ctx context.Context, namespace string) (*a
 	return r0, r1
 }
 
-// GetUserPermissions provides a mock function with given fields: ctx
-func (_m *MockHandler) GetUserPermissions(ctx context.Context) (*api.UserPermissions, error) {
-	ret := _m.Called(ctx)
+// GetMonitoringInstance provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) GetMonitoringInstance(ctx context.Context, namespace string, name string) (*v1alpha1.MonitoringConfig, error) {
+	ret := _m.Called(ctx, namespace, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserPermissions")
+		panic("no return value specified for GetMonitoringInstance")
 	}
 
-	var r0 *api.UserPermissions
+	var r0 *v1alpha1.MonitoringConfig
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*api.UserPermissions, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.MonitoringConfig, error)); ok {
+		return rf(ctx, namespace, name)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *api.UserPermissions); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.MonitoringConfig); ok {
+		r0 = rf(ctx, namespace, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*api.UserPermissions)
+			r0 = ret.Get(0).(*v1alpha1.MonitoringConfig)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -772,24 +941,24 @@ func (_m *MockHandler) GetUserPermissions(ctx context.Context) (*api.UserPermiss
 	return r0, r1
 }
 
-// ListBackupStorages provides a mock function with given fields: ctx, namespace
-func (_m *MockHandler) ListBackupStorages(ctx context.Context, namespace string) (*v1alpha1.BackupStorageList, error) {
+// GetNamespaceProvisioningJob provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) GetNamespaceProvisioningJob(ctx context.Context, namespace string) (*api.NamespaceProvisioningJob, error) {
 	ret := _m.Called(ctx, namespace)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListBackupStorages")
+		panic("no return value specified for GetNamespaceProvisioningJob")
 	}
 
-	var r0 *v1alpha1.BackupStorageList
+	var r0 *api.NamespaceProvisioningJob
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.BackupStorageList, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.NamespaceProvisioningJob, error)); ok {
 		return rf(ctx, namespace)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.BackupStorageList); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.NamespaceProvisioningJob); ok {
 		r0 = rf(ctx, namespace)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.BackupStorageList)
+			r0 = ret.Get(0).(*api.NamespaceProvisioningJob)
 		}
 	}
 
@@ -802,12 +971,252 @@ func (_m *MockHandler) ListBackupStorages(ctx context.Context, namespace string)
 	return r0, r1
 }
 
-// ListDatabaseClusterBackups provides a mock function with given fields: ctx, namespace, clusterName
-func (_m *MockHandler) ListDatabaseClusterBackups(ctx context.Context, namespace string, clusterName string) (*v1alpha1.DatabaseClusterBackupList, error) {
-	ret := _m.Called(ctx, namespace, clusterName)
+// GetNamespaceQuotaUsage provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) GetNamespaceQuotaUsage(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error) {
+	ret := _m.Called(ctx, namespace)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListDatabaseClusterBackups")
+		panic("no return value specified for GetNamespaceQuotaUsage")
+	}
+
+	var r0 *api.NamespaceQuotaUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.NamespaceQuotaUsage, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.NamespaceQuotaUsage); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.NamespaceQuotaUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPodSchedulingPolicy provides a mock function with given fields: ctx, name
+func (_m *MockHandler) GetPodSchedulingPolicy(ctx context.Context, name string) (*v1alpha1.PodSchedulingPolicy, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPodSchedulingPolicy")
+	}
+
+	var r0 *v1alpha1.PodSchedulingPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.PodSchedulingPolicy, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.PodSchedulingPolicy); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.PodSchedulingPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostUpgradeTasksJob provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) GetPostUpgradeTasksJob(ctx context.Context, namespace string) (*api.PostUpgradeTasksJob, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostUpgradeTasksJob")
+	}
+
+	var r0 *api.PostUpgradeTasksJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.PostUpgradeTasksJob, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.PostUpgradeTasksJob); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.PostUpgradeTasksJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSettings provides a mock function with given fields: ctx
+func (_m *MockHandler) GetSettings(ctx context.Context) (*api.Settings, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSettings")
+	}
+
+	var r0 *api.Settings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.Settings, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *api.Settings); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Settings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUpgradePlan provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) GetUpgradePlan(ctx context.Context, namespace string) (*api.UpgradePlan, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpgradePlan")
+	}
+
+	var r0 *api.UpgradePlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.UpgradePlan, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.UpgradePlan); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.UpgradePlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUsageReport provides a mock function with given fields: ctx, params
+func (_m *MockHandler) GetUsageReport(ctx context.Context, params *api.GetUsageReportParams) (*api.UsageReport, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsageReport")
+	}
+
+	var r0 *api.UsageReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.GetUsageReportParams) (*api.UsageReport, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.GetUsageReportParams) *api.UsageReport); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.UsageReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.GetUsageReportParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserPermissions provides a mock function with given fields: ctx
+func (_m *MockHandler) GetUserPermissions(ctx context.Context) (*api.UserPermissions, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserPermissions")
+	}
+
+	var r0 *api.UserPermissions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.UserPermissions, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *api.UserPermissions); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.UserPermissions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackupStorages provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) ListBackupStorages(ctx context.Context, namespace string) (*v1alpha1.BackupStorageList, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackupStorages")
+	}
+
+	var r0 *v1alpha1.BackupStorageList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.BackupStorageList, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.BackupStorageList); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.BackupStorageList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDatabaseClusterBackups provides a mock function with given fields: ctx, namespace, clusterName
+func (_m *MockHandler) ListDatabaseClusterBackups(ctx context.Context, namespace string, clusterName string) (*v1alpha1.DatabaseClusterBackupList, error) {
+	ret := _m.Called(ctx, namespace, clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDatabaseClusterBackups")
 	}
 
 	var r0 *v1alpha1.DatabaseClusterBackupList
@@ -922,6 +1331,36 @@ func (_m *MockHandler) ListDatabaseEngines(ctx context.Context, namespace string
 	return r0, r1
 }
 
+// ListEngineConfigTemplates provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) ListEngineConfigTemplates(ctx context.Context, namespace string) (*api.EngineConfigTemplateList, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEngineConfigTemplates")
+	}
+
+	var r0 *api.EngineConfigTemplateList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.EngineConfigTemplateList, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.EngineConfigTemplateList); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.EngineConfigTemplateList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListMonitoringInstances provides a mock function with given fields: ctx, namespaces
 func (_m *MockHandler) ListMonitoringInstances(ctx context.Context, namespaces string) (*v1alpha1.MonitoringConfigList, error) {
 	ret := _m.Called(ctx, namespaces)
@@ -952,6 +1391,36 @@ func (_m *MockHandler) ListMonitoringInstances(ctx context.Context, namespaces s
 	return r0, r1
 }
 
+// ListNamespaceProvisioningJobs provides a mock function with given fields: ctx
+func (_m *MockHandler) ListNamespaceProvisioningJobs(ctx context.Context) (api.NamespaceProvisioningJobList, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNamespaceProvisioningJobs")
+	}
+
+	var r0 api.NamespaceProvisioningJobList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (api.NamespaceProvisioningJobList, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) api.NamespaceProvisioningJobList); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.NamespaceProvisioningJobList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListNamespaces provides a mock function with given fields: ctx
 func (_m *MockHandler) ListNamespaces(ctx context.Context) ([]string, error) {
 	ret := _m.Called(ctx)
@@ -1012,6 +1481,156 @@ func (_m *MockHandler) ListPodSchedulingPolicies(ctx context.Context, params *ap
 	return r0, r1
 }
 
+// ListStorageClasses provides a mock function with given fields: ctx
+func (_m *MockHandler) ListStorageClasses(ctx context.Context) (api.StorageClassInfoList, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStorageClasses")
+	}
+
+	var r0 api.StorageClassInfoList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (api.StorageClassInfoList, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) api.StorageClassInfoList); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.StorageClassInfoList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlanDatabaseClusterCapacity provides a mock function with given fields: ctx, namespace, req
+func (_m *MockHandler) PlanDatabaseClusterCapacity(ctx context.Context, namespace string, req *api.CapacityPlanRequest) (*api.CapacityPlan, error) {
+	ret := _m.Called(ctx, namespace, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanDatabaseClusterCapacity")
+	}
+
+	var r0 *api.CapacityPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.CapacityPlanRequest) (*api.CapacityPlan, error)); ok {
+		return rf(ctx, namespace, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.CapacityPlanRequest) *api.CapacityPlan); ok {
+		r0 = rf(ctx, namespace, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.CapacityPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.CapacityPlanRequest) error); ok {
+		r1 = rf(ctx, namespace, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewPodSchedulingPolicy provides a mock function with given fields: ctx, req
+func (_m *MockHandler) PreviewPodSchedulingPolicy(ctx context.Context, req *PodSchedulingPolicyPreviewRequest) (*api.PodSchedulingPolicyPreview, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewPodSchedulingPolicy")
+	}
+
+	var r0 *api.PodSchedulingPolicyPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *PodSchedulingPolicyPreviewRequest) (*api.PodSchedulingPolicyPreview, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *PodSchedulingPolicyPreviewRequest) *api.PodSchedulingPolicyPreview); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.PodSchedulingPolicyPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *PodSchedulingPolicyPreviewRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProvisionNamespace provides a mock function with given fields: ctx, req
+func (_m *MockHandler) ProvisionNamespace(ctx context.Context, req *api.NamespaceProvisioningRequest) (*api.NamespaceProvisioningJob, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProvisionNamespace")
+	}
+
+	var r0 *api.NamespaceProvisioningJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.NamespaceProvisioningRequest) (*api.NamespaceProvisioningJob, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.NamespaceProvisioningRequest) *api.NamespaceProvisioningJob); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.NamespaceProvisioningJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.NamespaceProvisioningRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RolloutEngineConfigTemplate provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) RolloutEngineConfigTemplate(ctx context.Context, namespace string, name string) (*api.EngineConfigTemplateRolloutResult, error) {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RolloutEngineConfigTemplate")
+	}
+
+	var r0 *api.EngineConfigTemplateRolloutResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*api.EngineConfigTemplateRolloutResult, error)); ok {
+		return rf(ctx, namespace, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *api.EngineConfigTemplateRolloutResult); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.EngineConfigTemplateRolloutResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetNext provides a mock function with given fields: h
 func (_m *MockHandler) SetNext(h Handler) {
 	_m.Called(h)
@@ -1137,24 +1756,84 @@ func (_m *MockHandler) UpdateDatabaseEngine(ctx context.Context, req *v1alpha1.D
 	return r0, r1
 }
 
+// UpdateEngineConfigTemplate provides a mock function with given fields: ctx, namespace, template
+func (_m *MockHandler) UpdateEngineConfigTemplate(ctx context.Context, namespace string, template *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error) {
+	ret := _m.Called(ctx, namespace, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEngineConfigTemplate")
+	}
+
+	var r0 *api.EngineConfigTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error)); ok {
+		return rf(ctx, namespace, template)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.EngineConfigTemplate) *api.EngineConfigTemplate); ok {
+		r0 = rf(ctx, namespace, template)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.EngineConfigTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.EngineConfigTemplate) error); ok {
+		r1 = rf(ctx, namespace, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEngineVersionPolicy provides a mock function with given fields: ctx, namespace, policy
+func (_m *MockHandler) UpdateEngineVersionPolicy(ctx context.Context, namespace string, policy *common.EngineVersionPolicy) (*common.EngineVersionPolicy, error) {
+	ret := _m.Called(ctx, namespace, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEngineVersionPolicy")
+	}
+
+	var r0 *common.EngineVersionPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *common.EngineVersionPolicy) (*common.EngineVersionPolicy, error)); ok {
+		return rf(ctx, namespace, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *common.EngineVersionPolicy) *common.EngineVersionPolicy); ok {
+		r0 = rf(ctx, namespace, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.EngineVersionPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *common.EngineVersionPolicy) error); ok {
+		r1 = rf(ctx, namespace, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMonitoringInstance provides a mock function with given fields: ctx, namespace, name, req
-func (_m *MockHandler) UpdateMonitoringInstance(ctx context.Context, namespace string, name string, req *api.MonitoringInstanceUpdateParams) (*v1alpha1.MonitoringConfig, error) {
+func (_m *MockHandler) UpdateMonitoringInstance(ctx context.Context, namespace string, name string, req *api.MonitoringInstanceUpdateParams) (*api.MonitoringInstance, error) {
 	ret := _m.Called(ctx, namespace, name, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateMonitoringInstance")
 	}
 
-	var r0 *v1alpha1.MonitoringConfig
+	var r0 *api.MonitoringInstance
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.MonitoringInstanceUpdateParams) (*v1alpha1.MonitoringConfig, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.MonitoringInstanceUpdateParams) (*api.MonitoringInstance, error)); ok {
 		return rf(ctx, namespace, name, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.MonitoringInstanceUpdateParams) *v1alpha1.MonitoringConfig); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.MonitoringInstanceUpdateParams) *api.MonitoringInstance); ok {
 		r0 = rf(ctx, namespace, name, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.MonitoringConfig)
+			r0 = ret.Get(0).(*api.MonitoringInstance)
 		}
 	}
 
@@ -1167,6 +1846,36 @@ func (_m *MockHandler) UpdateMonitoringInstance(ctx context.Context, namespace s
 	return r0, r1
 }
 
+// UpdateNamespaceOperators provides a mock function with given fields: ctx, namespace, req
+func (_m *MockHandler) UpdateNamespaceOperators(ctx context.Context, namespace string, req *api.NamespaceOperators) (*api.NamespaceProvisioningJob, error) {
+	ret := _m.Called(ctx, namespace, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNamespaceOperators")
+	}
+
+	var r0 *api.NamespaceProvisioningJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.NamespaceOperators) (*api.NamespaceProvisioningJob, error)); ok {
+		return rf(ctx, namespace, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.NamespaceOperators) *api.NamespaceProvisioningJob); ok {
+		r0 = rf(ctx, namespace, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.NamespaceProvisioningJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.NamespaceOperators) error); ok {
+		r1 = rf(ctx, namespace, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdatePodSchedulingPolicy provides a mock function with given fields: ctx, psp
 func (_m *MockHandler) UpdatePodSchedulingPolicy(ctx context.Context, psp *v1alpha1.PodSchedulingPolicy) (*v1alpha1.PodSchedulingPolicy, error) {
 	ret := _m.Called(ctx, psp)
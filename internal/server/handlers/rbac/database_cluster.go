@@ -27,6 +27,9 @@ func (h *rbacHandler) CreateDatabaseCluster(ctx context.Context, db *everestv1al
 	if err := h.enforce(ctx, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(namespace, engineName)); err != nil {
 		return nil, err
 	}
+	if err := h.enforceEngineConfigTemplateRead(ctx, db); err != nil {
+		return nil, err
+	}
 
 	schedules := db.Spec.Backup.Schedules
 	if len(schedules) > 0 {
@@ -112,6 +115,9 @@ func (h *rbacHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1al
 	if err := h.enforce(ctx, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(namespace, engineName)); err != nil {
 		return nil, err
 	}
+	if err := h.enforceEngineConfigTemplateRead(ctx, db); err != nil {
+		return nil, err
+	}
 
 	oldDB, err := h.next.GetDatabaseCluster(ctx, namespace, name)
 	if err != nil {
@@ -227,3 +233,31 @@ func (h *rbacHandler) enforceDBClusterRead(ctx context.Context, db *everestv1alp
 	}
 	return nil
 }
+
+// PlanDatabaseClusterCapacity checks whether a prospective database cluster fits into the kubernetes cluster.
+func (h *rbacHandler) PlanDatabaseClusterCapacity(ctx context.Context, namespace string, req *api.CapacityPlanRequest) (*api.CapacityPlan, error) {
+	db, _, err := req.ToCR(namespace)
+	if err != nil {
+		return nil, err
+	}
+	// Planning the capacity for a database cluster is a step of creating it.
+	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionCreate, rbac.ObjectName(namespace, db.GetName())); err != nil {
+		return nil, err
+	}
+	if name := db.Spec.PodSchedulingPolicyName; name != "" && req.PodSchedulingPolicy == nil {
+		if err := h.enforce(ctx, rbac.ResourcePodSchedulingPolicies, rbac.ActionRead, rbac.ObjectName(name)); err != nil {
+			return nil, err
+		}
+	}
+	return h.next.PlanDatabaseClusterCapacity(ctx, namespace, req)
+}
+
+// CheckDatabaseClusterStorageResize checks whether the storage of a database cluster can be resized.
+func (h *rbacHandler) CheckDatabaseClusterStorageResize(
+	ctx context.Context, namespace, name string, params *api.CheckDatabaseClusterStorageResizeParams,
+) (*api.StorageResizeCheck, error) {
+	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+		return nil, err
+	}
+	return h.next.CheckDatabaseClusterStorageResize(ctx, namespace, name, params)
+}
@@ -78,3 +78,114 @@ func (h *rbacHandler) ApproveUpgradePlan(ctx context.Context, namespace string)
 	}
 	return h.next.ApproveUpgradePlan(ctx, namespace)
 }
+
+func (h *rbacHandler) GetFleetUpgradePlan(ctx context.Context) (*api.FleetUpgradePlan, error) {
+	result, err := h.next.GetFleetUpgradePlan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetFleetUpgradePlan failed: %w", err)
+	}
+	filtered := make([]api.NamespaceUpgradePlan, 0, len(result.Namespaces))
+	for _, plan := range result.Namespaces {
+		if ok, err := h.canViewNamespaceUpgradePlan(ctx, plan); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+		filtered = append(filtered, plan)
+	}
+	result.Namespaces = filtered
+	return result, nil
+}
+
+// canViewNamespaceUpgradePlan checks that the user has access to all DatabaseClusters
+// and to all upgraded DatabaseEngines in the namespace, same as for GetUpgradePlan.
+func (h *rbacHandler) canViewNamespaceUpgradePlan(ctx context.Context, plan api.NamespaceUpgradePlan) (bool, error) {
+	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(plan.Namespace, "")); errors.Is(err, ErrInsufficientPermissions) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("enforce failed: %w", err)
+	}
+	for _, upg := range pointer.Get(plan.Upgrades) {
+		if err := h.enforce(ctx, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(plan.Namespace, *upg.Name)); errors.Is(err, ErrInsufficientPermissions) {
+			return false, nil
+		} else if err != nil {
+			return false, fmt.Errorf("enforce failed: %w", err)
+		}
+	}
+	return true, nil
+}
+
+func (h *rbacHandler) ApproveFleetUpgradePlan(ctx context.Context, req *api.FleetUpgradePlanApproval) (*api.FleetUpgradeRollout, error) {
+	plan, err := h.GetFleetUpgradePlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requested := pointer.Get(req.Namespaces)
+	namespaces := make([]string, 0, len(plan.Namespaces))
+	visible := make(map[string]api.NamespaceUpgradePlan, len(plan.Namespaces))
+	for _, nsPlan := range plan.Namespaces {
+		visible[nsPlan.Namespace] = nsPlan
+		if len(requested) == 0 && len(pointer.Get(nsPlan.Upgrades)) > 0 {
+			namespaces = append(namespaces, nsPlan.Namespace)
+		}
+	}
+	for _, ns := range requested {
+		if _, ok := visible[ns]; !ok {
+			return nil, ErrInsufficientPermissions
+		}
+		namespaces = append(namespaces, ns)
+	}
+	// Ensure we can update all the engines in the selected namespaces.
+	for _, ns := range namespaces {
+		for _, upg := range pointer.Get(visible[ns].Upgrades) {
+			if err := h.enforce(ctx, rbac.ResourceDatabaseEngines, rbac.ActionUpdate, rbac.ObjectName(ns, *upg.Name)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// Pass the namespaces explicitly, so that the rollout does not touch namespaces the user cannot see.
+	approval := *req
+	approval.Namespaces = &namespaces
+	return h.next.ApproveFleetUpgradePlan(ctx, &approval)
+}
+
+func (h *rbacHandler) GetFleetUpgradeRollout(ctx context.Context) (*api.FleetUpgradeRollout, error) {
+	result, err := h.next.GetFleetUpgradeRollout(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]api.NamespaceUpgradeProgress, 0, len(result.Namespaces))
+	for _, progress := range result.Namespaces {
+		if err := h.enforce(ctx, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(progress.Namespace, "")); errors.Is(err, ErrInsufficientPermissions) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("enforce failed: %w", err)
+		}
+		filtered = append(filtered, progress)
+	}
+	result.Namespaces = filtered
+	return result, nil
+}
+
+func (h *rbacHandler) ExecutePostUpgradeTasks(ctx context.Context, namespace string, req *api.PostUpgradeTasksExecution) (*api.PostUpgradeTasksJob, error) {
+	// Need access to all DatabaseClusters if no specific databases are requested.
+	if req.Databases == nil {
+		if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, rbac.ObjectName(namespace, "")); err != nil {
+			return nil, err
+		}
+		return h.next.ExecutePostUpgradeTasks(ctx, namespace, req)
+	}
+	for _, name := range *req.Databases {
+		if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, rbac.ObjectName(namespace, name)); err != nil {
+			return nil, err
+		}
+	}
+	return h.next.ExecutePostUpgradeTasks(ctx, namespace, req)
+}
+
+func (h *rbacHandler) GetPostUpgradeTasksJob(ctx context.Context, namespace string) (*api.PostUpgradeTasksJob, error) {
+	if err := h.enforce(ctx, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(namespace, "")); err != nil {
+		return nil, err
+	}
+	return h.next.GetPostUpgradeTasksJob(ctx, namespace)
+}
@@ -591,4 +591,93 @@ func TestRBAC_DatabaseEngines(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("GetFleetUpgradePlan", func(t *testing.T) {
+		next := func() *handlers.MockHandler {
+			h := handlers.MockHandler{}
+			h.On("GetFleetUpgradePlan", mock.Anything).Return(&api.FleetUpgradePlan{
+				Namespaces: []api.NamespaceUpgradePlan{
+					{
+						Namespace: "ns-1",
+						Upgrades: &[]api.Upgrade{
+							{Name: pointer.ToString(common.MySQLOperatorName)},
+						},
+					},
+					{
+						Namespace: "ns-2",
+						Upgrades: &[]api.Upgrade{
+							{Name: pointer.ToString(common.PostgreSQLOperatorName)},
+						},
+					},
+				},
+			}, nil)
+			return &h
+		}
+
+		testCases := []struct {
+			desc       string
+			policy     string
+			namespaces []string
+		}{
+			{
+				desc: "admin",
+				policy: newPolicy(
+					"g, bob, role:admin",
+				),
+				namespaces: []string{"ns-1", "ns-2"},
+			},
+			{
+				desc: "read all in ns-1",
+				policy: newPolicy(
+					"p, role:test, database-clusters, read, ns-1/*",
+					"p, role:test, database-engines, read, ns-1/*",
+					"g, bob, role:test",
+				),
+				namespaces: []string{"ns-1"},
+			},
+			{
+				desc: "missing read permission on database-engines",
+				policy: newPolicy(
+					"p, role:test, database-clusters, read, */*",
+					"p, role:test, database-engines, read, ns-1/*",
+					"g, bob, role:test",
+				),
+				namespaces: []string{"ns-1"},
+			},
+			{
+				desc: "missing read permission on database-clusters",
+				policy: newPolicy(
+					"p, role:test, database-engines, read, */*",
+					"g, bob, role:test",
+				),
+				namespaces: []string{},
+			},
+		}
+
+		ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+		for _, tc := range testCases {
+			t.Run(tc.desc, func(t *testing.T) {
+				t.Parallel()
+				k8sMock := newConfigMapMock(tc.policy)
+				enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+				require.NoError(t, err)
+				next := next()
+
+				h := &rbacHandler{
+					next:       next,
+					log:        zap.NewNop().Sugar(),
+					enforcer:   enf,
+					userGetter: testUserGetter,
+				}
+
+				plan, err := h.GetFleetUpgradePlan(ctx)
+				require.NoError(t, err)
+				namespaces := make([]string, 0, len(plan.Namespaces))
+				for _, p := range plan.Namespaces {
+					namespaces = append(namespaces, p.Namespace)
+				}
+				assert.Equal(t, tc.namespaces, namespaces)
+			})
+		}
+	})
 }
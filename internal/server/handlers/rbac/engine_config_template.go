@@ -0,0 +1,109 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+// ListEngineConfigTemplates lists the engine config templates the user can read.
+func (h *rbacHandler) ListEngineConfigTemplates(ctx context.Context, namespace string) (*api.EngineConfigTemplateList, error) {
+	list, err := h.next.ListEngineConfigTemplates(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	filtered := []api.EngineConfigTemplate{}
+	for _, t := range list.Items {
+		if err := h.enforce(ctx, rbac.ResourceEngineConfigTemplates, rbac.ActionRead, rbac.ObjectName(namespace, t.Name)); errors.Is(err, ErrInsufficientPermissions) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("enforce failed: %w", err)
+		}
+		filtered = append(filtered, t)
+	}
+	list.Items = filtered
+	return list, nil
+}
+
+// GetEngineConfigTemplate returns an engine config template.
+func (h *rbacHandler) GetEngineConfigTemplate(ctx context.Context, namespace, name string) (*api.EngineConfigTemplate, error) {
+	if err := h.enforce(ctx, rbac.ResourceEngineConfigTemplates, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+		return nil, err
+	}
+	return h.next.GetEngineConfigTemplate(ctx, namespace, name)
+}
+
+// CreateEngineConfigTemplate creates an engine config template.
+func (h *rbacHandler) CreateEngineConfigTemplate(ctx context.Context, namespace string, template *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error) {
+	if err := h.enforce(ctx, rbac.ResourceEngineConfigTemplates, rbac.ActionCreate, rbac.ObjectName(namespace, template.Name)); err != nil {
+		return nil, err
+	}
+	return h.next.CreateEngineConfigTemplate(ctx, namespace, template)
+}
+
+// UpdateEngineConfigTemplate updates an engine config template.
+func (h *rbacHandler) UpdateEngineConfigTemplate(ctx context.Context, namespace string, template *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error) {
+	if err := h.enforce(ctx, rbac.ResourceEngineConfigTemplates, rbac.ActionUpdate, rbac.ObjectName(namespace, template.Name)); err != nil {
+		return nil, err
+	}
+	return h.next.UpdateEngineConfigTemplate(ctx, namespace, template)
+}
+
+// DeleteEngineConfigTemplate deletes an engine config template.
+func (h *rbacHandler) DeleteEngineConfigTemplate(ctx context.Context, namespace, name string) error {
+	if err := h.enforce(ctx, rbac.ResourceEngineConfigTemplates, rbac.ActionDelete, rbac.ObjectName(namespace, name)); err != nil {
+		return err
+	}
+	return h.next.DeleteEngineConfigTemplate(ctx, namespace, name)
+}
+
+// RolloutEngineConfigTemplate applies an engine config template to the referencing database clusters.
+// The user needs to be able to update all the referencing database clusters.
+func (h *rbacHandler) RolloutEngineConfigTemplate(ctx context.Context, namespace, name string) (*api.EngineConfigTemplateRolloutResult, error) {
+	if err := h.enforce(ctx, rbac.ResourceEngineConfigTemplates, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+		return nil, err
+	}
+	databases, err := h.next.ListDatabaseClusters(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, db := range databases.Items {
+		if db.GetAnnotations()[common.EngineConfigTemplateAnnotation] != name {
+			continue
+		}
+		if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, rbac.ObjectName(namespace, db.GetName())); err != nil {
+			return nil, err
+		}
+	}
+	return h.next.RolloutEngineConfigTemplate(ctx, namespace, name)
+}
+
+// enforceEngineConfigTemplateRead checks that the user can read the engine config template
+// referenced by the database cluster, if any.
+func (h *rbacHandler) enforceEngineConfigTemplateRead(ctx context.Context, db *everestv1alpha1.DatabaseCluster) error {
+	name := db.GetAnnotations()[common.EngineConfigTemplateAnnotation]
+	if name == "" {
+		return nil
+	}
+	return h.enforce(ctx, rbac.ResourceEngineConfigTemplates, rbac.ActionRead, rbac.ObjectName(db.GetNamespace(), name))
+}
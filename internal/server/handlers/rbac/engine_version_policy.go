@@ -0,0 +1,39 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+// GetEngineVersionPolicy returns the database engine version policy of the namespace.
+func (h *rbacHandler) GetEngineVersionPolicy(ctx context.Context, namespace string) (*common.EngineVersionPolicy, error) {
+	if err := h.enforce(ctx, rbac.ResourceEngineVersionPolicies, rbac.ActionRead, rbac.ObjectName(namespace, "")); err != nil {
+		return nil, err
+	}
+	return h.next.GetEngineVersionPolicy(ctx, namespace)
+}
+
+// UpdateEngineVersionPolicy replaces the database engine version policy of the namespace.
+func (h *rbacHandler) UpdateEngineVersionPolicy(ctx context.Context, namespace string, policy *common.EngineVersionPolicy) (*common.EngineVersionPolicy, error) {
+	if err := h.enforce(ctx, rbac.ResourceEngineVersionPolicies, rbac.ActionUpdate, rbac.ObjectName(namespace, "")); err != nil {
+		return nil, err
+	}
+	return h.next.UpdateEngineVersionPolicy(ctx, namespace, policy)
+}
@@ -18,6 +18,10 @@ func (h *rbacHandler) GetKubernetesClusterInfo(ctx context.Context) (*api.Kubern
 	return h.next.GetKubernetesClusterInfo(ctx)
 }
 
+func (h *rbacHandler) ListStorageClasses(ctx context.Context) (api.StorageClassInfoList, error) {
+	return h.next.ListStorageClasses(ctx)
+}
+
 func (h *rbacHandler) GetSettings(ctx context.Context) (*api.Settings, error) {
 	return h.next.GetSettings(ctx)
 }
@@ -55,7 +55,10 @@ func TestRBAC_Kubernetes(t *testing.T) {
 					{"bob", "database-clusters", "*", "*/*"},
 					{"bob", "database-cluster-credentials", "*", "*/*"},
 					{"bob", "database-engines", "*", "*/*"},
+					{"bob", "engine-version-policies", "*", "*/*"},
+					{"bob", "engine-config-templates", "*", "*/*"},
 					{"bob", "namespaces", "*", "*"},
+					{"bob", "namespace-provisioning", "*", "*"},
 					{"bob", "backup-storages", "*", "*/*"},
 					{"bob", "pod-scheduling-policies", "*", "*"},
 				},
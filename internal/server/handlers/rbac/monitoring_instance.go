@@ -35,11 +35,11 @@ func (h *rbacHandler) CreateMonitoringInstance(ctx context.Context, namespace st
 	return h.next.CreateMonitoringInstance(ctx, namespace, req)
 }
 
-func (h *rbacHandler) DeleteMonitoringInstance(ctx context.Context, namespace, name string) error {
+func (h *rbacHandler) DeleteMonitoringInstance(ctx context.Context, namespace, name string, req *api.DeleteMonitoringInstanceJSONRequestBody) error {
 	if err := h.enforce(ctx, rbac.ResourceMonitoringInstances, rbac.ActionDelete, rbac.ObjectName(namespace, name)); err != nil {
 		return err
 	}
-	return h.next.DeleteMonitoringInstance(ctx, namespace, name)
+	return h.next.DeleteMonitoringInstance(ctx, namespace, name, req)
 }
 
 func (h *rbacHandler) GetMonitoringInstance(ctx context.Context, namespace, name string) (*everestv1alpha1.MonitoringConfig, error) {
@@ -49,7 +49,7 @@ func (h *rbacHandler) GetMonitoringInstance(ctx context.Context, namespace, name
 	return h.next.GetMonitoringInstance(ctx, namespace, name)
 }
 
-func (h *rbacHandler) UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error) {
+func (h *rbacHandler) UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*api.MonitoringInstance, error) {
 	if err := h.enforce(ctx, rbac.ResourceMonitoringInstances, rbac.ActionUpdate, rbac.ObjectName(namespace, name)); err != nil {
 		return nil, err
 	}
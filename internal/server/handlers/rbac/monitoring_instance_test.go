@@ -440,7 +440,7 @@ func TestRBAC_MonitoringInstance(t *testing.T) {
 		next := func() *handlers.MockHandler {
 			next := handlers.MockHandler{}
 			next.On("UpdateMonitoringInstance", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
-				&everestv1alpha1.MonitoringConfig{}, nil,
+				&api.MonitoringInstance{}, nil,
 			)
 			return &next
 		}
@@ -605,7 +605,7 @@ func TestRBAC_MonitoringInstance(t *testing.T) {
 		t.Parallel()
 		next := func() *handlers.MockHandler {
 			next := handlers.MockHandler{}
-			next.On("DeleteMonitoringInstance", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			next.On("DeleteMonitoringInstance", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			return &next
 		}
 
@@ -759,7 +759,7 @@ func TestRBAC_MonitoringInstance(t *testing.T) {
 					enforcer:   enf,
 					userGetter: testUserGetter,
 				}
-				err = h.DeleteMonitoringInstance(ctx, "default", "monitoring-instance-1")
+				err = h.DeleteMonitoringInstance(ctx, "default", "monitoring-instance-1", nil)
 				assert.ErrorIs(t, err, tc.wantErr)
 			})
 		}
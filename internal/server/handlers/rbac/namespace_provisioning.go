@@ -0,0 +1,71 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+// ListNamespaceProvisioningJobs returns the provisioning jobs of the namespaces the user can read.
+func (h *rbacHandler) ListNamespaceProvisioningJobs(ctx context.Context) (api.NamespaceProvisioningJobList, error) {
+	jobs, err := h.next.ListNamespaceProvisioningJobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ListNamespaceProvisioningJobs failed: %w", err)
+	}
+	result := make(api.NamespaceProvisioningJobList, 0, len(jobs))
+	for _, job := range jobs {
+		if err := h.enforce(ctx, rbac.ResourceNamespaceProvisioning, rbac.ActionRead, job.Namespace); errors.Is(err, ErrInsufficientPermissions) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("enforce error: %w", err)
+		}
+		result = append(result, job)
+	}
+	return result, nil
+}
+
+func (h *rbacHandler) GetNamespaceProvisioningJob(ctx context.Context, namespace string) (*api.NamespaceProvisioningJob, error) {
+	if err := h.enforce(ctx, rbac.ResourceNamespaceProvisioning, rbac.ActionRead, namespace); err != nil {
+		return nil, err
+	}
+	return h.next.GetNamespaceProvisioningJob(ctx, namespace)
+}
+
+func (h *rbacHandler) ProvisionNamespace(ctx context.Context, req *api.NamespaceProvisioningRequest) (*api.NamespaceProvisioningJob, error) {
+	if err := h.enforce(ctx, rbac.ResourceNamespaceProvisioning, rbac.ActionCreate, req.Namespace); err != nil {
+		return nil, err
+	}
+	return h.next.ProvisionNamespace(ctx, req)
+}
+
+func (h *rbacHandler) UpdateNamespaceOperators(ctx context.Context, namespace string, req *api.NamespaceOperators) (*api.NamespaceProvisioningJob, error) {
+	if err := h.enforce(ctx, rbac.ResourceNamespaceProvisioning, rbac.ActionUpdate, namespace); err != nil {
+		return nil, err
+	}
+	return h.next.UpdateNamespaceOperators(ctx, namespace, req)
+}
+
+func (h *rbacHandler) DeprovisionNamespace(ctx context.Context, namespace string, params *api.DeprovisionNamespaceParams) (*api.NamespaceProvisioningJob, error) {
+	if err := h.enforce(ctx, rbac.ResourceNamespaceProvisioning, rbac.ActionDelete, namespace); err != nil {
+		return nil, err
+	}
+	return h.next.DeprovisionNamespace(ctx, namespace, params)
+}
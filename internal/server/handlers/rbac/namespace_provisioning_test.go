@@ -0,0 +1,104 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestRBAC_NamespaceProvisioning(t *testing.T) {
+	t.Parallel()
+
+	next := func() *handlers.MockHandler {
+		next := handlers.MockHandler{}
+		next.On("ListNamespaceProvisioningJobs", mock.Anything).Return(
+			api.NamespaceProvisioningJobList{{Namespace: "ns-1"}, {Namespace: "ns-2"}},
+			nil,
+		)
+		next.On("ProvisionNamespace", mock.Anything, mock.Anything).Return(&api.NamespaceProvisioningJob{}, nil)
+		next.On("DeprovisionNamespace", mock.Anything, mock.Anything, mock.Anything).Return(&api.NamespaceProvisioningJob{}, nil)
+		return &next
+	}
+
+	testCases := []struct {
+		desc           string
+		policy         string
+		namespaces     []string
+		canProvision   bool
+		canDeprovision bool
+	}{
+		{
+			desc:           "admin",
+			policy:         newPolicy("g, bob, role:admin"),
+			namespaces:     []string{"ns-1", "ns-2"},
+			canProvision:   true,
+			canDeprovision: true,
+		},
+		{
+			desc: "all permissions on namespaces",
+			policy: newPolicy(
+				"p, role:test, namespaces, *, *",
+				"g, bob, role:test",
+			),
+			namespaces: []string{},
+		},
+		{
+			desc: "provision ns-1 only",
+			policy: newPolicy(
+				"p, role:test, namespace-provisioning, read, *",
+				"p, role:test, namespace-provisioning, create, ns-1",
+				"g, bob, role:test",
+			),
+			namespaces:   []string{"ns-1", "ns-2"},
+			canProvision: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			k8sMock := newConfigMapMock(tc.policy)
+			enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+			require.NoError(t, err)
+
+			h := &rbacHandler{
+				next:       next(),
+				log:        zap.NewNop().Sugar(),
+				enforcer:   enf,
+				userGetter: testUserGetter,
+			}
+
+			jobs, err := h.ListNamespaceProvisioningJobs(ctx)
+			require.NoError(t, err)
+			namespaces := []string{}
+			for _, job := range jobs {
+				namespaces = append(namespaces, job.Namespace)
+			}
+			assert.Equal(t, tc.namespaces, namespaces)
+
+			_, err = h.ProvisionNamespace(ctx, &api.NamespaceProvisioningRequest{Namespace: "ns-1"})
+			if tc.canProvision {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInsufficientPermissions)
+			}
+
+			_, err = h.DeprovisionNamespace(ctx, "ns-1", &api.DeprovisionNamespaceParams{})
+			if tc.canDeprovision {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInsufficientPermissions)
+			}
+		})
+	}
+}
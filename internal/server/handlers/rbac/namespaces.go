@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/rbac"
 )
 
@@ -24,3 +25,40 @@ func (h *rbacHandler) ListNamespaces(ctx context.Context) ([]string, error) {
 	}
 	return result, nil
 }
+
+func (h *rbacHandler) GetNamespaceQuotaUsage(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error) {
+	if err := h.enforce(ctx, rbac.ResourceNamespaces, rbac.ActionRead, namespace); err != nil {
+		return nil, err
+	}
+	return h.next.GetNamespaceQuotaUsage(ctx, namespace)
+}
+
+// GetUsageReport returns the usage of the namespaces and of the database clusters the user can read.
+func (h *rbacHandler) GetUsageReport(ctx context.Context, params *api.GetUsageReportParams) (*api.UsageReport, error) {
+	report, err := h.next.GetUsageReport(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make([]api.UsageAggregate, 0, len(report.Namespaces))
+	for _, ns := range report.Namespaces {
+		if err := h.enforce(ctx, rbac.ResourceNamespaces, rbac.ActionRead, ns.Namespace); errors.Is(err, ErrInsufficientPermissions) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("enforce error: %w", err)
+		}
+		namespaces = append(namespaces, ns)
+	}
+	clusters := make([]api.UsageAggregate, 0, len(report.DatabaseClusters))
+	for _, db := range report.DatabaseClusters {
+		err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(db.Namespace, db.Name))
+		if errors.Is(err, ErrInsufficientPermissions) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("enforce error: %w", err)
+		}
+		clusters = append(clusters, db)
+	}
+	report.Namespaces = namespaces
+	report.DatabaseClusters = clusters
+	return report, nil
+}
@@ -0,0 +1,97 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestRBAC_GetUsageReport(t *testing.T) {
+	t.Parallel()
+
+	next := func() *handlers.MockHandler {
+		next := handlers.MockHandler{}
+		next.On("GetUsageReport", mock.Anything, mock.Anything).Return(
+			&api.UsageReport{
+				Namespaces: []api.UsageAggregate{{Namespace: "ns-1"}, {Namespace: "ns-2"}},
+				DatabaseClusters: []api.UsageAggregate{
+					{Namespace: "ns-1", Name: "db-1"},
+					{Namespace: "ns-1", Name: "db-2"},
+					{Namespace: "ns-2", Name: "db-1"},
+				},
+			},
+			nil,
+		)
+		return &next
+	}
+
+	testCases := []struct {
+		desc       string
+		policy     string
+		namespaces []string
+		clusters   []string
+	}{
+		{
+			desc:       "admin",
+			policy:     newPolicy("g, bob, role:admin"),
+			namespaces: []string{"ns-1", "ns-2"},
+			clusters:   []string{"ns-1/db-1", "ns-1/db-2", "ns-2/db-1"},
+		},
+		{
+			desc: "namespace and some database clusters",
+			policy: newPolicy(
+				"p, role:test, namespaces, read, ns-1",
+				"p, role:test, database-clusters, read, ns-1/db-1",
+				"p, role:test, database-clusters, read, ns-2/*",
+				"g, bob, role:test",
+			),
+			namespaces: []string{"ns-1"},
+			clusters:   []string{"ns-1/db-1", "ns-2/db-1"},
+		},
+		{
+			desc:       "no permissions",
+			policy:     newPolicy(),
+			namespaces: []string{},
+			clusters:   []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			k8sMock := newConfigMapMock(tc.policy)
+			enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+			require.NoError(t, err)
+
+			h := &rbacHandler{
+				next:       next(),
+				log:        zap.NewNop().Sugar(),
+				enforcer:   enf,
+				userGetter: testUserGetter,
+			}
+
+			report, err := h.GetUsageReport(ctx, &api.GetUsageReportParams{})
+			require.NoError(t, err)
+			namespaces := []string{}
+			for _, ns := range report.Namespaces {
+				namespaces = append(namespaces, ns.Namespace)
+			}
+			clusters := []string{}
+			for _, db := range report.DatabaseClusters {
+				clusters = append(clusters, rbac.ObjectName(db.Namespace, db.Name))
+			}
+			assert.Equal(t, tc.namespaces, namespaces)
+			assert.Equal(t, tc.clusters, clusters)
+		})
+	}
+}
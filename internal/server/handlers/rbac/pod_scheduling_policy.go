@@ -22,6 +22,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/pkg/rbac"
 )
 
@@ -69,3 +70,16 @@ func (h *rbacHandler) GetPodSchedulingPolicy(ctx context.Context, name string) (
 	}
 	return h.next.GetPodSchedulingPolicy(ctx, name)
 }
+
+// PreviewPodSchedulingPolicy evaluates a pod scheduling policy against the current nodes.
+func (h *rbacHandler) PreviewPodSchedulingPolicy(ctx context.Context, req *handlers.PodSchedulingPolicyPreviewRequest) (*api.PodSchedulingPolicyPreview, error) {
+	// Previewing an existing policy discloses it, previewing a new one is a step of creating it.
+	action, name := rbac.ActionRead, req.PolicyName
+	if req.Policy != nil {
+		action, name = rbac.ActionCreate, req.Policy.GetName()
+	}
+	if err := h.enforce(ctx, rbac.ResourcePodSchedulingPolicies, action, rbac.ObjectName(name)); err != nil {
+		return nil, err
+	}
+	return h.next.PreviewPodSchedulingPolicy(ctx, req)
+}
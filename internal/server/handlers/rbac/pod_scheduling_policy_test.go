@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
 	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/rbac"
@@ -811,3 +812,95 @@ func TestRBAC_DeletePodSchedulingPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestRBAC_PreviewPodSchedulingPolicy(t *testing.T) {
+	t.Parallel()
+
+	data := func() *handlers.MockHandler {
+		next := handlers.MockHandler{}
+		next.On("PreviewPodSchedulingPolicy",
+			mock.Anything,
+			mock.Anything,
+		).Return(
+			&api.PodSchedulingPolicyPreview{},
+			nil,
+		)
+		return &next
+	}
+
+	existing := &handlers.PodSchedulingPolicyPreviewRequest{PolicyName: "test-policy-1"}
+	inline := &handlers.PodSchedulingPolicyPreviewRequest{
+		Policy: &everestv1alpha1.PodSchedulingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "test-policy-1"}},
+	}
+
+	type testCase struct {
+		desc    string
+		policy  string
+		req     *handlers.PodSchedulingPolicyPreviewRequest
+		wantErr error
+	}
+	testCases := []testCase{
+		{
+			desc: "admin",
+			policy: newPolicy(
+				"g, bob, role:admin",
+			),
+			req: inline,
+		},
+		{
+			desc: "read only action for existing 'test-policy-1'",
+			policy: newPolicy(
+				"p, role:test, pod-scheduling-policies, read, test-policy-1",
+				"g, bob, role:test",
+			),
+			req: existing,
+		},
+		{
+			desc: "read only action for existing some",
+			policy: newPolicy(
+				"p, role:test, pod-scheduling-policies, read, some",
+				"g, bob, role:test",
+			),
+			req:     existing,
+			wantErr: ErrInsufficientPermissions,
+		},
+		{
+			desc: "read only action for inline policy",
+			policy: newPolicy(
+				"p, role:test, pod-scheduling-policies, read, *",
+				"g, bob, role:test",
+			),
+			req:     inline,
+			wantErr: ErrInsufficientPermissions,
+		},
+		{
+			desc: "create only action for inline policy",
+			policy: newPolicy(
+				"p, role:test, pod-scheduling-policies, create, *",
+				"g, bob, role:test",
+			),
+			req: inline,
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			k8sMock := newConfigMapMock(tc.policy)
+			enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+			require.NoError(t, err)
+
+			next := data()
+
+			h := &rbacHandler{
+				next:       next,
+				log:        zap.NewNop().Sugar(),
+				enforcer:   enf,
+				userGetter: testUserGetter,
+			}
+			_, err = h.PreviewPodSchedulingPolicy(ctx, tc.req)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -7,16 +7,20 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/AlekSi/pointer"
 	goversion "github.com/hashicorp/go-version"
 	"golang.org/x/mod/semver"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/autoscaling"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/utils"
 )
 
@@ -31,7 +35,13 @@ const (
 )
 
 func (h *validateHandler) CreateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
-	if err := h.validateDatabaseClusterCR(ctx, db.GetNamespace(), db); err != nil {
+	if err := h.validateDatabaseClusterCR(ctx, db.GetNamespace(), db, nil); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	if err := h.validateStorageClass(ctx, db, nil); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	if err := h.validateNamespaceQuota(ctx, db, nil); err != nil {
 		return nil, errors.Join(ErrInvalidRequest, err)
 	}
 
@@ -55,17 +65,22 @@ func (h *validateHandler) DeleteDatabaseCluster(ctx context.Context, namespace,
 }
 
 func (h *validateHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
-	if err := h.validateDatabaseClusterCR(ctx, db.GetNamespace(), db); err != nil {
-		return nil, errors.Join(ErrInvalidRequest, err)
-	}
-
 	current, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: db.GetNamespace(), Name: db.GetName()})
 	if err != nil {
 		return nil, fmt.Errorf("failed to GetDatabaseCluster: %w", err)
 	}
+	if err := h.validateDatabaseClusterCR(ctx, db.GetNamespace(), db, current); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
 	if err := h.validateDatabaseClusterOnUpdate(db, current); err != nil {
 		return nil, errors.Join(ErrInvalidRequest, err)
 	}
+	if err := h.validateStorageClass(ctx, db, current); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	if err := h.validateNamespaceQuota(ctx, db, current); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
 	return h.next.UpdateDatabaseCluster(ctx, db)
 }
 
@@ -85,11 +100,71 @@ func (h *validateHandler) GetDatabaseClusterPitr(ctx context.Context, namespace,
 	return h.next.GetDatabaseClusterPitr(ctx, namespace, name)
 }
 
+// CheckDatabaseClusterStorageResize checks whether the storage of a database cluster can be resized.
+func (h *validateHandler) CheckDatabaseClusterStorageResize(
+	ctx context.Context, namespace, name string, params *api.CheckDatabaseClusterStorageResizeParams,
+) (*api.StorageResizeCheck, error) {
+	size, err := resource.ParseQuantity(params.Size)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidRequest, fmt.Errorf("invalid size '%s': %w", params.Size, err))
+	}
+	if size.Cmp(minStorageQuantity) == -1 {
+		return nil, errors.Join(ErrInvalidRequest, errNotEnoughDiskSize)
+	}
+	return h.next.CheckDatabaseClusterStorageResize(ctx, namespace, name, params)
+}
+
+// PlanDatabaseClusterCapacity checks whether a prospective database cluster fits into the kubernetes cluster.
+func (h *validateHandler) PlanDatabaseClusterCapacity(ctx context.Context, namespace string, req *api.CapacityPlanRequest) (*api.CapacityPlan, error) {
+	if req.DatabaseCluster.Spec == nil {
+		return nil, errors.Join(ErrInvalidRequest, errCapacityPlanDatabaseCluster)
+	}
+	db, psp, err := req.ToCR(namespace)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	if _, ok := common.OperatorTypeToName[db.Spec.Engine.Type]; !ok {
+		return nil, errors.Join(ErrInvalidRequest, errCapacityPlanEngineType)
+	}
+	if db.Spec.Engine.Replicas < 1 {
+		return nil, errors.Join(ErrInvalidRequest, errCapacityPlanReplicas)
+	}
+	if db.Spec.Sharding != nil && db.Spec.Sharding.Enabled && db.Spec.Sharding.Shards < minShardsNum {
+		return nil, errors.Join(ErrInvalidRequest, errInsufficientShardsNumber)
+	}
+	if err := validateResourceLimits(db); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	if psp != nil {
+		if psp.GetName() == "" {
+			// The policy being planned with may have no name yet.
+			psp.SetName("capacity-plan")
+		}
+		if err := h.validatePSPCR(psp); err != nil {
+			return nil, errors.Join(ErrInvalidRequest, err)
+		}
+		if psp.Spec.EngineType != db.Spec.Engine.Type {
+			return nil, errors.Join(ErrInvalidRequest, errDBClusterPSPEngineTypeMismatch(psp.GetName(), db.Spec.Engine.Type))
+		}
+	} else if err := h.validatePodSchedulingPolicy(ctx, db); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.PlanDatabaseClusterCapacity(ctx, namespace, req)
+}
+
+// validateDatabaseClusterCR validates the database cluster. The current state of the database cluster
+// is provided on updates, it is nil on creation.
+//
 //nolint:cyclop
 func (h *validateHandler) validateDatabaseClusterCR(
 	ctx context.Context,
 	namespace string,
 	databaseCluster *everestv1alpha1.DatabaseCluster,
+	current *everestv1alpha1.DatabaseCluster,
 ) error {
 	if err := validateMetadata(databaseCluster); err != nil {
 		return err
@@ -106,7 +181,20 @@ func (h *validateHandler) validateDatabaseClusterCR(
 	if err != nil {
 		return err
 	}
-	if err := validateEngine(databaseCluster, engine); err != nil {
+	rule, err := h.engineVersionRule(ctx, databaseCluster, current)
+	if err != nil {
+		return err
+	}
+	if current == nil && databaseCluster.Spec.Engine.Version == "" && rule.DefaultVersion != "" {
+		// The DB cluster is created with the default version of the engine version policy,
+		// so that version is validated without changing the request.
+		databaseCluster = databaseCluster.DeepCopy()
+		databaseCluster.Spec.Engine.Version = rule.DefaultVersion
+	}
+	if err := validateEngine(databaseCluster, engine, rule); err != nil {
+		return err
+	}
+	if err := h.validateEngineConfigTemplateRef(ctx, databaseCluster); err != nil {
 		return err
 	}
 	if databaseCluster.Spec.Proxy.Type != "" {
@@ -139,7 +227,22 @@ func (h *validateHandler) validateDatabaseClusterCR(
 	if err := validateSharding(databaseCluster); err != nil {
 		return err
 	}
+	if err := validateStorageAutoscaling(databaseCluster); err != nil {
+		return err
+	}
 
+	if current == nil && databaseCluster.Spec.PodSchedulingPolicyName == "" {
+		// The DB cluster is created with the default policy of the namespace,
+		// so that policy is validated like a requested one without changing the request.
+		pspName, err := h.defaultPodSchedulingPolicyName(ctx, databaseCluster)
+		if err != nil {
+			return err
+		}
+		if pspName != "" {
+			databaseCluster = databaseCluster.DeepCopy()
+			databaseCluster.Spec.PodSchedulingPolicyName = pspName
+		}
+	}
 	if err = h.validatePodSchedulingPolicy(ctx, databaseCluster); err != nil {
 		h.log.Errorf("failed to validate .spec.podSchedulingPolicyName='%s': %v", databaseCluster.Spec.PodSchedulingPolicyName, err)
 		return err
@@ -187,10 +290,17 @@ func validateCreateDatabaseClusterRequest(dbc *everestv1alpha1.DatabaseCluster)
 	return utils.ValidateEverestResourceName(dbc.GetName(), "metadata.name")
 }
 
-func validateEngine(databaseCluster *everestv1alpha1.DatabaseCluster, engine *everestv1alpha1.DatabaseEngine) error {
+func validateEngine(
+	databaseCluster *everestv1alpha1.DatabaseCluster,
+	engine *everestv1alpha1.DatabaseEngine,
+	rule common.EngineVersionRule,
+) error {
 	if err := validateVersion(databaseCluster.Spec.Engine.Version, engine); err != nil {
 		return err
 	}
+	if err := validateEngineVersionPolicy(databaseCluster.Spec.Engine.Version, engine, rule); err != nil {
+		return err
+	}
 
 	switch databaseCluster.Spec.Engine.Type {
 	case everestv1alpha1.DatabaseEnginePXC:
@@ -538,6 +648,51 @@ func validateStorageSize(cluster *everestv1alpha1.DatabaseCluster) error {
 	return nil
 }
 
+func validateStorageAutoscaling(cluster *everestv1alpha1.DatabaseCluster) error {
+	settings, err := autoscaling.GetSettings(cluster)
+	if err != nil || settings == nil {
+		return err
+	}
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	if settings.Maximum.Cmp(cluster.Spec.Engine.Storage.Size) == -1 {
+		return errStorageAutoscalingMaximum
+	}
+	return nil
+}
+
+// validateStorageClass checks that the storage class of a new database cluster exists.
+// On update, it checks that the storage class is not changed and that the storage can be resized.
+func (h *validateHandler) validateStorageClass(ctx context.Context, db, oldDB *everestv1alpha1.DatabaseCluster) error {
+	name := pointer.Get(db.Spec.Engine.Storage.Class)
+	if oldDB != nil {
+		if name != pointer.Get(oldDB.Spec.Engine.Storage.Class) {
+			return errStorageClassChange
+		}
+		// The storage class of an existing database cluster is only needed to resize the volumes.
+		if db.Spec.Engine.Storage.Size.Cmp(oldDB.Spec.Engine.Storage.Size) == 0 {
+			return nil
+		}
+	}
+
+	classes, err := h.kubeConnector.ListStorageClasses(ctx)
+	if err != nil {
+		return err
+	}
+	sc := kubernetes.FindStorageClass(classes.Items, name)
+	if sc == nil {
+		if name == "" {
+			return errNoDefaultStorageClass
+		}
+		return errStorageClassNotFound(name)
+	}
+	if oldDB == nil {
+		return nil
+	}
+	return kubernetes.CheckStorageResize(sc, oldDB.Spec.Engine.Storage.Size, db.Spec.Engine.Storage.Size)
+}
+
 func validateMetadata(obj metav1.Object) error {
 	if obj.GetNamespace() == "" {
 		return errEmptyNamespace
@@ -634,25 +789,8 @@ func validateShardingOnUpdate(dbc, oldDB *everestv1alpha1.DatabaseCluster) error
 }
 
 // isDatabaseClusterUpdateAllowed checks if the requested change is allowed for the database cluster.
-// The returns false in case DB cluster is in one of the following states:
-// - restoring
-// - deleting
-// - upgrading
-// - resizingVolumes
 func isDatabaseClusterUpdateAllowed(currentDB *everestv1alpha1.DatabaseCluster) bool {
-	if currentDB == nil {
-		return false
-	}
-
-	switch currentDB.Status.Status {
-	case everestv1alpha1.AppStateRestoring,
-		everestv1alpha1.AppStateDeleting,
-		everestv1alpha1.AppStateUpgrading,
-		everestv1alpha1.AppStateResizingVolumes:
-		return false
-	}
-
-	return true
+	return kubernetes.IsDatabaseClusterUpdateAllowed(currentDB)
 }
 
 var (
@@ -660,6 +798,9 @@ var (
 	errDBClusterPSPEngineTypeMismatch = func(pspName string, engineType everestv1alpha1.EngineType) error {
 		return fmt.Errorf("requested pod scheduling policy='%s' is not applicable with engineType='%s'", pspName, engineType)
 	}
+	errDBClusterPSPNamespaceNotAllowed = func(pspName, namespace string) error {
+		return fmt.Errorf("requested pod scheduling policy='%s' is not allowed in namespace='%s'", pspName, namespace)
+	}
 	// Affinity config errors
 	errDBClusterInvalidPSPAffinityConfig = func(pspName string) error {
 		return fmt.Errorf("pod scheduling policy='%s' is not applicable: affinityConfig is absent or empty", pspName)
@@ -707,6 +848,10 @@ func (h *validateHandler) validatePodSchedulingPolicy(ctx context.Context, db *e
 		return errDBClusterPSPEngineTypeMismatch(pspName, db.Spec.Engine.Type)
 	}
 
+	if !common.IsPodSchedulingPolicyAllowed(psp, db.GetNamespace()) {
+		return errDBClusterPSPNamespaceNotAllowed(pspName, db.GetNamespace())
+	}
+
 	affinityConfig := psp.Spec.AffinityConfig
 	if affinityConfig == nil {
 		return errDBClusterInvalidPSPAffinityConfig(pspName)
@@ -21,6 +21,9 @@ func (h *validateHandler) CreateDatabaseClusterBackup(ctx context.Context, req *
 	if err := h.validateDatabaseClusterBackup(ctx, req); err != nil {
 		return nil, errors.Join(ErrInvalidRequest, err)
 	}
+	if err := h.validateBackupQuota(ctx, req.GetNamespace()); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
 	return h.next.CreateDatabaseClusterBackup(ctx, req)
 }
 
@@ -2,6 +2,8 @@ package validation
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	storagev1 "k8s.io/api/storage/v1"
 	k8sError "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,7 +20,9 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
 	"github.com/percona/everest/internal/server/handlers/k8s"
+	"github.com/percona/everest/pkg/autoscaling"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/utils"
@@ -2042,6 +2047,24 @@ func TestValidatePodSchedulingPolicy(t *testing.T) {
 			},
 			wantErr: errDBClusterPSPEngineTypeMismatch("everest-default-postgresql", everestv1alpha1.DatabaseEnginePXC),
 		},
+		// namespace not allowed
+		{
+			name: "namespace not allowed",
+			objs: []ctrlclient.Object{pxcPolicyWithNamespaces("pxc-prod", "", "prod")},
+			dbCluster: &everestv1alpha1.DatabaseCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "db-policy-not-allowed",
+					Namespace: "test-ns",
+				},
+				Spec: everestv1alpha1.DatabaseClusterSpec{
+					Engine: everestv1alpha1.Engine{
+						Type: everestv1alpha1.DatabaseEnginePXC,
+					},
+					PodSchedulingPolicyName: "pxc-prod",
+				},
+			},
+			wantErr: errDBClusterPSPNamespaceNotAllowed("pxc-prod", "test-ns"),
+		},
 		{
 			name: "engineType mismatch PSMDB",
 			objs: []ctrlclient.Object{
@@ -2323,3 +2346,236 @@ func TestValidatePodSchedulingPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate_PlanDatabaseClusterCapacity(t *testing.T) {
+	t.Parallel()
+
+	db := func(replicas int32) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test-ns"},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{
+					Type:     everestv1alpha1.DatabaseEnginePXC,
+					Replicas: replicas,
+					Storage:  everestv1alpha1.Storage{Size: resource.MustParse("1Gi")},
+					Resources: everestv1alpha1.Resources{
+						CPU:    resource.MustParse("1"),
+						Memory: resource.MustParse("1Gi"),
+					},
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name    string
+		req     *api.CapacityPlanRequest
+		wantErr error
+	}{
+		{
+			name:    "no database cluster",
+			req:     &api.CapacityPlanRequest{},
+			wantErr: errors.Join(ErrInvalidRequest, errCapacityPlanDatabaseCluster),
+		},
+		{
+			name:    "no replicas",
+			req:     capacityPlanRequest(t, db(0), nil),
+			wantErr: errors.Join(ErrInvalidRequest, errCapacityPlanReplicas),
+		},
+		{
+			name: "policy engine type mismatch",
+			req: capacityPlanRequest(t, db(3), &everestv1alpha1.PodSchedulingPolicy{
+				Spec: everestv1alpha1.PodSchedulingPolicySpec{EngineType: everestv1alpha1.DatabaseEnginePSMDB},
+			}),
+			wantErr: errors.Join(ErrInvalidRequest, errDBClusterPSPEngineTypeMismatch("capacity-plan", everestv1alpha1.DatabaseEnginePXC)),
+		},
+		{
+			name: "valid",
+			req:  capacityPlanRequest(t, db(3), nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).Build()
+			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+			valHandler := New(zap.NewNop().Sugar(), k)
+			valHandler.SetNext(k8s.New(zap.NewNop().Sugar(), k, ""))
+
+			_, err := valHandler.PlanDatabaseClusterCapacity(context.Background(), "test-ns", tc.req)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// capacityPlanRequest returns the request to plan the capacity for the database cluster and the policy.
+func capacityPlanRequest(
+	t *testing.T, db *everestv1alpha1.DatabaseCluster, psp *everestv1alpha1.PodSchedulingPolicy,
+) *api.CapacityPlanRequest {
+	t.Helper()
+	data, err := json.Marshal(map[string]any{"databaseCluster": db, "podSchedulingPolicy": psp})
+	require.NoError(t, err)
+	req := &api.CapacityPlanRequest{}
+	require.NoError(t, json.Unmarshal(data, req))
+	return req
+}
+
+func TestValidateStorageClass(t *testing.T) {
+	t.Parallel()
+
+	db := func(class, size string) *everestv1alpha1.DatabaseCluster {
+		cluster := &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test-ns"},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{
+					Type:    everestv1alpha1.DatabaseEnginePXC,
+					Storage: everestv1alpha1.Storage{Size: resource.MustParse(size)},
+				},
+			},
+		}
+		if class != "" {
+			cluster.Spec.Engine.Storage.Class = pointer.To(class)
+		}
+		return cluster
+	}
+	standard := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "standard",
+			Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+		},
+		Provisioner: "csi.example.com",
+	}
+	expandable := &storagev1.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: "expandable"},
+		Provisioner:          "csi.example.com",
+		AllowVolumeExpansion: pointer.To(true),
+	}
+
+	testCases := []struct {
+		name    string
+		objs    []ctrlclient.Object
+		db      *everestv1alpha1.DatabaseCluster
+		oldDB   *everestv1alpha1.DatabaseCluster
+		wantErr error
+	}{
+		{
+			name: "create with default storage class",
+			objs: []ctrlclient.Object{standard},
+			db:   db("", "10Gi"),
+		},
+		{
+			name:    "create without default storage class",
+			objs:    []ctrlclient.Object{expandable},
+			db:      db("", "10Gi"),
+			wantErr: errNoDefaultStorageClass,
+		},
+		{
+			name:    "create with absent storage class",
+			objs:    []ctrlclient.Object{standard},
+			db:      db("fast", "10Gi"),
+			wantErr: errStorageClassNotFound("fast"),
+		},
+		{
+			name:    "update storage class",
+			objs:    []ctrlclient.Object{standard, expandable},
+			db:      db("expandable", "10Gi"),
+			oldDB:   db("standard", "10Gi"),
+			wantErr: errStorageClassChange,
+		},
+		{
+			name:  "update without resize",
+			db:    db("absent", "10Gi"),
+			oldDB: db("absent", "10Gi"),
+		},
+		{
+			name:  "grow expandable storage class",
+			objs:  []ctrlclient.Object{expandable},
+			db:    db("expandable", "20Gi"),
+			oldDB: db("expandable", "10Gi"),
+		},
+		{
+			name:    "grow storage class without volume expansion",
+			objs:    []ctrlclient.Object{standard},
+			db:      db("", "20Gi"),
+			oldDB:   db("", "10Gi"),
+			wantErr: errors.New("storage class 'standard' does not allow volume expansion"),
+		},
+		{
+			name:    "shrink",
+			objs:    []ctrlclient.Object{expandable},
+			db:      db("expandable", "5Gi"),
+			oldDB:   db("expandable", "10Gi"),
+			wantErr: errCannotShrinkStorageSize,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(tc.objs...).Build()
+			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+			valHandler := &validateHandler{log: zap.NewNop().Sugar(), kubeConnector: k}
+
+			err := valHandler.validateStorageClass(context.Background(), tc.db, tc.oldDB)
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateStorageAutoscaling(t *testing.T) {
+	t.Parallel()
+
+	db := func(settings string) *everestv1alpha1.DatabaseCluster {
+		cluster := &everestv1alpha1.DatabaseCluster{
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Storage: everestv1alpha1.Storage{Size: resource.MustParse("10Gi")}},
+			},
+		}
+		if settings != "" {
+			cluster.SetAnnotations(map[string]string{common.StorageAutoscalingAnnotation: settings})
+		}
+		return cluster
+	}
+
+	testCases := []struct {
+		name     string
+		settings string
+		wantErr  error
+	}{
+		{name: "disabled"},
+		{name: "valid", settings: `{"thresholdPercent":80,"step":"5Gi","maximum":"100Gi"}`},
+		{
+			name:     "invalid threshold",
+			settings: `{"thresholdPercent":100,"step":"5Gi","maximum":"100Gi"}`,
+			wantErr:  autoscaling.ErrInvalidThreshold,
+		},
+		{
+			name:     "no step",
+			settings: `{"thresholdPercent":80,"maximum":"100Gi"}`,
+			wantErr:  autoscaling.ErrInvalidStep,
+		},
+		{
+			name:     "maximum below size",
+			settings: `{"thresholdPercent":80,"step":"5Gi","maximum":"5Gi"}`,
+			wantErr:  errStorageAutoscalingMaximum,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateStorageAutoscaling(db(tc.settings))
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
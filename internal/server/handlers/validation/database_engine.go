@@ -30,3 +30,69 @@ func (h *validateHandler) GetUpgradePlan(ctx context.Context, namespace string)
 func (h *validateHandler) ApproveUpgradePlan(ctx context.Context, namespace string) error {
 	return h.next.ApproveUpgradePlan(ctx, namespace)
 }
+
+func (h *validateHandler) GetFleetUpgradePlan(ctx context.Context) (*api.FleetUpgradePlan, error) {
+	return h.next.GetFleetUpgradePlan(ctx)
+}
+
+func (h *validateHandler) ApproveFleetUpgradePlan(ctx context.Context, req *api.FleetUpgradePlanApproval) (*api.FleetUpgradeRollout, error) {
+	if err := validateFleetUpgradePlanApproval(req); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.ApproveFleetUpgradePlan(ctx, req)
+}
+
+func (h *validateHandler) GetFleetUpgradeRollout(ctx context.Context) (*api.FleetUpgradeRollout, error) {
+	return h.next.GetFleetUpgradeRollout(ctx)
+}
+
+func validateFleetUpgradePlanApproval(req *api.FleetUpgradePlanApproval) error {
+	if req.MaxConcurrency != nil && *req.MaxConcurrency < 1 {
+		return errInvalidMaxConcurrency
+	}
+	if req.Namespaces == nil {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(*req.Namespaces))
+	for _, ns := range *req.Namespaces {
+		if ns == "" {
+			return errEmptyNamespace
+		}
+		if _, ok := seen[ns]; ok {
+			return errDuplicatedNamespace
+		}
+		seen[ns] = struct{}{}
+	}
+	return nil
+}
+
+func (h *validateHandler) ExecutePostUpgradeTasks(ctx context.Context, namespace string, req *api.PostUpgradeTasksExecution) (*api.PostUpgradeTasksJob, error) {
+	if err := validatePostUpgradeTasksExecution(req); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.ExecutePostUpgradeTasks(ctx, namespace, req)
+}
+
+func (h *validateHandler) GetPostUpgradeTasksJob(ctx context.Context, namespace string) (*api.PostUpgradeTasksJob, error) {
+	return h.next.GetPostUpgradeTasksJob(ctx, namespace)
+}
+
+func validatePostUpgradeTasksExecution(req *api.PostUpgradeTasksExecution) error {
+	if req.BatchSize != nil && *req.BatchSize < 1 {
+		return errInvalidBatchSize
+	}
+	if req.Databases == nil {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(*req.Databases))
+	for _, name := range *req.Databases {
+		if name == "" {
+			return errEmptyName
+		}
+		if _, ok := seen[name]; ok {
+			return errDuplicatedDatabaseCluster
+		}
+		seen[name] = struct{}{}
+	}
+	return nil
+}
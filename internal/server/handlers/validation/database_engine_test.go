@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
 )
 
 func TestValidateVersion(t *testing.T) {
@@ -162,3 +163,141 @@ func TestValidateDBEngineUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEngineVersionPolicySpec(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		policy *common.EngineVersionPolicy
+		err    error
+	}{
+		{
+			name:   "empty policy",
+			policy: &common.EngineVersionPolicy{},
+			err:    nil,
+		},
+		{
+			name: "valid policy",
+			policy: &common.EngineVersionPolicy{Engines: map[everestv1alpha1.EngineType]common.EngineVersionRule{
+				everestv1alpha1.DatabaseEnginePXC: {
+					Mode:            common.EngineVersionPolicyModePinned,
+					AllowedVersions: []string{"8.0.35", "8.0.36"},
+					DefaultVersion:  "8.0.36",
+				},
+				everestv1alpha1.DatabaseEnginePSMDB: {Mode: common.EngineVersionPolicyModeRecommended},
+			}},
+			err: nil,
+		},
+		{
+			name: "unsupported engine",
+			policy: &common.EngineVersionPolicy{Engines: map[everestv1alpha1.EngineType]common.EngineVersionRule{
+				"mysql": {Mode: common.EngineVersionPolicyModeAll},
+			}},
+			err: errors.New("unsupported database engine 'mysql'"),
+		},
+		{
+			name: "unsupported mode",
+			policy: &common.EngineVersionPolicy{Engines: map[everestv1alpha1.EngineType]common.EngineVersionRule{
+				everestv1alpha1.DatabaseEnginePXC: {Mode: "latest"},
+			}},
+			err: errors.New("unsupported engine version policy mode 'latest'"),
+		},
+		{
+			name: "pinned without versions",
+			policy: &common.EngineVersionPolicy{Engines: map[everestv1alpha1.EngineType]common.EngineVersionRule{
+				everestv1alpha1.DatabaseEnginePXC: {Mode: common.EngineVersionPolicyModePinned},
+			}},
+			err: errNoAllowedVersions,
+		},
+		{
+			name: "pinned default is not allowed",
+			policy: &common.EngineVersionPolicy{Engines: map[everestv1alpha1.EngineType]common.EngineVersionRule{
+				everestv1alpha1.DatabaseEnginePXC: {
+					Mode:            common.EngineVersionPolicyModePinned,
+					AllowedVersions: []string{"8.0.35"},
+					DefaultVersion:  "8.0.36",
+				},
+			}},
+			err: errDefaultVersionNotAllowed,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateEngineVersionPolicySpec(tc.policy)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.err.Error())
+		})
+	}
+}
+
+func TestValidateEngineVersionPolicy(t *testing.T) {
+	t.Parallel()
+	engine := &everestv1alpha1.DatabaseEngine{
+		Spec: everestv1alpha1.DatabaseEngineSpec{Type: everestv1alpha1.DatabaseEnginePXC},
+		Status: everestv1alpha1.DatabaseEngineStatus{
+			AvailableVersions: everestv1alpha1.Versions{
+				Engine: everestv1alpha1.ComponentsMap{
+					"8.0.35": &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentAvailable},
+					"8.0.36": &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentRecommended},
+				},
+			},
+		},
+	}
+	pinned := common.EngineVersionRule{
+		Mode:            common.EngineVersionPolicyModePinned,
+		AllowedVersions: []string{"8.0.35"},
+	}
+	cases := []struct {
+		name    string
+		version string
+		rule    common.EngineVersionRule
+		err     error
+	}{
+		{
+			name:    "no policy",
+			version: "8.0.35",
+		},
+		{
+			name:    "recommended version",
+			version: "8.0.36",
+			rule:    common.EngineVersionRule{Mode: common.EngineVersionPolicyModeRecommended},
+		},
+		{
+			name:    "not recommended version",
+			version: "8.0.35",
+			rule:    common.EngineVersionRule{Mode: common.EngineVersionPolicyModeRecommended},
+			err:     errEngineVersionNotAllowed,
+		},
+		{
+			name:    "pinned version",
+			version: "8.0.35",
+			rule:    pinned,
+		},
+		{
+			name:    "not pinned version",
+			version: "8.0.36",
+			rule:    pinned,
+			err:     errEngineVersionNotAllowed,
+		},
+		{
+			name: "pinned without version",
+			rule: pinned,
+			err:  errEngineVersionRequired,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateEngineVersionPolicy(tc.version, engine, tc.rule)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/engineconfig"
+	"github.com/percona/everest/pkg/utils"
+)
+
+var errDeleteInUseEngineConfigTemplate = func(namespace, name string) error {
+	return fmt.Errorf("engine config template='%s' in namespace='%s' is used and cannot be deleted", name, namespace)
+}
+
+func (h *validateHandler) ListEngineConfigTemplates(ctx context.Context, namespace string) (*api.EngineConfigTemplateList, error) {
+	return h.next.ListEngineConfigTemplates(ctx, namespace)
+}
+
+func (h *validateHandler) GetEngineConfigTemplate(ctx context.Context, namespace, name string) (*api.EngineConfigTemplate, error) {
+	return h.next.GetEngineConfigTemplate(ctx, namespace, name)
+}
+
+func (h *validateHandler) CreateEngineConfigTemplate(ctx context.Context, namespace string, template *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error) {
+	if err := validateEngineConfigTemplate(template); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.CreateEngineConfigTemplate(ctx, namespace, template)
+}
+
+func (h *validateHandler) UpdateEngineConfigTemplate(ctx context.Context, namespace string, template *api.EngineConfigTemplate) (*api.EngineConfigTemplate, error) {
+	if err := validateEngineConfigTemplate(template); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	current, err := h.kubeConnector.GetEngineConfigTemplate(ctx, namespace, template.Name)
+	if err != nil {
+		return nil, err
+	}
+	if current.EngineType != everestv1alpha1.EngineType(template.EngineType) {
+		return nil, errors.Join(ErrInvalidRequest, errTemplateEngineTypeChange)
+	}
+	return h.next.UpdateEngineConfigTemplate(ctx, namespace, template)
+}
+
+func (h *validateHandler) DeleteEngineConfigTemplate(ctx context.Context, namespace, name string) error {
+	databases, err := h.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	for _, db := range databases.Items {
+		if db.GetAnnotations()[common.EngineConfigTemplateAnnotation] == name {
+			return errors.Join(ErrInvalidRequest, errDeleteInUseEngineConfigTemplate(namespace, name))
+		}
+	}
+	return h.next.DeleteEngineConfigTemplate(ctx, namespace, name)
+}
+
+func (h *validateHandler) RolloutEngineConfigTemplate(ctx context.Context, namespace, name string) (*api.EngineConfigTemplateRolloutResult, error) {
+	return h.next.RolloutEngineConfigTemplate(ctx, namespace, name)
+}
+
+func validateEngineConfigTemplate(template *api.EngineConfigTemplate) error {
+	if err := utils.ValidateEverestResourceName(template.Name, "name"); err != nil {
+		return err
+	}
+	engineType := everestv1alpha1.EngineType(template.EngineType)
+	if _, ok := common.OperatorTypeToName[engineType]; !ok {
+		return fmt.Errorf("unsupported database engine '%s'", engineType)
+	}
+	if err := engineconfig.Validate(engineType, template.Config); err != nil {
+		return errors.Join(errInvalidEngineConfig, err)
+	}
+	return nil
+}
+
+// validateEngineConfigTemplateRef checks that the engine config template referenced
+// by the database cluster exists and can be used with its database engine.
+func (h *validateHandler) validateEngineConfigTemplateRef(ctx context.Context, db *everestv1alpha1.DatabaseCluster) error {
+	name := db.GetAnnotations()[common.EngineConfigTemplateAnnotation]
+	if name == "" {
+		return nil
+	}
+	template, err := h.kubeConnector.GetEngineConfigTemplate(ctx, db.GetNamespace(), name)
+	if k8serrors.IsNotFound(err) {
+		return fmt.Errorf("engine config template '%s' does not exist", name)
+	} else if err != nil {
+		return err
+	}
+	if template.EngineType != db.Spec.Engine.Type {
+		return fmt.Errorf("engine config template '%s' cannot be used with %s", name, db.Spec.Engine.Type)
+	}
+	return nil
+}
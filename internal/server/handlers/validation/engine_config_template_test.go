@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/utils"
+)
+
+func TestValidateEngineConfigTemplate(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		template *api.EngineConfigTemplate
+		err      error
+	}{
+		{
+			name: "valid template",
+			template: &api.EngineConfigTemplate{
+				Name:       "tuned-mysql",
+				EngineType: string(everestv1alpha1.DatabaseEnginePXC),
+				Config:     "[mysqld]\nmax_connections = 250\n",
+			},
+		},
+		{
+			name: "invalid name",
+			template: &api.EngineConfigTemplate{
+				Name:       "Tuned_MySQL",
+				EngineType: string(everestv1alpha1.DatabaseEnginePXC),
+			},
+			err: utils.ErrNameNotRFC1035Compatible("name"),
+		},
+		{
+			name: "unsupported engine",
+			template: &api.EngineConfigTemplate{
+				Name:       "tuned",
+				EngineType: "mysql",
+			},
+			err: errors.New("unsupported database engine 'mysql'"),
+		},
+		{
+			name: "invalid config",
+			template: &api.EngineConfigTemplate{
+				Name:       "tuned",
+				EngineType: string(everestv1alpha1.DatabaseEnginePSMDB),
+				Config:     "- a\n- b\n",
+			},
+			err: errInvalidEngineConfig,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateEngineConfigTemplate(tc.template)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.err.Error())
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+)
+
+func (h *validateHandler) GetEngineVersionPolicy(ctx context.Context, namespace string) (*common.EngineVersionPolicy, error) {
+	return h.next.GetEngineVersionPolicy(ctx, namespace)
+}
+
+func (h *validateHandler) UpdateEngineVersionPolicy(ctx context.Context, namespace string, policy *common.EngineVersionPolicy) (*common.EngineVersionPolicy, error) {
+	if err := validateEngineVersionPolicySpec(policy); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.UpdateEngineVersionPolicy(ctx, namespace, policy)
+}
+
+func validateEngineVersionPolicySpec(policy *common.EngineVersionPolicy) error {
+	for engineType, rule := range policy.Engines {
+		if _, ok := common.OperatorTypeToName[engineType]; !ok {
+			return fmt.Errorf("unsupported database engine '%s'", engineType)
+		}
+		switch rule.Mode {
+		case "", common.EngineVersionPolicyModeAll, common.EngineVersionPolicyModeRecommended:
+		case common.EngineVersionPolicyModePinned:
+			if len(rule.AllowedVersions) == 0 {
+				return fmt.Errorf("%w for %s", errNoAllowedVersions, engineType)
+			}
+			if rule.DefaultVersion != "" && !slices.Contains(rule.AllowedVersions, rule.DefaultVersion) {
+				return fmt.Errorf("%w for %s", errDefaultVersionNotAllowed, engineType)
+			}
+		default:
+			return fmt.Errorf("unsupported engine version policy mode '%s'", rule.Mode)
+		}
+	}
+	return nil
+}
+
+// engineVersionRule returns the engine version policy rule the database cluster is validated against.
+// Only version changes are checked against the policy, so that existing clusters
+// can still be updated after the policy changes.
+func (h *validateHandler) engineVersionRule(
+	ctx context.Context,
+	db, oldDB *everestv1alpha1.DatabaseCluster,
+) (common.EngineVersionRule, error) {
+	if oldDB != nil && (db.Spec.Engine.Version == "" || db.Spec.Engine.Version == oldDB.Spec.Engine.Version) {
+		return common.EngineVersionRule{}, nil
+	}
+	policy, err := h.kubeConnector.GetEngineVersionPolicy(ctx, db.GetNamespace())
+	if err != nil {
+		return common.EngineVersionRule{}, fmt.Errorf("failed to get engine version policy: %w", err)
+	}
+	return policy.Rule(db.Spec.Engine.Type), nil
+}
+
+// validateEngineVersionPolicy checks that the engine version is allowed by the engine version policy rule.
+func validateEngineVersionPolicy(version string, engine *everestv1alpha1.DatabaseEngine, rule common.EngineVersionRule) error {
+	if !rule.IsRestricted() {
+		return nil
+	}
+	if version == "" {
+		if rule.Mode == common.EngineVersionPolicyModePinned {
+			return errEngineVersionRequired
+		}
+		// The operator picks the recommended version.
+		return nil
+	}
+	if !rule.IsAllowed(version, engine) {
+		return fmt.Errorf("%w: using %s version for %s", errEngineVersionNotAllowed, version, engine.Spec.Type)
+	}
+	return nil
+}
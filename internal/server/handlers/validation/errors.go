@@ -7,6 +7,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/kubernetes"
 )
 
 var (
@@ -17,7 +18,7 @@ var (
 	minCPUQuantity     = resource.MustParse("600m") //nolint:gochecknoglobals
 	minMemQuantity     = resource.MustParse("512M") //nolint:gochecknoglobals
 
-	errCannotShrinkStorageSize       = errors.New("cannot shrink storage size")
+	errCannotShrinkStorageSize       = kubernetes.ErrStorageShrink
 	errNotEnoughMemory               = fmt.Errorf("memory limits should be above %s", minMemQuantity.String())
 	errNotEnoughCPU                  = fmt.Errorf("CPU limits should be above %s", minCPUQuantity.String())
 	errNotEnoughDiskSize             = fmt.Errorf("storage size should be above %s", minStorageQuantity.String())
@@ -60,8 +61,30 @@ var (
 	errMinPXCProxyReplicas           = errors.New("min replicas number for Proxy is 2")
 	errEmptyName                     = errors.New("name cannot be empty")
 	errEmptyNamespace                = errors.New("namespace cannot be empty")
+	errInvalidMaxConcurrency         = errors.New("'maxConcurrency' should be greater than 0")
+	errDuplicatedNamespace           = errors.New("duplicated namespaces are not allowed")
+	errInvalidBatchSize              = errors.New("'batchSize' should be greater than 0")
+	errDuplicatedDatabaseCluster     = errors.New("duplicated database clusters are not allowed")
+	errNoAllowedVersions             = errors.New("'allowedVersions' cannot be empty in pinned mode")
+	errDefaultVersionNotAllowed      = errors.New("'defaultVersion' should be one of 'allowedVersions'")
+	errEngineVersionRequired         = errors.New("engine version should be specified when the engine version policy has no default version")
+	errEngineVersionNotAllowed       = errors.New("engine version is not allowed by the engine version policy")
+	errInvalidEngineConfig           = errors.New("invalid engine config")
+	errNamespaceQuotaExceeded        = errors.New("namespace quota exceeded")
+	errTemplateEngineTypeChange      = errors.New("'engineType' of an engine config template cannot be changed")
+	errCapacityPlanDatabaseCluster   = errors.New("'databaseCluster' is required")
+	errCapacityPlanEngineType        = errors.New("unsupported .spec.engine.type")
+	errCapacityPlanReplicas          = errors.New(".spec.engine.replicas should be greater than 0")
+	errUsageReportRange              = errors.New("'from' should be before 'to'")
+	errStorageClassChange            = errors.New("the storage class of a database cluster cannot be changed")
+	errStorageAutoscalingMaximum     = errors.New("storage autoscaling 'maximum' should not be below '.spec.engine.storage.size'")
+	errNoDefaultStorageClass         = errors.New("'.spec.engine.storage.class' is required since the cluster has no default storage class")
 )
 
+func errStorageClassNotFound(name string) error {
+	return fmt.Errorf("storage class '%s' not found", name)
+}
+
 // ErrUpdateStorageNotSupported appears when trying to update a storage of a type that is not supported.
 func ErrUpdateStorageNotSupported(storageType string) error {
 	return fmt.Errorf("updating storage is not implemented for '%s'", storageType)
@@ -15,6 +15,10 @@ func (h *validateHandler) GetKubernetesClusterInfo(ctx context.Context) (*api.Ku
 	return h.next.GetKubernetesClusterInfo(ctx)
 }
 
+func (h *validateHandler) ListStorageClasses(ctx context.Context) (api.StorageClassInfoList, error) {
+	return h.next.ListStorageClasses(ctx)
+}
+
 func (h *validateHandler) GetUserPermissions(ctx context.Context) (*api.UserPermissions, error) {
 	return h.next.GetUserPermissions(ctx)
 }
@@ -44,7 +44,7 @@ func (h *validateHandler) CreateMonitoringInstance(ctx context.Context, namespac
 	return h.next.CreateMonitoringInstance(ctx, namespace, req)
 }
 
-func (h *validateHandler) DeleteMonitoringInstance(ctx context.Context, namespace, name string) error {
+func (h *validateHandler) DeleteMonitoringInstance(ctx context.Context, namespace, name string, req *api.DeleteMonitoringInstanceJSONRequestBody) error {
 	var mc *metav1.PartialObjectMetadata
 	var err error
 	if mc, err = h.kubeConnector.GetMonitoringConfigMeta(ctx, types.NamespacedName{Namespace: namespace, Name: name}); err != nil {
@@ -55,14 +55,14 @@ func (h *validateHandler) DeleteMonitoringInstance(ctx context.Context, namespac
 		// monitoringConfig is used by some DB cluster
 		return errors.Join(ErrInvalidRequest, errDeleteInUseMonitoringConfig(namespace, name))
 	}
-	return h.next.DeleteMonitoringInstance(ctx, namespace, name)
+	return h.next.DeleteMonitoringInstance(ctx, namespace, name, req)
 }
 
 func (h *validateHandler) GetMonitoringInstance(ctx context.Context, namespace, name string) (*everestv1alpha1.MonitoringConfig, error) {
 	return h.next.GetMonitoringInstance(ctx, namespace, name)
 }
 
-func (h *validateHandler) UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error) {
+func (h *validateHandler) UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*api.MonitoringInstance, error) {
 	if req.Url != "" {
 		if ok := utils.ValidateURL(req.Url); !ok {
 			err := ErrInvalidURL("url")
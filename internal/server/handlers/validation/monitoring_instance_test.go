@@ -132,7 +132,7 @@ func TestValidate_DeleteMonitoringInstance(t *testing.T) {
 			valHandler := New(zap.NewNop().Sugar(), k)
 			valHandler.SetNext(k8sHandler)
 
-			err := valHandler.DeleteMonitoringInstance(context.Background(), mcNamespace, tc.objNameToDelete)
+			err := valHandler.DeleteMonitoringInstance(context.Background(), mcNamespace, tc.objNameToDelete, nil)
 			if tc.wantErr != nil {
 				assert.Equal(t, tc.wantErr.Error(), err.Error())
 				return
@@ -0,0 +1,69 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlekSi/pointer"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/namespaces"
+)
+
+func (h *validateHandler) ListNamespaceProvisioningJobs(ctx context.Context) (api.NamespaceProvisioningJobList, error) {
+	return h.next.ListNamespaceProvisioningJobs(ctx)
+}
+
+func (h *validateHandler) GetNamespaceProvisioningJob(ctx context.Context, namespace string) (*api.NamespaceProvisioningJob, error) {
+	return h.next.GetNamespaceProvisioningJob(ctx, namespace)
+}
+
+func (h *validateHandler) ProvisionNamespace(ctx context.Context, req *api.NamespaceProvisioningRequest) (*api.NamespaceProvisioningJob, error) {
+	if err := validateProvisionedNamespace(req.Namespace, &req.Operators); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.ProvisionNamespace(ctx, req)
+}
+
+func (h *validateHandler) UpdateNamespaceOperators(ctx context.Context, namespace string, req *api.NamespaceOperators) (*api.NamespaceProvisioningJob, error) {
+	if err := validateProvisionedNamespace(namespace, req); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.UpdateNamespaceOperators(ctx, namespace, req)
+}
+
+func (h *validateHandler) DeprovisionNamespace(ctx context.Context, namespace string, params *api.DeprovisionNamespaceParams) (*api.NamespaceProvisioningJob, error) {
+	if err := namespaces.ValidateNamespaceNames([]string{namespace}); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.DeprovisionNamespace(ctx, namespace, params)
+}
+
+// validateProvisionedNamespace validates the name of the namespace and the operators to install in it.
+func validateProvisionedNamespace(namespace string, operators *api.NamespaceOperators) error {
+	if namespace == "" {
+		return errEmptyNamespace
+	}
+	if err := namespaces.ValidateNamespaceNames([]string{namespace}); err != nil {
+		return err
+	}
+	if !pointer.Get(operators.Mysql) && !pointer.Get(operators.Mongodb) && !pointer.Get(operators.Postgresql) {
+		return namespaces.ErrOperatorsNotSelected
+	}
+	return nil
+}
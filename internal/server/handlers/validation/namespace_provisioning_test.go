@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/namespaces"
+)
+
+func TestValidateProvisionedNamespace(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		namespace string
+		operators api.NamespaceOperators
+		wantErr   bool
+	}{
+		{
+			name:      "valid",
+			namespace: "everest-db",
+			operators: api.NamespaceOperators{Mysql: pointer.ToBool(true)},
+		},
+		{
+			name:      "empty namespace",
+			operators: api.NamespaceOperators{Mysql: pointer.ToBool(true)},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid namespace name",
+			namespace: "Everest_DB",
+			operators: api.NamespaceOperators{Mysql: pointer.ToBool(true)},
+			wantErr:   true,
+		},
+		{
+			name:      "reserved namespace",
+			namespace: "everest-system",
+			operators: api.NamespaceOperators{Mysql: pointer.ToBool(true)},
+			wantErr:   true,
+		},
+		{
+			name:      "no operators",
+			namespace: "everest-db",
+			operators: api.NamespaceOperators{Mysql: pointer.ToBool(false)},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateProvisionedNamespace(tc.namespace, &tc.operators)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	assert.ErrorIs(t, validateProvisionedNamespace("everest-db", &api.NamespaceOperators{}), namespaces.ErrOperatorsNotSelected)
+}
@@ -0,0 +1,89 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+// validateNamespaceQuota checks that the database cluster fits into the quota of its namespace.
+// Only the increase of the requested resources is checked, so that the database clusters
+// can still be scaled down or updated otherwise when the namespace is over the quota.
+// oldDB is nil if the database cluster is being created.
+func (h *validateHandler) validateNamespaceQuota(ctx context.Context, db, oldDB *everestv1alpha1.DatabaseCluster) error {
+	quota, err := h.kubeConnector.GetNamespaceQuota(ctx, db.GetNamespace())
+	if err != nil {
+		return err
+	}
+	if quota.IsEmpty() {
+		return nil
+	}
+	usage, err := h.kubeConnector.GetNamespaceResourceUsage(ctx, db.GetNamespace())
+	if err != nil {
+		return err
+	}
+	return checkNamespaceQuota(quota, usage, db, oldDB)
+}
+
+func checkNamespaceQuota(
+	quota *common.NamespaceQuota,
+	usage *common.NamespaceResourceUsage,
+	db, oldDB *everestv1alpha1.DatabaseCluster,
+) error {
+	if oldDB == nil && quota.DatabaseClusters != nil && usage.DatabaseClusters+1 > *quota.DatabaseClusters {
+		return fmt.Errorf("%w: the number of database clusters is limited to %d", errNamespaceQuotaExceeded, *quota.DatabaseClusters)
+	}
+
+	requested := kubernetes.GetRequestedResources(db)
+	current := kubernetes.GetRequestedResources(oldDB)
+	if quota.CPU != nil && requested.CPUMillis > current.CPUMillis &&
+		int64(usage.CPUMillis)+requested.CPUMillis-current.CPUMillis > quota.CPU.MilliValue() { //nolint:gosec
+		return fmt.Errorf("%w: CPU is limited to %s", errNamespaceQuotaExceeded, quota.CPU.String())
+	}
+	if quota.Memory != nil && requested.MemoryBytes > current.MemoryBytes &&
+		int64(usage.MemoryBytes)+requested.MemoryBytes-current.MemoryBytes > quota.Memory.Value() { //nolint:gosec
+		return fmt.Errorf("%w: memory is limited to %s", errNamespaceQuotaExceeded, quota.Memory.String())
+	}
+	if quota.Disk != nil && requested.DiskBytes > current.DiskBytes &&
+		int64(usage.DiskBytes)+requested.DiskBytes-current.DiskBytes > quota.Disk.Value() { //nolint:gosec
+		return fmt.Errorf("%w: disk is limited to %s", errNamespaceQuotaExceeded, quota.Disk.String())
+	}
+	return nil
+}
+
+// validateBackupQuota checks that one more backup fits into the quota of the namespace.
+func (h *validateHandler) validateBackupQuota(ctx context.Context, namespace string) error {
+	quota, err := h.kubeConnector.GetNamespaceQuota(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	if quota.DatabaseClusterBackups == nil {
+		return nil
+	}
+	usage, err := h.kubeConnector.GetNamespaceResourceUsage(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	if usage.DatabaseClusterBackups+1 > *quota.DatabaseClusterBackups {
+		return fmt.Errorf("%w: the number of database cluster backups is limited to %d", errNamespaceQuotaExceeded, *quota.DatabaseClusterBackups)
+	}
+	return nil
+}
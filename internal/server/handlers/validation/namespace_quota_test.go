@@ -0,0 +1,112 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+)
+
+func quotaTestDB(replicas int32, cpu, memory, storage string) *everestv1alpha1.DatabaseCluster {
+	return &everestv1alpha1.DatabaseCluster{
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Replicas: replicas,
+				Resources: everestv1alpha1.Resources{
+					CPU:    resource.MustParse(cpu),
+					Memory: resource.MustParse(memory),
+				},
+				Storage: everestv1alpha1.Storage{Size: resource.MustParse(storage)},
+			},
+		},
+	}
+}
+
+func TestCheckNamespaceQuota(t *testing.T) {
+	t.Parallel()
+	usage := &common.NamespaceResourceUsage{
+		CPUMillis:        3000,
+		MemoryBytes:      6_000_000_000,
+		DiskBytes:        30_000_000_000,
+		DatabaseClusters: 1,
+	}
+	quota := func(cpu, memory, disk string, clusters *int) *common.NamespaceQuota {
+		q := &common.NamespaceQuota{DatabaseClusters: clusters}
+		for raw, dst := range map[string]**resource.Quantity{cpu: &q.CPU, memory: &q.Memory, disk: &q.Disk} {
+			if raw != "" {
+				v := resource.MustParse(raw)
+				*dst = &v
+			}
+		}
+		return q
+	}
+
+	type tcase struct {
+		name  string
+		quota *common.NamespaceQuota
+		db    *everestv1alpha1.DatabaseCluster
+		oldDB *everestv1alpha1.DatabaseCluster
+		err   string
+	}
+	tcases := []tcase{
+		{
+			name:  "create within quota",
+			quota: quota("6", "12G", "60G", pointer.ToInt(2)),
+			db:    quotaTestDB(3, "1", "2G", "10G"),
+		},
+		{
+			name:  "create exceeds clusters",
+			quota: quota("", "", "", pointer.ToInt(1)),
+			db:    quotaTestDB(1, "1", "1G", "1G"),
+			err:   "namespace quota exceeded: the number of database clusters is limited to 1",
+		},
+		{
+			name:  "create exceeds cpu",
+			quota: quota("5", "", "", nil),
+			db:    quotaTestDB(3, "1", "2G", "10G"),
+			err:   "namespace quota exceeded: CPU is limited to 5",
+		},
+		{
+			name:  "create exceeds memory",
+			quota: quota("", "10G", "", nil),
+			db:    quotaTestDB(3, "1", "2G", "10G"),
+			err:   "namespace quota exceeded: memory is limited to 10G",
+		},
+		{
+			name:  "update exceeds disk",
+			quota: quota("", "", "40G", nil),
+			db:    quotaTestDB(3, "1", "2G", "20G"),
+			oldDB: quotaTestDB(3, "1", "2G", "10G"),
+			err:   "namespace quota exceeded: disk is limited to 40G",
+		},
+		{
+			name:  "update does not count the cluster again",
+			quota: quota("", "", "", pointer.ToInt(1)),
+			db:    quotaTestDB(3, "1", "2G", "10G"),
+			oldDB: quotaTestDB(3, "1", "2G", "10G"),
+		},
+		{
+			name:  "scale down over quota",
+			quota: quota("1", "", "", nil),
+			db:    quotaTestDB(1, "1", "2G", "10G"),
+			oldDB: quotaTestDB(3, "1", "2G", "10G"),
+		},
+	}
+	for _, tc := range tcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := checkNamespaceQuota(tc.quota, usage, tc.db, tc.oldDB)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, errNamespaceQuotaExceeded)
+			assert.Equal(t, tc.err, err.Error())
+		})
+	}
+}
@@ -1,7 +1,34 @@
 package validation
 
-import "context"
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/utils"
+)
 
 func (h *validateHandler) ListNamespaces(ctx context.Context) ([]string, error) {
 	return h.next.ListNamespaces(ctx)
 }
+
+func (h *validateHandler) GetNamespaceQuotaUsage(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error) {
+	return h.next.GetNamespaceQuotaUsage(ctx, namespace)
+}
+
+func (h *validateHandler) GetUsageReport(ctx context.Context, params *api.GetUsageReportParams) (*api.UsageReport, error) {
+	to := time.Now()
+	if params.To != nil {
+		to = *params.To
+	}
+	if params.From != nil && !params.From.Before(to) {
+		return nil, errors.Join(ErrInvalidRequest, errUsageReportRange)
+	}
+	if params.Namespace != nil {
+		if err := utils.ValidateRFC1035(*params.Namespace, "namespace"); err != nil {
+			return nil, errors.Join(ErrInvalidRequest, err)
+		}
+	}
+	return h.next.GetUsageReport(ctx, params)
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,6 +27,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/utils"
 )
@@ -36,6 +38,9 @@ var (
 		return fmt.Errorf("unsupported .spec.engineType='%s'", engineType)
 	}
 	errUpdatePSPEngineType = errors.New("changing .spec.engineType is forbidden")
+	// Preview errors
+	errPSPPreviewPolicy     = errors.New("either 'policyName' or 'policy' should be specified")
+	errPSPPreviewEngineType = errors.New("'engineType' doesn't match .spec.engineType of the policy")
 	// PXC affinity config errors
 	errInvalidPSPAffinityPXCWithPSMDB       = newPspValidationAffinityError(fmt.Sprintf(".spec.affinityConfig.psmdb is not applicable with engineType='%s'", everestv1alpha1.DatabaseEnginePXC))
 	errInvalidPSPAffinityPXCWithPostgresql  = newPspValidationAffinityError(fmt.Sprintf(".spec.affinityConfig.postgresql is not applicable with engineType='%s'", everestv1alpha1.DatabaseEnginePXC))
@@ -58,6 +63,17 @@ var (
 	errDeleteDefaultPSP = func(name string) error {
 		return fmt.Errorf("pod scheduling policy with name='%s' is default and cannot be deleted", name)
 	}
+	// Namespace restriction errors
+	errPSPDefaultWithoutRules = errors.New("pod scheduling policy without affinity rules cannot be a default")
+	errPSPDefaultNotAllowed   = func(namespace string) error {
+		return fmt.Errorf("pod scheduling policy cannot be the default in namespace='%s' that is not allowed to use it", namespace)
+	}
+	errPSPDefaultConflict = func(name, namespace string) error {
+		return fmt.Errorf("pod scheduling policy with name='%s' is already the default in namespace='%s'", name, namespace)
+	}
+	errPSPUsedInNamespace = func(name, namespace string) error {
+		return fmt.Errorf("pod scheduling policy with name='%s' is used by some DB cluster in namespace='%s' and the namespace cannot be disallowed", name, namespace)
+	}
 	// Used policy error
 	errDeleteInUsePSP = func(name string) error {
 		return fmt.Errorf("pod scheduling policy with name='%s' is used by some DB cluster and cannot be deleted", name)
@@ -82,6 +98,10 @@ func (h *validateHandler) CreatePodSchedulingPolicy(ctx context.Context, psp *ev
 		return nil, errors.Join(ErrInvalidRequest, err)
 	}
 
+	if err := h.validatePSPNamespaces(ctx, psp); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
 	return h.next.CreatePodSchedulingPolicy(ctx, psp)
 }
 
@@ -99,6 +119,10 @@ func (h *validateHandler) UpdatePodSchedulingPolicy(ctx context.Context, psp *ev
 		return nil, errors.Join(ErrInvalidRequest, err)
 	}
 
+	if err := h.validatePSPNamespaces(ctx, psp); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
 	return h.next.UpdatePodSchedulingPolicy(ctx, psp)
 }
 
@@ -209,9 +233,82 @@ func (h *validateHandler) validatePSPOnUpdate(ctx context.Context, newPsp *evere
 		// changing .spec.engineType is not allowed
 		return errUpdatePSPEngineType
 	}
+
+	// the namespaces of the DB clusters using the policy cannot be disallowed
+	allowed := common.PodSchedulingPolicyAllowedNamespaces(newPsp)
+	if allowed == nil || !h.isEverestObjectInUse(oldPsp) {
+		return nil
+	}
+	dbs, err := h.kubeConnector.ListDatabaseClusters(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list DB clusters: %w", err)
+	}
+	for _, db := range dbs.Items {
+		if db.Spec.PodSchedulingPolicyName == newPsp.GetName() && !slices.Contains(allowed, db.GetNamespace()) {
+			return errPSPUsedInNamespace(newPsp.GetName(), db.GetNamespace())
+		}
+	}
 	return nil
 }
 
+// validatePSPNamespaces validates the allowed and default namespaces of the policy.
+func (h *validateHandler) validatePSPNamespaces(ctx context.Context, psp *everestv1alpha1.PodSchedulingPolicy) error {
+	for _, ns := range common.PodSchedulingPolicyAllowedNamespaces(psp) {
+		if err := utils.ValidateRFC1035(ns, "metadata.annotations."+common.PodSchedulingPolicyAllowedNamespacesAnnotation); err != nil {
+			return err
+		}
+	}
+
+	defaults := common.PodSchedulingPolicyDefaultNamespaces(psp)
+	if len(defaults) == 0 {
+		return nil
+	}
+	if !psp.HasRules() {
+		return errPSPDefaultWithoutRules
+	}
+	for _, ns := range defaults {
+		if ns == common.AllNamespaces {
+			continue
+		}
+		if err := utils.ValidateRFC1035(ns, "metadata.annotations."+common.PodSchedulingPolicyDefaultNamespacesAnnotation); err != nil {
+			return err
+		}
+		if !common.IsPodSchedulingPolicyAllowed(psp, ns) {
+			return errPSPDefaultNotAllowed(ns)
+		}
+	}
+
+	// there can be only one default policy per engine type and namespace
+	pspList, err := h.kubeConnector.ListPodSchedulingPolicies(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list pod scheduling policies: %w", err)
+	}
+	for _, other := range pspList.Items {
+		if other.GetName() == psp.GetName() || other.Spec.EngineType != psp.Spec.EngineType {
+			continue
+		}
+		for _, ns := range common.PodSchedulingPolicyDefaultNamespaces(&other) {
+			if slices.Contains(defaults, ns) {
+				return errPSPDefaultConflict(other.GetName(), ns)
+			}
+		}
+	}
+	return nil
+}
+
+// defaultPodSchedulingPolicyName returns the name of the default pod scheduling policy of the engine type
+// in the namespace of the DB cluster, or an empty string if there is none.
+func (h *validateHandler) defaultPodSchedulingPolicyName(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (string, error) {
+	pspList, err := h.kubeConnector.ListPodSchedulingPolicies(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list pod scheduling policies: %w", err)
+	}
+	if psp := common.DefaultPodSchedulingPolicy(pspList.Items, db.Spec.Engine.Type, db.GetNamespace()); psp != nil {
+		return psp.GetName(), nil
+	}
+	return "", nil
+}
+
 func (h *validateHandler) validatePSPOnDelete(ctx context.Context, pspName string) error {
 	var psp *metav1.PartialObjectMetadata
 	var err error
@@ -230,3 +327,36 @@ func (h *validateHandler) validatePSPOnDelete(ctx context.Context, pspName strin
 	}
 	return nil
 }
+
+// PreviewPodSchedulingPolicy evaluates a pod scheduling policy against the current nodes.
+func (h *validateHandler) PreviewPodSchedulingPolicy(ctx context.Context, req *handlers.PodSchedulingPolicyPreviewRequest) (*api.PodSchedulingPolicyPreview, error) {
+	if (req.PolicyName == "") == (req.Policy == nil) {
+		return nil, errors.Join(ErrInvalidRequest, errPSPPreviewPolicy)
+	}
+	if req.EngineType != "" {
+		if _, ok := common.OperatorTypeToName[req.EngineType]; !ok {
+			return nil, errors.Join(ErrInvalidRequest, errInvalidPSPEngineType(req.EngineType))
+		}
+	}
+
+	psp := req.Policy
+	if psp != nil {
+		psp = psp.DeepCopy()
+		if psp.GetName() == "" {
+			// The policy being previewed may have no name yet.
+			psp.SetName("preview")
+		}
+		if err := h.validatePSPCR(psp); err != nil {
+			return nil, errors.Join(ErrInvalidRequest, err)
+		}
+	} else {
+		var err error
+		if psp, err = h.kubeConnector.GetPodSchedulingPolicy(ctx, types.NamespacedName{Name: req.PolicyName}); err != nil {
+			return nil, err
+		}
+	}
+	if req.EngineType != "" && req.EngineType != psp.Spec.EngineType {
+		return nil, errors.Join(ErrInvalidRequest, errPSPPreviewEngineType)
+	}
+	return h.next.PreviewPodSchedulingPolicy(ctx, req)
+}
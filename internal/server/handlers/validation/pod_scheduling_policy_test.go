@@ -32,11 +32,42 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/internal/server/handlers/k8s"
+	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/utils"
 )
 
+// pxcPolicyWithNamespaces returns a PXC policy with rules and the given default and allowed namespaces.
+func pxcPolicyWithNamespaces(name, defaults, allowed string) *everestv1alpha1.PodSchedulingPolicy {
+	return &everestv1alpha1.PodSchedulingPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Annotations: map[string]string{
+				common.PodSchedulingPolicyDefaultNamespacesAnnotation: defaults,
+				common.PodSchedulingPolicyAllowedNamespacesAnnotation: allowed,
+			},
+		},
+		Spec: everestv1alpha1.PodSchedulingPolicySpec{
+			EngineType: everestv1alpha1.DatabaseEnginePXC,
+			AffinityConfig: &everestv1alpha1.AffinityConfig{
+				PXC: &everestv1alpha1.PXCAffinityConfig{
+					Engine: &corev1.Affinity{
+						PodAntiAffinity: &corev1.PodAntiAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+								{
+									TopologyKey: "kubernetes.io/hostname",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func getDefaultPXCPolicy() *everestv1alpha1.PodSchedulingPolicy {
 	return &everestv1alpha1.PodSchedulingPolicy{
 		ObjectMeta: metav1.ObjectMeta{
@@ -548,6 +579,53 @@ func TestValidate_CreatePodSchedulingPolicy(t *testing.T) {
 				},
 			},
 		},
+		// namespace restrictions
+		{
+			name: "default policy without rules",
+			policyToCreate: &everestv1alpha1.PodSchedulingPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-pxc",
+					Annotations: map[string]string{common.PodSchedulingPolicyDefaultNamespacesAnnotation: "prod"},
+				},
+				Spec: everestv1alpha1.PodSchedulingPolicySpec{
+					EngineType: everestv1alpha1.DatabaseEnginePXC,
+				},
+			},
+			wantErr: errors.Join(ErrInvalidRequest, errPSPDefaultWithoutRules),
+		},
+		{
+			name:           "default in not allowed namespace",
+			policyToCreate: pxcPolicyWithNamespaces("test-pxc", "prod", "dev,staging"),
+			wantErr:        errors.Join(ErrInvalidRequest, errPSPDefaultNotAllowed("prod")),
+		},
+		{
+			name:           "invalid allowed namespace",
+			policyToCreate: pxcPolicyWithNamespaces("test-pxc", "", "Prod"),
+			wantErr: errors.Join(ErrInvalidRequest,
+				utils.ErrNameNotRFC1035Compatible("metadata.annotations."+common.PodSchedulingPolicyAllowedNamespacesAnnotation)),
+		},
+		{
+			name:           "default conflict",
+			objs:           []ctrlclient.Object{pxcPolicyWithNamespaces("pxc-prod", "prod", "")},
+			policyToCreate: pxcPolicyWithNamespaces("test-pxc", "prod,dev", "prod,dev"),
+			wantErr:        errors.Join(ErrInvalidRequest, errPSPDefaultConflict("pxc-prod", "prod")),
+		},
+		{
+			name: "default in other namespace",
+			objs: []ctrlclient.Object{
+				pxcPolicyWithNamespaces("pxc-prod", "prod", ""),
+				&everestv1alpha1.PodSchedulingPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "psmdb-dev",
+						Annotations: map[string]string{common.PodSchedulingPolicyDefaultNamespacesAnnotation: "dev"},
+					},
+					Spec: everestv1alpha1.PodSchedulingPolicySpec{
+						EngineType: everestv1alpha1.DatabaseEnginePSMDB,
+					},
+				},
+			},
+			policyToCreate: pxcPolicyWithNamespaces("test-pxc", "dev,*", ""),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2075,3 +2153,141 @@ func TestValidate_DeletePodSchedulingPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate_PreviewPodSchedulingPolicy(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		objs    []ctrlclient.Object
+		req     *handlers.PodSchedulingPolicyPreviewRequest
+		wantErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:    "neither name nor policy",
+			req:     &handlers.PodSchedulingPolicyPreviewRequest{EngineType: everestv1alpha1.DatabaseEnginePXC},
+			wantErr: errors.Join(ErrInvalidRequest, errPSPPreviewPolicy),
+		},
+		{
+			name: "both name and policy",
+			req: &handlers.PodSchedulingPolicyPreviewRequest{
+				PolicyName: "everest-default-mysql",
+				Policy:     getDefaultPXCPolicy(),
+			},
+			wantErr: errors.Join(ErrInvalidRequest, errPSPPreviewPolicy),
+		},
+		{
+			name: "unsupported engine type",
+			req: &handlers.PodSchedulingPolicyPreviewRequest{
+				EngineType: "mysql",
+				PolicyName: "everest-default-mysql",
+			},
+			wantErr: errors.Join(ErrInvalidRequest, errInvalidPSPEngineType("mysql")),
+		},
+		{
+			name: "non-existing policy",
+			req:  &handlers.PodSchedulingPolicyPreviewRequest{PolicyName: "everest-default-mysql"},
+			wantErr: k8sError.NewNotFound(schema.GroupResource{
+				Group:    everestv1alpha1.GroupVersion.Group,
+				Resource: "podschedulingpolicies",
+			}, "everest-default-mysql"),
+		},
+		{
+			name: "engine type mismatch",
+			objs: []ctrlclient.Object{getDefaultPXCPolicy()},
+			req: &handlers.PodSchedulingPolicyPreviewRequest{
+				EngineType: everestv1alpha1.DatabaseEnginePSMDB,
+				PolicyName: "everest-default-mysql",
+			},
+			wantErr: errors.Join(ErrInvalidRequest, errPSPPreviewEngineType),
+		},
+		{
+			name: "invalid policy",
+			req: &handlers.PodSchedulingPolicyPreviewRequest{
+				Policy: &everestv1alpha1.PodSchedulingPolicy{
+					Spec: everestv1alpha1.PodSchedulingPolicySpec{
+						EngineType:     everestv1alpha1.DatabaseEnginePXC,
+						AffinityConfig: &everestv1alpha1.AffinityConfig{},
+					},
+				},
+			},
+			wantErr: errors.Join(ErrInvalidRequest, errInvalidPSPAffinityPXCEmpty),
+		},
+		{
+			name: "existing policy",
+			objs: []ctrlclient.Object{getDefaultPXCPolicy()},
+			req:  &handlers.PodSchedulingPolicyPreviewRequest{PolicyName: "everest-default-mysql"},
+		},
+		{
+			name: "new policy without name",
+			req: &handlers.PodSchedulingPolicyPreviewRequest{
+				EngineType: everestv1alpha1.DatabaseEnginePXC,
+				Policy: &everestv1alpha1.PodSchedulingPolicy{
+					Spec: everestv1alpha1.PodSchedulingPolicySpec{
+						EngineType: everestv1alpha1.DatabaseEnginePXC,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient := fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.CreateScheme()).
+				WithObjects(tc.objs...).
+				Build()
+			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+			k8sHandler := k8s.New(zap.NewNop().Sugar(), k, "")
+
+			valHandler := New(zap.NewNop().Sugar(), k)
+			valHandler.SetNext(k8sHandler)
+			_, err := valHandler.PreviewPodSchedulingPolicy(context.Background(), tc.req)
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tc.wantErr.Error(), err.Error())
+		})
+	}
+}
+
+func TestValidate_DefaultPodSchedulingPolicyName(t *testing.T) {
+	t.Parallel()
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			pxcPolicyWithNamespaces("pxc-all", common.AllNamespaces, ""),
+			pxcPolicyWithNamespaces("pxc-prod", "prod", ""),
+		).
+		Build()
+	valHandler := &validateHandler{
+		log:           zap.NewNop().Sugar(),
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient),
+	}
+
+	defaultName := func(namespace string, engineType everestv1alpha1.EngineType) string {
+		db := &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: namespace},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: engineType},
+			},
+		}
+		name, err := valHandler.defaultPodSchedulingPolicyName(context.Background(), db)
+		require.NoError(t, err)
+		if name != "" {
+			// The default policy passes the same validation as a requested one.
+			db.Spec.PodSchedulingPolicyName = name
+			require.NoError(t, valHandler.validatePodSchedulingPolicy(context.Background(), db))
+		}
+		return name
+	}
+	assert.Equal(t, "pxc-prod", defaultName("prod", everestv1alpha1.DatabaseEnginePXC))
+	assert.Equal(t, "pxc-all", defaultName("dev", everestv1alpha1.DatabaseEnginePXC))
+	assert.Empty(t, defaultName("other", everestv1alpha1.DatabaseEnginePSMDB))
+}
@@ -16,13 +16,28 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/monitoring"
 )
 
+// monitoringCheckerLease is the name of the lease held by the replica that runs the monitoring checks.
+const monitoringCheckerLease = "everest-monitoring-checker"
+
+// RunMonitoringCheckJob runs background job for checking the connectivity of the monitoring instances.
+// Only the replica of the Everest server that holds the monitoring checker lease runs the checks.
+func (e *EverestServer) RunMonitoringCheckJob(ctx context.Context) {
+	checker := monitoring.NewChecker(e.kubeConnector, e.l, e.config.MonitoringCheckInterval)
+	if err := e.kubeConnector.RunWithLeaderElection(ctx, monitoringCheckerLease, checker.Run); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not run the monitoring check job")))
+	}
+}
+
 // CreateMonitoringInstance creates a new monitoring instance.
 func (e *EverestServer) CreateMonitoringInstance(ctx echo.Context, namespace string) error {
 	var params api.CreateMonitoringInstanceJSONRequestBody
@@ -79,15 +94,16 @@ func (e *EverestServer) UpdateMonitoringInstance(ctx echo.Context, namespace, na
 	if err != nil {
 		return err
 	}
-
-	out := &api.MonitoringInstance{}
-	out.FromCR(updated)
-	return ctx.JSON(http.StatusOK, out)
+	return ctx.JSON(http.StatusOK, updated)
 }
 
 // DeleteMonitoringInstance deletes a monitoring instance.
 func (e *EverestServer) DeleteMonitoringInstance(ctx echo.Context, namespace, name string) error {
-	if err := e.handler.DeleteMonitoringInstance(ctx.Request().Context(), namespace, name); err != nil {
+	var params api.DeleteMonitoringInstanceJSONRequestBody
+	if err := ctx.Bind(&params); err != nil {
+		return err
+	}
+	if err := e.handler.DeleteMonitoringInstance(ctx.Request().Context(), namespace, name, &params); err != nil {
 		return err
 	}
 
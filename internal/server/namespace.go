@@ -1,9 +1,14 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/usage"
 )
 
 // ListNamespaces returns the current version information.
@@ -15,3 +20,35 @@ func (e *EverestServer) ListNamespaces(ctx echo.Context) error {
 	}
 	return ctx.JSON(http.StatusOK, result)
 }
+
+// GetNamespaceQuotaUsage returns the quota and the resource usage of the namespace.
+func (e *EverestServer) GetNamespaceQuotaUsage(ctx echo.Context, namespace string) error {
+	result, err := e.handler.GetNamespaceQuotaUsage(ctx.Request().Context(), namespace)
+	if err != nil {
+		e.l.Errorf("GetNamespaceQuotaUsage failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// GetUsageReport returns the resource usage report of the namespaces and database clusters.
+func (e *EverestServer) GetUsageReport(ctx echo.Context, params api.GetUsageReportParams) error {
+	result, err := e.handler.GetUsageReport(ctx.Request().Context(), &params)
+	if err != nil {
+		e.l.Errorf("GetUsageReport failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// usageSamplerLease is the name of the lease held by the replica that samples the resource usage.
+const usageSamplerLease = "everest-usage-sampler"
+
+// RunUsageSamplingJob runs background job for sampling the resource usage of the database clusters.
+// Only the replica of the Everest server that holds the usage sampler lease takes the samples.
+func (e *EverestServer) RunUsageSamplingJob(ctx context.Context) {
+	sampler := usage.NewSampler(e.kubeConnector, e.l, e.config.UsageSampleInterval, e.config.UsageRetention)
+	if err := e.kubeConnector.RunWithLeaderElection(ctx, usageSamplerLease, sampler.Run); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not run the usage sampling job")))
+	}
+}
@@ -0,0 +1,83 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+)
+
+// ListNamespaceProvisioningJobs returns the latest provisioning jobs of the namespaces.
+func (e *EverestServer) ListNamespaceProvisioningJobs(ctx echo.Context) error {
+	result, err := e.handler.ListNamespaceProvisioningJobs(ctx.Request().Context())
+	if err != nil {
+		e.l.Errorf("ListNamespaceProvisioningJobs failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// GetNamespaceProvisioningJob returns the latest provisioning job of the namespace.
+func (e *EverestServer) GetNamespaceProvisioningJob(ctx echo.Context, namespace string) error {
+	result, err := e.handler.GetNamespaceProvisioningJob(ctx.Request().Context(), namespace)
+	if err != nil {
+		e.l.Errorf("GetNamespaceProvisioningJob failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// ProvisionNamespace starts provisioning a new database namespace.
+func (e *EverestServer) ProvisionNamespace(ctx echo.Context) error {
+	req := &api.NamespaceProvisioningRequest{}
+	if err := e.getBodyFromContext(ctx, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	result, err := e.handler.ProvisionNamespace(ctx.Request().Context(), req)
+	if err != nil {
+		e.l.Errorf("ProvisionNamespace failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusAccepted, result)
+}
+
+// UpdateNamespaceOperators starts updating the operators installed in the database namespace.
+func (e *EverestServer) UpdateNamespaceOperators(ctx echo.Context, namespace string) error {
+	req := &api.NamespaceOperators{}
+	if err := e.getBodyFromContext(ctx, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	result, err := e.handler.UpdateNamespaceOperators(ctx.Request().Context(), namespace, req)
+	if err != nil {
+		e.l.Errorf("UpdateNamespaceOperators failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusAccepted, result)
+}
+
+// DeprovisionNamespace starts removing the database namespace.
+func (e *EverestServer) DeprovisionNamespace(ctx echo.Context, namespace string, params api.DeprovisionNamespaceParams) error {
+	result, err := e.handler.DeprovisionNamespace(ctx.Request().Context(), namespace, &params)
+	if err != nil {
+		e.l.Errorf("DeprovisionNamespace failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusAccepted, result)
+}
@@ -23,6 +23,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
 )
 
 // ListPodSchedulingPolicy lists all pod scheduling policies.
@@ -85,3 +86,18 @@ func (e *EverestServer) UpdatePodSchedulingPolicy(c echo.Context, policyName str
 	}
 	return c.JSON(http.StatusOK, result)
 }
+
+// PreviewPodSchedulingPolicy evaluates a pod scheduling policy against the current nodes.
+func (e *EverestServer) PreviewPodSchedulingPolicy(c echo.Context) error {
+	req := &handlers.PodSchedulingPolicyPreviewRequest{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.handler.PreviewPodSchedulingPolicy(c.Request().Context(), req)
+	if err != nil {
+		e.l.Errorf("PreviewPodSchedulingPolicy failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
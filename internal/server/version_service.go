@@ -0,0 +1,48 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+// getBundledVersionService serves the version service responses of the offline bundle Everest
+// was installed or upgraded from, so that Everest does not need access to the version service.
+func (e *EverestServer) getBundledVersionService(c echo.Context) error {
+	cm, err := e.kubeConnector.GetConfigMap(c.Request().Context(), types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.EverestVersionServiceConfigMapName,
+	})
+	if k8serrors.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not get the bundled version service responses")))
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+	data, ok := cm.Data[common.VersionServiceConfigMapKey(strings.TrimPrefix(c.Request().URL.Path, common.VersionServicePath))]
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, []byte(data))
+}
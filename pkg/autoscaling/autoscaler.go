@@ -0,0 +1,205 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const (
+	// DefaultInterval is the default interval between the evaluations of the database clusters.
+	DefaultInterval = 5 * time.Minute
+
+	// EventReasonStorageScaled is the reason of the events recorded when the storage of a database cluster is grown.
+	EventReasonStorageScaled = "StorageAutoscaled"
+	// EventReasonStorageScalingFailed is the reason of the events recorded when the storage
+	// of a database cluster needs to be grown but cannot be.
+	EventReasonStorageScalingFailed = "StorageAutoscalingFailed"
+)
+
+// DatabaseClusterUpdater updates database clusters.
+type DatabaseClusterUpdater interface {
+	UpdateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error)
+}
+
+// Autoscaler periodically grows the storage of the database clusters with storage autoscaling enabled
+// whose volumes are used above the threshold. The database clusters are updated with the updater,
+// so the updates are validated the same way as the updates made through the API.
+type Autoscaler struct {
+	kubeConnector kubernetes.KubernetesConnector
+	updater       DatabaseClusterUpdater
+	l             *zap.SugaredLogger
+	interval      time.Duration
+}
+
+// NewAutoscaler returns a new storage autoscaler.
+func NewAutoscaler(
+	k kubernetes.KubernetesConnector,
+	updater DatabaseClusterUpdater,
+	l *zap.SugaredLogger,
+	interval time.Duration,
+) *Autoscaler {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Autoscaler{
+		kubeConnector: k,
+		updater:       updater,
+		l:             l.With("component", "storage-autoscaler"),
+		interval:      interval,
+	}
+}
+
+// Run evaluates the database clusters until the context is canceled.
+func (a *Autoscaler) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		if err := a.ScaleAll(ctx); err != nil {
+			a.l.Error(errors.Join(err, errors.New("failed to autoscale the storage of the database clusters")))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScaleAll evaluates the database clusters in the namespaces managed by Everest.
+func (a *Autoscaler) ScaleAll(ctx context.Context) error {
+	namespaces, err := a.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, ns := range namespaces.Items {
+		if err := a.ScaleNamespace(ctx, ns.GetName()); err != nil {
+			errs = append(errs, fmt.Errorf("namespace %s: %w", ns.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ScaleNamespace evaluates the database clusters in the namespace.
+func (a *Autoscaler) ScaleNamespace(ctx context.Context, namespace string) error {
+	dbs, err := a.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	enabled := make(map[string]*Settings)
+	for _, db := range dbs.Items {
+		settings, err := GetSettings(&db)
+		if err != nil || settings == nil || settings.Validate() != nil {
+			continue
+		}
+		enabled[db.GetName()] = settings
+	}
+	// Reading the volume usage requires calls to the kubelets, so it is skipped when possible.
+	if len(enabled) == 0 {
+		return nil
+	}
+
+	usage, err := a.kubeConnector.GetDatabaseClusterVolumesUsage(ctx, namespace)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to get the volume usage"))
+	}
+	var errs []error
+	for _, db := range dbs.Items {
+		settings, ok := enabled[db.GetName()]
+		if !ok {
+			continue
+		}
+		if err := a.scale(ctx, &db, settings, usage[db.GetName()]); err != nil {
+			errs = append(errs, fmt.Errorf("database cluster %s: %w", db.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// scale grows the storage of the database cluster if any of its volumes is used above the threshold.
+func (a *Autoscaler) scale(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	settings *Settings,
+	volumes []kubernetes.VolumeUsage,
+) error {
+	var usedPercent uint64
+	for _, v := range volumes {
+		// The volume is still being expanded, either to a size requested by the claim
+		// or to the size of the database cluster that is not requested by the claim yet.
+		if v.Resizing || v.RequestedBytes < uint64(max(db.Spec.Engine.Storage.Size.Value(), 0)) {
+			return nil
+		}
+		if v.CapacityBytes == 0 {
+			continue
+		}
+		usedPercent = max(usedPercent, v.UsedBytes*100/v.CapacityBytes)
+	}
+	if usedPercent < uint64(settings.ThresholdPercent) { //nolint:gosec
+		return nil
+	}
+
+	current := db.Spec.Engine.Storage.Size
+	size, ok := settings.NextSize(current)
+	if !ok {
+		a.l.Warnf("Storage of database cluster %s/%s is used at %d%% but is already at the maximum size %s",
+			db.GetNamespace(), db.GetName(), usedPercent, settings.Maximum.String())
+		return nil
+	}
+
+	classes, err := a.kubeConnector.ListStorageClasses(ctx)
+	if err != nil {
+		return err
+	}
+	sc := kubernetes.FindStorageClass(classes.Items, pointer.Get(db.Spec.Engine.Storage.Class))
+	if sc == nil || !pointer.Get(sc.AllowVolumeExpansion) {
+		// Nothing can be done until the storage class allows volume expansion, so no event is recorded.
+		a.l.Warnf("Storage of database cluster %s/%s is used at %d%% but its storage class does not allow volume expansion",
+			db.GetNamespace(), db.GetName(), usedPercent)
+		return nil
+	}
+
+	updated := db.DeepCopy()
+	updated.Spec.Engine.Storage.Size = size
+	if _, err := a.updater.UpdateDatabaseCluster(ctx, updated); err != nil {
+		a.recordEvent(ctx, db, corev1.EventTypeWarning, EventReasonStorageScalingFailed,
+			fmt.Sprintf("Failed to grow storage from %s to %s: %s", current.String(), size.String(), err))
+		return errors.Join(err, errors.New("failed to update the database cluster"))
+	}
+	message := fmt.Sprintf("Storage grown from %s to %s since volume usage reached %d%% (threshold %d%%)",
+		current.String(), size.String(), usedPercent, settings.ThresholdPercent)
+	a.l.Infof("%s/%s: %s", db.GetNamespace(), db.GetName(), message)
+	a.recordEvent(ctx, db, corev1.EventTypeNormal, EventReasonStorageScaled, message)
+	return nil
+}
+
+func (a *Autoscaler) recordEvent(ctx context.Context, db *everestv1alpha1.DatabaseCluster, eventType, reason, message string) {
+	if err := a.kubeConnector.CreateEvent(ctx, db, eventType, reason, message); err != nil {
+		a.l.Error(errors.Join(err, fmt.Errorf("failed to record event for database cluster %s/%s", db.GetNamespace(), db.GetName())))
+	}
+}
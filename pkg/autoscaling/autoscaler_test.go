@@ -0,0 +1,170 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+// volumeUsageConnector reports a fixed volume usage since the kubelet stats are not available in tests.
+type volumeUsageConnector struct {
+	kubernetes.KubernetesConnector
+	usage map[string][]kubernetes.VolumeUsage
+}
+
+func (c *volumeUsageConnector) GetDatabaseClusterVolumesUsage(context.Context, string) (map[string][]kubernetes.VolumeUsage, error) {
+	return c.usage, nil
+}
+
+type fakeUpdater struct {
+	updated map[string]string
+	err     error
+}
+
+func (u *fakeUpdater) UpdateDatabaseCluster(_ context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	if u.err != nil {
+		return nil, u.err
+	}
+	u.updated[db.GetName()] = db.Spec.Engine.Storage.Size.String()
+	return db, nil
+}
+
+func TestSettingsNextSize(t *testing.T) {
+	t.Parallel()
+
+	settings := &Settings{ThresholdPercent: 80, Step: resource.MustParse("5Gi"), Maximum: resource.MustParse("12Gi")}
+	size, ok := settings.NextSize(resource.MustParse("5Gi"))
+	assert.True(t, ok)
+	assert.Equal(t, "10Gi", size.String())
+	size, ok = settings.NextSize(resource.MustParse("10Gi"))
+	assert.True(t, ok)
+	assert.Equal(t, "12Gi", size.String())
+	_, ok = settings.NextSize(resource.MustParse("12Gi"))
+	assert.False(t, ok)
+}
+
+func TestAutoscaler(t *testing.T) {
+	t.Parallel()
+
+	const gi = 1 << 30
+	db := func(name, class, size string, settings *Settings) *everestv1alpha1.DatabaseCluster {
+		cluster := &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{
+					Type:    everestv1alpha1.DatabaseEnginePXC,
+					Storage: everestv1alpha1.Storage{Class: pointer.To(class), Size: resource.MustParse(size)},
+				},
+			},
+		}
+		require.NoError(t, SetSettings(cluster, settings))
+		return cluster
+	}
+	settings := func(maximum string) *Settings {
+		return &Settings{ThresholdPercent: 80, Step: resource.MustParse("5Gi"), Maximum: resource.MustParse(maximum)}
+	}
+	// The filesystem of a volume is a bit smaller than the storage requested by its claim.
+	volumes := func(usedGi, requestedGi uint64, resizing bool) []kubernetes.VolumeUsage {
+		capacity := requestedGi * gi * 39 / 40
+		return []kubernetes.VolumeUsage{
+			{PersistentVolumeClaim: "datadir-0", UsedBytes: usedGi * gi / 2, CapacityBytes: capacity, RequestedBytes: requestedGi * gi},
+			{PersistentVolumeClaim: "datadir-1", UsedBytes: usedGi * gi, CapacityBytes: capacity, RequestedBytes: requestedGi * gi, Resizing: resizing},
+		}
+	}
+
+	objs := []ctrlclient.Object{
+		&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "expandable"}, AllowVolumeExpansion: pointer.To(true)},
+		&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "fixed"}},
+		db("grow", "expandable", "10Gi", settings("100Gi")),
+		db("grow-to-maximum", "expandable", "10Gi", settings("12Gi")),
+		db("below-threshold", "expandable", "10Gi", settings("100Gi")),
+		db("at-maximum", "expandable", "10Gi", settings("10Gi")),
+		db("not-expandable", "fixed", "10Gi", settings("100Gi")),
+		db("disabled", "expandable", "10Gi", nil),
+		db("expanding", "expandable", "15Gi", settings("100Gi")),
+		db("resizing", "expandable", "10Gi", settings("100Gi")),
+	}
+	usage := map[string][]kubernetes.VolumeUsage{
+		"grow":            volumes(9, 10, false),
+		"grow-to-maximum": volumes(9, 10, false),
+		"below-threshold": volumes(7, 10, false),
+		"at-maximum":      volumes(9, 10, false),
+		"not-expandable":  volumes(9, 10, false),
+		"disabled":        volumes(9, 10, false),
+		// The claims don't request the size of the database cluster yet.
+		"expanding": volumes(9, 10, false),
+		// The claims request the size of the database cluster but the volumes are not expanded yet.
+		"resizing": volumes(9, 10, true),
+	}
+
+	t.Run("scale", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(objs...).Build()
+		k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+		updater := &fakeUpdater{updated: make(map[string]string)}
+		a := NewAutoscaler(&volumeUsageConnector{KubernetesConnector: k, usage: usage}, updater, zap.NewNop().Sugar(), 0)
+
+		require.NoError(t, a.ScaleNamespace(context.Background(), "test-ns"))
+		assert.Equal(t, map[string]string{
+			"grow":            "15Gi",
+			"grow-to-maximum": "12Gi",
+		}, updater.updated)
+
+		events, err := k.ListEvents(context.Background(), ctrlclient.InNamespace("test-ns"))
+		require.NoError(t, err)
+		require.Len(t, events.Items, 2)
+		for _, e := range events.Items {
+			assert.Equal(t, corev1.EventTypeNormal, e.Type)
+			assert.Equal(t, EventReasonStorageScaled, e.Reason)
+			assert.Equal(t, "DatabaseCluster", e.InvolvedObject.Kind)
+		}
+	})
+
+	t.Run("update failure", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(objs...).Build()
+		k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+		updater := &fakeUpdater{err: errors.New("invalid request")}
+		a := NewAutoscaler(&volumeUsageConnector{KubernetesConnector: k, usage: usage}, updater, zap.NewNop().Sugar(), 0)
+
+		require.Error(t, a.ScaleNamespace(context.Background(), "test-ns"))
+		events, err := k.ListEvents(context.Background(), ctrlclient.InNamespace("test-ns"))
+		require.NoError(t, err)
+		require.Len(t, events.Items, 2)
+		for _, e := range events.Items {
+			assert.Equal(t, corev1.EventTypeWarning, e.Type)
+			assert.Equal(t, EventReasonStorageScalingFailed, e.Reason)
+		}
+	})
+}
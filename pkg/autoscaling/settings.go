@@ -0,0 +1,111 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package autoscaling grows the storage of the database clusters before they run out of disk space.
+package autoscaling
+
+import (
+	"encoding/json"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+)
+
+var (
+	// ErrInvalidThreshold is returned when the threshold of the settings is out of range.
+	ErrInvalidThreshold = errors.New("storage autoscaling 'thresholdPercent' should be between 1 and 99")
+	// ErrInvalidStep is returned when the step of the settings is not positive.
+	ErrInvalidStep = errors.New("storage autoscaling 'step' should be greater than 0")
+	// ErrInvalidMaximum is returned when the maximum of the settings is not positive.
+	ErrInvalidMaximum = errors.New("storage autoscaling 'maximum' should be greater than 0")
+)
+
+// Settings holds the storage autoscaling settings of a database cluster.
+// The DatabaseCluster CRD has no autoscaling fields, so they are kept in an annotation.
+type Settings struct {
+	// ThresholdPercent is the used space of a volume, in percent of its capacity,
+	// at which the storage is grown.
+	ThresholdPercent int `json:"thresholdPercent"`
+	// Step is the size the storage is grown by.
+	Step resource.Quantity `json:"step"`
+	// Maximum is the size the storage is never grown beyond.
+	Maximum resource.Quantity `json:"maximum"`
+}
+
+// GetSettings returns the storage autoscaling settings of the database cluster.
+// It returns nil if storage autoscaling is not enabled for the database cluster.
+func GetSettings(db *everestv1alpha1.DatabaseCluster) (*Settings, error) {
+	data, ok := db.GetAnnotations()[common.StorageAutoscalingAnnotation]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+	s := &Settings{}
+	if err := json.Unmarshal([]byte(data), s); err != nil {
+		return nil, errors.Join(err, errors.New("invalid storage autoscaling settings"))
+	}
+	return s, nil
+}
+
+// SetSettings stores the storage autoscaling settings in the database cluster.
+// Passing nil settings disables storage autoscaling. The database cluster is not updated in Kubernetes.
+func SetSettings(db *everestv1alpha1.DatabaseCluster, s *Settings) error {
+	annotations := db.GetAnnotations()
+	if s == nil {
+		delete(annotations, common.StorageAutoscalingAnnotation)
+		db.SetAnnotations(annotations)
+		return nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[common.StorageAutoscalingAnnotation] = string(data)
+	db.SetAnnotations(annotations)
+	return nil
+}
+
+// Validate checks the settings.
+func (s *Settings) Validate() error {
+	if s.ThresholdPercent < 1 || s.ThresholdPercent > 99 {
+		return ErrInvalidThreshold
+	}
+	if s.Step.Sign() <= 0 {
+		return ErrInvalidStep
+	}
+	if s.Maximum.Sign() <= 0 {
+		return ErrInvalidMaximum
+	}
+	return nil
+}
+
+// NextSize returns the size the storage should be grown to from the current size,
+// and false if the storage is already at the maximum size.
+func (s *Settings) NextSize(current resource.Quantity) (resource.Quantity, bool) {
+	if current.Cmp(s.Maximum) >= 0 {
+		return current, false
+	}
+	next := current.DeepCopy()
+	next.Add(s.Step)
+	if next.Cmp(s.Maximum) > 0 {
+		next = s.Maximum.DeepCopy()
+	}
+	return next, true
+}
@@ -0,0 +1,324 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle provides the offline bundles with everything everestctl needs to install and upgrade
+// Everest without access to the Percona version service and Helm repository.
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+const (
+	// manifestFile is the name of the file describing the bundle.
+	manifestFile = "bundle.json"
+	// imagesFile is the name of the file listing the images used by the bundled version, one per line.
+	imagesFile = "images.txt"
+	// chartsDir is the directory with the packaged Helm charts.
+	chartsDir = "charts"
+	// versionServiceDir is the directory with the version service responses,
+	// stored at the same paths as they are served by the version service.
+	versionServiceDir = "version-service"
+
+	// maxFileSize is the maximum size of a file extracted from a bundle.
+	maxFileSize = 1 << 30
+)
+
+// ErrInvalidBundle is returned when the file is not an Everest bundle.
+var ErrInvalidBundle = errors.New("invalid bundle")
+
+type (
+	// Manifest describes the content of a bundle.
+	Manifest struct {
+		// Version is the Everest version of the bundle.
+		Version string `json:"version"`
+		// CreatedAt is the time the bundle was created.
+		CreatedAt time.Time `json:"createdAt"`
+		// Charts are the names of the bundled Helm charts.
+		Charts []string `json:"charts"`
+		// Operators maps the names of the bundled operators to their versions.
+		Operators map[string]string `json:"operators"`
+		// Images are the images used by the bundled version.
+		Images []string `json:"images"`
+	}
+
+	// Bundle is an extracted offline bundle.
+	Bundle struct {
+		dir      string
+		manifest Manifest
+	}
+)
+
+// Open extracts the bundle at path into the everestctl cache directory and returns it.
+func Open(path string) (*Bundle, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(cacheDir, "everestctl", "bundles", strings.TrimSuffix(filepath.Base(path), ".tar.gz"))
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := extract(path, dir); err != nil {
+		return nil, fmt.Errorf("could not extract bundle %s: %w", path, err)
+	}
+	return load(dir)
+}
+
+// load returns the bundle extracted in dir.
+func load(dir string) (*Bundle, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errors.Join(ErrInvalidBundle, fmt.Errorf("%s not found", manifestFile))
+	} else if err != nil {
+		return nil, err
+	}
+	b := &Bundle{dir: dir}
+	if err := json.Unmarshal(data, &b.manifest); err != nil {
+		return nil, errors.Join(ErrInvalidBundle, err)
+	}
+	if b.manifest.Version == "" {
+		return nil, errors.Join(ErrInvalidBundle, errors.New("version not set"))
+	}
+	return b, nil
+}
+
+// Manifest returns the description of the bundle.
+func (b *Bundle) Manifest() Manifest {
+	return b.manifest
+}
+
+// Version returns the Everest version of the bundle.
+func (b *Bundle) Version() string {
+	return b.manifest.Version
+}
+
+// ChartsDir returns the directory with the packaged Helm charts of the bundle.
+func (b *Bundle) ChartsDir() string {
+	return filepath.Join(b.dir, chartsDir)
+}
+
+// VersionServiceURL returns the URL serving the version service responses stored in the bundle.
+func (b *Bundle) VersionServiceURL() string {
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(filepath.Join(b.dir, versionServiceDir))}
+	return u.String()
+}
+
+// VersionService returns the version service client backed by the bundle.
+func (b *Bundle) VersionService() versionservice.Interface { //nolint:ireturn
+	return versionservice.New(b.VersionServiceURL())
+}
+
+// InClusterVersionServiceURL returns the URL the Everest server with the given values serves
+// the version service responses of the bundle at, to be used by Everest instead of the version service.
+func InClusterVersionServiceURL(server helmutils.ServerValues) string {
+	u := url.URL{
+		Scheme: "http",
+		Host:   fmt.Sprintf("%s.%s.svc:%d", server.Service.Name, common.SystemNamespace, server.Service.Port),
+		Path:   common.VersionServicePath,
+	}
+	if server.TLS.Enabled {
+		u.Scheme = "https"
+		u.Host = fmt.Sprintf("%s.%s.svc", server.Service.Name, common.SystemNamespace)
+	}
+	return u.String()
+}
+
+// VersionServiceConfigMap returns the ConfigMap holding the version service responses of the bundle.
+// If the registry is set, the images of the database engines are pulled from it.
+func (b *Bundle) VersionServiceConfigMap(registry string) (*corev1.ConfigMap, error) {
+	root := filepath.Join(b.dir, versionServiceDir)
+	data := map[string]string{}
+	if err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(p) //nolint:gosec
+		if err != nil {
+			return err
+		}
+		if registry != "" {
+			if content, err = rewriteResponseImages(content, registry); err != nil {
+				return fmt.Errorf("could not rewrite the images of %s: %w", rel, err)
+			}
+		}
+		data[common.VersionServiceConfigMapKey(filepath.ToSlash(rel))] = string(content)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.EverestVersionServiceConfigMapName,
+			Namespace: common.SystemNamespace,
+		},
+		Data: data,
+	}, nil
+}
+
+// StoreVersionService stores the version service responses of the bundle in the Everest system namespace,
+// so that the Everest server serves them to Everest. If the registry is set, the images of
+// the database engines are pulled from it.
+func (b *Bundle) StoreVersionService(ctx context.Context, k kubernetes.KubernetesConnector, registry string) error {
+	cm, err := b.VersionServiceConfigMap(registry)
+	if err != nil {
+		return err
+	}
+	current, err := k.GetConfigMap(ctx, ctrlclient.ObjectKeyFromObject(cm))
+	if k8serrors.IsNotFound(err) {
+		_, err = k.CreateConfigMap(ctx, cm)
+		return err
+	} else if err != nil {
+		return err
+	}
+	current.Data = cm.Data
+	_, err = k.UpdateConfigMap(ctx, current)
+	return err
+}
+
+// rewriteResponseImages makes the images listed in the version service response to be pulled from the registry.
+func rewriteResponseImages(content []byte, registry string) ([]byte, error) {
+	var response interface{}
+	if err := json.Unmarshal(content, &response); err != nil {
+		return nil, err
+	}
+	helm.RewriteImages(response, "imagePath", registry)
+	return json.Marshal(response)
+}
+
+// extract extracts the gzipped tarball at path into dir.
+func extract(path, dir string) error {
+	f, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return errors.Join(ErrInvalidBundle, err)
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return errors.Join(ErrInvalidBundle, err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		// Reject the entries escaping the target directory.
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if !filepath.IsLocal(name) {
+			return errors.Join(ErrInvalidBundle, fmt.Errorf("invalid file name %s", hdr.Name))
+		}
+		if err := extractFile(tr, filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+}
+
+func extractFile(r io.Reader, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:mnd
+		return err
+	}
+	f, err := os.Create(path) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+	if _, err := io.Copy(f, io.LimitReader(r, maxFileSize)); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// archive writes the content of dir as a gzipped tarball to path.
+func archive(dir, path string) error {
+	f, err := os.Create(path) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	if err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		src, err := os.Open(p) //nolint:gosec
+		if err != nil {
+			return err
+		}
+		defer src.Close() //nolint:errcheck
+		_, err = io.Copy(tw, src)
+		return err
+	}); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
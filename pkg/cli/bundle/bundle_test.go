@@ -0,0 +1,123 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
+	"github.com/percona/everest/pkg/common"
+)
+
+func TestOpen(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	dir := t.TempDir()
+	chart, err := loader.LoadDir("../../../data/testchart")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, chartsDir), 0o755))
+	_, err = chartutil.Save(chart, filepath.Join(dir, chartsDir))
+	require.NoError(t, err)
+	require.NoError(t, writeFile(filepath.Join(dir, versionServiceDir, "metadata", "v1", "everest"),
+		[]byte(`{"versions":[{"version":"1.5.0","supported":{"cli":">= 1.5.0"}}]}`)))
+	require.NoError(t, writeFile(filepath.Join(dir, versionServiceDir, "versions", "v1", "pxc-operator", "1.16.0"),
+		[]byte(`{"versions":[{"matrix":{"pxc":{"8.0.39":{"imagePath":"percona/percona-xtradb-cluster:8.0.39"}}}}]}`)))
+	require.NoError(t, writeManifest(dir, &Manifest{Version: "1.5.0", Images: []string{"percona/everest:1.5.0"}}))
+	path := filepath.Join(t.TempDir(), DefaultOutput("1.5.0"))
+	require.NoError(t, archive(dir, path))
+
+	b, err := Open(path)
+	require.NoError(t, err)
+	assert.Equal(t, "1.5.0", b.Version())
+	assert.Equal(t, []string{"percona/everest:1.5.0"}, b.Manifest().Images)
+
+	meta, err := b.VersionService().GetEverestMetadata(context.Background())
+	require.NoError(t, err)
+	require.Len(t, meta.GetVersions(), 1)
+	assert.Equal(t, "1.5.0", meta.GetVersions()[0].GetVersion())
+	assert.Equal(t, ">= 1.5.0", meta.GetVersions()[0].GetSupported()["cli"])
+
+	assert.True(t, strings.HasPrefix(b.VersionServiceURL(), "file:///"))
+
+	cm, err := b.VersionServiceConfigMap("registry.local")
+	require.NoError(t, err)
+	assert.Equal(t, common.EverestVersionServiceConfigMapName, cm.GetName())
+	assert.Contains(t, cm.Data, "metadata_v1_everest")
+	assert.JSONEq(t,
+		`{"versions":[{"matrix":{"pxc":{"8.0.39":{"imagePath":"registry.local/percona/percona-xtradb-cluster:8.0.39"}}}}]}`,
+		cm.Data["versions_v1_pxc-operator_1.16.0"])
+
+	installer := helm.Installer{ReleaseName: "test", ReleaseNamespace: "test"}
+	require.NoError(t, installer.Init("", helm.ChartOptions{
+		ArchiveDir: b.ChartsDir(),
+		Name:       "testchart",
+		Version:    "0.1.0",
+	}))
+	require.Error(t, installer.Init("", helm.ChartOptions{
+		ArchiveDir: b.ChartsDir(),
+		Name:       "testchart",
+		Version:    "0.2.0",
+	}))
+}
+
+func TestOpenInvalid(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	writeTarball := func(t *testing.T, name string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		defer f.Close() //nolint:errcheck
+		gz := gzip.NewWriter(f)
+		tw := tar.NewWriter(gz)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: 2, Typeflag: tar.TypeReg}))
+		_, err = tw.Write([]byte("{}"))
+		require.NoError(t, err)
+		require.NoError(t, tw.Close())
+		require.NoError(t, gz.Close())
+		return path
+	}
+
+	_, err := Open(writeTarball(t, "../"+manifestFile))
+	require.ErrorIs(t, err, ErrInvalidBundle)
+
+	_, err = Open(writeTarball(t, "images.txt"))
+	require.ErrorIs(t, err, ErrInvalidBundle)
+
+	_, err = Open(writeTarball(t, manifestFile))
+	require.ErrorIs(t, err, ErrInvalidBundle)
+}
+
+func TestInClusterVersionServiceURL(t *testing.T) {
+	t.Parallel()
+	server := helmutils.ServerValues{Service: helmutils.ServiceValues{Name: "everest", Port: 8080}}
+	assert.Equal(t, "http://everest.everest-system.svc:8080/version-service", InClusterVersionServiceURL(server))
+	server.TLS.Enabled = true
+	assert.Equal(t, "https://everest.everest-system.svc/version-service", InClusterVersionServiceURL(server))
+}
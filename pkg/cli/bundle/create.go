@@ -0,0 +1,276 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	perconavs "github.com/Percona-Lab/percona-version-service/versionpb"
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/common"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+// CreateConfig is the configuration for creating a bundle.
+type CreateConfig struct {
+	// Version is the Everest version to bundle.
+	Version string
+	// VersionMetadataURL is the URL of the version service to download the version metadata from.
+	VersionMetadataURL string
+	// RepoURL is the URL of the Helm repository to download the charts from.
+	RepoURL string
+	// Output is the path of the bundle to create. Defaults to everest-bundle-<version>.tar.gz.
+	Output string
+}
+
+// DefaultOutput returns the default path of the bundle of the version.
+func DefaultOutput(version string) string {
+	return fmt.Sprintf("everest-bundle-%s.tar.gz", version)
+}
+
+// Create downloads the Helm charts, the version metadata and the version service responses
+// of the operators of the Everest version, lists the images used by them and writes everything into a bundle.
+func Create(ctx context.Context, cfg CreateConfig, l *zap.SugaredLogger) (*Manifest, error) {
+	if cfg.Version == "" {
+		return nil, errors.New("version must be set")
+	}
+	if cfg.Output == "" {
+		cfg.Output = DefaultOutput(cfg.Version)
+	}
+	dir, err := os.MkdirTemp("", "everest-bundle-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	manifest := &Manifest{
+		Version:   cfg.Version,
+		CreatedAt: time.Now().UTC(),
+		Charts:    []string{helm.EverestChartName, helm.EverestDBNamespaceChartName},
+		Operators: map[string]string{},
+	}
+	if err := os.MkdirAll(filepath.Join(dir, chartsDir), 0o755); err != nil { //nolint:mnd
+		return nil, err
+	}
+	for _, name := range manifest.Charts {
+		l.Infof("Downloading Helm chart %s %s", name, cfg.Version)
+		if _, err := helm.PullChart(cfg.Version, name, cfg.RepoURL, filepath.Join(dir, chartsDir)); err != nil {
+			return nil, fmt.Errorf("could not download Helm chart %s: %w", name, err)
+		}
+	}
+
+	l.Info("Downloading version metadata")
+	if err := saveMetadata(ctx, cfg, dir); err != nil {
+		return nil, err
+	}
+
+	manifest.Operators, err = operatorVersions(filepath.Join(dir, chartsDir), cfg.Version)
+	if err != nil {
+		return nil, err
+	}
+	var images []string
+	for operator, version := range manifest.Operators {
+		l.Infof("Downloading supported versions of %s %s", operator, version)
+		versionImages, err := saveOperatorVersions(ctx, cfg.VersionMetadataURL, dir, operator, version)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, versionImages...)
+	}
+
+	l.Info("Listing images")
+	chartImages, err := chartsImages(ctx, filepath.Join(dir, chartsDir), cfg.Version)
+	if err != nil {
+		return nil, err
+	}
+	images = append(images, chartImages...)
+	slices.Sort(images)
+	manifest.Images = slices.Compact(images)
+
+	if err := writeManifest(dir, manifest); err != nil {
+		return nil, err
+	}
+	if err := archive(dir, cfg.Output); err != nil {
+		return nil, fmt.Errorf("could not write bundle %s: %w", cfg.Output, err)
+	}
+	return manifest, nil
+}
+
+// saveMetadata stores the version metadata limited to the bundled version, so that
+// install and upgrade do not pick a version missing from the bundle.
+func saveMetadata(ctx context.Context, cfg CreateConfig, dir string) error {
+	meta, err := versionservice.New(cfg.VersionMetadataURL).GetEverestMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(meta.GetVersions(), func(v *perconavs.MetadataVersion) bool {
+		return strings.TrimPrefix(v.GetVersion(), "v") == strings.TrimPrefix(cfg.Version, "v")
+	})
+	if idx < 0 {
+		return fmt.Errorf("version %s not found in the version metadata", cfg.Version)
+	}
+	meta.Versions = meta.GetVersions()[idx : idx+1]
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(dir, versionServiceDir, "metadata", "v1", "everest"), data)
+}
+
+// operatorVersions returns the versions of the operators of the DB namespace chart by the names
+// used by the version service.
+func operatorVersions(chartsDir, version string) (map[string]string, error) {
+	chart, err := loader.Load(filepath.Join(chartsDir, fmt.Sprintf("%s-%s.tgz", helm.EverestDBNamespaceChartName, version)))
+	if err != nil {
+		return nil, fmt.Errorf("could not load Helm chart %s: %w", helm.EverestDBNamespaceChartName, err)
+	}
+	result := map[string]string{}
+	for _, dep := range chart.Dependencies() {
+		for _, operator := range versionservice.EngineTypeToOperatorName {
+			if dep.Name() == operator {
+				result[operator] = dep.Metadata.Version
+			}
+		}
+	}
+	return result, nil
+}
+
+// saveOperatorVersions stores the version service response for the operator version
+// and returns the images listed in it. These are the images of the database engines and
+// of the operator itself, which is the image deployed by the OLM ClusterServiceVersion of the operator.
+func saveOperatorVersions(ctx context.Context, vsURL, dir, operator, version string) ([]string, error) {
+	u, err := url.Parse(vsURL)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not parse version service URL"))
+	}
+	elems := []string{"versions", "v1", operator, strings.TrimPrefix(version, "v")}
+	data, err := download(ctx, u.JoinPath(elems...).String())
+	if err != nil {
+		return nil, fmt.Errorf("could not download supported versions of %s %s: %w", operator, version, err)
+	}
+	if err := writeFile(filepath.Join(append([]string{dir, versionServiceDir}, elems...)...), data); err != nil {
+		return nil, err
+	}
+
+	var response interface{}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("could not decode supported versions of %s %s: %w", operator, version, err)
+	}
+	return findImages(response, "imagePath"), nil
+}
+
+// chartsImages returns the images of the bundled charts rendered with all the operators enabled.
+func chartsImages(ctx context.Context, chartsDir, version string) ([]string, error) {
+	var images []string
+	for name, values := range map[string]map[string]interface{}{
+		helm.EverestChartName:            nil,
+		helm.EverestDBNamespaceChartName: {"pxc": true, "psmdb": true, "postgresql": true},
+	} {
+		installer := helm.Installer{
+			ReleaseName:      name,
+			ReleaseNamespace: common.SystemNamespace,
+			Values:           values,
+		}
+		if err := installer.Init("", helm.ChartOptions{
+			ArchiveDir: chartsDir,
+			Name:       name,
+			Version:    version,
+		}); err != nil {
+			return nil, fmt.Errorf("could not initialize Helm installer: %w", err)
+		}
+		manifests, err := installer.RenderTemplates(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not render Helm chart %s: %w", name, err)
+		}
+		for _, doc := range manifests.Strings() {
+			var obj interface{}
+			if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+				return nil, fmt.Errorf("could not parse Helm chart %s: %w", name, err)
+			}
+			images = append(images, findImages(obj, "image")...)
+		}
+	}
+	return images, nil
+}
+
+// findImages returns the string values of the fields with the key found in the decoded object.
+func findImages(obj interface{}, key string) []string {
+	var result []string
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for k, v := range o {
+			if image, ok := v.(string); ok && k == key && image != "" {
+				result = append(result, image)
+				continue
+			}
+			result = append(result, findImages(v, key)...)
+		}
+	case []interface{}:
+		for _, v := range o {
+			result = append(result, findImages(v, key)...)
+		}
+	}
+	return result
+}
+
+func download(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close() //nolint:errcheck
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("invalid response http %d", res.StatusCode)
+	}
+	return io.ReadAll(res.Body)
+}
+
+func writeManifest(dir string, m *Manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile(filepath.Join(dir, manifestFile), data); err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(dir, imagesFile), []byte(strings.Join(m.Images, "\n")+"\n"))
+}
+
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:mnd
+		return err
+	}
+	return os.WriteFile(path, data, 0o644) //nolint:gosec,mnd
+}
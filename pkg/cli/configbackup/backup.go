@@ -0,0 +1,225 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configbackup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/output"
+)
+
+//nolint:gochecknoglobals
+var (
+	// backedUpConfigMaps are the Everest ConfigMaps backed up from the Everest namespace.
+	backedUpConfigMaps = []string{
+		common.EverestSettingsConfigMapName,
+		common.EverestRBACConfigMapName,
+	}
+	// backedUpKinds are the kinds of the Everest resources backed up from the database namespaces.
+	// The resources referenced by database clusters come before them.
+	backedUpKinds = []string{
+		"BackupStorage",
+		"MonitoringConfig",
+		"DatabaseCluster",
+	}
+)
+
+type (
+	// BackupConfig is the configuration for backing up the Everest configuration.
+	BackupConfig struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// Output is the path of the file the configuration is written to.
+		Output string
+		// IncludeJWTKeys is set if the keys signing the Everest sessions shall be backed up.
+		IncludeJWTKeys bool
+	}
+
+	// Backup is the CLI operation to back up the Everest configuration.
+	Backup struct {
+		cfg           BackupConfig
+		kubeConnector kubernetes.KubernetesConnector
+		l             *zap.SugaredLogger
+	}
+)
+
+// NewBackup returns a new CLI operation to back up the Everest configuration.
+func NewBackup(cfg BackupConfig, l *zap.SugaredLogger) (*Backup, error) {
+	b := &Backup{
+		cfg: cfg,
+		l:   l.With("component", "config-backup"),
+	}
+	if cfg.Pretty {
+		b.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(b.l, cfg.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	b.kubeConnector = k
+	return b, nil
+}
+
+// Run the backup operation.
+func (b *Backup) Run(ctx context.Context) error {
+	// This command expects a Helm based installation (>= 1.4.0)
+	ver, err := cliutils.CheckHelmInstallation(ctx, b.kubeConnector)
+	if err != nil {
+		return err
+	}
+
+	c, err := b.Collect(ctx)
+	if err != nil {
+		return err
+	}
+	c.EverestVersion = ver
+	if err := WriteConfig(b.cfg.Output, c); err != nil {
+		return err
+	}
+
+	b.l.Infof("Everest configuration has been saved to '%s'", b.cfg.Output)
+	if b.cfg.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("Everest configuration has been saved to '%s'", b.cfg.Output))
+		_, _ = fmt.Fprint(os.Stdout, output.Warn("The file contains secrets, keep it in a safe place"))
+	}
+	return nil
+}
+
+// Collect returns the Everest configuration of the cluster.
+func (b *Backup) Collect(ctx context.Context) (*Config, error) {
+	c := &Config{CreatedAt: time.Now().UTC()}
+
+	secrets := []string{common.EverestAccountsSecretName}
+	if b.cfg.IncludeJWTKeys {
+		secrets = append(secrets, common.EverestJWTSecretName)
+	}
+	for _, name := range secrets {
+		if err := b.addSecret(ctx, c, common.SystemNamespace, name); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range backedUpConfigMaps {
+		cm, err := b.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: name})
+		if k8serrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if err := addObject(c, cm, "ConfigMap"); err != nil {
+			return nil, err
+		}
+	}
+
+	policies, err := b.kubeConnector.ListEverestResources(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range policies {
+		c.Objects = append(c.Objects, cleanObject(&p))
+	}
+
+	nsList, err := b.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ns := range nsList.Items {
+		if err := b.collectNamespace(ctx, c, ns.GetName()); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// collectNamespace adds the database namespace, its Everest resources and the secrets with their credentials.
+func (b *Backup) collectNamespace(ctx context.Context, c *Config, namespace string) error {
+	engines, err := b.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	ns := Namespace{Name: namespace, Operators: []string{}}
+	for _, engine := range engines.Items {
+		if engine.Status.State == everestv1alpha1.DBEngineStateInstalled {
+			ns.Operators = append(ns.Operators, string(engine.Spec.Type))
+		}
+	}
+	slices.Sort(ns.Operators)
+	c.Namespaces = append(c.Namespaces, ns)
+
+	objs, err := b.kubeConnector.ListEverestResources(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	var secrets []string
+	for _, kind := range backedUpKinds {
+		for _, obj := range objs {
+			if obj.GetKind() != kind {
+				continue
+			}
+			// The credentials of the backup storages and the monitoring instances must be created first.
+			if secret, _, _ := unstructured.NestedString(obj.Object, "spec", "credentialsSecretName"); secret != "" &&
+				!slices.Contains(secrets, secret) {
+				if err := b.addSecret(ctx, c, namespace, secret); err != nil {
+					return err
+				}
+				secrets = append(secrets, secret)
+			}
+			c.Objects = append(c.Objects, cleanObject(&obj))
+		}
+	}
+	return nil
+}
+
+func (b *Backup) addSecret(ctx context.Context, c *Config, namespace, name string) error {
+	secret, err := b.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if k8serrors.IsNotFound(err) {
+		b.l.Warnf("Secret '%s' in namespace '%s' does not exist, skipping", name, namespace)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return addObject(c, secret, "Secret")
+}
+
+// addObject adds the core object of the kind to the configuration.
+func addObject(c *Config, obj runtime.Object, kind string) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetAPIVersion("v1")
+	u.SetKind(kind)
+	c.Objects = append(c.Objects, cleanObject(u))
+	return nil
+}
@@ -0,0 +1,123 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package configbackup provides the functionality to back up the Everest configuration
+// and to restore it on another Everest installation.
+package configbackup
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// lastAppliedAnnotation is the annotation set by kubectl apply, which is not restored.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// ErrInvalidConfig is returned when the configuration file cannot be restored.
+var ErrInvalidConfig = errors.New("invalid Everest configuration file")
+
+type (
+	// Config is the Everest configuration saved to a file.
+	Config struct {
+		// EverestVersion is the version of Everest the configuration was taken from.
+		EverestVersion string `json:"everestVersion"`
+		// CreatedAt is the time the configuration was taken.
+		CreatedAt time.Time `json:"createdAt"`
+		// Namespaces are the database namespaces managed by Everest.
+		Namespaces []Namespace `json:"namespaces"`
+		// Objects are the Kubernetes objects of the configuration, in the order they are restored.
+		Objects []map[string]interface{} `json:"objects"`
+	}
+
+	// Namespace is a database namespace managed by Everest.
+	Namespace struct {
+		// Name is the namespace name.
+		Name string `json:"name"`
+		// Operators are the engine types of the database operators installed in the namespace.
+		Operators []string `json:"operators"`
+	}
+)
+
+// ReadConfig reads the Everest configuration from the file.
+func ReadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, errors.Join(ErrInvalidConfig, err)
+	}
+	for _, obj := range c.objects() {
+		if obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, errors.Join(ErrInvalidConfig, errors.New("object without kind or name"))
+		}
+	}
+	return c, nil
+}
+
+// WriteConfig writes the Everest configuration to the file.
+// The file holds secrets, so it is only readable by its owner.
+func WriteConfig(path string, c *Config) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600) //nolint:mnd
+}
+
+func (c *Config) objects() []*unstructured.Unstructured {
+	result := make([]*unstructured.Unstructured, 0, len(c.Objects))
+	for _, obj := range c.Objects {
+		result = append(result, &unstructured.Unstructured{Object: obj})
+	}
+	return result
+}
+
+// cleanObject returns a copy of the object without the fields set by the cluster,
+// so that it can be created on another cluster.
+func cleanObject(obj *unstructured.Unstructured) map[string]interface{} {
+	result := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	for k, v := range obj.Object {
+		if k == "metadata" || k == "status" {
+			continue
+		}
+		result.Object[k] = v
+	}
+	result.SetName(obj.GetName())
+	result.SetNamespace(obj.GetNamespace())
+	if labels := obj.GetLabels(); len(labels) > 0 {
+		result.SetLabels(labels)
+	}
+	annotations := obj.GetAnnotations()
+	delete(annotations, lastAppliedAnnotation)
+	if len(annotations) > 0 {
+		result.SetAnnotations(annotations)
+	}
+	return result.Object
+}
+
+// resourceName identifies the object as kind/namespace/name, or kind/name if it is cluster-scoped.
+func resourceName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+	}
+	return fmt.Sprintf("%s/%s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
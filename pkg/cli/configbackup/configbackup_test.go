@@ -0,0 +1,184 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configbackup
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func newTestConnector(objs ...ctrlclient.Object) kubernetes.KubernetesConnector {
+	c := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(objs...).Build()
+	return kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(c)
+}
+
+func testSecret(namespace, name, value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Data:       map[string][]byte{"key": []byte(value)},
+	}
+}
+
+func sourceObjects() []ctrlclient.Object {
+	return []ctrlclient.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "ns-1",
+			Labels: map[string]string{common.KubernetesManagedByLabel: common.Everest},
+		}},
+		&everestv1alpha1.DatabaseEngine{
+			ObjectMeta: metav1.ObjectMeta{Name: "percona-xtradb-cluster-operator", Namespace: "ns-1"},
+			Spec:       everestv1alpha1.DatabaseEngineSpec{Type: everestv1alpha1.DatabaseEnginePXC},
+			Status:     everestv1alpha1.DatabaseEngineStatus{State: everestv1alpha1.DBEngineStateInstalled},
+		},
+		&everestv1alpha1.DatabaseEngine{
+			ObjectMeta: metav1.ObjectMeta{Name: "percona-server-mongodb-operator", Namespace: "ns-1"},
+			Spec:       everestv1alpha1.DatabaseEngineSpec{Type: everestv1alpha1.DatabaseEnginePSMDB},
+			Status:     everestv1alpha1.DatabaseEngineStatus{State: everestv1alpha1.DBEngineStateNotInstalled},
+		},
+		&everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "ns-1", ResourceVersion: "5"},
+		},
+		&everestv1alpha1.BackupStorage{
+			ObjectMeta: metav1.ObjectMeta{Name: "s3", Namespace: "ns-1"},
+			Spec:       everestv1alpha1.BackupStorageSpec{Bucket: "bucket", CredentialsSecretName: "s3-creds"},
+		},
+		testSecret("ns-1", "s3-creds", "s3"),
+		testSecret(common.SystemNamespace, common.EverestAccountsSecretName, "accounts"),
+		testSecret(common.SystemNamespace, common.EverestJWTSecretName, "jwt"),
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.EverestSettingsConfigMapName, Namespace: common.SystemNamespace},
+			Data:       map[string]string{"oidc.config": "issuer"},
+		},
+	}
+}
+
+func TestBackupCollect(t *testing.T) {
+	t.Parallel()
+
+	b := &Backup{
+		cfg:           BackupConfig{},
+		kubeConnector: newTestConnector(sourceObjects()...),
+		l:             zap.NewNop().Sugar(),
+	}
+	c, err := b.Collect(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []Namespace{{Name: "ns-1", Operators: []string{"pxc"}}}, c.Namespaces)
+	var resources []string
+	for _, obj := range c.objects() {
+		resources = append(resources, resourceName(obj))
+		assert.Empty(t, obj.GetResourceVersion())
+		assert.NotContains(t, obj.Object, "status")
+	}
+	assert.Equal(t, []string{
+		"Secret/everest-system/everest-accounts",
+		"ConfigMap/everest-system/everest-settings",
+		"Secret/ns-1/s3-creds",
+		"BackupStorage/ns-1/s3",
+		"DatabaseCluster/ns-1/db",
+	}, resources)
+
+	b.cfg.IncludeJWTKeys = true
+	c, err = b.Collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Secret/everest-system/everest-jwt", resourceName(c.objects()[1]))
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, WriteConfig(path, c))
+	read, err := ReadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, c.Namespaces, read.Namespaces)
+	assert.Len(t, read.Objects, len(c.Objects))
+}
+
+func TestRestorePlan(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	b := &Backup{kubeConnector: newTestConnector(sourceObjects()...), l: zap.NewNop().Sugar()}
+	c, err := b.Collect(ctx)
+	require.NoError(t, err)
+
+	// A fresh installation with other accounts and the same settings.
+	target := newTestConnector(
+		testSecret(common.SystemNamespace, common.EverestAccountsSecretName, "other"),
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.EverestSettingsConfigMapName, Namespace: common.SystemNamespace},
+			Data:       map[string]string{"oidc.config": "issuer"},
+		},
+	)
+	r := &Restore{cfg: RestoreConfig{OnConflict: ConflictFail}, config: c, kubeConnector: target, l: zap.NewNop().Sugar()}
+
+	p, err := r.plan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []NamespaceChange{{Name: "ns-1", Action: ActionCreate, Operators: []string{"pxc"}}}, p.Namespaces)
+	actions := map[string]Action{}
+	for _, o := range p.Objects {
+		actions[o.Resource] = o.Action
+	}
+	assert.Equal(t, map[string]Action{
+		"Secret/everest-system/everest-accounts":    ActionConflict,
+		"ConfigMap/everest-system/everest-settings": ActionUnchanged,
+		"Secret/ns-1/s3-creds":                      ActionCreate,
+		"BackupStorage/ns-1/s3":                     ActionCreate,
+		"DatabaseCluster/ns-1/db":                   ActionCreate,
+	}, actions)
+	assert.Equal(t, []string{"Secret/everest-system/everest-accounts"}, p.Conflicts())
+	assert.Equal(t, []string{"data.key"}, p.Objects[0].Fields)
+
+	r.cfg.OnConflict = ConflictSkip
+	p, err = r.plan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ActionSkip, p.Objects[0].Action)
+	assert.Empty(t, p.Conflicts())
+
+	r.cfg.OnConflict = ConflictOverwrite
+	p, err = r.plan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ActionUpdate, p.Objects[0].Action)
+	for _, o := range p.Objects {
+		if o.Action == ActionCreate || o.Action == ActionUpdate {
+			require.NoError(t, r.newStepRestoreObject(o).F(ctx))
+		}
+	}
+
+	accounts, err := target.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestAccountsSecretName})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("accounts"), accounts.Data["key"])
+	storage, err := target.GetBackupStorage(ctx, types.NamespacedName{Namespace: "ns-1", Name: "s3"})
+	require.NoError(t, err)
+	assert.Equal(t, "s3-creds", storage.Spec.CredentialsSecretName)
+
+	p, err = r.plan(ctx)
+	require.NoError(t, err)
+	for _, o := range p.Objects {
+		assert.Equal(t, ActionUnchanged, o.Action, o.Resource)
+	}
+}
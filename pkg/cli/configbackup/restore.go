@@ -0,0 +1,435 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configbackup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/cli/steps"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/namespaces"
+	"github.com/percona/everest/pkg/output"
+)
+
+const (
+	// ConflictFail aborts the restore if an object exists with a different content.
+	ConflictFail ConflictPolicy = "fail"
+	// ConflictSkip keeps the existing objects.
+	ConflictSkip ConflictPolicy = "skip"
+	// ConflictOverwrite replaces the content of the existing objects.
+	ConflictOverwrite ConflictPolicy = "overwrite"
+
+	// ActionCreate creates the namespace or the object.
+	ActionCreate Action = "create"
+	// ActionUpdate installs the missing operators into the namespace or overwrites the object.
+	ActionUpdate Action = "update"
+	// ActionSkip keeps the existing object although it differs.
+	ActionSkip Action = "skip"
+	// ActionUnchanged is set if the namespace or the object already exists with the same content.
+	ActionUnchanged Action = "unchanged"
+	// ActionConflict is set if the object exists with a different content and the conflicts are not allowed.
+	ActionConflict Action = "conflict"
+)
+
+// ErrConflict is returned when the restored objects exist with a different content.
+var ErrConflict = errors.New("objects exist with a different content")
+
+type (
+	// ConflictPolicy defines how to handle the objects that exist with a different content.
+	ConflictPolicy string
+
+	// Action is the change applied by a restore.
+	Action string
+
+	// RestoreConfig is the configuration for restoring the Everest configuration.
+	RestoreConfig struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// Input is the path of the file the configuration is read from.
+		Input string
+		// OnConflict defines how to handle the objects that exist with a different content.
+		OnConflict ConflictPolicy
+	}
+
+	// Restore is the CLI operation to restore the Everest configuration.
+	Restore struct {
+		cfg           RestoreConfig
+		config        *Config
+		kubeConnector kubernetes.KubernetesConnector
+		l             *zap.SugaredLogger
+	}
+
+	// Plan lists the changes applied by a restore.
+	Plan struct {
+		Namespaces []NamespaceChange `json:"namespaces"`
+		Objects    []ObjectChange    `json:"objects"`
+	}
+
+	// NamespaceChange is the change of a database namespace.
+	NamespaceChange struct {
+		Name   string `json:"name"`
+		Action Action `json:"action"`
+		// Operators are the operators installed in the namespace after the restore.
+		Operators []string `json:"operators"`
+		// exists is set if the namespace exists but is not managed by Everest.
+		exists bool
+	}
+
+	// ObjectChange is the change of a Kubernetes object.
+	ObjectChange struct {
+		// Resource identifies the object as kind/namespace/name.
+		Resource string `json:"resource"`
+		Action   Action `json:"action"`
+		// Fields are the paths of the fields that differ from the existing object.
+		// The values are not listed since they may hold secrets.
+		Fields []string `json:"fields,omitempty"`
+
+		obj      *unstructured.Unstructured
+		existing *unstructured.Unstructured
+	}
+)
+
+// NewRestore returns a new CLI operation to restore the Everest configuration.
+func NewRestore(cfg RestoreConfig, l *zap.SugaredLogger) (*Restore, error) {
+	if !slices.Contains([]ConflictPolicy{ConflictFail, ConflictSkip, ConflictOverwrite}, cfg.OnConflict) {
+		return nil, fmt.Errorf("unknown conflict policy '%s'", cfg.OnConflict)
+	}
+	c, err := ReadConfig(cfg.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Restore{
+		cfg:    cfg,
+		config: c,
+		l:      l.With("component", "config-restore"),
+	}
+	if cfg.Pretty {
+		r.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(r.l, cfg.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	r.kubeConnector = k
+	return r, nil
+}
+
+// Plan returns the changes applied by the restore without applying them.
+func (r *Restore) Plan(ctx context.Context) (*Plan, error) {
+	// This command expects a Helm based installation (>= 1.4.0)
+	if _, err := cliutils.CheckHelmInstallation(ctx, r.kubeConnector); err != nil {
+		return nil, err
+	}
+	return r.plan(ctx)
+}
+
+func (r *Restore) plan(ctx context.Context) (*Plan, error) {
+	p := &Plan{Namespaces: []NamespaceChange{}, Objects: []ObjectChange{}}
+	for _, ns := range r.config.Namespaces {
+		change, err := r.planNamespace(ctx, ns)
+		if err != nil {
+			return nil, err
+		}
+		p.Namespaces = append(p.Namespaces, change)
+	}
+	for _, obj := range r.config.objects() {
+		change, err := r.planObject(ctx, obj)
+		if err != nil {
+			return nil, err
+		}
+		p.Objects = append(p.Objects, change)
+	}
+	return p, nil
+}
+
+func (r *Restore) planNamespace(ctx context.Context, ns Namespace) (NamespaceChange, error) {
+	change := NamespaceChange{Name: ns.Name, Action: ActionCreate, Operators: ns.Operators}
+	namespace, err := r.kubeConnector.GetNamespace(ctx, types.NamespacedName{Name: ns.Name})
+	if k8serrors.IsNotFound(err) {
+		return change, nil
+	} else if err != nil {
+		return change, err
+	}
+	if namespace.GetLabels()[common.KubernetesManagedByLabel] != common.Everest {
+		change.exists = true
+		return change, nil
+	}
+
+	engines, err := r.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(ns.Name))
+	if err != nil {
+		return change, err
+	}
+	var installed []string
+	for _, engine := range engines.Items {
+		if engine.Status.State == everestv1alpha1.DBEngineStateInstalled {
+			installed = append(installed, string(engine.Spec.Type))
+		}
+	}
+	change.Action = ActionUnchanged
+	change.Operators = installed
+	for _, op := range ns.Operators {
+		if !slices.Contains(installed, op) {
+			// The installed operators are kept, only the missing ones are added.
+			change.Action = ActionUpdate
+			change.Operators = append(change.Operators, op)
+		}
+	}
+	slices.Sort(change.Operators)
+	return change, nil
+}
+
+func (r *Restore) planObject(ctx context.Context, obj *unstructured.Unstructured) (ObjectChange, error) {
+	change := ObjectChange{Resource: resourceName(obj), Action: ActionCreate, obj: obj}
+	existing, err := r.kubeConnector.GetUnstructuredObject(ctx, obj.GroupVersionKind(),
+		types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()})
+	if k8serrors.IsNotFound(err) {
+		return change, nil
+	} else if err != nil {
+		return change, fmt.Errorf("could not get %s: %w", change.Resource, err)
+	}
+
+	change.existing = existing
+	change.Fields = diffFields("", contentOf(existing), contentOf(obj))
+	switch {
+	case len(change.Fields) == 0:
+		change.Action = ActionUnchanged
+	case r.cfg.OnConflict == ConflictSkip:
+		change.Action = ActionSkip
+	case r.cfg.OnConflict == ConflictOverwrite:
+		change.Action = ActionUpdate
+	default:
+		change.Action = ActionConflict
+	}
+	return change, nil
+}
+
+// Run the restore operation.
+func (r *Restore) Run(ctx context.Context) error {
+	p, err := r.Plan(ctx)
+	if err != nil {
+		return err
+	}
+	if conflicts := p.Conflicts(); len(conflicts) > 0 {
+		return errors.Join(ErrConflict, fmt.Errorf("%s; use --on-conflict to skip or overwrite them",
+			strings.Join(conflicts, ", ")))
+	}
+
+	for _, ns := range p.Namespaces {
+		if ns.Action == ActionUnchanged {
+			continue
+		}
+		if err := r.restoreNamespace(ctx, ns); err != nil {
+			return fmt.Errorf("could not restore namespace '%s': %w", ns.Name, err)
+		}
+	}
+
+	var restoreSteps []steps.Step
+	restartServer := false
+	for _, change := range p.Objects {
+		if change.Action != ActionCreate && change.Action != ActionUpdate {
+			continue
+		}
+		restoreSteps = append(restoreSteps, r.newStepRestoreObject(change))
+		if change.obj.GetKind() == "Secret" && change.obj.GetName() == common.EverestJWTSecretName &&
+			change.obj.GetNamespace() == common.SystemNamespace {
+			restartServer = true
+		}
+	}
+	if restartServer {
+		restoreSteps = append(restoreSteps, steps.Step{
+			Desc: "Restarting Everest to load the restored JWT keys",
+			F: func(ctx context.Context) error {
+				return r.kubeConnector.RestartDeployment(ctx, types.NamespacedName{
+					Namespace: common.SystemNamespace,
+					Name:      common.PerconaEverestDeploymentName,
+				})
+			},
+		})
+	}
+	if err := steps.RunStepsWithSpinner(ctx, r.l, restoreSteps, r.cfg.Pretty); err != nil {
+		return err
+	}
+
+	r.l.Info("Everest configuration has been restored")
+	if r.cfg.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("Everest configuration has been restored"))
+	}
+	return nil
+}
+
+func (r *Restore) restoreNamespace(ctx context.Context, ns NamespaceChange) error {
+	cfg := namespaces.NewNamespaceAddConfig()
+	cfg.NamespaceList = []string{ns.Name}
+	cfg.SkipWizard = true
+	cfg.KubeconfigPath = r.cfg.KubeconfigPath
+	cfg.Pretty = r.cfg.Pretty
+	cfg.TakeOwnership = ns.exists
+	cfg.Update = ns.Action == ActionUpdate
+	for _, op := range ns.Operators {
+		switch everestv1alpha1.EngineType(op) {
+		case everestv1alpha1.DatabaseEnginePXC:
+			cfg.Operators.PXC = true
+		case everestv1alpha1.DatabaseEnginePSMDB:
+			cfg.Operators.PSMDB = true
+		case everestv1alpha1.DatabaseEnginePostgresql:
+			cfg.Operators.PG = true
+		default:
+			return fmt.Errorf("unknown operator '%s'", op)
+		}
+	}
+	adder, err := namespaces.NewNamespaceAdd(cfg, r.l)
+	if err != nil {
+		return err
+	}
+	return adder.Run(ctx)
+}
+
+func (r *Restore) newStepRestoreObject(change ObjectChange) steps.Step {
+	action := "Creating"
+	if change.Action == ActionUpdate {
+		action = "Overwriting"
+	}
+	return steps.Step{
+		Desc: fmt.Sprintf("%s %s", action, change.Resource),
+		F: func(ctx context.Context) error {
+			if change.Action == ActionCreate {
+				return r.kubeConnector.CreateUnstructuredObject(ctx, change.obj.DeepCopy())
+			}
+			// Keep the metadata of the existing object, only its content is replaced.
+			updated := change.existing.DeepCopy()
+			for k, v := range contentOf(change.obj) {
+				updated.Object[k] = v
+			}
+			return r.kubeConnector.UpdateUnstructuredObject(ctx, updated)
+		},
+	}
+}
+
+// Conflicts returns the objects that exist with a different content and cannot be restored.
+func (p *Plan) Conflicts() []string {
+	var result []string
+	for _, o := range p.Objects {
+		if o.Action == ActionConflict {
+			result = append(result, o.Resource)
+		}
+	}
+	return result
+}
+
+// WritePlan writes the changes applied by a restore in a human readable form.
+func WritePlan(w io.Writer, p *Plan) {
+	markers := map[Action]string{
+		ActionCreate:    "+",
+		ActionUpdate:    "~",
+		ActionSkip:      "-",
+		ActionUnchanged: "=",
+		ActionConflict:  "!",
+	}
+	_, _ = fmt.Fprintln(w, "Namespaces:")
+	if len(p.Namespaces) == 0 {
+		_, _ = fmt.Fprintln(w, "  none")
+	}
+	for _, ns := range p.Namespaces {
+		_, _ = fmt.Fprintf(w, "  %s %s (%s): %s\n", markers[ns.Action], ns.Name, strings.Join(ns.Operators, ", "), ns.Action)
+	}
+	_, _ = fmt.Fprintln(w, "Objects:")
+	if len(p.Objects) == 0 {
+		_, _ = fmt.Fprintln(w, "  none")
+	}
+	for _, o := range p.Objects {
+		_, _ = fmt.Fprintf(w, "  %s %s: %s\n", markers[o.Action], o.Resource, o.Action)
+		if o.Action == ActionUnchanged {
+			continue
+		}
+		for _, f := range o.Fields {
+			_, _ = fmt.Fprintf(w, "      %s\n", f)
+		}
+	}
+}
+
+// contentOf returns the fields of the object compared and restored, that is all but the metadata and the status.
+func contentOf(obj *unstructured.Unstructured) map[string]interface{} {
+	result := make(map[string]interface{}, len(obj.Object))
+	for k, v := range obj.Object {
+		if k == "apiVersion" || k == "kind" || k == "metadata" || k == "status" {
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// diffFields returns the paths of the leaf fields that differ between two decoded JSON values.
+func diffFields(path string, from, to interface{}) []string {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if fromIsMap && toIsMap {
+		keys := make([]string, 0, len(fromMap)+len(toMap))
+		for k := range fromMap {
+			keys = append(keys, k)
+		}
+		for k := range toMap {
+			if _, ok := fromMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		var result []string
+		for _, k := range keys {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			result = append(result, diffFields(p, fromMap[k], toMap[k])...)
+		}
+		return result
+	}
+	if equalValues(from, to) {
+		return nil
+	}
+	return []string{path}
+}
+
+// equalValues compares the decoded JSON values, regardless of the numeric types they are decoded to.
+func equalValues(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	aData, aErr := json.Marshal(a)
+	bData, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aData) == string(bData)
+}
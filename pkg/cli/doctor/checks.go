@@ -0,0 +1,423 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/oidc"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const (
+	// tlsCertsPathEnv is the environment variable of the Everest server holding the path to the TLS certificates.
+	tlsCertsPathEnv = "TLS_CERTS_PATH"
+	// certExpiryWarning is how long before its expiry a certificate is reported.
+	certExpiryWarning = 30 * 24 * time.Hour
+	// oidcTimeout is the timeout for fetching the OIDC discovery document.
+	oidcTimeout = 10 * time.Second
+	// catalogSourceReady is the connection state of a healthy catalog source.
+	catalogSourceReady = "READY"
+)
+
+// checkDeployments checks that the Everest API server and the Everest operator are ready.
+func (d *Doctor) checkDeployments(ctx context.Context) []Check {
+	var checks []Check
+	for _, name := range []string{common.PerconaEverestDeploymentName, common.PerconaEverestOperatorDeploymentName} {
+		checkName := "Deployment " + name
+		dep, err := d.kubeConnector.GetDeployment(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: name})
+		if err != nil {
+			checks = append(checks, fail(checkName, "could not get deployment: %s", err))
+			continue
+		}
+		want := int32(1)
+		if dep.Spec.Replicas != nil {
+			want = *dep.Spec.Replicas
+		}
+		if dep.Status.ReadyReplicas < want {
+			checks = append(checks, fail(checkName, "%d of %d replicas are ready", dep.Status.ReadyReplicas, want))
+			continue
+		}
+		checks = append(checks, pass(checkName, "%d of %d replicas are ready", dep.Status.ReadyReplicas, want))
+	}
+	return checks
+}
+
+// checkHelmReleases checks the status of the Everest release and of the releases of the database namespaces.
+func (d *Doctor) checkHelmReleases(ctx context.Context) []Check {
+	checks := []Check{d.checkHelmRelease(common.SystemNamespace, common.SystemNamespace)}
+	nsList, err := d.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return append(checks, fail("Helm releases", "could not get database namespaces: %s", err))
+	}
+	for _, ns := range nsList.Items {
+		// The release of each database namespace has the same name as the namespace.
+		checks = append(checks, d.checkHelmRelease(ns.GetName(), ns.GetName()))
+	}
+	return checks
+}
+
+func (d *Doctor) checkHelmRelease(name, namespace string) Check {
+	checkName := fmt.Sprintf("Helm release %s/%s", namespace, name)
+	info, err := d.getRelease(name, namespace)
+	if err != nil {
+		return fail(checkName, "could not get release: %s", err)
+	}
+	if info.Revision == 0 {
+		return fail(checkName, "release not found")
+	}
+	switch info.Status {
+	case release.StatusDeployed.String():
+		return pass(checkName, "chart %s is deployed (revision %d)", info.ChartVersion, info.Revision)
+	case release.StatusFailed.String():
+		return fail(checkName, "chart %s is in status %s (revision %d)", info.ChartVersion, info.Status, info.Revision)
+	default:
+		return warn(checkName, "chart %s is in status %s (revision %d)", info.ChartVersion, info.Status, info.Revision)
+	}
+}
+
+// checkCRDs checks that the installed CRDs match the CRDs of the installed Everest chart.
+func (d *Doctor) checkCRDs(ctx context.Context) []Check {
+	info, err := d.getRelease(common.SystemNamespace, common.SystemNamespace)
+	if err != nil || info.Revision == 0 {
+		return []Check{fail("CRDs", "could not get the CRDs of the Everest chart: release not found")}
+	}
+	var checks []Check
+	count := 0
+	for _, file := range info.CRDs {
+		docs := helm.NewRenderedTemplate(file)
+		for _, doc := range docs.Strings() {
+			expected := &apiextv1.CustomResourceDefinition{}
+			if err := yaml.Unmarshal([]byte(doc), expected); err != nil {
+				return []Check{fail("CRDs", "could not parse the CRDs of the Everest chart: %s", err)}
+			}
+			if expected.GetName() == "" {
+				continue
+			}
+			count++
+			checkName := "CRD " + expected.GetName()
+			current, err := d.kubeConnector.GetCRD(ctx, types.NamespacedName{Name: expected.GetName()})
+			if k8serrors.IsNotFound(err) {
+				checks = append(checks, fail(checkName, "not installed"))
+				continue
+			} else if err != nil {
+				checks = append(checks, fail(checkName, "could not get CRD: %s", err))
+				continue
+			}
+			if c := compareCRD(checkName, current, expected, info.ChartVersion); c != nil {
+				checks = append(checks, *c)
+			}
+		}
+	}
+	if len(checks) == 0 {
+		checks = append(checks, pass("CRDs", "%d CRDs match the Everest chart %s", count, info.ChartVersion))
+	}
+	return checks
+}
+
+// compareCRD returns a failed check if the installed CRD does not serve the versions of the chart,
+// or nil if the CRD matches the chart.
+func compareCRD(checkName string, current, expected *apiextv1.CustomResourceDefinition, chartVersion string) *Check {
+	if cur, exp := servedVersions(current), servedVersions(expected); !slices.Equal(cur, exp) {
+		c := fail(checkName, "serves versions %s, the chart %s serves %s",
+			strings.Join(cur, ", "), chartVersion, strings.Join(exp, ", "))
+		return &c
+	}
+	if cur, exp := storageVersion(current), storageVersion(expected); cur != exp {
+		c := fail(checkName, "stores version %s, the chart %s stores %s", cur, chartVersion, exp)
+		return &c
+	}
+	for _, exp := range expected.Spec.Versions {
+		for _, cur := range current.Spec.Versions {
+			if cur.Name == exp.Name && !reflect.DeepEqual(cur.Schema, exp.Schema) {
+				c := warn(checkName, "the schema of version %s differs from the chart %s", exp.Name, chartVersion)
+				return &c
+			}
+		}
+	}
+	return nil
+}
+
+func servedVersions(crd *apiextv1.CustomResourceDefinition) []string {
+	var result []string
+	for _, v := range crd.Spec.Versions {
+		if v.Served {
+			result = append(result, v.Name)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+func storageVersion(crd *apiextv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return ""
+}
+
+// checkNamespaces checks the database operators of the namespaces managed by Everest, and looks for
+// namespaces with database engines which are not labeled as managed by Everest.
+func (d *Doctor) checkNamespaces(ctx context.Context) []Check {
+	nsList, err := d.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return []Check{fail("Database namespaces", "could not get database namespaces: %s", err)}
+	}
+	if len(nsList.Items) == 0 {
+		return []Check{warn("Database namespaces", "no namespace is managed by Everest")}
+	}
+
+	var checks []Check
+	managed := make([]string, 0, len(nsList.Items))
+	for _, ns := range nsList.Items {
+		managed = append(managed, ns.GetName())
+		checkName := "Namespace " + ns.GetName()
+		engines, err := d.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(ns.GetName()))
+		if err != nil {
+			checks = append(checks, fail(checkName, "could not list database engines: %s", err))
+			continue
+		}
+		var installed, pending []string
+		for _, e := range engines.Items {
+			switch e.Status.State {
+			case everestv1alpha1.DBEngineStateInstalled:
+				installed = append(installed, fmt.Sprintf("%s(v%s)", e.Spec.Type, e.Status.OperatorVersion))
+			case everestv1alpha1.DBEngineStateInstalling, everestv1alpha1.DBEngineStateUpgrading:
+				pending = append(pending, fmt.Sprintf("%s(%s)", e.Spec.Type, e.Status.State))
+			}
+		}
+		slices.Sort(installed)
+		slices.Sort(pending)
+		switch {
+		case len(pending) > 0:
+			checks = append(checks, warn(checkName, "operators are not ready: %s", strings.Join(pending, ", ")))
+		case len(installed) == 0:
+			checks = append(checks, fail(checkName, "no database operator is installed"))
+		default:
+			checks = append(checks, pass(checkName, "operators: %s", strings.Join(installed, ", ")))
+		}
+	}
+
+	engines, err := d.kubeConnector.ListDatabaseEngines(ctx)
+	if err != nil {
+		return append(checks, fail("Database namespaces", "could not list database engines: %s", err))
+	}
+	var unlabeled []string
+	for _, e := range engines.Items {
+		ns := e.GetNamespace()
+		if !slices.Contains(managed, ns) && !slices.Contains(unlabeled, ns) {
+			unlabeled = append(unlabeled, ns)
+		}
+	}
+	slices.Sort(unlabeled)
+	for _, ns := range unlabeled {
+		checks = append(checks, warn("Namespace "+ns, "has database engines but is not labeled %s=%s",
+			common.KubernetesManagedByLabel, common.Everest))
+	}
+	return checks
+}
+
+// checkOLMLeftovers looks for the OLM resources left by the installations of Everest older than 1.4.0.
+func (d *Doctor) checkOLMLeftovers(ctx context.Context) []Check {
+	const checkName = "OLM leftovers"
+	var found []string
+	if _, err := d.kubeConnector.GetNamespace(ctx, types.NamespacedName{Name: kubernetes.OLMNamespace}); err == nil {
+		found = append(found, "namespace "+kubernetes.OLMNamespace)
+	} else if !k8serrors.IsNotFound(err) {
+		return []Check{fail(checkName, "could not get namespace %s: %s", kubernetes.OLMNamespace, err)}
+	}
+	subs, err := d.kubeConnector.ListSubscriptions(ctx)
+	if err != nil && !isNotInstalled(err) {
+		return []Check{fail(checkName, "could not list subscriptions: %s", err)}
+	}
+	if subs != nil {
+		for _, sub := range subs.Items {
+			found = append(found, fmt.Sprintf("subscription %s/%s", sub.GetNamespace(), sub.GetName()))
+		}
+	}
+	if len(found) == 0 {
+		return []Check{pass(checkName, "no OLM resources from legacy installations found")}
+	}
+	return []Check{warn(checkName, "found %s", strings.Join(found, ", "))}
+}
+
+// checkCatalogSource checks the health of the Everest catalog source used by the OLM based installations.
+func (d *Doctor) checkCatalogSource(ctx context.Context) []Check {
+	const checkName = "Catalog source"
+	cs, err := d.kubeConnector.GetCatalogSource(ctx, types.NamespacedName{
+		Namespace: kubernetes.OLMNamespace,
+		Name:      common.PerconaEverestCatalogName,
+	})
+	if k8serrors.IsNotFound(err) || isNotInstalled(err) {
+		return []Check{pass(checkName, "not used by this installation")}
+	} else if err != nil {
+		return []Check{fail(checkName, "could not get catalog source: %s", err)}
+	}
+	state := ""
+	if cs.Status.GRPCConnectionState != nil {
+		state = cs.Status.GRPCConnectionState.LastObservedState
+	}
+	if state != catalogSourceReady {
+		return []Check{warn(checkName, "catalog source %s/%s is in state %q", cs.GetNamespace(), cs.GetName(), state)}
+	}
+	return []Check{pass(checkName, "catalog source %s/%s is ready", cs.GetNamespace(), cs.GetName())}
+}
+
+// checkCertificate checks the expiry of the TLS certificate of the Everest server, which is read from
+// the secret mounted at the TLS certificates path of the server.
+func (d *Doctor) checkCertificate(ctx context.Context) []Check {
+	const checkName = "TLS certificate"
+	dep, err := d.kubeConnector.GetDeployment(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.PerconaEverestDeploymentName,
+	})
+	if err != nil {
+		return []Check{fail(checkName, "could not get deployment: %s", err)}
+	}
+	idx := slices.IndexFunc(dep.Spec.Template.Spec.Containers, func(c corev1.Container) bool {
+		return c.Name == common.EverestContainerNameInDeployment
+	})
+	if idx < 0 {
+		return []Check{fail(checkName, "container %s not found", common.EverestContainerNameInDeployment)}
+	}
+	container := dep.Spec.Template.Spec.Containers[idx]
+
+	certsPath := ""
+	for _, env := range container.Env {
+		if env.Name == tlsCertsPathEnv {
+			certsPath = env.Value
+		}
+	}
+	if certsPath == "" {
+		return []Check{pass(checkName, "TLS is not enabled on the Everest server")}
+	}
+	volume := ""
+	for _, m := range container.VolumeMounts {
+		if filepath.Clean(m.MountPath) == filepath.Clean(certsPath) {
+			volume = m.Name
+		}
+	}
+	secretName := ""
+	for _, v := range dep.Spec.Template.Spec.Volumes {
+		if v.Name == volume && v.Secret != nil {
+			secretName = v.Secret.SecretName
+		}
+	}
+	if secretName == "" {
+		return []Check{warn(checkName, "the certificates at %s are not mounted from a secret, the expiry is not checked", certsPath)}
+	}
+
+	secret, err := d.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: secretName})
+	if err != nil {
+		return []Check{fail(checkName, "could not get secret %s: %s", secretName, err)}
+	}
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return []Check{fail(checkName, "could not parse the certificate of secret %s: %s", secretName, err)}
+	}
+	return []Check{certificateCheck(checkName, cert, time.Now())}
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func certificateCheck(checkName string, cert *x509.Certificate, now time.Time) Check {
+	expiry := cert.NotAfter.UTC().Format(time.RFC3339)
+	switch {
+	case now.After(cert.NotAfter):
+		return fail(checkName, "expired on %s", expiry)
+	case cert.NotAfter.Sub(now) < certExpiryWarning:
+		return warn(checkName, "expires on %s", expiry)
+	default:
+		return pass(checkName, "valid until %s", expiry)
+	}
+}
+
+// checkRBACPolicy checks that the RBAC policy is valid if RBAC is enabled.
+func (d *Doctor) checkRBACPolicy(ctx context.Context) []Check {
+	const checkName = "RBAC policy"
+	cm, err := d.kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.EverestRBACConfigMapName,
+	})
+	if err != nil {
+		return []Check{fail(checkName, "could not get ConfigMap %s: %s", common.EverestRBACConfigMapName, err)}
+	}
+	if !rbac.IsEnabled(cm) {
+		return []Check{pass(checkName, "RBAC is disabled")}
+	}
+	if err := rbac.ValidatePolicy(ctx, d.kubeConnector, ""); err != nil {
+		return []Check{fail(checkName, "%s", err)}
+	}
+	return []Check{pass(checkName, "the policy is valid")}
+}
+
+// checkOIDC checks that the discovery document of the configured OIDC provider can be fetched.
+func (d *Doctor) checkOIDC(ctx context.Context) []Check {
+	const checkName = "OIDC"
+	settings, err := d.kubeConnector.GetEverestSettings(ctx)
+	if err != nil {
+		return []Check{fail(checkName, "could not get Everest settings: %s", err)}
+	}
+	cfg, err := settings.OIDCConfig()
+	if err != nil {
+		return []Check{fail(checkName, "could not parse the OIDC configuration: %s", err)}
+	}
+	if cfg.IssuerURL == "" {
+		return []Check{pass(checkName, "OIDC is not configured")}
+	}
+	ctx, cancel := context.WithTimeout(ctx, oidcTimeout)
+	defer cancel()
+	if _, err := oidc.NewProviderConfig(ctx, cfg.IssuerURL); err != nil {
+		return []Check{fail(checkName, "could not fetch the discovery document of %s: %s", cfg.IssuerURL, err)}
+	}
+	return []Check{pass(checkName, "the discovery document of %s is reachable", cfg.IssuerURL)}
+}
+
+// isNotInstalled returns true if the error is caused by a kind which is not installed in the cluster.
+func isNotInstalled(err error) bool {
+	return meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err)
+}
@@ -0,0 +1,152 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package doctor provides the functionality to diagnose an Everest installation.
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const (
+	// StatusPass is set if the check succeeded.
+	StatusPass Status = "pass"
+	// StatusWarn is set if the check found a problem that does not break Everest.
+	StatusWarn Status = "warn"
+	// StatusFail is set if the check found a problem that breaks Everest.
+	StatusFail Status = "fail"
+)
+
+type (
+	// Status is the result of a check.
+	Status string
+
+	// Check is the result of a diagnostic check.
+	Check struct {
+		Name    string `json:"name"`
+		Status  Status `json:"status"`
+		Message string `json:"message"`
+	}
+
+	// Report lists the results of the diagnostic checks.
+	Report struct {
+		Checks []Check `json:"checks"`
+	}
+
+	// Config is the configuration for the doctor operation.
+	Config struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+	}
+
+	// Doctor is the CLI operation to diagnose an Everest installation.
+	Doctor struct {
+		cfg           Config
+		kubeConnector kubernetes.KubernetesConnector
+		l             *zap.SugaredLogger
+		// getRelease returns the Helm release with the name from the namespace.
+		getRelease func(name, namespace string) (helm.ReleaseInfo, error)
+	}
+)
+
+// NewDoctor returns a new CLI operation to diagnose an Everest installation.
+func NewDoctor(cfg Config, l *zap.SugaredLogger) (*Doctor, error) {
+	d := &Doctor{
+		cfg: cfg,
+		l:   l.With("component", "doctor"),
+	}
+	if cfg.Pretty {
+		d.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(d.l, cfg.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	d.kubeConnector = k
+	d.getRelease = func(name, namespace string) (helm.ReleaseInfo, error) {
+		return helm.GetReleaseInfo(name, namespace, cfg.KubeconfigPath)
+	}
+	return d, nil
+}
+
+// Run all the diagnostic checks.
+// A check that cannot be performed is reported as failed, so that the other checks still run.
+func (d *Doctor) Run(ctx context.Context) *Report {
+	checks := []func(ctx context.Context) []Check{
+		d.checkDeployments,
+		d.checkHelmReleases,
+		d.checkCRDs,
+		d.checkNamespaces,
+		d.checkOLMLeftovers,
+		d.checkCatalogSource,
+		d.checkCertificate,
+		d.checkRBACPolicy,
+		d.checkOIDC,
+		d.checkBackupStorages,
+	}
+	r := &Report{Checks: []Check{}}
+	for _, check := range checks {
+		r.Checks = append(r.Checks, check(ctx)...)
+	}
+	return r
+}
+
+// Failed returns true if any check failed.
+func (r *Report) Failed() bool {
+	for _, c := range r.Checks {
+		if c.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteReport writes the results of the checks in a human readable form.
+func WriteReport(w io.Writer, r *Report) {
+	markers := map[Status]string{
+		StatusPass: "✓",
+		StatusWarn: "!",
+		StatusFail: "✗",
+	}
+	counts := map[Status]int{}
+	for _, c := range r.Checks {
+		counts[c.Status]++
+		_, _ = fmt.Fprintf(w, "%s %s: %s\n", markers[c.Status], c.Name, c.Message)
+	}
+	_, _ = fmt.Fprintf(w, "\n%d passed, %d warnings, %d failed\n", counts[StatusPass], counts[StatusWarn], counts[StatusFail])
+}
+
+func pass(name, msg string, args ...any) Check {
+	return Check{Name: name, Status: StatusPass, Message: fmt.Sprintf(msg, args...)}
+}
+
+func warn(name, msg string, args ...any) Check {
+	return Check{Name: name, Status: StatusWarn, Message: fmt.Sprintf(msg, args...)}
+}
+
+func fail(name, msg string, args ...any) Check {
+	return Check{Name: name, Status: StatusFail, Message: fmt.Sprintf(msg, args...)}
+}
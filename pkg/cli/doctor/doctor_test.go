@@ -0,0 +1,198 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const testCRD = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: databaseclusters.everest.percona.com
+spec:
+  group: everest.percona.com
+  names:
+    kind: DatabaseCluster
+    plural: databaseclusters
+  scope: Namespaced
+  versions:
+  - name: v1alpha1
+    served: true
+    storage: true
+`
+
+func newTestDoctor(releases map[string]helm.ReleaseInfo, objs ...ctrlclient.Object) *Doctor {
+	c := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(objs...).Build()
+	return &Doctor{
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(c),
+		l:             zap.NewNop().Sugar(),
+		getRelease: func(name, _ string) (helm.ReleaseInfo, error) {
+			return releases[name], nil
+		},
+	}
+}
+
+func testDeployment(name string, ready int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.SystemNamespace},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.To(int32(1)),
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: common.EverestContainerNameInDeployment}},
+			}},
+		},
+		Status: appsv1.DeploymentStatus{ReadyReplicas: ready},
+	}
+}
+
+func testEngine(namespace string, engineType everestv1alpha1.EngineType, state everestv1alpha1.DBEngineState) *everestv1alpha1.DatabaseEngine {
+	return &everestv1alpha1.DatabaseEngine{
+		ObjectMeta: metav1.ObjectMeta{Name: string(engineType), Namespace: namespace},
+		Spec:       everestv1alpha1.DatabaseEngineSpec{Type: engineType},
+		Status:     everestv1alpha1.DatabaseEngineStatus{State: state, OperatorVersion: "1.0.0"},
+	}
+}
+
+func TestChecks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	d := newTestDoctor(
+		map[string]helm.ReleaseInfo{
+			common.SystemNamespace: {Revision: 2, ChartVersion: "1.5.0", Status: "deployed", CRDs: []string{testCRD}},
+			"ns-1":                 {Revision: 1, ChartVersion: "1.5.0", Status: "failed"},
+		},
+		testDeployment(common.PerconaEverestDeploymentName, 1),
+		testDeployment(common.PerconaEverestOperatorDeploymentName, 0),
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "ns-1",
+			Labels: map[string]string{common.KubernetesManagedByLabel: common.Everest},
+		}},
+		testEngine("ns-1", everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.DBEngineStateInstalled),
+		testEngine("ns-1", everestv1alpha1.DatabaseEnginePSMDB, everestv1alpha1.DBEngineStateNotInstalled),
+		testEngine("ns-2", everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.DBEngineStateInstalled),
+		&apiextv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "databaseclusters.everest.percona.com"},
+			Spec: apiextv1.CustomResourceDefinitionSpec{Versions: []apiextv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true, Storage: false},
+				{Name: "v1", Served: true, Storage: true},
+			}},
+		},
+	)
+
+	assert.Equal(t, []Check{
+		pass("Deployment everest-server", "1 of 1 replicas are ready"),
+		fail("Deployment everest-operator", "0 of 1 replicas are ready"),
+	}, d.checkDeployments(ctx))
+	assert.Equal(t, []Check{
+		pass("Helm release everest-system/everest-system", "chart 1.5.0 is deployed (revision 2)"),
+		fail("Helm release ns-1/ns-1", "chart 1.5.0 is in status failed (revision 1)"),
+	}, d.checkHelmReleases(ctx))
+	assert.Equal(t, []Check{
+		fail("CRD databaseclusters.everest.percona.com", "serves versions v1, v1alpha1, the chart 1.5.0 serves v1alpha1"),
+	}, d.checkCRDs(ctx))
+	assert.Equal(t, []Check{
+		pass("Namespace ns-1", "operators: pxc(v1.0.0)"),
+		warn("Namespace ns-2", "has database engines but is not labeled app.kubernetes.io/managed-by=everest"),
+	}, d.checkNamespaces(ctx))
+	assert.Equal(t, []Check{
+		pass("OLM leftovers", "no OLM resources from legacy installations found"),
+	}, d.checkOLMLeftovers(ctx))
+	assert.Equal(t, []Check{
+		pass("TLS certificate", "TLS is not enabled on the Everest server"),
+	}, d.checkCertificate(ctx))
+}
+
+func TestCheckCertificate(t *testing.T) {
+	t.Parallel()
+
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "everest"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	cert, err := parseCertificate(certPEM)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPass, certificateCheck("c", cert, notAfter.Add(-60*24*time.Hour)).Status)
+	assert.Equal(t, StatusWarn, certificateCheck("c", cert, notAfter.Add(-7*24*time.Hour)).Status)
+	assert.Equal(t, StatusFail, certificateCheck("c", cert, notAfter.Add(time.Hour)).Status)
+
+	dep := testDeployment(common.PerconaEverestDeploymentName, 1)
+	dep.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: tlsCertsPathEnv, Value: "/etc/tls/"}}
+	dep.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{Name: "certs", MountPath: "/etc/tls"}}
+	dep.Spec.Template.Spec.Volumes = []corev1.Volume{{
+		Name:         "certs",
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "everest-tls"}},
+	}}
+	d := newTestDoctor(nil, dep, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "everest-tls", Namespace: common.SystemNamespace},
+		Data:       map[string][]byte{corev1.TLSCertKey: certPEM},
+	})
+	checks := d.checkCertificate(context.Background())
+	require.Len(t, checks, 1)
+	assert.Contains(t, checks[0].Message, "2030-01-01T00:00:00Z")
+}
+
+func TestWriteReport(t *testing.T) {
+	t.Parallel()
+
+	r := &Report{Checks: []Check{
+		pass("OIDC", "OIDC is not configured"),
+		fail("Deployment everest-operator", "0 of 1 replicas are ready"),
+	}}
+	assert.True(t, r.Failed())
+
+	var buf bytes.Buffer
+	WriteReport(&buf, r)
+	assert.Equal(t, "✓ OIDC: OIDC is not configured\n"+
+		"✗ Deployment everest-operator: 0 of 1 replicas are ready\n"+
+		"\n1 passed, 0 warnings, 1 failed\n", buf.String())
+}
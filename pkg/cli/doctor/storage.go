@@ -0,0 +1,128 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+// storageTimeout is the timeout for accessing a backup storage.
+const storageTimeout = 10 * time.Second
+
+// checkBackupStorages checks that the bucket of every backup storage can be accessed with its credentials.
+// The storages are accessed from the host running the command, so an unreachable storage is only reported
+// as a warning: it may still be reachable from the cluster.
+func (d *Doctor) checkBackupStorages(ctx context.Context) []Check {
+	nsList, err := d.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return []Check{fail("Backup storages", "could not get database namespaces: %s", err)}
+	}
+	var checks []Check
+	for _, ns := range nsList.Items {
+		storages, err := d.kubeConnector.ListBackupStorages(ctx, ctrlclient.InNamespace(ns.GetName()))
+		if err != nil {
+			checks = append(checks, fail("Backup storages", "could not list backup storages in namespace %s: %s", ns.GetName(), err))
+			continue
+		}
+		for _, bs := range storages.Items {
+			checks = append(checks, d.checkBackupStorage(ctx, &bs))
+		}
+	}
+	if len(checks) == 0 {
+		checks = append(checks, pass("Backup storages", "no backup storage is configured"))
+	}
+	return checks
+}
+
+func (d *Doctor) checkBackupStorage(ctx context.Context, bs *everestv1alpha1.BackupStorage) Check {
+	checkName := fmt.Sprintf("Backup storage %s/%s", bs.GetNamespace(), bs.GetName())
+	secret, err := d.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: bs.GetNamespace(), Name: bs.Spec.CredentialsSecretName})
+	if err != nil {
+		return fail(checkName, "could not get credentials secret %s: %s", bs.Spec.CredentialsSecretName, err)
+	}
+	accessKey := string(secret.Data["AWS_ACCESS_KEY_ID"])
+	secretKey := string(secret.Data["AWS_SECRET_ACCESS_KEY"])
+
+	ctx, cancel := context.WithTimeout(ctx, storageTimeout)
+	defer cancel()
+	switch bs.Spec.Type {
+	case everestv1alpha1.BackupStorageTypeS3:
+		err = s3Access(ctx, bs, accessKey, secretKey)
+	case everestv1alpha1.BackupStorageTypeAzure:
+		err = azureAccess(ctx, bs, accessKey, secretKey)
+	default:
+		return warn(checkName, "access to storage type %s is not checked", bs.Spec.Type)
+	}
+	if err != nil {
+		return warn(checkName, "could not access bucket %s from this host: %s", bs.Spec.Bucket, err)
+	}
+	return pass(checkName, "bucket %s is accessible", bs.Spec.Bucket)
+}
+
+// s3Access checks that the bucket exists and can be accessed, without writing to it.
+func s3Access(ctx context.Context, bs *everestv1alpha1.BackupStorage, accessKey, secretKey string) error {
+	var endpoint *string
+	if bs.Spec.EndpointURL != "" {
+		endpoint = aws.String(bs.Spec.EndpointURL)
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:    endpoint,
+		Region:      aws.String(bs.Spec.Region),
+		Credentials: credentials.NewStaticCredentials(accessKey, secretKey, ""),
+		HTTPClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: !pointer.Get(bs.Spec.VerifyTLS)}, //nolint:gosec
+			},
+		},
+		S3ForcePathStyle: aws.Bool(pointer.Get(bs.Spec.ForcePathStyle)),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s3.New(sess).HeadBucketWithContext(ctx, &s3.HeadBucketInput{Bucket: aws.String(bs.Spec.Bucket)})
+	return err
+}
+
+// azureAccess checks that the blobs of the container can be listed.
+func azureAccess(ctx context.Context, bs *everestv1alpha1.BackupStorage, accountName, accountKey string) error {
+	cred, err := azblob.NewSharedKeyCredential(accountName, accountKey)
+	if err != nil {
+		return err
+	}
+	client, err := azblob.NewClientWithSharedKeyCredential(
+		fmt.Sprintf("https://%s.blob.core.windows.net/", url.PathEscape(accountName)), cred, nil)
+	if err != nil {
+		return err
+	}
+	_, err = client.NewListBlobsFlatPager(bs.Spec.Bucket, nil).NextPage(ctx)
+	return err
+}
@@ -36,6 +36,12 @@ const (
 	FlagOperatorMySQL = "operator.mysql"
 	// FlagOperatorMongoDB represents the psmdb operator flag.
 	FlagOperatorMongoDB = "operator.mongodb"
+	// FlagOperatorPostgresqlVersion represents the pg operator version flag.
+	FlagOperatorPostgresqlVersion = "operator.postgresql-version"
+	// FlagOperatorMySQLVersion represents the MySQL operator version flag.
+	FlagOperatorMySQLVersion = "operator.mysql-version"
+	// FlagOperatorMongoDBVersion represents the psmdb operator version flag.
+	FlagOperatorMongoDBVersion = "operator.mongodb-version"
 	// FlagNamespaces represents the namespaces flag.
 	FlagNamespaces = "namespaces"
 	// FlagVersionMetadataURL represents the version service url flag.
@@ -50,6 +56,12 @@ const (
 	FlagDisableTelemetry = "disable-telemetry"
 	// FlagInstallSkipDBNamespace is the name of the skip-db-namespace flag.
 	FlagInstallSkipDBNamespace = "skip-db-namespace"
+	// FlagInstallProfile is the name of the profile flag.
+	FlagInstallProfile = "profile"
+	// FlagInstallConfig is the name of the config flag.
+	FlagInstallConfig = "config"
+	// FlagEnableNamespaceProvisioning is the name of the enable-namespace-provisioning flag.
+	FlagEnableNamespaceProvisioning = "enable-namespace-provisioning"
 
 	// `namespaces` flags
 
@@ -61,6 +73,54 @@ const (
 	FlagNamespaceForce = "force"
 	// FlagNamespaceAll is the name of the all flag.
 	FlagNamespaceAll = "all"
+	// FlagQuotaCPU is the name of the quota-cpu flag.
+	FlagQuotaCPU = "quota-cpu"
+	// FlagQuotaMemory is the name of the quota-memory flag.
+	FlagQuotaMemory = "quota-memory"
+	// FlagQuotaDisk is the name of the quota-disk flag.
+	FlagQuotaDisk = "quota-disk"
+	// FlagQuotaDatabaseClusters is the name of the quota-database-clusters flag.
+	FlagQuotaDatabaseClusters = "quota-database-clusters"
+	// FlagQuotaBackups is the name of the quota-backups flag.
+	FlagQuotaBackups = "quota-backups"
+
+	// `bundle` flags
+
+	// FlagBundle is the name of the bundle flag.
+	FlagBundle = "bundle"
+	// FlagImageRegistry is the name of the image-registry flag.
+	FlagImageRegistry = "image-registry"
+	// FlagBundleOutput is the name of the output flag.
+	FlagBundleOutput = "output"
+
+	// `uninstall` flags
+
+	// FlagUninstallBackupStorage is the name of the backup-storage flag.
+	FlagUninstallBackupStorage = "backup-storage"
+	// FlagUninstallBackupTimeout is the name of the backup-timeout flag.
+	FlagUninstallBackupTimeout = "backup-timeout"
+	// FlagUninstallExport is the name of the export flag.
+	FlagUninstallExport = "export"
+	// FlagUninstallKeepDatabases is the name of the keep-databases flag.
+	FlagUninstallKeepDatabases = "keep-databases"
+
+	// `backup-config` and `restore-config` flags
+
+	// FlagConfigBackupOutput is the name of the output flag.
+	FlagConfigBackupOutput = "output"
+	// FlagConfigBackupIncludeJWTKeys is the name of the include-jwt-keys flag.
+	FlagConfigBackupIncludeJWTKeys = "include-jwt-keys"
+	// FlagConfigRestoreOnConflict is the name of the on-conflict flag.
+	FlagConfigRestoreOnConflict = "on-conflict"
+	// FlagConfigRestoreDryRun is the name of the dry-run flag.
+	FlagConfigRestoreDryRun = "dry-run"
+
+	// `support-bundle` flags
+
+	// FlagSupportBundleOutput is the name of the output flag.
+	FlagSupportBundleOutput = "output"
+	// FlagSupportBundleLogLines is the name of the log-lines flag.
+	FlagSupportBundleLogLines = "log-lines"
 
 	// `upgrade` flags
 
@@ -68,6 +128,40 @@ const (
 	FlagUpgradeDryRun = "dry-run"
 	// FlagUpgradeInCluster is the name of the in-cluster flag.
 	FlagUpgradeInCluster = "in-cluster"
+	// FlagUpgradeDatabases is the name of the databases flag.
+	FlagUpgradeDatabases = "databases"
+	// FlagUpgradeBatchSize is the name of the batch-size flag.
+	FlagUpgradeBatchSize = "batch-size"
+	// FlagUpgradeNoWait is the name of the no-wait flag.
+	FlagUpgradeNoWait = "no-wait"
+	// FlagUpgradeRollback is the name of the rollback flag.
+	FlagUpgradeRollback = "rollback"
+	// FlagUpgradeNoAutoRollback is the name of the no-auto-rollback flag.
+	FlagUpgradeNoAutoRollback = "no-auto-rollback"
+
+	// `monitoring` flags
+
+	// FlagMonitoringPMMURL is the name of the pmm-url flag.
+	FlagMonitoringPMMURL = "pmm-url"
+	// FlagMonitoringPMMUser is the name of the pmm-user flag.
+	FlagMonitoringPMMUser = "pmm-user"
+	// FlagMonitoringPMMPassword is the name of the pmm-password flag.
+	FlagMonitoringPMMPassword = "pmm-password"
+	// FlagMonitoringSkipTLSVerify is the name of the skip-tls-verify flag.
+	FlagMonitoringSkipTLSVerify = "skip-tls-verify"
+
+	// `report` flags
+
+	// FlagReportFrom is the name of the from flag.
+	FlagReportFrom = "from"
+	// FlagReportTo is the name of the to flag.
+	FlagReportTo = "to"
+	// FlagReportNamespace is the name of the namespace flag.
+	FlagReportNamespace = "namespace"
+	// FlagReportGroupBy is the name of the group-by flag.
+	FlagReportGroupBy = "group-by"
+	// FlagReportCSV is the name of the csv flag.
+	FlagReportCSV = "csv"
 
 	// `accounts` flags
 
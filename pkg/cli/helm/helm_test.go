@@ -10,6 +10,8 @@ import (
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chartutil"
 	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+
+	"github.com/percona/everest/pkg/common"
 )
 
 func TestHelm_RenderTemplates(t *testing.T) {
@@ -53,3 +55,84 @@ func TestHelm_RenderTemplates(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, crds, 2)
 }
+
+func TestHelm_RenderTemplatesImageRegistry(t *testing.T) {
+	t.Parallel()
+
+	installer := Installer{
+		ReleaseName:      "test-release",
+		ReleaseNamespace: "test-ns",
+		ImageRegistry:    "registry.example.com/mirror/",
+	}
+	err := installer.Init("", ChartOptions{
+		Directory: "../../../data/testchart",
+		Version:   "0.1.0",
+	})
+	require.NoError(t, err)
+
+	rendered, err := installer.RenderTemplates(context.Background())
+	require.NoError(t, err)
+	deployments, err := rendered.filter("deployment.yaml")
+	require.NoError(t, err)
+	require.Len(t, deployments, 1)
+	assert.Contains(t, deployments[0], "image: registry.example.com/mirror/nginx:1.16.0")
+}
+
+func TestPinOperatorVersion(t *testing.T) {
+	t.Parallel()
+
+	pins := OperatorVersionsFromValues(map[string]interface{}{
+		OperatorVersionsValue: map[string]interface{}{"psmdb": "1.18.0", "pxc": ""},
+	})
+	assert.Equal(t, map[string]string{"psmdb": "1.18.0"}, pins)
+
+	sub := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"kind": "Subscription",
+			"spec": map[string]interface{}{"name": name, "channel": "stable-v1"},
+		}
+	}
+	psmdb := sub("percona-server-mongodb-operator")
+	pinOperatorVersion(psmdb, pins)
+	assert.Equal(t, "percona-server-mongodb-operator.v1.18.0", psmdb["spec"].(map[string]interface{})["startingCSV"])
+	assert.Equal(t, map[string]interface{}{common.OperatorVersionPinnedAnnotation: "1.18.0"},
+		psmdb["metadata"].(map[string]interface{})["annotations"])
+
+	pxc := sub("percona-xtradb-cluster-operator")
+	pinOperatorVersion(pxc, pins)
+	assert.NotContains(t, pxc["spec"], "startingCSV")
+	assert.NotContains(t, pxc, "metadata")
+}
+
+func TestRepoURLFromValues(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]interface{}{}
+	assert.Equal(t, DefaultHelmRepoURL, RepoURLFromValues(values))
+
+	for k, v := range NewValues(Values{RepoURL: "https://charts.example.com/"}) {
+		values[k] = v
+	}
+	assert.Equal(t, "https://charts.example.com/", RepoURLFromValues(values))
+}
+
+func TestRewriteImageRegistry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		image    string
+		expected string
+	}{
+		{image: "nginx:1.16.0", expected: "mirror.local/nginx:1.16.0"},
+		{image: "percona/everest:1.5.0", expected: "mirror.local/percona/everest:1.5.0"},
+		{image: "docker.io/percona/everest:1.5.0", expected: "mirror.local/percona/everest:1.5.0"},
+		{image: "localhost:5000/percona/everest@sha256:abc", expected: "mirror.local/percona/everest@sha256:abc"},
+		{image: "localhost/everest", expected: "mirror.local/everest"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.image, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, RewriteImageRegistry(tc.image, "mirror.local"))
+		})
+	}
+}
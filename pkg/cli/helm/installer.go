@@ -34,6 +34,7 @@ import (
 	"helm.sh/helm/v3/pkg/cli/values"
 	"helm.sh/helm/v3/pkg/downloader"
 	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/postrender"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -61,6 +62,11 @@ type (
 		ChartDir string
 		// RepoURL URL of the Helm repository to download the chart from.
 		RepoURL string
+		// ArchiveDir path to the local directory with the packaged Helm charts, such as an extracted offline bundle.
+		// If set, ChartDir and RepoURL are ignored.
+		ArchiveDir string
+		// ImageRegistry is the registry to pull all the images from instead of their original registries.
+		ImageRegistry string
 		// Values Helm values to be used during installation.
 		Values values.Options
 		// Devel indicates whether to use development versions of Helm charts, if available.
@@ -83,6 +89,11 @@ type (
 		Values map[string]interface{}
 		// CreateReleaseNamespace indicates whether to create the release namespace.
 		CreateReleaseNamespace bool
+		// ImageRegistry is the registry to pull all the images from instead of their original registries.
+		ImageRegistry string
+		// OperatorVersions are the versions of the database operators to install, keyed by the values
+		// of the DB namespace chart enabling them. See OperatorVersionsFromValues.
+		OperatorVersions map[string]string
 		// internal fields, set only after Init() is called.
 		chart *chart.Chart
 		cfg   *action.Configuration
@@ -96,6 +107,9 @@ type (
 
 	// ChartOptions provide the options for loading a Helm chart.
 	ChartOptions struct {
+		// ArchiveDir is the directory to load the packaged Helm chart named <name>-<version>.tgz from.
+		// If set, ignores Directory and URL.
+		ArchiveDir string
 		// Directory to load the Helm chart from.
 		// If set, ignores URL.
 		Directory string
@@ -112,15 +126,15 @@ type (
 
 // Init initializes the Installer with the specified options.
 func (i *Installer) Init(kubeconfigPath string, o ChartOptions) error {
-	if o.Directory == "" && o.URL == "" {
-		return errors.New("either chart directory or URL must be set")
+	if o.ArchiveDir == "" && o.Directory == "" && o.URL == "" {
+		return errors.New("either chart archive directory, chart directory or URL must be set")
 	}
 
 	if o.Version == "" {
 		return errors.New("chart version must be set")
 	}
 
-	chart, err := resolveHelmChart(o.Version, o.Name, o.URL, o.Directory)
+	chart, err := resolveHelmChart(o)
 	if err != nil {
 		return fmt.Errorf("failed to resolve Helm chart: %w", err)
 	}
@@ -156,7 +170,7 @@ func (i *Installer) GetParsedValues() *utils.Values {
 // In the latter case, the installation step does not talk to the kube-apiserver. So Helm functions like `lookup` will not work.
 func (i *Installer) RenderTemplates(ctx context.Context) (RenderedTemplate, error) {
 	if i.release != nil {
-		return newRenderedTemplate(i.release.Manifest), nil
+		return NewRenderedTemplate(i.release.Manifest), nil
 	}
 
 	// create a new actions configuration so that it does not accidentally interfere with the actual installation.
@@ -165,11 +179,11 @@ func (i *Installer) RenderTemplates(ctx context.Context) (RenderedTemplate, erro
 		return nil, fmt.Errorf("failed to create Helm action configuration: %w", err)
 	}
 
-	rel, err := installDryRun(ctx, cfg, i.chart, i.ReleaseName, i.ReleaseNamespace, i.Values)
+	rel, err := installDryRun(ctx, cfg, i.chart, i.ReleaseName, i.ReleaseNamespace, i.Values, i.postRenderer())
 	if err != nil {
 		return nil, err
 	}
-	return newRenderedTemplate(rel.Manifest), nil
+	return NewRenderedTemplate(rel.Manifest), nil
 }
 
 func installDryRun(
@@ -178,6 +192,7 @@ func installDryRun(
 	chart *chart.Chart,
 	releaseName, releaseNamespace string,
 	values map[string]interface{},
+	postRenderer postrender.PostRenderer,
 ) (*release.Release, error) {
 	install := action.NewInstall(cfg)
 	install.ReleaseName = releaseName
@@ -189,6 +204,7 @@ func installDryRun(
 	install.ClientOnly = true
 	install.Replace = true
 	install.IncludeCRDs = true
+	install.PostRenderer = postRenderer
 
 	parsedKubeVersion, err := chartutil.ParseKubeVersion("1.30.0")
 	if err != nil {
@@ -235,6 +251,7 @@ func (i *Installer) install(ctx context.Context) error {
 	install.Namespace = i.ReleaseNamespace
 	install.CreateNamespace = i.CreateReleaseNamespace
 	install.TakeOwnership = true
+	install.PostRenderer = i.postRenderer()
 
 	rel, err := install.RunWithContext(ctx, i.chart, i.Values)
 	if err != nil {
@@ -263,6 +280,7 @@ func (i *Installer) Upgrade(ctx context.Context, opts UpgradeOptions) error {
 	upgrade.ResetThenReuseValues = opts.ResetThenReuseValues
 	upgrade.DisableHooks = opts.DisableHooks
 	upgrade.Force = opts.Force
+	upgrade.PostRenderer = i.postRenderer()
 
 	rel, err := upgrade.RunWithContext(ctx, i.ReleaseName, i.chart, i.Values)
 	if err != nil {
@@ -272,11 +290,22 @@ func (i *Installer) Upgrade(ctx context.Context, opts UpgradeOptions) error {
 	return nil
 }
 
-func resolveHelmChart(version, chartName, repoURL, dir string) (*chart.Chart, error) {
-	if dir != "" {
-		return resolveDir(version, dir)
+func resolveHelmChart(o ChartOptions) (*chart.Chart, error) {
+	if o.ArchiveDir != "" {
+		return resolveArchive(o.Version, o.Name, o.ArchiveDir)
 	}
-	return resolveRepo(version, chartName, repoURL)
+	if o.Directory != "" {
+		return resolveDir(o.Version, o.Directory)
+	}
+	return resolveRepo(o.Version, o.Name, o.URL)
+}
+
+func resolveArchive(version, chartName, dir string) (*chart.Chart, error) {
+	file := path.Join(dir, chartArchiveName(chartName, version))
+	if _, err := os.Stat(file); err != nil {
+		return nil, fmt.Errorf("chart %s version %s not found in %s: %w", chartName, version, dir, err)
+	}
+	return loader.Load(file)
 }
 
 func resolveDir(version, dir string) (*chart.Chart, error) {
@@ -313,20 +342,14 @@ func newChartFromRemoteWithCache(version, name string, repository string) (*char
 		return nil, err
 	}
 
-	file := path.Join(cacheDir, fmt.Sprintf("%s-%s.tgz", name, version))
+	file := path.Join(cacheDir, chartArchiveName(name, version))
 	if _, err = os.Stat(file); err != nil {
 		if !errors.Is(err, fs.ErrNotExist) {
 			return nil, err
 		}
 
 		// Download the chart from remote repository
-		actionConfig := &action.Configuration{}
-		pull := action.NewPullWithOpts(action.WithConfig(actionConfig))
-		pull.Settings = settings
-		pull.Version = version
-		pull.DestDir = cacheDir
-		pull.RepoURL = repository
-		if _, err = pull.Run(name); err != nil {
+		if _, err = PullChart(version, name, repository, cacheDir); err != nil {
 			return nil, err
 		}
 	}
@@ -339,6 +362,24 @@ func newChartFromRemoteWithCache(version, name string, repository string) (*char
 	return loader.LoadArchive(f)
 }
 
+// PullChart downloads the packaged chart from the repository into destDir and returns the path to it.
+func PullChart(version, name, repository, destDir string) (string, error) {
+	actionConfig := &action.Configuration{}
+	pull := action.NewPullWithOpts(action.WithConfig(actionConfig))
+	pull.Settings = settings
+	pull.Version = version
+	pull.DestDir = destDir
+	pull.RepoURL = repository
+	if _, err := pull.Run(name); err != nil {
+		return "", err
+	}
+	return path.Join(destDir, chartArchiveName(name, version)), nil
+}
+
+func chartArchiveName(name, version string) string {
+	return fmt.Sprintf("%s-%s.tgz", name, version)
+}
+
 func everestctlCacheDir() (string, error) {
 	cacheDir, err := os.UserCacheDir()
 	if err != nil {
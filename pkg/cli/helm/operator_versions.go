@@ -0,0 +1,86 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"fmt"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+// OperatorVersionsValue is the DB namespace chart value recording the pinned operator versions.
+// The chart does not use it; the pinned versions are applied to the operator Subscriptions when rendering the chart.
+const OperatorVersionsValue = "operatorVersions"
+
+// dbNamespaceOperators maps the DB namespace chart values enabling the operators to the names of their Subscriptions.
+//
+//nolint:gochecknoglobals
+var dbNamespaceOperators = map[string]string{
+	"pxc":        common.MySQLOperatorName,
+	"psmdb":      common.MongoDBOperatorName,
+	"postgresql": common.PostgreSQLOperatorName,
+}
+
+// DBNamespaceOperatorName returns the name of the operator enabled by the DB namespace chart value.
+func DBNamespaceOperatorName(value string) string {
+	return dbNamespaceOperators[value]
+}
+
+// OperatorVersionsFromValues returns the operator versions pinned in the DB namespace chart values.
+func OperatorVersionsFromValues(values map[string]interface{}) map[string]string {
+	result := map[string]string{}
+	pins, ok := values[OperatorVersionsValue].(map[string]interface{})
+	if !ok {
+		return result
+	}
+	for op, v := range pins {
+		if version, ok := v.(string); ok && version != "" {
+			result[op] = version
+		}
+	}
+	return result
+}
+
+// pinOperatorVersion makes the OLM Subscription install the pinned version of its operator.
+// OLM honors the starting CSV only when installing the operator, later upgrades still need to be approved,
+// so the pinned version is recorded in an annotation of the Subscription for the Everest server to enforce it.
+func pinOperatorVersion(obj map[string]interface{}, operatorVersions map[string]string) {
+	if obj["kind"] != "Subscription" {
+		return
+	}
+	spec, ok := obj["spec"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for op, version := range operatorVersions {
+		name := dbNamespaceOperators[op]
+		if name == "" || spec["name"] != name {
+			continue
+		}
+		spec["startingCSV"] = fmt.Sprintf("%s.v%s", name, version)
+		metadata, ok := obj["metadata"].(map[string]interface{})
+		if !ok {
+			metadata = map[string]interface{}{}
+			obj["metadata"] = metadata
+		}
+		annotations, ok := metadata["annotations"].(map[string]interface{})
+		if !ok {
+			annotations = map[string]interface{}{}
+			metadata["annotations"] = annotations
+		}
+		annotations[common.OperatorVersionPinnedAnnotation] = version
+	}
+}
@@ -0,0 +1,103 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/postrender"
+	"sigs.k8s.io/yaml"
+)
+
+// manifestRenderer is a Helm post-renderer that makes all the images of the rendered manifests
+// to be pulled from a private registry, such as a mirror in an air-gapped environment,
+// and pins the versions of the operators installed with OLM Subscriptions.
+type manifestRenderer struct {
+	registry         string
+	operatorVersions map[string]string
+}
+
+func (i *Installer) postRenderer() postrender.PostRenderer { //nolint:ireturn
+	if i.ImageRegistry == "" && len(i.OperatorVersions) == 0 {
+		return nil
+	}
+	return &manifestRenderer{registry: i.ImageRegistry, operatorVersions: i.OperatorVersions}
+}
+
+// Run rewrites the images and the operator Subscriptions of the rendered manifests.
+func (r *manifestRenderer) Run(manifests *bytes.Buffer) (*bytes.Buffer, error) {
+	result := &bytes.Buffer{}
+	for _, doc := range splitYaml(manifests.String()) {
+		obj := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			return nil, fmt.Errorf("could not parse manifest: %w", err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		if r.registry != "" {
+			RewriteImages(obj, "image", r.registry)
+		}
+		pinOperatorVersion(obj, r.operatorVersions)
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		result.WriteString("---\n")
+		// Keep the leading comments, such as the source of the manifest set by Helm.
+		for _, line := range strings.Split(doc, "\n") {
+			if !strings.HasPrefix(line, "#") {
+				break
+			}
+			result.WriteString(line + "\n")
+		}
+		result.Write(data)
+	}
+	return result, nil
+}
+
+// RewriteImages rewrites the values of all the fields with the key found in the decoded object,
+// such as the image fields of a manifest, to pull the images from the registry.
+func RewriteImages(obj interface{}, key, registry string) {
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for k, v := range o {
+			if image, ok := v.(string); ok && k == key && image != "" {
+				o[k] = RewriteImageRegistry(image, registry)
+				continue
+			}
+			RewriteImages(v, key, registry)
+		}
+	case []interface{}:
+		for _, v := range o {
+			RewriteImages(v, key, registry)
+		}
+	}
+}
+
+// RewriteImageRegistry replaces the registry of the image with the given one.
+// Images without a registry are assumed to be pulled from Docker Hub.
+func RewriteImageRegistry(image, registry string) string {
+	registry = strings.TrimSuffix(registry, "/")
+	if i := strings.Index(image, "/"); i > 0 {
+		if host := image[:i]; strings.ContainsAny(host, ".:") || host == "localhost" {
+			image = image[i+1:]
+		}
+	}
+	return registry + "/" + image
+}
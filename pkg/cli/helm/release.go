@@ -0,0 +1,100 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"errors"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+// ReleaseInfo describes a deployed Helm release.
+type ReleaseInfo struct {
+	// Revision is the revision of the release. It is 0 if the release does not exist.
+	Revision int
+	// ChartVersion is the version of the chart of the release.
+	ChartVersion string
+	// Manifest is the rendered manifest of the release.
+	Manifest string
+	// Values are the values supplied to the release.
+	Values map[string]interface{}
+	// Status is the status of the release, such as deployed or failed.
+	Status string
+	// CRDs are the manifests of the CRDs shipped with the chart of the release.
+	CRDs []string
+}
+
+// GetReleaseInfo returns the deployed revision of the Helm release.
+func GetReleaseInfo(relName, relNamespace, kubeconfigPath string) (ReleaseInfo, error) {
+	cfg, err := newActionsCfg(relNamespace, kubeconfigPath)
+	if err != nil {
+		return ReleaseInfo{}, err
+	}
+	rel, err := action.NewGet(cfg).Run(relName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return ReleaseInfo{}, nil
+		}
+		return ReleaseInfo{}, err
+	}
+	info := ReleaseInfo{Revision: rel.Version, Manifest: rel.Manifest, Values: rel.Config}
+	if rel.Info != nil {
+		info.Status = rel.Info.Status.String()
+	}
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		info.ChartVersion = rel.Chart.Metadata.Version
+	}
+	if rel.Chart != nil {
+		for _, crd := range rel.Chart.CRDObjects() {
+			info.CRDs = append(info.CRDs, string(crd.File.Data))
+		}
+	}
+	return info, nil
+}
+
+// Rollback rolls the Helm release back to the revision.
+func Rollback(relName, relNamespace, kubeconfigPath string, revision int) error {
+	cfg, err := newActionsCfg(relNamespace, kubeconfigPath)
+	if err != nil {
+		return err
+	}
+	rollback := action.NewRollback(cfg)
+	rollback.Version = revision
+	return rollback.Run(relName)
+}
+
+// ForgetRelease deletes the records of the Helm release without uninstalling it,
+// so that its resources are kept but are not managed by Helm anymore.
+func ForgetRelease(relName, relNamespace, kubeconfigPath string) error {
+	cfg, err := newActionsCfg(relNamespace, kubeconfigPath)
+	if err != nil {
+		return err
+	}
+	history, err := cfg.Releases.History(relName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil
+		}
+		return err
+	}
+	for _, rel := range history {
+		if _, err := cfg.Releases.Delete(rel.Name, rel.Version); err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+			return err
+		}
+	}
+	return nil
+}
@@ -15,7 +15,8 @@ import (
 // It is a slice of strings, where each string is a YAML document.
 type RenderedTemplate []string
 
-func newRenderedTemplate(y string) RenderedTemplate {
+// NewRenderedTemplate splits a YAML stream, such as the manifest of a Helm release, into its documents.
+func NewRenderedTemplate(y string) RenderedTemplate {
 	return splitYaml(y)
 }
 
@@ -37,10 +37,16 @@ type ServerValues struct {
 	Service ServiceValues `json:"service" yaml:"service"`
 }
 
+// MonitoringValues represents the configuration values for the monitoring stack.
+type MonitoringValues struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
 // Values represents all the known configuration values for the Everest Helm chart.
 // Fields may be added here as needed.
 type Values struct {
-	Server ServerValues `json:"server" yaml:"server"`
+	Server     ServerValues     `json:"server" yaml:"server"`
+	Monitoring MonitoringValues `json:"monitoring" yaml:"monitoring"`
 }
 
 // ParseValues parses the given values map into a Values struct.
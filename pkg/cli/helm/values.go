@@ -2,10 +2,15 @@ package helm
 
 import "github.com/percona/everest/pkg/kubernetes"
 
+// RepoURLValue is the Everest chart value recording the URL of the Helm repository Everest is installed from.
+// The chart does not use it; the Everest server installs the DB namespace chart from the same repository.
+const RepoURLValue = "helmRepoURL"
+
 // Values contains the different values that can be set in the Helm chart.
 type Values struct {
 	ClusterType        kubernetes.ClusterType
 	VersionMetadataURL string
+	RepoURL            string
 }
 
 // NewValues creates a map of values that can be used to render the Helm chart.
@@ -28,5 +33,17 @@ func NewValues(v Values) map[string]string {
 	if v.VersionMetadataURL != "" {
 		values["versionMetadataURL"] = v.VersionMetadataURL
 	}
+	if v.RepoURL != "" {
+		values[RepoURLValue] = v.RepoURL
+	}
 	return values
 }
+
+// RepoURLFromValues returns the Helm repository URL recorded in the Everest chart values.
+// Falls back to DefaultHelmRepoURL for the installations that do not record it.
+func RepoURLFromValues(values map[string]interface{}) string {
+	if url, ok := values[RepoURLValue].(string); ok && url != "" {
+		return url
+	}
+	return DefaultHelmRepoURL
+}
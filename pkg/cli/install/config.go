@@ -0,0 +1,450 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/namespaces"
+	"github.com/percona/everest/pkg/oidc"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+// Profile is a named set of installation settings.
+type Profile string
+
+const (
+	// ProfileMinimal installs Everest without the monitoring stack.
+	ProfileMinimal Profile = "minimal"
+	// ProfileProduction enables TLS and RBAC and sizes the Everest components for production use.
+	// It is not highly available: the Everest chart runs a single server replica.
+	ProfileProduction Profile = "production"
+	// ProfileOpenShift installs Everest on OpenShift without detecting the environment.
+	ProfileOpenShift Profile = "openshift"
+)
+
+// Operator names accepted in the configuration file.
+const (
+	operatorMySQL      = "mysql"
+	operatorMongoDB    = "mongodb"
+	operatorPostgreSQL = "postgresql"
+)
+
+// ErrInvalidConfigFile is returned when the installation configuration file is not valid.
+var ErrInvalidConfigFile = errors.New("invalid installation configuration")
+
+// Profiles returns the names of the supported installation profiles.
+func Profiles() []string {
+	return []string{string(ProfileMinimal), string(ProfileProduction), string(ProfileOpenShift)}
+}
+
+type (
+	// ConfigFile describes an Everest installation, so that it can be reproduced across environments.
+	// Sections set in the file replace the ones from the profile.
+	ConfigFile struct {
+		// Profile is the installation profile the file is based on.
+		Profile Profile `json:"profile,omitempty"`
+		// Version is the Everest version to install. If empty, the latest version is installed.
+		Version string `json:"version,omitempty"`
+		// Namespaces are the database namespaces to provision.
+		Namespaces []NamespaceConfig `json:"namespaces,omitempty"`
+		// TLS configures TLS for the Everest server.
+		TLS *TLSConfig `json:"tls,omitempty"`
+		// OIDC configures the OIDC provider used to log in to Everest.
+		OIDC *OIDCConfig `json:"oidc,omitempty"`
+		// RBAC configures role-based access control.
+		RBAC *RBACConfig `json:"rbac,omitempty"`
+		// Monitoring configures the monitoring stack.
+		Monitoring *MonitoringConfig `json:"monitoring,omitempty"`
+		// Resources configures the resources of the Everest components.
+		Resources *ResourcesConfig `json:"resources,omitempty"`
+
+		// clusterType is set by the profile only.
+		clusterType kubernetes.ClusterType
+	}
+
+	// NamespaceConfig is a database namespace to provision.
+	NamespaceConfig struct {
+		// Name is the namespace name.
+		Name string `json:"name"`
+		// Operators are the database operators to install in the namespace: mysql, mongodb or postgresql.
+		Operators []string `json:"operators"`
+	}
+
+	// TLSConfig configures TLS for the Everest server.
+	// Without certificates, the server creates a self-signed certificate.
+	TLSConfig struct {
+		// Enabled enables TLS.
+		Enabled bool `json:"enabled"`
+		// CertFile is the path to the PEM encoded certificate.
+		CertFile string `json:"certFile,omitempty"`
+		// KeyFile is the path to the PEM encoded private key.
+		KeyFile string `json:"keyFile,omitempty"`
+		// CertManager requests the certificate from cert-manager instead.
+		CertManager *CertManagerConfig `json:"certManager,omitempty"`
+	}
+
+	// CertManagerConfig configures the cert-manager Certificate for the Everest server.
+	CertManagerConfig struct {
+		// Domain is the primary domain of the certificate.
+		Domain string `json:"domain"`
+		// AdditionalHosts are the subject alternative names of the certificate.
+		AdditionalHosts []string `json:"additionalHosts,omitempty"`
+		// IssuerKind is the kind of the issuer, either Issuer or ClusterIssuer.
+		IssuerKind string `json:"issuerKind"`
+		// IssuerName is the name of the issuer.
+		IssuerName string `json:"issuerName"`
+	}
+
+	// OIDCConfig configures the OIDC provider used to log in to Everest.
+	OIDCConfig struct {
+		// IssuerURL is the URL of the OIDC issuer.
+		IssuerURL string `json:"issuerUrl"`
+		// ClientID is the ID of the OIDC application.
+		ClientID string `json:"clientId"`
+		// Scopes are the requested scopes. If empty, the default scopes are used.
+		Scopes []string `json:"scopes,omitempty"`
+	}
+
+	// RBACConfig configures role-based access control.
+	RBACConfig struct {
+		// Enabled enables RBAC.
+		Enabled bool `json:"enabled"`
+		// PolicyFile is the path to the RBAC policy. If empty, the default policy is used.
+		PolicyFile string `json:"policyFile,omitempty"`
+	}
+
+	// MonitoringConfig configures the monitoring stack.
+	MonitoringConfig struct {
+		// Enabled installs the monitoring stack.
+		Enabled bool `json:"enabled"`
+	}
+
+	// ResourcesConfig configures the resources of the Everest components.
+	ResourcesConfig struct {
+		// Server are the resources of the Everest server.
+		Server *corev1.ResourceRequirements `json:"server,omitempty"`
+		// Operator are the resources of the Everest operator.
+		Operator *corev1.ResourceRequirements `json:"operator,omitempty"`
+	}
+)
+
+// ReadConfigFile reads the installation configuration from the file.
+func ReadConfigFile(path string) (*ConfigFile, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	c := &ConfigFile{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, errors.Join(ErrInvalidConfigFile, err)
+	}
+	return c, nil
+}
+
+// NewProfileConfig returns the installation configuration of the profile.
+func NewProfileConfig(p Profile) (*ConfigFile, error) {
+	switch p {
+	case ProfileMinimal:
+		return &ConfigFile{
+			Profile:    p,
+			Monitoring: &MonitoringConfig{Enabled: false},
+		}, nil
+	case ProfileProduction:
+		return &ConfigFile{
+			Profile: p,
+			TLS:     &TLSConfig{Enabled: true},
+			RBAC:    &RBACConfig{Enabled: true},
+			Resources: &ResourcesConfig{
+				Server:   resourceRequirements("500m", "512Mi", "2", "2Gi"),
+				Operator: resourceRequirements("100m", "128Mi", "1", "512Mi"),
+			},
+		}, nil
+	case ProfileOpenShift:
+		return &ConfigFile{
+			Profile:     p,
+			clusterType: kubernetes.ClusterTypeOpenShift,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown profile '%s', supported profiles: %s",
+			ErrInvalidConfigFile, p, strings.Join(Profiles(), ", "))
+	}
+}
+
+// Merge returns the configuration of the profile with the sections set in c replacing the profile ones.
+func (c *ConfigFile) Merge(profile *ConfigFile) *ConfigFile {
+	result := *profile
+	result.Profile = c.Profile
+	if c.Version != "" {
+		result.Version = c.Version
+	}
+	if len(c.Namespaces) > 0 {
+		result.Namespaces = c.Namespaces
+	}
+	if c.TLS != nil {
+		result.TLS = c.TLS
+	}
+	if c.OIDC != nil {
+		result.OIDC = c.OIDC
+	}
+	if c.RBAC != nil {
+		result.RBAC = c.RBAC
+	}
+	if c.Monitoring != nil {
+		result.Monitoring = c.Monitoring
+	}
+	if c.Resources != nil {
+		result.Resources = c.Resources
+	}
+	return &result
+}
+
+// Validate validates the configuration without connecting to the cluster.
+func (c *ConfigFile) Validate(ctx context.Context) error {
+	if err := c.validate(ctx); err != nil {
+		return errors.Join(ErrInvalidConfigFile, err)
+	}
+	return nil
+}
+
+func (c *ConfigFile) validate(ctx context.Context) error {
+	if c.Profile != "" && !slices.Contains(Profiles(), string(c.Profile)) {
+		return fmt.Errorf("unknown profile '%s', supported profiles: %s", c.Profile, strings.Join(Profiles(), ", "))
+	}
+
+	names := make([]string, 0, len(c.Namespaces))
+	for _, ns := range c.Namespaces {
+		if slices.Contains(names, ns.Name) {
+			return fmt.Errorf("namespace '%s' is listed more than once", ns.Name)
+		}
+		names = append(names, ns.Name)
+		if _, err := ns.operatorConfig(); err != nil {
+			return fmt.Errorf("namespace '%s': %w", ns.Name, err)
+		}
+	}
+	if len(names) > 0 {
+		if err := namespaces.ValidateNamespaceNames(names); err != nil {
+			return err
+		}
+	}
+
+	if c.TLS != nil {
+		if err := c.TLS.validate(); err != nil {
+			return fmt.Errorf("tls: %w", err)
+		}
+	}
+
+	if c.OIDC != nil {
+		if err := oidc.ValidateURL(c.OIDC.IssuerURL); err != nil {
+			return fmt.Errorf("oidc: %w", err)
+		}
+		if err := oidc.ValidateClientID(c.OIDC.ClientID); err != nil {
+			return fmt.Errorf("oidc: %w", err)
+		}
+		if len(c.OIDC.Scopes) > 0 {
+			if err := oidc.ValidateScopes(c.OIDC.Scopes); err != nil {
+				return fmt.Errorf("oidc: %w", err)
+			}
+		}
+	}
+
+	if c.RBAC != nil && c.RBAC.PolicyFile != "" {
+		if err := rbac.ValidatePolicy(ctx, nil, c.RBAC.PolicyFile); err != nil {
+			return fmt.Errorf("rbac: %w", err)
+		}
+	}
+	return nil
+}
+
+func (t *TLSConfig) validate() error {
+	if (t.CertFile == "") != (t.KeyFile == "") {
+		return errors.New("certFile and keyFile must be set together")
+	}
+	if t.CertFile != "" && t.CertManager != nil {
+		return errors.New("certFile and certManager are mutually exclusive")
+	}
+	if !t.Enabled && (t.CertFile != "" || t.CertManager != nil) {
+		return errors.New("certificates are set but TLS is not enabled")
+	}
+	if t.CertFile != "" {
+		if _, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile); err != nil {
+			return fmt.Errorf("invalid certificate: %w", err)
+		}
+	}
+	if cm := t.CertManager; cm != nil {
+		if cm.Domain == "" || cm.IssuerName == "" {
+			return errors.New("certManager requires domain and issuerName")
+		}
+		if cm.IssuerKind != "Issuer" && cm.IssuerKind != "ClusterIssuer" {
+			return fmt.Errorf("unknown certManager issuerKind '%s', must be Issuer or ClusterIssuer", cm.IssuerKind)
+		}
+	}
+	return nil
+}
+
+// operatorConfig returns the operators to install in the namespace.
+func (n NamespaceConfig) operatorConfig() (namespaces.OperatorConfig, error) {
+	var cfg namespaces.OperatorConfig
+	for _, op := range n.Operators {
+		switch op {
+		case operatorMySQL:
+			cfg.PXC = true
+		case operatorMongoDB:
+			cfg.PSMDB = true
+		case operatorPostgreSQL:
+			cfg.PG = true
+		default:
+			return cfg, fmt.Errorf("unknown operator '%s', supported operators: %s", op,
+				strings.Join([]string{operatorMySQL, operatorMongoDB, operatorPostgreSQL}, ", "))
+		}
+	}
+	if !(cfg.PXC || cfg.PSMDB || cfg.PG) {
+		return cfg, namespaces.ErrOperatorsNotSelected
+	}
+	return cfg, nil
+}
+
+// HelmValues returns the values of the Everest Helm chart described by the configuration.
+// The configuration must be validated first.
+func (c *ConfigFile) HelmValues() (map[string]interface{}, error) {
+	server := map[string]interface{}{}
+	values := map[string]interface{}{"server": server}
+
+	if c.TLS != nil {
+		tlsValues := map[string]interface{}{"enabled": c.TLS.Enabled}
+		if c.TLS.CertFile != "" {
+			crt, err := os.ReadFile(c.TLS.CertFile)
+			if err != nil {
+				return nil, err
+			}
+			key, err := os.ReadFile(c.TLS.KeyFile)
+			if err != nil {
+				return nil, err
+			}
+			tlsValues["secret"] = map[string]interface{}{
+				"certs": map[string]interface{}{
+					"tls.crt": string(crt),
+					"tls.key": string(key),
+				},
+			}
+		}
+		if cm := c.TLS.CertManager; cm != nil {
+			tlsValues["certificate"] = map[string]interface{}{
+				"create":          true,
+				"domain":          cm.Domain,
+				"additionalHosts": toInterfaceSlice(cm.AdditionalHosts),
+				"issuer": map[string]interface{}{
+					"kind": cm.IssuerKind,
+					"name": cm.IssuerName,
+				},
+			}
+		}
+		server["tls"] = tlsValues
+	}
+
+	if c.OIDC != nil {
+		scopes := c.OIDC.Scopes
+		if len(scopes) == 0 {
+			scopes = common.DefaultOIDCScopes
+		}
+		server["oidc"] = map[string]interface{}{
+			"issuerUrl": c.OIDC.IssuerURL,
+			"clientId":  c.OIDC.ClientID,
+			"scopes":    toInterfaceSlice(scopes),
+		}
+	}
+
+	if c.RBAC != nil {
+		rbacValues := map[string]interface{}{"enabled": c.RBAC.Enabled}
+		if c.RBAC.PolicyFile != "" {
+			policy, err := os.ReadFile(c.RBAC.PolicyFile)
+			if err != nil {
+				return nil, err
+			}
+			rbacValues["policy"] = string(policy)
+		}
+		server["rbac"] = rbacValues
+	}
+
+	if c.Monitoring != nil {
+		values["monitoring"] = map[string]interface{}{"enabled": c.Monitoring.Enabled}
+		values["kube-state-metrics"] = map[string]interface{}{"enabled": c.Monitoring.Enabled}
+		values["createMonitoringResources"] = c.Monitoring.Enabled
+	}
+
+	if c.Resources != nil {
+		if c.Resources.Server != nil {
+			res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(c.Resources.Server)
+			if err != nil {
+				return nil, err
+			}
+			server["resources"] = res
+		}
+		if c.Resources.Operator != nil {
+			res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(c.Resources.Operator)
+			if err != nil {
+				return nil, err
+			}
+			values["operator"] = map[string]interface{}{"resources": res}
+		}
+	}
+	return values, nil
+}
+
+// DBNamespaces returns the database namespaces to provision with their operators.
+// The configuration must be validated first.
+func (c *ConfigFile) DBNamespaces() []DBNamespace {
+	result := make([]DBNamespace, 0, len(c.Namespaces))
+	for _, ns := range c.Namespaces {
+		operators, _ := ns.operatorConfig()
+		result = append(result, DBNamespace{Name: ns.Name, Operators: operators})
+	}
+	return result
+}
+
+func resourceRequirements(cpuRequest, memoryRequest, cpuLimit, memoryLimit string) *corev1.ResourceRequirements {
+	return &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuRequest),
+			corev1.ResourceMemory: resource.MustParse(memoryRequest),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuLimit),
+			corev1.ResourceMemory: resource.MustParse(memoryLimit),
+		},
+	}
+}
+
+func toInterfaceSlice(s []string) []interface{} {
+	result := make([]interface{}, 0, len(s))
+	for _, v := range s {
+		result = append(result, v)
+	}
+	return result
+}
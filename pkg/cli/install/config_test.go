@@ -0,0 +1,226 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/namespaces"
+)
+
+func TestConfigFile_Validate(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	validPolicy := filepath.Join(dir, "valid.csv")
+	require.NoError(t, os.WriteFile(validPolicy, []byte("g, admin, role:admin\n"), 0o600))
+	invalidPolicy := filepath.Join(dir, "invalid.csv")
+	require.NoError(t, os.WriteFile(invalidPolicy, []byte("p, role:test, unknown-resource, read, *\n"), 0o600))
+
+	testCases := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name: "valid configuration",
+			config: `
+profile: production
+version: 1.6.0
+namespaces:
+- name: dev
+  operators: [mysql]
+- name: prod
+  operators: [mysql, mongodb, postgresql]
+oidc:
+  issuerUrl: https://idp.example.com
+  clientId: everest
+rbac:
+  enabled: true
+  policyFile: ` + validPolicy + `
+monitoring:
+  enabled: false
+resources:
+  server:
+    requests:
+      cpu: 200m
+      memory: 256Mi
+`,
+		},
+		{
+			name:    "unknown field",
+			config:  "namespace: dev",
+			wantErr: "unknown field",
+		},
+		{
+			name:    "unknown profile",
+			config:  "profile: huge",
+			wantErr: "unknown profile 'huge'",
+		},
+		{
+			name:    "unknown operator",
+			config:  "namespaces: [{name: dev, operators: [redis]}]",
+			wantErr: "unknown operator 'redis'",
+		},
+		{
+			name:    "no operators",
+			config:  "namespaces: [{name: dev}]",
+			wantErr: namespaces.ErrOperatorsNotSelected.Error(),
+		},
+		{
+			name:    "duplicate namespace",
+			config:  "namespaces: [{name: dev, operators: [mysql]}, {name: dev, operators: [mongodb]}]",
+			wantErr: "namespace 'dev' is listed more than once",
+		},
+		{
+			name:    "reserved namespace",
+			config:  "namespaces: [{name: everest-system, operators: [mysql]}]",
+			wantErr: "everest-system",
+		},
+		{
+			name:    "certificate without key",
+			config:  "tls: {enabled: true, certFile: tls.crt}",
+			wantErr: "certFile and keyFile must be set together",
+		},
+		{
+			name:    "certificate with TLS disabled",
+			config:  "tls: {certManager: {domain: everest.example.com, issuerKind: ClusterIssuer, issuerName: letsencrypt}}",
+			wantErr: "TLS is not enabled",
+		},
+		{
+			name:    "unknown issuer kind",
+			config:  "tls: {enabled: true, certManager: {domain: everest.example.com, issuerKind: Vault, issuerName: vault}}",
+			wantErr: "unknown certManager issuerKind 'Vault'",
+		},
+		{
+			name:    "OIDC without client ID",
+			config:  "oidc: {issuerUrl: https://idp.example.com}",
+			wantErr: "client ID is required",
+		},
+		{
+			name:    "OIDC without openid scope",
+			config:  "oidc: {issuerUrl: https://idp.example.com, clientId: everest, scopes: [email]}",
+			wantErr: "scopes must contain 'openid'",
+		},
+		{
+			name:    "invalid RBAC policy",
+			config:  "rbac: {enabled: true, policyFile: " + invalidPolicy + "}",
+			wantErr: "policy syntax error",
+		},
+		{
+			name:    "invalid resources",
+			config:  "resources: {server: {requests: {cpu: lots}}}",
+			wantErr: "quantities must match",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "everest.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.config), 0o600))
+
+			c, err := ReadConfigFile(path)
+			if err == nil {
+				err = c.Validate(context.Background())
+			}
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidConfigFile)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestConfigFile_Merge(t *testing.T) {
+	t.Parallel()
+
+	profile, err := NewProfileConfig(ProfileProduction)
+	require.NoError(t, err)
+
+	c := &ConfigFile{
+		Profile: ProfileProduction,
+		TLS:     &TLSConfig{Enabled: false},
+		Namespaces: []NamespaceConfig{
+			{Name: "dev", Operators: []string{operatorMySQL, operatorPostgreSQL}},
+		},
+	}
+	merged := c.Merge(profile)
+	assert.False(t, merged.TLS.Enabled)
+	assert.True(t, merged.RBAC.Enabled)
+	assert.NotNil(t, merged.Resources)
+	assert.Equal(t, []DBNamespace{
+		{Name: "dev", Operators: namespaces.OperatorConfig{PXC: true, PG: true}},
+	}, merged.DBNamespaces())
+
+	_, err = NewProfileConfig("huge")
+	require.ErrorIs(t, err, ErrInvalidConfigFile)
+}
+
+func TestInstallConfig_ApplyConfigFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	policy := filepath.Join(dir, "policy.csv")
+	require.NoError(t, os.WriteFile(policy, []byte("g, admin, role:admin\n"), 0o600))
+
+	profile, err := NewProfileConfig(ProfileOpenShift)
+	require.NoError(t, err)
+	c := (&ConfigFile{
+		Profile:    ProfileOpenShift,
+		Version:    "1.6.0",
+		OIDC:       &OIDCConfig{IssuerURL: "https://idp.example.com", ClientID: "everest"},
+		RBAC:       &RBACConfig{Enabled: true, PolicyFile: policy},
+		Monitoring: &MonitoringConfig{Enabled: false},
+		Namespaces: []NamespaceConfig{{Name: "dev", Operators: []string{operatorMongoDB}}},
+	}).Merge(profile)
+	require.NoError(t, c.Validate(context.Background()))
+
+	cfg := NewInstallConfig()
+	cfg.Version = "1.5.0"
+	require.NoError(t, cfg.ApplyConfigFile(c))
+
+	assert.Equal(t, "1.5.0", cfg.Version, "version set explicitly takes precedence")
+	assert.Equal(t, kubernetes.ClusterTypeOpenShift, cfg.ClusterType)
+	assert.True(t, cfg.SkipEnvDetection)
+	assert.Equal(t, []DBNamespace{{Name: "dev", Operators: namespaces.OperatorConfig{PSMDB: true}}}, cfg.DBNamespaces)
+	assert.Equal(t, map[string]interface{}{
+		"server": map[string]interface{}{
+			"oidc": map[string]interface{}{
+				"issuerUrl": "https://idp.example.com",
+				"clientId":  "everest",
+				"scopes":    []interface{}{"openid", "profile", "email"},
+			},
+			"rbac": map[string]interface{}{
+				"enabled": true,
+				"policy":  "g, admin, role:admin\n",
+			},
+		},
+		"monitoring":                map[string]interface{}{"enabled": false},
+		"kube-state-metrics":        map[string]interface{}{"enabled": false},
+		"createMonitoringResources": false,
+	}, cfg.Values)
+}
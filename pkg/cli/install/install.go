@@ -28,17 +28,19 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	goversion "github.com/hashicorp/go-version"
 	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/cli/values"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/percona/everest/pkg/cli/bundle"
 	"github.com/percona/everest/pkg/cli/helm"
 	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
-	"github.com/percona/everest/pkg/cli/namespaces"
 	"github.com/percona/everest/pkg/cli/steps"
 	cliutils "github.com/percona/everest/pkg/cli/utils"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/namespaces"
 	"github.com/percona/everest/pkg/output"
 	. "github.com/percona/everest/pkg/utils/must" //nolint:revive,stylecheck
 	"github.com/percona/everest/pkg/version"
@@ -73,10 +75,28 @@ type (
 		Pretty bool
 		// SkipDBNamespace is set if the installation should skip provisioning database.
 		SkipDBNamespace bool
+		// Bundle is the path to the offline bundle to install from instead of the version service and the Helm repository.
+		Bundle string
 		// Options related to Helm.
 		HelmConfig helm.CLIOptions
+		// Values are the Helm values set by the installation profile and configuration file.
+		// Values set with the Helm flags take precedence over them.
+		Values map[string]interface{}
 		// NamespaceAddConfig is the configuration for the namespace add operation.
 		NamespaceAddConfig namespaces.NamespaceAddConfig
+		// DBNamespaces are the database namespaces to provision with their own operators.
+		// If set, they are used instead of NamespaceAddConfig.NamespaceList and NamespaceAddConfig.Operators.
+		DBNamespaces []DBNamespace
+		// EnableNamespaceProvisioning grants the Everest API server the permissions to provision the DB namespaces.
+		EnableNamespaceProvisioning bool
+	}
+
+	// DBNamespace is a database namespace to provision.
+	DBNamespace struct {
+		// Name is the namespace name.
+		Name string
+		// Operators are the operators to install in the namespace.
+		Operators namespaces.OperatorConfig
 	}
 
 	// Installer provides the functionality to install Everest.
@@ -85,6 +105,8 @@ type (
 		cfg            InstallConfig
 		kubeClient     kubernetes.KubernetesConnector
 		versionService versionservice.Interface
+		// bundle is the offline bundle to install from, if any.
+		bundle *bundle.Bundle
 		// these are set only when Run is called.
 		installVersion string
 		helmInstaller  *helm.Installer
@@ -127,6 +149,30 @@ func (cfg *InstallConfig) detectKubernetesEnv(ctx context.Context, l *zap.Sugare
 	return nil
 }
 
+// ApplyConfigFile applies the validated installation configuration file to the configuration.
+// Settings provided explicitly through the configuration, such as the version, take precedence.
+func (cfg *InstallConfig) ApplyConfigFile(c *ConfigFile) error {
+	vals, err := c.HelmValues()
+	if err != nil {
+		return fmt.Errorf("failed to read the installation configuration: %w", err)
+	}
+	cfg.Values = vals
+
+	if cfg.Version == "" {
+		cfg.Version = c.Version
+	}
+	if c.clusterType != "" {
+		cfg.ClusterType = c.clusterType
+		cfg.NamespaceAddConfig.ClusterType = c.clusterType
+		cfg.SkipEnvDetection = true
+		cfg.NamespaceAddConfig.SkipEnvDetection = true
+	}
+	if len(c.Namespaces) > 0 {
+		cfg.DBNamespaces = c.DBNamespaces()
+	}
+	return nil
+}
+
 // ------ Installer ------
 
 // NewInstall returns a new Installer struct.
@@ -138,6 +184,24 @@ func NewInstall(c InstallConfig, l *zap.SugaredLogger) (*Installer, error) {
 		cli.l = zap.NewNop().Sugar()
 	}
 
+	cli.versionService = versionservice.New(c.VersionMetadataURL)
+	if c.Bundle != "" {
+		b, err := bundle.Open(c.Bundle)
+		if err != nil {
+			return nil, err
+		}
+		c.HelmConfig.ArchiveDir = b.ChartsDir()
+		cli.versionService = b.VersionService()
+		cli.bundle = b
+	}
+
+	if len(c.DBNamespaces) > 0 {
+		c.NamespaceAddConfig.NamespaceList = make([]string, 0, len(c.DBNamespaces))
+		for _, ns := range c.DBNamespaces {
+			c.NamespaceAddConfig.NamespaceList = append(c.NamespaceAddConfig.NamespaceList, ns.Name)
+		}
+	}
+
 	c.NamespaceAddConfig.Pretty = c.Pretty
 	c.NamespaceAddConfig.HelmConfig = c.HelmConfig
 	c.NamespaceAddConfig.KubeconfigPath = c.KubeconfigPath
@@ -150,8 +214,6 @@ func NewInstall(c InstallConfig, l *zap.SugaredLogger) (*Installer, error) {
 	if err != nil {
 		return nil, err
 	}
-
-	cli.versionService = versionservice.New(c.VersionMetadataURL)
 	return cli, nil
 }
 
@@ -208,13 +270,32 @@ func (o *Installer) Run(ctx context.Context) error {
 // It returns nil if the namespaces are already installed.
 // Note: o.cfg.NamespaceAddConfig.NamespaceList and o.cfg.NamespaceAddConfig.Operators
 // must be set before calling this function.
+// If o.cfg.DBNamespaces is set, each namespace is provisioned with its own operators.
 func (o *Installer) getDBNamespacesInstallSteps(ctx context.Context) ([]steps.Step, error) {
-	i, err := namespaces.NewNamespaceAdd(o.cfg.NamespaceAddConfig, o.l)
-	if err != nil {
-		return nil, err
+	if len(o.cfg.DBNamespaces) == 0 {
+		i, err := namespaces.NewNamespaceAdd(o.cfg.NamespaceAddConfig, o.l)
+		if err != nil {
+			return nil, err
+		}
+		return i.GetNamespaceInstallSteps(ctx, o.installVersion)
 	}
 
-	return i.GetNamespaceInstallSteps(ctx, o.installVersion)
+	var result []steps.Step
+	for _, ns := range o.cfg.DBNamespaces {
+		cfg := o.cfg.NamespaceAddConfig
+		cfg.NamespaceList = []string{ns.Name}
+		cfg.Operators = ns.Operators
+		i, err := namespaces.NewNamespaceAdd(cfg, o.l)
+		if err != nil {
+			return nil, err
+		}
+		nsSteps, err := i.GetNamespaceInstallSteps(ctx, o.installVersion)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nsSteps...)
+	}
+	return result, nil
 }
 
 //nolint:gochecknoglobals
@@ -305,35 +386,59 @@ func (o *Installer) setupHelmInstaller(ctx context.Context) error {
 	overrides := helm.NewValues(helm.Values{
 		ClusterType:        o.cfg.ClusterType,
 		VersionMetadataURL: o.cfg.VersionMetadataURL,
+		RepoURL:            o.cfg.HelmConfig.RepoURL,
 	})
-	values := Must(helmutils.MergeVals(o.cfg.HelmConfig.Values, overrides))
+	// The profile and configuration file values override the defaults,
+	// while the values set with the Helm flags override everything.
+	vals := helmutils.MergeMaps(
+		helmutils.MergeMaps(Must(helmutils.MergeVals(values.Options{}, overrides)), o.cfg.Values),
+		Must(helmutils.MergeVals(o.cfg.HelmConfig.Values, nil)),
+	)
 	installer := &helm.Installer{
 		ReleaseName:            common.SystemNamespace,
 		ReleaseNamespace:       common.SystemNamespace,
-		Values:                 values,
+		ImageRegistry:          o.cfg.HelmConfig.ImageRegistry,
+		Values:                 vals,
 		CreateReleaseNamespace: !nsExists,
 	}
 	if err := installer.Init(o.cfg.KubeconfigPath, helm.ChartOptions{
-		Directory: o.cfg.HelmConfig.ChartDir,
-		URL:       o.cfg.HelmConfig.RepoURL,
-		Name:      helm.EverestChartName,
-		Version:   o.installVersion,
+		ArchiveDir: o.cfg.HelmConfig.ArchiveDir,
+		Directory:  o.cfg.HelmConfig.ChartDir,
+		URL:        o.cfg.HelmConfig.RepoURL,
+		Name:       helm.EverestChartName,
+		Version:    o.installVersion,
 	}); err != nil {
 		return fmt.Errorf("could not initialize Helm installer: %w", err)
 	}
+	if o.bundle != nil {
+		// Everest uses the version service responses of the bundle served by the Everest server.
+		installer.Values["versionMetadataURL"] = bundle.InClusterVersionServiceURL(installer.GetParsedValues().Server)
+	}
 	o.helmInstaller = installer
 	return nil
 }
 
 func (o *Installer) newInstallSteps() []steps.Step {
-	return []steps.Step{
+	result := []steps.Step{
 		o.newStepInstallEverestHelmChart(),
+		o.newStepApplyEverestServerRBAC(),
+	}
+	if o.cfg.EnableNamespaceProvisioning {
+		result = append(result, o.newStepApplyNamespaceProvisioningRBAC())
+	}
+	if o.bundle != nil {
+		result = append(result, o.newStepStoreBundledVersionService())
+	}
+	result = append(result,
 		o.newStepEnsureEverestAPI(),
 		o.newStepEnsureEverestOperator(),
 		o.newStepEnsureEverestOLM(),
 		o.newStepEnsureCatalogSource(),
-		o.newStepEnsureEverestMonitoring(),
+	)
+	if o.helmInstaller.GetParsedValues().Monitoring.Enabled {
+		result = append(result, o.newStepEnsureEverestMonitoring())
 	}
+	return result
 }
 
 func (o *Installer) latestVersion(meta *versionpb.MetadataResponse) (*goversion.Version, *versionpb.MetadataVersion, error) {
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/percona/everest/data"
 	"github.com/percona/everest/pkg/cli/steps"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
@@ -38,6 +39,33 @@ func (o *Installer) newStepInstallEverestHelmChart() steps.Step {
 	}
 }
 
+func (o *Installer) newStepApplyEverestServerRBAC() steps.Step {
+	return steps.Step{
+		Desc: "Applying Everest API RBAC",
+		F: func(ctx context.Context) error {
+			return o.kubeClient.ApplyManifestFile(ctx, data.EverestServerRBAC, common.SystemNamespace)
+		},
+	}
+}
+
+func (o *Installer) newStepApplyNamespaceProvisioningRBAC() steps.Step {
+	return steps.Step{
+		Desc: "Enabling namespace provisioning from the Everest API",
+		F: func(ctx context.Context) error {
+			return o.kubeClient.ApplyManifestFile(ctx, data.EverestServerNamespaceProvisioningRBAC, common.SystemNamespace)
+		},
+	}
+}
+
+func (o *Installer) newStepStoreBundledVersionService() steps.Step {
+	return steps.Step{
+		Desc: "Storing the version service data of the bundle",
+		F: func(ctx context.Context) error {
+			return o.bundle.StoreVersionService(ctx, o.kubeClient, o.cfg.HelmConfig.ImageRegistry)
+		},
+	}
+}
+
 func (o *Installer) newStepEnsureEverestOperator() steps.Step {
 	return steps.Step{
 		Desc: "Ensuring Everest operator deployment is ready",
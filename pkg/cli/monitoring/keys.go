@@ -0,0 +1,182 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package monitoring provides the functionality to manage the monitoring instances.
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/monitoring"
+	"github.com/percona/everest/pkg/pmm"
+)
+
+type (
+	// KeysConfig is the configuration for the PMM keys operations.
+	KeysConfig struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// PMMURL is the URL of the PMM instance.
+		PMMURL string
+		// PMMUser is the name of a PMM admin user.
+		PMMUser string
+		// PMMPassword is the password of the PMM admin user.
+		PMMPassword string
+		// SkipTLSVerify if set, the TLS certificate of PMM is not verified.
+		SkipTLSVerify bool
+	}
+
+	// Keys is the CLI operation to manage the PMM keys created by Everest.
+	Keys struct {
+		cfg        KeysConfig
+		kubeClient kubernetes.KubernetesConnector
+		l          *zap.SugaredLogger
+	}
+
+	// PurgeResult is the result of purging a PMM key.
+	PurgeResult struct {
+		pmm.Token
+
+		// Error is the reason the key could not be purged, empty on success.
+		Error string `json:"error,omitempty"`
+	}
+)
+
+// NewKeys returns a new CLI operation to manage the PMM keys created by Everest.
+func NewKeys(c KeysConfig, l *zap.SugaredLogger) (*Keys, error) {
+	k := &Keys{
+		cfg: c,
+		l:   l.With("component", "monitoring-keys"),
+	}
+	if c.Pretty {
+		k.l = zap.NewNop().Sugar()
+	}
+
+	kubeClient, err := cliutils.NewKubeConnector(k.l, c.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	k.kubeClient = kubeClient
+	return k, nil
+}
+
+// ListOrphaned returns the API keys and service accounts created by Everest in PMM
+// that are not used by any monitoring instance of the cluster.
+func (k *Keys) ListOrphaned(ctx context.Context) ([]pmm.Token, error) {
+	tokens, err := pmm.ListEverestTokens(ctx, k.cfg.PMMURL, k.cfg.PMMUser, k.cfg.PMMPassword, k.cfg.SkipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+	used, err := k.usedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []pmm.Token{}
+	for _, t := range tokens {
+		if !used.contains(t) {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+// Purge revokes the orphaned API keys and service accounts created by Everest in PMM.
+func (k *Keys) Purge(ctx context.Context) ([]PurgeResult, error) {
+	orphaned, err := k.ListOrphaned(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]PurgeResult, 0, len(orphaned))
+	for _, t := range orphaned {
+		r := PurgeResult{Token: t}
+		k.l.Infof("Revoking PMM token %q (ID %d)", t.Name, t.ID)
+		if err := pmm.RevokeToken(ctx, k.cfg.PMMURL, k.cfg.PMMUser, k.cfg.PMMPassword, &t, k.cfg.SkipTLSVerify); err != nil {
+			r.Error = err.Error()
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// usedTokenSet holds the PMM tokens used by the monitoring instances.
+type usedTokenSet struct {
+	// ids holds the IDs of the tracked tokens.
+	ids map[pmm.Token]struct{}
+	// namePrefixes holds the name prefixes of the tokens of the monitoring
+	// instances that don't track the token ID, e.g. created by older Everest versions.
+	namePrefixes []string
+}
+
+func (s usedTokenSet) contains(t pmm.Token) bool {
+	if _, ok := s.ids[pmm.Token{Username: t.Username, ID: t.ID}]; ok {
+		return true
+	}
+	for _, p := range s.namePrefixes {
+		if strings.HasPrefix(t.Name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *Keys) usedTokens(ctx context.Context) (usedTokenSet, error) {
+	used := usedTokenSet{ids: make(map[pmm.Token]struct{})}
+	namespaces, err := k.kubeClient.GetDBNamespaces(ctx)
+	if err != nil {
+		return used, err
+	}
+	for _, ns := range namespaces.Items {
+		configs, err := k.kubeClient.ListMonitoringConfigs(ctx, ctrlclient.InNamespace(ns.GetName()))
+		if err != nil {
+			return used, err
+		}
+		for _, mc := range configs.Items {
+			if !sameURL(mc.Spec.PMM.URL, k.cfg.PMMURL) {
+				continue
+			}
+			secret, err := k.kubeClient.GetSecret(ctx, types.NamespacedName{Namespace: mc.GetNamespace(), Name: mc.Spec.CredentialsSecretName})
+			if k8serrors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return used, err
+			}
+			token := monitoring.TokenFromSecret(secret)
+			if token.ID == 0 {
+				// See pmm.EverestTokenName.
+				used.namePrefixes = append(used.namePrefixes, fmt.Sprintf("%s%s-", pmm.EverestTokenPrefix, mc.GetName()))
+				continue
+			}
+			used.ids[pmm.Token{Username: token.Username, ID: token.ID}] = struct{}{}
+		}
+	}
+	return used, nil
+}
+
+func sameURL(a, b string) bool {
+	return strings.TrimRight(a, "/") == strings.TrimRight(b, "/")
+}
@@ -0,0 +1,44 @@
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/percona/everest/pkg/pmm"
+)
+
+func TestUsedTokenSetContains(t *testing.T) {
+	t.Parallel()
+
+	used := usedTokenSet{
+		ids: map[pmm.Token]struct{}{
+			{Username: pmm.ServiceTokenUsername, ID: 7}: {},
+		},
+		namePrefixes: []string{"everest-legacy-"},
+	}
+
+	for _, tc := range []struct {
+		name  string
+		token pmm.Token
+		used  bool
+	}{
+		{name: "tracked ID", token: pmm.Token{Username: pmm.ServiceTokenUsername, ID: 7, Name: "everest-pmm-1"}, used: true},
+		{name: "same ID of an API key", token: pmm.Token{Username: pmm.APIKeyUsername, ID: 7, Name: "everest-pmm-1"}},
+		{name: "untracked ID", token: pmm.Token{Username: pmm.ServiceTokenUsername, ID: 8, Name: "everest-pmm-2"}},
+		{name: "legacy name", token: pmm.Token{Username: pmm.APIKeyUsername, ID: 3, Name: "everest-legacy-1"}, used: true},
+		{name: "prefix of another instance", token: pmm.Token{Username: pmm.APIKeyUsername, ID: 4, Name: "everest-legacy2-1"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.used, used.contains(tc.token))
+		})
+	}
+}
+
+func TestSameURL(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, sameURL("https://pmm.example.com/", "https://pmm.example.com"))
+	assert.False(t, sameURL("https://pmm.example.com", "https://pmm2.example.com"))
+}
@@ -0,0 +1,185 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package report provides the functionality to report the resource usage of the database clusters.
+package report
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/usage"
+)
+
+const (
+	// GroupByNamespace groups the usage by namespace.
+	GroupByNamespace = "namespace"
+	// GroupByDatabaseCluster groups the usage by database cluster.
+	GroupByDatabaseCluster = "database-cluster"
+
+	dateLayout    = "2006-01-02"
+	millisPerCore = 1000
+	bytesPerGiB   = 1 << 30
+)
+
+type (
+	// UsageConfig is the configuration for the usage report.
+	UsageConfig struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// From is the start of the time range, RFC 3339 time or date. Defaults to 30 days before To.
+		From string
+		// To is the end of the time range, RFC 3339 time or date. Defaults to the current time.
+		To string
+		// Namespace limits the report to the namespace if set.
+		Namespace string
+	}
+
+	// Usage is the CLI operation to report the resource usage of the database clusters.
+	Usage struct {
+		cfg        UsageConfig
+		kubeClient kubernetes.KubernetesConnector
+		l          *zap.SugaredLogger
+	}
+)
+
+// NewUsage returns a new CLI operation to report the resource usage of the database clusters.
+func NewUsage(c UsageConfig, l *zap.SugaredLogger) (*Usage, error) {
+	u := &Usage{
+		cfg: c,
+		l:   l.With("component", "report-usage"),
+	}
+	if c.Pretty {
+		u.l = zap.NewNop().Sugar()
+	}
+
+	kubeClient, err := cliutils.NewKubeConnector(u.l, c.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	u.kubeClient = kubeClient
+	return u, nil
+}
+
+// Run returns the usage report of the configured time range.
+func (u *Usage) Run(ctx context.Context) (*api.UsageReport, error) {
+	to := time.Now().UTC()
+	if u.cfg.To != "" {
+		t, err := ParseTime(u.cfg.To)
+		if err != nil {
+			return nil, err
+		}
+		to = t
+	}
+	from := to.Add(-usage.DefaultReportRange)
+	if u.cfg.From != "" {
+		t, err := ParseTime(u.cfg.From)
+		if err != nil {
+			return nil, err
+		}
+		from = t
+	}
+	if !from.Before(to) {
+		return nil, errors.New("the start of the time range should be before its end")
+	}
+	u.l.Infof("Reporting the resource usage from %s to %s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	return usage.GetReport(ctx, u.kubeClient, from, to, u.cfg.Namespace)
+}
+
+// ParseTime parses an RFC 3339 time or a date, which is the start of the day in UTC.
+func ParseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected a date (%s) or an RFC 3339 time", s, dateLayout)
+	}
+	return t, nil
+}
+
+// WriteCSV writes the usage aggregates as CSV.
+// CPU is reported in cores and core-hours, memory and storage in GiB and GiB-hours.
+// The used CPU and memory are empty if the metrics API was not available.
+func WriteCSV(w io.Writer, aggregates []api.UsageAggregate) error {
+	header := []string{"namespace", "name", "engine_type", "hours", "backups"}
+	for _, m := range csvMetrics {
+		header = append(header, m.name+"_"+m.unit+"_avg", m.name+"_"+m.unit+"_peak", m.name+"_"+m.unit+"_hours")
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, a := range aggregates {
+		row := []string{a.Namespace, a.Name, a.EngineType, formatFloat(a.Hours), strconv.Itoa(a.Backups)}
+		for _, m := range csvMetrics {
+			s := m.stat(a)
+			if s == nil {
+				row = append(row, "", "", "")
+				continue
+			}
+			row = append(row, formatFloat(s.Average/m.divisor), formatFloat(s.Peak/m.divisor), formatFloat(s.Total/m.divisor))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+type csvMetric struct {
+	name    string
+	unit    string
+	divisor float64
+	stat    func(a api.UsageAggregate) *api.UsageStat
+}
+
+//nolint:gochecknoglobals
+var csvMetrics = []csvMetric{
+	{"cpu_requested", "cores", millisPerCore, func(a api.UsageAggregate) *api.UsageStat { return &a.CpuRequestedMillis }},
+	{"cpu_used", "cores", millisPerCore, func(a api.UsageAggregate) *api.UsageStat { return a.CpuUsedMillis }},
+	{"memory_requested", "gib", bytesPerGiB, func(a api.UsageAggregate) *api.UsageStat { return &a.MemoryRequestedBytes }},
+	{"memory_used", "gib", bytesPerGiB, func(a api.UsageAggregate) *api.UsageStat { return a.MemoryUsedBytes }},
+	{"disk_requested", "gib", bytesPerGiB, func(a api.UsageAggregate) *api.UsageStat { return &a.DiskRequestedBytes }},
+	{"disk_used", "gib", bytesPerGiB, func(a api.UsageAggregate) *api.UsageStat { return a.DiskUsedBytes }},
+	{"backup", "gib", bytesPerGiB, func(a api.UsageAggregate) *api.UsageStat { return &a.BackupBytes }},
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 4, 64)
+}
+
+// FormatCores formats CPU millis as cores.
+func FormatCores(millis float64) string {
+	return strconv.FormatFloat(millis/millisPerCore, 'f', 2, 64)
+}
+
+// FormatGiB formats bytes as GiB.
+func FormatGiB(bytes float64) string {
+	return strconv.FormatFloat(bytes/bytesPerGiB, 'f', 2, 64) + "Gi"
+}
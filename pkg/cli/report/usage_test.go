@@ -0,0 +1,59 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/api"
+)
+
+func TestWriteCSV(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteCSV(buf, []api.UsageAggregate{{
+		Namespace:            "ns",
+		Name:                 "db",
+		EngineType:           "pxc",
+		Hours:                2,
+		Backups:              3,
+		CpuRequestedMillis:   api.UsageStat{Average: 1500, Peak: 2000, Total: 3000},
+		MemoryRequestedBytes: api.UsageStat{Average: 1 << 30, Peak: 2 << 30, Total: 2 << 30},
+		DiskUsedBytes:        &api.UsageStat{Average: 1 << 30, Peak: 1 << 30, Total: 2 << 30},
+	}}))
+	assert.Equal(t, "namespace,name,engine_type,hours,backups,"+
+		"cpu_requested_cores_avg,cpu_requested_cores_peak,cpu_requested_cores_hours,"+
+		"cpu_used_cores_avg,cpu_used_cores_peak,cpu_used_cores_hours,"+
+		"memory_requested_gib_avg,memory_requested_gib_peak,memory_requested_gib_hours,"+
+		"memory_used_gib_avg,memory_used_gib_peak,memory_used_gib_hours,"+
+		"disk_requested_gib_avg,disk_requested_gib_peak,disk_requested_gib_hours,"+
+		"disk_used_gib_avg,disk_used_gib_peak,disk_used_gib_hours,"+
+		"backup_gib_avg,backup_gib_peak,backup_gib_hours\n"+
+		"ns,db,pxc,2.0000,3,"+
+		"1.5000,2.0000,3.0000,"+
+		",,,"+
+		"1.0000,2.0000,2.0000,"+
+		",,,"+
+		"0.0000,0.0000,0.0000,"+
+		"1.0000,1.0000,2.0000,"+
+		"0.0000,0.0000,0.0000\n", buf.String())
+}
+
+func TestParseTime(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseTime("2025-03-01")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), got)
+
+	got, err = ParseTime("2025-03-01T10:00:00+02:00")
+	require.NoError(t, err)
+	assert.True(t, got.Equal(time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)))
+
+	_, err = ParseTime("yesterday")
+	require.Error(t, err)
+}
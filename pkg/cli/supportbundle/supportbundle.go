@@ -0,0 +1,392 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package supportbundle provides the functionality to collect the diagnostic data of an Everest installation.
+package supportbundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/version"
+)
+
+const (
+	// DefaultLogLines is the default number of lines collected from the logs of each container.
+	DefaultLogLines = 10000
+
+	// clusterScopedDir is the directory of the bundle holding the cluster-scoped resources.
+	clusterScopedDir = "_cluster"
+	// errorsFile lists the data that could not be collected.
+	errorsFile = "errors.txt"
+)
+
+// collectedConfigMaps are the Everest ConfigMaps collected from the Everest namespace.
+//
+//nolint:gochecknoglobals
+var collectedConfigMaps = []string{
+	common.EverestSettingsConfigMapName,
+	common.EverestRBACConfigMapName,
+}
+
+type (
+	// Config is the configuration for collecting a support bundle.
+	Config struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// Output is the path of the support bundle.
+		Output string
+		// Namespaces are the database namespaces to collect. All the database namespaces are collected if it is empty.
+		Namespaces []string
+		// LogLines is the number of lines collected from the logs of each container.
+		LogLines int64
+	}
+
+	// SupportBundle is the CLI operation to collect a support bundle.
+	SupportBundle struct {
+		cfg           Config
+		kubeConnector kubernetes.KubernetesConnector
+		l             *zap.SugaredLogger
+		// getRelease returns the Helm release with the name from the namespace.
+		getRelease func(name, namespace string) (helm.ReleaseInfo, error)
+		// errs lists the data that could not be collected.
+		errs []string
+	}
+
+	// versionInfo is the version information collected in the support bundle.
+	versionInfo struct {
+		CLI            version.Info  `json:"cli"`
+		EverestVersion string        `json:"everestVersion"`
+		Releases       []releaseInfo `json:"releases"`
+	}
+
+	releaseInfo struct {
+		Name         string `json:"name"`
+		Namespace    string `json:"namespace"`
+		ChartVersion string `json:"chartVersion"`
+		Revision     int    `json:"revision"`
+		Status       string `json:"status"`
+	}
+)
+
+// DefaultOutput returns the default path of a support bundle collected at the time.
+func DefaultOutput(now time.Time) string {
+	return fmt.Sprintf("everest-support-bundle-%s.tar.gz", now.UTC().Format("20060102150405"))
+}
+
+// NewSupportBundle returns a new CLI operation to collect a support bundle.
+func NewSupportBundle(cfg Config, l *zap.SugaredLogger) (*SupportBundle, error) {
+	if cfg.Output == "" {
+		cfg.Output = DefaultOutput(time.Now())
+	}
+	if cfg.LogLines <= 0 {
+		cfg.LogLines = DefaultLogLines
+	}
+	s := &SupportBundle{
+		cfg: cfg,
+		l:   l.With("component", "support-bundle"),
+	}
+	if cfg.Pretty {
+		s.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(s.l, cfg.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	s.kubeConnector = k
+	s.getRelease = func(name, namespace string) (helm.ReleaseInfo, error) {
+		return helm.GetReleaseInfo(name, namespace, cfg.KubeconfigPath)
+	}
+	return s, nil
+}
+
+// Run the support bundle collection.
+// The data that cannot be collected is listed in the bundle instead of failing the collection.
+func (s *SupportBundle) Run(ctx context.Context) error {
+	f, err := os.Create(s.cfg.Output)
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+
+	w := newArchiveWriter(f)
+	if err := s.collect(ctx, w); err != nil {
+		return err
+	}
+	if err := w.close(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	s.l.Infof("Support bundle has been saved to '%s'", s.cfg.Output)
+	if s.cfg.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("Support bundle has been saved to '%s'", s.cfg.Output))
+		if len(s.errs) > 0 {
+			_, _ = fmt.Fprint(os.Stdout, output.Warn("%d items could not be collected, see %s in the bundle", len(s.errs), errorsFile))
+		}
+	}
+	return nil
+}
+
+func (s *SupportBundle) collect(ctx context.Context, w *archiveWriter) error {
+	namespaces, err := s.namespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	info := versionInfo{
+		CLI: version.Info{
+			ProjectName: version.ProjectName,
+			Version:     version.Version,
+			FullCommit:  version.FullCommit,
+		},
+		Releases: []releaseInfo{},
+	}
+	if v, err := version.EverestVersionFromDeployment(ctx, s.kubeConnector); err != nil {
+		s.recordError("Everest version", err)
+	} else {
+		info.EverestVersion = v.String()
+	}
+
+	for _, ns := range namespaces {
+		if rel := s.collectRelease(w, ns); rel != nil {
+			info.Releases = append(info.Releases, *rel)
+		}
+		if err := s.collectNamespace(ctx, w, ns); err != nil {
+			return err
+		}
+	}
+
+	policies, err := s.kubeConnector.ListEverestResources(ctx, "")
+	if err != nil {
+		s.recordError("cluster-scoped Everest resources", err)
+	}
+	for _, p := range policies {
+		if err := w.addObject(path.Join(clusterScopedDir, p.GetKind(), p.GetName()+".yaml"), p.Object); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range collectedConfigMaps {
+		cm, err := s.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: name})
+		if err != nil {
+			s.recordError("ConfigMap "+name, err)
+			continue
+		}
+		if err := w.addTyped(path.Join(common.SystemNamespace, "ConfigMap", name+".yaml"), cm); err != nil {
+			return err
+		}
+	}
+
+	if err := w.addObject("version.yaml", info); err != nil {
+		return err
+	}
+	if len(s.errs) > 0 {
+		return w.add(errorsFile, []byte(strings.Join(s.errs, "\n")+"\n"))
+	}
+	return nil
+}
+
+// namespaces returns the Everest namespaces and the database namespaces to collect.
+func (s *SupportBundle) namespaces(ctx context.Context) ([]string, error) {
+	dbNamespaces, err := s.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []string{common.SystemNamespace, common.MonitoringNamespace}
+	for _, ns := range dbNamespaces.Items {
+		if len(s.cfg.Namespaces) == 0 || slices.Contains(s.cfg.Namespaces, ns.GetName()) {
+			result = append(result, ns.GetName())
+		}
+	}
+	for _, ns := range s.cfg.Namespaces {
+		if !slices.Contains(result, ns) {
+			s.recordError("namespace "+ns, fmt.Errorf("namespace '%s' is not managed by Everest", ns))
+		}
+	}
+	return result, nil
+}
+
+// collectRelease adds the values of the Helm release of the namespace.
+// The release of each namespace has the same name as the namespace.
+func (s *SupportBundle) collectRelease(w *archiveWriter, namespace string) *releaseInfo {
+	rel, err := s.getRelease(namespace, namespace)
+	if err != nil {
+		s.recordError("Helm release "+namespace, err)
+		return nil
+	}
+	if rel.Revision == 0 {
+		return nil
+	}
+	if err := w.addObject(path.Join(namespace, "helm-values.yaml"), rel.Values); err != nil {
+		s.recordError("Helm values "+namespace, err)
+	}
+	return &releaseInfo{
+		Name:         namespace,
+		Namespace:    namespace,
+		ChartVersion: rel.ChartVersion,
+		Revision:     rel.Revision,
+		Status:       rel.Status,
+	}
+}
+
+// collectNamespace adds the pods and their logs, the events and the Everest resources of the namespace.
+func (s *SupportBundle) collectNamespace(ctx context.Context, w *archiveWriter, namespace string) error {
+	pods, err := s.kubeConnector.ListPods(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		s.recordError("pods of namespace "+namespace, err)
+	} else {
+		if err := w.addTyped(path.Join(namespace, "pods.yaml"), pods); err != nil {
+			return err
+		}
+		for _, pod := range pods.Items {
+			containers := slices.Concat(pod.Spec.InitContainers, pod.Spec.Containers)
+			for _, c := range containers {
+				logs, err := s.kubeConnector.GetPodLogs(ctx, ctrlclient.ObjectKeyFromObject(&pod), c.Name, s.cfg.LogLines)
+				if err != nil {
+					s.recordError(fmt.Sprintf("logs of container %s/%s/%s", namespace, pod.GetName(), c.Name), err)
+					continue
+				}
+				if err := w.add(path.Join(namespace, "logs", pod.GetName(), c.Name+".log"), []byte(RedactString(string(logs)))); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	events, err := s.kubeConnector.ListEvents(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		s.recordError("events of namespace "+namespace, err)
+	} else if err := w.addTyped(path.Join(namespace, "events.yaml"), events); err != nil {
+		return err
+	}
+
+	objs, err := s.kubeConnector.ListEverestResources(ctx, namespace)
+	if err != nil {
+		s.recordError("Everest resources of namespace "+namespace, err)
+	}
+	for _, obj := range objs {
+		if err := w.addObject(path.Join(namespace, obj.GetKind(), obj.GetName()+".yaml"), obj.Object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SupportBundle) recordError(item string, err error) {
+	s.l.Warnf("Could not collect %s: %s", item, err)
+	s.errs = append(s.errs, fmt.Sprintf("%s: %s", item, err))
+}
+
+// archiveWriter writes the redacted files of a support bundle to a gzipped tarball.
+type archiveWriter struct {
+	gz  *gzip.Writer
+	tw  *tar.Writer
+	now time.Time
+}
+
+func newArchiveWriter(w io.Writer) *archiveWriter {
+	gz := gzip.NewWriter(w)
+	return &archiveWriter{gz: gz, tw: tar.NewWriter(gz), now: time.Now()}
+}
+
+func (w *archiveWriter) add(name string, data []byte) error {
+	if err := w.tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0o600, //nolint:mnd
+		Size:     int64(len(data)),
+		ModTime:  w.now,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	_, err := w.tw.Write(data)
+	return err
+}
+
+// addTyped adds the Kubernetes object as redacted YAML.
+func (w *archiveWriter) addTyped(name string, obj runtime.Object) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	return w.addObject(name, content)
+}
+
+// addObject adds the value as redacted YAML. The managed fields of the Kubernetes objects are dropped.
+func (w *archiveWriter) addObject(name string, v interface{}) error {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	// Decode the value again, so that structs are redacted the same way as the unstructured objects.
+	var decoded interface{}
+	if err := yaml.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	dropManagedFields(decoded)
+	data, err = yaml.Marshal(RedactValue(decoded))
+	if err != nil {
+		return err
+	}
+	return w.add(name, data)
+}
+
+func dropManagedFields(v interface{}) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if metadata, ok := val["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "managedFields")
+		}
+		for _, item := range val {
+			dropManagedFields(item)
+		}
+	case []interface{}:
+		for _, item := range val {
+			dropManagedFields(item)
+		}
+	}
+}
+
+func (w *archiveWriter) close() error {
+	if err := w.tw.Close(); err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
@@ -0,0 +1,284 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package uninstall
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/cli/steps"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/output"
+)
+
+const (
+	backupPollInterval = 10 * time.Second
+	// DefaultBackupTimeout is the default time to wait for the final backups to complete.
+	DefaultBackupTimeout = 15 * time.Minute
+
+	// clusterScopedDir is the directory of the export archive holding the cluster-scoped resources.
+	clusterScopedDir = "_cluster"
+)
+
+// exportedConfigMaps are the Everest ConfigMaps exported from the Everest namespace.
+//
+//nolint:gochecknoglobals
+var exportedConfigMaps = []string{
+	common.EverestSettingsConfigMapName,
+	common.EverestRBACConfigMapName,
+	common.EverestEngineVersionPolicyConfigMapName,
+	common.EverestEngineConfigTemplatesConfigMapName,
+}
+
+// ErrBackupStorageNotFound is returned when the backup storage for the final backups is missing from a namespace.
+var ErrBackupStorageNotFound = errors.New("backup storage not found")
+
+// validateBackupStorage checks that the backup storage for the final backups exists in every namespace with databases.
+func (u *Uninstall) validateBackupStorage(ctx context.Context, nsList *corev1.NamespaceList) error {
+	for _, ns := range nsList.Items {
+		dbs, err := u.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(ns.GetName()))
+		if err != nil {
+			return err
+		}
+		if len(dbs.Items) == 0 {
+			continue
+		}
+		_, err = u.kubeConnector.GetBackupStorage(ctx, types.NamespacedName{Namespace: ns.GetName(), Name: u.config.BackupStorage})
+		if k8serrors.IsNotFound(err) {
+			return errors.Join(ErrBackupStorageNotFound,
+				fmt.Errorf("backup storage '%s' does not exist in namespace '%s'", u.config.BackupStorage, ns.GetName()))
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *Uninstall) newStepExportResources(nsList *corev1.NamespaceList) steps.Step {
+	return steps.Step{
+		Desc: fmt.Sprintf("Exporting Everest resources to '%s'", u.config.ExportPath),
+		F: func(ctx context.Context) error {
+			return u.exportResources(ctx, nsList)
+		},
+	}
+}
+
+// exportResources writes the Everest resources of the DB namespaces, the cluster-scoped Everest resources
+// and the Everest ConfigMaps to a gzipped tarball, one <namespace>/<kind>/<name>.yaml file per resource.
+// The Secrets are not exported.
+func (u *Uninstall) exportResources(ctx context.Context, nsList *corev1.NamespaceList) error {
+	objs, err := u.kubeConnector.ListEverestResources(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, ns := range nsList.Items {
+		nsObjs, err := u.kubeConnector.ListEverestResources(ctx, ns.GetName())
+		if err != nil {
+			return err
+		}
+		objs = append(objs, nsObjs...)
+
+		quota, err := u.configMapObject(ctx, ns.GetName(), common.EverestQuotaConfigMapName)
+		if err != nil {
+			return err
+		}
+		if quota != nil {
+			objs = append(objs, *quota)
+		}
+	}
+	for _, name := range exportedConfigMaps {
+		cm, err := u.configMapObject(ctx, common.SystemNamespace, name)
+		if err != nil {
+			return err
+		}
+		if cm != nil {
+			objs = append(objs, *cm)
+		}
+	}
+	return writeExport(u.config.ExportPath, objs)
+}
+
+// configMapObject returns the ConfigMap as an unstructured object, or nil if it does not exist.
+func (u *Uninstall) configMapObject(ctx context.Context, namespace, name string) (*unstructured.Unstructured, error) {
+	cm, err := u.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil //nolint:nilnil
+	} else if err != nil {
+		return nil, err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cm)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{Object: content}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	return obj, nil
+}
+
+func writeExport(exportPath string, objs []unstructured.Unstructured) error {
+	f, err := os.Create(exportPath) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, obj := range objs {
+		// Drop the fields set by the cluster, so that the resources can be applied again.
+		obj.SetManagedFields(nil)
+		obj.SetResourceVersion("")
+		obj.SetUID("")
+		obj.SetGeneration(0)
+		obj.SetCreationTimestamp(metav1.Time{})
+		obj.SetOwnerReferences(nil)
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return err
+		}
+		dir := obj.GetNamespace()
+		if dir == "" {
+			dir = clusterScopedDir
+		}
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     path.Join(dir, obj.GetKind(), obj.GetName()+".yaml"),
+			Mode:     0o600, //nolint:mnd
+			Size:     int64(len(data)),
+			ModTime:  now,
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func (u *Uninstall) newStepTakeFinalBackups(namespace string) steps.Step {
+	return steps.Step{
+		Desc: fmt.Sprintf("Taking final backups of database clusters in namespace '%s'", namespace),
+		F: func(ctx context.Context) error {
+			return u.takeFinalBackups(ctx, namespace)
+		},
+	}
+}
+
+// takeFinalBackups backs up every database cluster in the namespace to the chosen backup storage and waits
+// for the backups to complete. The paused and not ready database clusters cannot be backed up, so they are
+// skipped with a warning. All the backups in the namespace are then protected, so that their data is kept
+// in the backup storage when the backups are deleted together with Everest.
+func (u *Uninstall) takeFinalBackups(ctx context.Context, namespace string) error {
+	dbs, err := u.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	suffix := time.Now().UTC().Format("20060102150405")
+	pending := make(map[string]struct{}, len(dbs.Items))
+	for _, db := range dbs.Items {
+		if db.Spec.Paused || db.Status.Status != everestv1alpha1.AppStateReady {
+			msg := fmt.Sprintf("Skipping the final backup of database cluster '%s' in namespace '%s' since it is paused or not ready", db.GetName(), namespace)
+			_, _ = fmt.Fprint(os.Stdout, output.Warn("%s", msg))
+			u.l.Warn(msg)
+			continue
+		}
+		backup := &everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-final-%s", db.GetName(), suffix),
+				Namespace: namespace,
+			},
+			Spec: everestv1alpha1.DatabaseClusterBackupSpec{
+				DBClusterName:     db.GetName(),
+				BackupStorageName: u.config.BackupStorage,
+			},
+		}
+		if _, err := u.kubeConnector.CreateDatabaseClusterBackup(ctx, backup); err != nil {
+			return fmt.Errorf("could not create final backup of database cluster '%s': %w", db.GetName(), err)
+		}
+		pending[backup.GetName()] = struct{}{}
+	}
+
+	if err := wait.PollUntilContextTimeout(ctx, backupPollInterval, u.config.BackupTimeout, true, func(ctx context.Context) (bool, error) {
+		for name := range pending {
+			backup, err := u.kubeConnector.GetDatabaseClusterBackup(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+			if err != nil {
+				return false, err
+			}
+			switch backup.Status.State {
+			case everestv1alpha1.BackupSucceeded:
+				u.l.Infof("Final backup '%s' of database cluster '%s' succeeded", name, backup.Spec.DBClusterName)
+				delete(pending, name)
+			case everestv1alpha1.BackupFailed:
+				return false, fmt.Errorf("final backup '%s' of database cluster '%s' failed", name, backup.Spec.DBClusterName)
+			}
+		}
+		return len(pending) == 0, nil
+	}); err != nil {
+		return err
+	}
+	return u.protectBackups(ctx, namespace)
+}
+
+func (u *Uninstall) protectBackups(ctx context.Context, namespace string) error {
+	backups, err := u.kubeConnector.ListDatabaseClusterBackups(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	for _, b := range backups.Items {
+		if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			backup, err := u.kubeConnector.GetDatabaseClusterBackup(ctx, types.NamespacedName{Namespace: namespace, Name: b.GetName()})
+			if err != nil {
+				return err
+			}
+			if !controllerutil.AddFinalizer(backup, everestv1alpha1.DBBackupStorageProtectionFinalizer) {
+				return nil
+			}
+			_, err = u.kubeConnector.UpdateDatabaseClusterBackup(ctx, backup)
+			return err
+		}); ctrlclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("could not protect backup '%s': %w", b.GetName(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package uninstall
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func newTestUninstall(cfg Config) *Uninstall {
+	c := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(
+		&everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "ns-1", ResourceVersion: "5"},
+		},
+		&everestv1alpha1.BackupStorage{
+			ObjectMeta: metav1.ObjectMeta{Name: "s3", Namespace: "ns-1"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.EverestRBACConfigMapName, Namespace: common.SystemNamespace},
+			Data:       map[string]string{"enabled": "true"},
+		},
+	).Build()
+	return &Uninstall{
+		config:        cfg,
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(c),
+		l:             zap.NewNop().Sugar(),
+	}
+}
+
+func testNamespaces(names ...string) *corev1.NamespaceList {
+	list := &corev1.NamespaceList{}
+	for _, name := range names {
+		list.Items = append(list.Items, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	return list
+}
+
+func TestValidateBackupStorage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	u := newTestUninstall(Config{BackupStorage: "s3"})
+	require.NoError(t, u.validateBackupStorage(ctx, testNamespaces("ns-1", "ns-2")))
+
+	u = newTestUninstall(Config{BackupStorage: "gcs"})
+	err := u.validateBackupStorage(ctx, testNamespaces("ns-1"))
+	require.ErrorIs(t, err, ErrBackupStorageNotFound)
+}
+
+func TestExportResources(t *testing.T) {
+	t.Parallel()
+	exportPath := filepath.Join(t.TempDir(), "export.tar.gz")
+	u := newTestUninstall(Config{ExportPath: exportPath})
+	require.NoError(t, u.exportResources(context.Background(), testNamespaces("ns-1")))
+
+	f, err := os.Open(exportPath)
+	require.NoError(t, err)
+	defer f.Close() //nolint:errcheck
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := map[string]map[string]interface{}{}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		obj := map[string]interface{}{}
+		require.NoError(t, yaml.Unmarshal(data, &obj))
+		files[hdr.Name] = obj
+	}
+
+	assert.Len(t, files, 3)
+	require.Contains(t, files, "ns-1/DatabaseCluster/db.yaml")
+	assert.NotContains(t, files["ns-1/DatabaseCluster/db.yaml"]["metadata"], "resourceVersion")
+	assert.Contains(t, files, "ns-1/BackupStorage/s3.yaml")
+	require.Contains(t, files, "everest-system/ConfigMap/everest-rbac.yaml")
+	assert.Equal(t, "ConfigMap", files["everest-system/ConfigMap/everest-rbac.yaml"]["kind"])
+}
+
+func TestTakeFinalBackupsSkipsNotReady(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	u := newTestUninstall(Config{BackupStorage: "s3", BackupTimeout: time.Second})
+	paused := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "paused", Namespace: "ns-1"},
+		Spec:       everestv1alpha1.DatabaseClusterSpec{Paused: true},
+	}
+	_, err := u.kubeConnector.CreateDatabaseCluster(ctx, paused)
+	require.NoError(t, err)
+
+	require.NoError(t, u.takeFinalBackups(ctx, "ns-1"))
+	backups, err := u.kubeConnector.ListDatabaseClusterBackups(ctx, ctrlclient.InNamespace("ns-1"))
+	require.NoError(t, err)
+	assert.Empty(t, backups.Items)
+}
+
+func TestNewUninstallStepsOrder(t *testing.T) {
+	t.Parallel()
+	u := newTestUninstall(Config{BackupStorage: "s3", ExportPath: "export.tar.gz"})
+	uninstallSteps := u.newUninstallSteps(testNamespaces("ns-1"))
+	require.GreaterOrEqual(t, len(uninstallSteps), 2)
+	assert.Equal(t, u.newStepTakeFinalBackups("ns-1").Desc, uninstallSteps[0].Desc)
+	assert.Equal(t, u.newStepExportResources(testNamespaces("ns-1")).Desc, uninstallSteps[1].Desc)
+}
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/data"
 	"github.com/percona/everest/pkg/cli/helm"
 	"github.com/percona/everest/pkg/cli/steps"
 	"github.com/percona/everest/pkg/common"
@@ -26,6 +27,19 @@ func (u *Uninstall) newStepUninstallHelmChart() steps.Step {
 	}
 }
 
+// The Everest API RBAC is applied by everestctl outside the Helm chart, so it is deleted separately.
+func (u *Uninstall) newStepDeleteEverestServerRBAC() steps.Step {
+	return steps.Step{
+		Desc: "Deleting Everest API RBAC",
+		F: func(_ context.Context) error {
+			if err := u.kubeConnector.DeleteManifestFile(data.EverestServerNamespaceProvisioningRBAC, common.SystemNamespace); err != nil {
+				return err
+			}
+			return u.kubeConnector.DeleteManifestFile(data.EverestServerRBAC, common.SystemNamespace)
+		},
+	}
+}
+
 func (u *Uninstall) newStepDeleteNamespace(ns string) steps.Step {
 	return steps.Step{
 		Desc: fmt.Sprintf("Deleting namespace '%s'", ns),
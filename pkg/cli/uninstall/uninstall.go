@@ -27,12 +27,12 @@ import (
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 
-	"github.com/percona/everest/pkg/cli/namespaces"
 	"github.com/percona/everest/pkg/cli/steps"
 	"github.com/percona/everest/pkg/cli/tui"
 	cliutils "github.com/percona/everest/pkg/cli/utils"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/namespaces"
 )
 
 const (
@@ -60,10 +60,23 @@ type Config struct {
 	SkipEnvDetection bool
 	// If set, we will print the pretty output.
 	Pretty bool
+	// BackupStorage is the name of the backup storage to take a final backup of every database cluster to
+	// before uninstalling. The backup storage must exist in every namespace with database clusters.
+	BackupStorage string
+	// BackupTimeout is the time to wait for the final backups to complete. Defaults to DefaultBackupTimeout.
+	BackupTimeout time.Duration
+	// ExportPath is the path of the archive to export the Everest resources to before uninstalling.
+	ExportPath string
+	// KeepDatabases is set if the database clusters, the database operators and the database namespaces
+	// should be left running, unmanaged by Everest.
+	KeepDatabases bool
 }
 
 // NewUninstall returns a new Uninstall struct.
 func NewUninstall(c Config, l *zap.SugaredLogger) (*Uninstall, error) {
+	if c.BackupTimeout <= 0 {
+		c.BackupTimeout = DefaultBackupTimeout
+	}
 	cli := &Uninstall{
 		config: c,
 		l:      l,
@@ -108,7 +121,7 @@ func (u *Uninstall) Run(ctx context.Context) error {
 		return errors.Join(err, errors.New("failed to check if databases exist"))
 	}
 
-	if dbsExist && !u.config.Force {
+	if dbsExist && !u.config.Force && !u.config.KeepDatabases {
 		// there are still DB clusters managed by Everest.
 		// Need to ask user for DB clusters deletion confirmation.
 		if force, err := u.confirmForce(ctx); err != nil {
@@ -123,6 +136,11 @@ func (u *Uninstall) Run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to get database namespaces: %w", err)
 	}
+	if u.config.BackupStorage != "" {
+		if err := u.validateBackupStorage(ctx, dbNamespaces); err != nil {
+			return err
+		}
+	}
 
 	uninstallSteps := u.newUninstallSteps(dbNamespaces)
 	if err := steps.RunStepsWithSpinner(ctx, u.l, uninstallSteps, u.config.Pretty); err != nil {
@@ -154,14 +172,34 @@ func (u *Uninstall) setKubernetesEnv(ctx context.Context) error {
 
 func (u *Uninstall) newUninstallSteps(nsList *corev1.NamespaceList) []steps.Step {
 	var uninstallSteps []steps.Step
+	if nsList == nil {
+		nsList = &corev1.NamespaceList{}
+	}
 
-	if nsList != nil {
+	// The final backups are taken first, so that they are exported together with the other backups.
+	if u.config.BackupStorage != "" {
 		for _, ns := range nsList.Items {
-			uninstallSteps = append(uninstallSteps, namespaces.NewRemoveNamespaceSteps(ns.GetName(), false, u.kubeConnector)...)
+			uninstallSteps = append(uninstallSteps, u.newStepTakeFinalBackups(ns.GetName()))
 		}
 	}
+	if u.config.ExportPath != "" {
+		uninstallSteps = append(uninstallSteps, u.newStepExportResources(nsList))
+	}
 
-	uninstallSteps = append(uninstallSteps, u.newStepUninstallHelmChart())
+	if u.config.KeepDatabases {
+		// The Everest operator is uninstalled first, so that it does not clean up
+		// the database clusters once their Everest resources are deleted.
+		uninstallSteps = append(uninstallSteps, u.newStepUninstallHelmChart())
+		for _, ns := range nsList.Items {
+			uninstallSteps = append(uninstallSteps, namespaces.NewUnmanageNamespaceSteps(ns.GetName(), u.kubeConnector)...)
+		}
+	} else {
+		for _, ns := range nsList.Items {
+			uninstallSteps = append(uninstallSteps, namespaces.NewRemoveNamespaceSteps(ns.GetName(), false, u.kubeConnector)...)
+		}
+		uninstallSteps = append(uninstallSteps, u.newStepUninstallHelmChart())
+	}
+	uninstallSteps = append(uninstallSteps, u.newStepDeleteEverestServerRBAC())
 	uninstallSteps = append(uninstallSteps, u.newStepDeleteNamespace(common.MonitoringNamespace))
 	uninstallSteps = append(uninstallSteps, u.newStepDeleteNamespace(common.SystemNamespace))
 	uninstallSteps = append(uninstallSteps, u.newStepDeleteCRDs())
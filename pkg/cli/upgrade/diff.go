@@ -0,0 +1,176 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/everest/pkg/cli/helm"
+)
+
+type (
+	// ResourceChange lists the fields of a Kubernetes resource changed by an upgrade.
+	ResourceChange struct {
+		// Resource identifies the resource as kind/namespace/name.
+		Resource string `json:"resource"`
+		// Fields are the changed fields.
+		Fields []FieldChange `json:"fields"`
+	}
+
+	// FieldChange is a changed field of a Kubernetes resource.
+	// The values are JSON encoded and empty if the field is added or removed, or if it holds a secret.
+	FieldChange struct {
+		Path string `json:"path"`
+		From string `json:"from,omitempty"`
+		To   string `json:"to,omitempty"`
+	}
+
+	// ManifestDiff is the difference between the manifests of a Helm release before and after an upgrade.
+	ManifestDiff struct {
+		// Added are the resources created by the upgrade.
+		Added []string `json:"added"`
+		// Removed are the resources deleted by the upgrade.
+		Removed []string `json:"removed"`
+		// Changed are the resources updated by the upgrade.
+		Changed []ResourceChange `json:"changed"`
+	}
+)
+
+// diffManifests compares the resources of two rendered Helm manifests. The CRDs are skipped
+// since they are not managed by the Helm releases.
+func diffManifests(from, to helm.RenderedTemplate) (*ManifestDiff, error) {
+	fromObjs, err := parseManifest(from)
+	if err != nil {
+		return nil, err
+	}
+	toObjs, err := parseManifest(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &ManifestDiff{Added: []string{}, Removed: []string{}, Changed: []ResourceChange{}}
+	for _, key := range sortedKeys(toObjs) {
+		fromObj, ok := fromObjs[key]
+		if !ok {
+			diff.Added = append(diff.Added, key)
+			continue
+		}
+		fields := diffValues("", fromObj, toObjs[key])
+		if len(fields) == 0 {
+			continue
+		}
+		if strings.HasPrefix(key, "Secret/") {
+			for i := range fields {
+				fields[i].From, fields[i].To = "", ""
+			}
+		}
+		diff.Changed = append(diff.Changed, ResourceChange{Resource: key, Fields: fields})
+	}
+	for _, key := range sortedKeys(fromObjs) {
+		if _, ok := toObjs[key]; !ok {
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+	return diff, nil
+}
+
+// parseManifest returns the resources of a rendered manifest by kind/namespace/name.
+func parseManifest(manifest helm.RenderedTemplate) (map[string]map[string]interface{}, error) {
+	result := make(map[string]map[string]interface{})
+	for _, doc := range manifest.Strings() {
+		obj := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			return nil, fmt.Errorf("could not parse manifest: %w", err)
+		}
+		kind, _ := obj["kind"].(string)
+		if kind == "" || kind == "CustomResourceDefinition" {
+			continue
+		}
+		metadata, _ := obj["metadata"].(map[string]interface{})
+		namespace, _ := metadata["namespace"].(string)
+		name, _ := metadata["name"].(string)
+		result[kind+"/"+namespace+"/"+name] = obj
+	}
+	return result, nil
+}
+
+// diffValues returns the paths of the leaf fields that differ between two decoded JSON values.
+func diffValues(path string, from, to interface{}) []FieldChange {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if fromIsMap && toIsMap {
+		keys := sortedKeys(fromMap)
+		for _, k := range sortedKeys(toMap) {
+			if _, ok := fromMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		var result []FieldChange
+		for _, k := range keys {
+			result = append(result, diffValues(joinPath(path, k), fromMap[k], toMap[k])...)
+		}
+		return result
+	}
+
+	fromSlice, fromIsSlice := from.([]interface{})
+	toSlice, toIsSlice := to.([]interface{})
+	if fromIsSlice && toIsSlice && len(fromSlice) == len(toSlice) {
+		var result []FieldChange
+		for i := range fromSlice {
+			result = append(result, diffValues(fmt.Sprintf("%s[%d]", path, i), fromSlice[i], toSlice[i])...)
+		}
+		return result
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return nil
+	}
+	return []FieldChange{{Path: path, From: encodeValue(from), To: encodeValue(to)}}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func encodeValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
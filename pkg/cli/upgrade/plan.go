@@ -0,0 +1,452 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/dbupgrade"
+	cliVersion "github.com/percona/everest/pkg/version"
+)
+
+// maxPlanValueLength is the length the values of the changed fields are truncated to in the plan text.
+const maxPlanValueLength = 60
+
+type (
+	// Plan describes what an upgrade would change.
+	Plan struct {
+		// CurrentVersion is the installed Everest version.
+		CurrentVersion string `json:"currentVersion"`
+		// TargetVersion is the Everest version to upgrade to. It is empty if no upgrade is available.
+		TargetVersion string `json:"targetVersion,omitempty"`
+		// CLIVersionError is set if the version of everestctl does not meet the requirements of the target version.
+		CLIVersionError string `json:"cliVersionError,omitempty"`
+		// OperatorRequirements are the results of the checks of the installed operator versions.
+		OperatorRequirements []OperatorRequirement `json:"operatorRequirements"`
+		// Releases are the changes to the Helm releases of Everest and of the DB namespaces.
+		Releases []ReleasePlan `json:"releases"`
+		// CRDs are the changes to the Everest CRDs.
+		CRDs []CRDChange `json:"crds"`
+		// DatabaseEngines lists the database engines with pending operator upgrades per DB namespace.
+		// Their database clusters need post-upgrade tasks once the operators are upgraded.
+		DatabaseEngines []DatabaseEnginePlan `json:"databaseEngines"`
+	}
+
+	// OperatorRequirement is the result of the check of an installed operator version.
+	OperatorRequirement struct {
+		Namespace   string `json:"namespace"`
+		Operator    string `json:"operator"`
+		Version     string `json:"version"`
+		Constraints string `json:"constraints"`
+		Satisfied   bool   `json:"satisfied"`
+		// Pinned is set if Version is the version the operator is pinned to in the namespace.
+		Pinned bool `json:"pinned,omitempty"`
+	}
+
+	// ReleasePlan describes the changes to a Helm release.
+	ReleasePlan struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+		// FromChartVersion is the chart version of the installed release. It is empty if there is no release.
+		FromChartVersion string `json:"fromChartVersion,omitempty"`
+		ToChartVersion   string `json:"toChartVersion"`
+		ManifestDiff
+	}
+
+	// CRDChange describes the changes to a CRD.
+	CRDChange struct {
+		Name string `json:"name"`
+		// New is set if the CRD is not installed yet.
+		New                bool               `json:"new,omitempty"`
+		AddedVersions      []string           `json:"addedVersions,omitempty"`
+		RemovedVersions    []string           `json:"removedVersions,omitempty"`
+		ChangedVersions    []CRDVersionChange `json:"changedVersions,omitempty"`
+		FromStorageVersion string             `json:"fromStorageVersion,omitempty"`
+		ToStorageVersion   string             `json:"toStorageVersion,omitempty"`
+	}
+
+	// CRDVersionChange lists the schema fields of a CRD version changed by an upgrade.
+	CRDVersionChange struct {
+		Version string        `json:"version"`
+		Fields  []FieldChange `json:"fields"`
+	}
+
+	// DatabaseEnginePlan lists the database engines with pending operator upgrades in a DB namespace
+	// and the tasks of the database clusters.
+	DatabaseEnginePlan struct {
+		Namespace        string            `json:"namespace"`
+		OperatorUpgrades []api.Upgrade     `json:"operatorUpgrades"`
+		PendingTasks     []api.UpgradeTask `json:"pendingTasks"`
+	}
+)
+
+// RequirementsMet returns true if the upgrade requirements are met.
+func (p *Plan) RequirementsMet() bool {
+	return p.CLIVersionError == "" && !slices.ContainsFunc(p.OperatorRequirements, func(r OperatorRequirement) bool {
+		return !r.Satisfied
+	})
+}
+
+// Plan returns what an upgrade would change without changing anything.
+// The manifests are rendered without access to the cluster, so the resources whose templates
+// look up existing objects may be reported as changed.
+func (u *Upgrade) Plan(ctx context.Context) (*Plan, error) {
+	everestVersion, err := cliVersion.EverestVersionFromDeployment(ctx, u.kubeConnector)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not retrieve Everest version"))
+	}
+	plan := &Plan{
+		CurrentVersion:       everestVersion.String(),
+		OperatorRequirements: []OperatorRequirement{},
+		Releases:             []ReleasePlan{},
+		CRDs:                 []CRDChange{},
+		DatabaseEngines:      []DatabaseEnginePlan{},
+	}
+
+	upgradeTo, meta, err := u.versionToUpgradeTo(ctx, everestVersion)
+	if errors.Is(err, ErrNoUpdateAvailable) {
+		return plan, nil
+	} else if err != nil {
+		return nil, err
+	}
+	u.upgradeToVersion = upgradeTo.String()
+	plan.TargetVersion = u.upgradeToVersion
+
+	supVer, err := common.NewSupportedVersion(meta)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.VerifyCLIVersion(supVer); err != nil {
+		plan.CLIVersionError = err.Error()
+	}
+	if plan.OperatorRequirements, err = u.getOperatorRequirements(ctx, supVer); err != nil {
+		return nil, err
+	}
+
+	if err := u.setKubernetesEnv(ctx); err != nil {
+		return nil, fmt.Errorf("could not detect Kubernetes environment: %w", err)
+	}
+	if err := u.setupHelmInstaller(ctx); err != nil {
+		return nil, fmt.Errorf("could not initialize Helm installer: %w", err)
+	}
+	manifests, err := u.helmInstaller.RenderTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not render Helm templates: %w", err)
+	}
+	release, err := u.planRelease(common.SystemNamespace, manifests)
+	if err != nil {
+		return nil, err
+	}
+	plan.Releases = append(plan.Releases, *release)
+	if plan.CRDs, err = u.planCRDs(ctx, manifests); err != nil {
+		return nil, err
+	}
+
+	dbNamespaces, err := u.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get database namespaces: %w", err)
+	}
+	// The engines are checked the same way as by the Everest API server, but are upgraded
+	// to the operator versions supported by the target version of Everest.
+	targetVersion := dbupgrade.SupportedUpgradeVersion(supVer)
+	for _, ns := range dbNamespaces.Items {
+		release, err := u.planDBNamespaceRelease(ctx, ns.GetName())
+		if err != nil {
+			return nil, err
+		}
+		plan.Releases = append(plan.Releases, *release)
+
+		upgradePlan, err := dbupgrade.GetUpgradePlan(ctx, u.kubeConnector, u.versionService, ns.GetName(), targetVersion)
+		if err != nil {
+			return nil, fmt.Errorf("could not get the upgrade plan of namespace '%s': %w", ns.GetName(), err)
+		}
+		if len(pointer.Get(upgradePlan.Upgrades)) == 0 {
+			continue
+		}
+		plan.DatabaseEngines = append(plan.DatabaseEngines, DatabaseEnginePlan{
+			Namespace:        ns.GetName(),
+			OperatorUpgrades: pointer.Get(upgradePlan.Upgrades),
+			PendingTasks:     pointer.Get(upgradePlan.PendingActions),
+		})
+	}
+	return plan, nil
+}
+
+// planRelease compares the manifest of the installed release with the rendered manifest of the new chart.
+// The release of each namespace has the same name as the namespace.
+func (u *Upgrade) planRelease(namespace string, manifests helm.RenderedTemplate) (*ReleasePlan, error) {
+	current, err := u.releases.GetReleaseInfo(namespace, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not get Helm release '%s': %w", namespace, err)
+	}
+	diff, err := diffManifests(helm.NewRenderedTemplate(current.Manifest), manifests)
+	if err != nil {
+		return nil, fmt.Errorf("could not compare the manifests of Helm release '%s': %w", namespace, err)
+	}
+	return &ReleasePlan{
+		Name:             namespace,
+		Namespace:        namespace,
+		FromChartVersion: current.ChartVersion,
+		ToChartVersion:   u.upgradeToVersion,
+		ManifestDiff:     *diff,
+	}, nil
+}
+
+// planDBNamespaceRelease renders the new DB namespace chart with the values of the installed release,
+// since the DB namespace releases are upgraded reusing their values.
+func (u *Upgrade) planDBNamespaceRelease(ctx context.Context, namespace string) (*ReleasePlan, error) {
+	current, err := u.releases.GetReleaseInfo(namespace, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not get Helm release '%s': %w", namespace, err)
+	}
+	installer := helm.Installer{
+		ReleaseName:      namespace,
+		ReleaseNamespace: namespace,
+		ImageRegistry:    u.config.ImageRegistry,
+		Values:           current.Values,
+	}
+	if err := installer.Init(u.config.KubeconfigPath, helm.ChartOptions{
+		ArchiveDir: u.config.ArchiveDir,
+		URL:        u.config.RepoURL,
+		Directory:  utils.DBNamespaceSubChartPath(u.config.ChartDir),
+		Name:       helm.EverestDBNamespaceChartName,
+		Version:    u.upgradeToVersion,
+	}); err != nil {
+		return nil, fmt.Errorf("could not initialize Helm installer: %w", err)
+	}
+	manifests, err := installer.RenderTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not render Helm templates of namespace '%s': %w", namespace, err)
+	}
+	return u.planRelease(namespace, manifests)
+}
+
+// planCRDs compares the installed CRDs with the CRDs of the new chart.
+func (u *Upgrade) planCRDs(ctx context.Context, manifests helm.RenderedTemplate) ([]CRDChange, error) {
+	docs, err := manifests.GetCRDs()
+	if err != nil {
+		return nil, fmt.Errorf("could not get CRDs: %w", err)
+	}
+	result := []CRDChange{}
+	for _, doc := range docs {
+		crd := &apiextv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal([]byte(doc), crd); err != nil {
+			return nil, fmt.Errorf("could not parse CRD: %w", err)
+		}
+		if crd.GetName() == "" {
+			continue
+		}
+		current, err := u.kubeConnector.GetCRD(ctx, types.NamespacedName{Name: crd.GetName()})
+		if k8serrors.IsNotFound(err) {
+			result = append(result, CRDChange{Name: crd.GetName(), New: true, ToStorageVersion: storageVersion(crd)})
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("could not get CRD '%s': %w", crd.GetName(), err)
+		}
+		if change := diffCRDs(current, crd); change != nil {
+			result = append(result, *change)
+		}
+	}
+	slices.SortFunc(result, func(a, b CRDChange) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result, nil
+}
+
+// diffCRDs returns the changes between two CRDs, or nil if their versions and schemas are the same.
+func diffCRDs(from, to *apiextv1.CustomResourceDefinition) *CRDChange {
+	change := &CRDChange{Name: to.GetName()}
+	for _, v := range to.Spec.Versions {
+		idx := slices.IndexFunc(from.Spec.Versions, func(fv apiextv1.CustomResourceDefinitionVersion) bool {
+			return fv.Name == v.Name
+		})
+		if idx < 0 {
+			change.AddedVersions = append(change.AddedVersions, v.Name)
+			continue
+		}
+		fromSchema, toSchema := from.Spec.Versions[idx].Schema, v.Schema
+		if equality.Semantic.DeepEqual(fromSchema, toSchema) {
+			continue
+		}
+		change.ChangedVersions = append(change.ChangedVersions, CRDVersionChange{
+			Version: v.Name,
+			Fields:  diffValues("", toUnstructured(fromSchema), toUnstructured(toSchema)),
+		})
+	}
+	for _, v := range from.Spec.Versions {
+		if !slices.ContainsFunc(to.Spec.Versions, func(tv apiextv1.CustomResourceDefinitionVersion) bool {
+			return tv.Name == v.Name
+		}) {
+			change.RemovedVersions = append(change.RemovedVersions, v.Name)
+		}
+	}
+	if fromStorage, toStorage := storageVersion(from), storageVersion(to); fromStorage != toStorage {
+		change.FromStorageVersion, change.ToStorageVersion = fromStorage, toStorage
+	}
+	if len(change.AddedVersions) == 0 && len(change.RemovedVersions) == 0 &&
+		len(change.ChangedVersions) == 0 && change.ToStorageVersion == "" {
+		return nil
+	}
+	return change
+}
+
+func storageVersion(crd *apiextv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return ""
+}
+
+func toUnstructured(v *apiextv1.CustomResourceValidation) map[string]interface{} {
+	if v == nil {
+		return nil
+	}
+	result, err := runtime.DefaultUnstructuredConverter.ToUnstructured(v)
+	if err != nil {
+		return nil
+	}
+	return result
+}
+
+// WritePlan writes the plan in a human-readable form.
+func WritePlan(w io.Writer, p *Plan) {
+	_, _ = fmt.Fprintf(w, "Current version: %s\n", p.CurrentVersion)
+	if p.TargetVersion == "" {
+		_, _ = fmt.Fprintln(w, "You're running the latest version of Everest")
+		return
+	}
+	_, _ = fmt.Fprintf(w, "Target version:  %s\n", p.TargetVersion)
+
+	_, _ = fmt.Fprintln(w, "\nRequirements:")
+	if p.CLIVersionError != "" {
+		_, _ = fmt.Fprintf(w, "  ✗ everestctl: %s\n", p.CLIVersionError)
+	} else {
+		_, _ = fmt.Fprintln(w, "  ✓ everestctl")
+	}
+	for _, r := range p.OperatorRequirements {
+		mark := "✓"
+		if !r.Satisfied {
+			mark = "✗"
+		}
+		pinned := ""
+		if r.Pinned {
+			pinned = " pinned"
+		}
+		_, _ = fmt.Fprintf(w, "  %s %s/%s %s%s (%s)\n", mark, r.Namespace, r.Operator, r.Version, pinned, r.Constraints)
+	}
+
+	_, _ = fmt.Fprintln(w, "\nHelm releases:")
+	for _, r := range p.Releases {
+		_, _ = fmt.Fprintf(w, "  %s (chart %s -> %s)\n", r.Name, valueOr(r.FromChartVersion, "not installed"), r.ToChartVersion)
+		if len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Changed) == 0 {
+			_, _ = fmt.Fprintln(w, "    no changes")
+		}
+		for _, res := range r.Added {
+			_, _ = fmt.Fprintf(w, "    + %s\n", res)
+		}
+		for _, res := range r.Removed {
+			_, _ = fmt.Fprintf(w, "    - %s\n", res)
+		}
+		for _, res := range r.Changed {
+			_, _ = fmt.Fprintf(w, "    ~ %s\n", res.Resource)
+			writeFieldChanges(w, res.Fields, "        ")
+		}
+	}
+
+	_, _ = fmt.Fprintln(w, "\nCRDs:")
+	if len(p.CRDs) == 0 {
+		_, _ = fmt.Fprintln(w, "  no changes")
+	}
+	for _, c := range p.CRDs {
+		if c.New {
+			_, _ = fmt.Fprintf(w, "  + %s\n", c.Name)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "  ~ %s\n", c.Name)
+		if len(c.AddedVersions) > 0 {
+			_, _ = fmt.Fprintf(w, "      added versions: %s\n", strings.Join(c.AddedVersions, ", "))
+		}
+		if len(c.RemovedVersions) > 0 {
+			_, _ = fmt.Fprintf(w, "      removed versions: %s\n", strings.Join(c.RemovedVersions, ", "))
+		}
+		if c.ToStorageVersion != "" {
+			_, _ = fmt.Fprintf(w, "      storage version: %s -> %s\n", c.FromStorageVersion, c.ToStorageVersion)
+		}
+		for _, v := range c.ChangedVersions {
+			_, _ = fmt.Fprintf(w, "      schema of %s:\n", v.Version)
+			writeFieldChanges(w, v.Fields, "        ")
+		}
+	}
+
+	_, _ = fmt.Fprintln(w, "\nDatabase engines needing post-upgrade tasks:")
+	if len(p.DatabaseEngines) == 0 {
+		_, _ = fmt.Fprintln(w, "  none")
+	}
+	for _, e := range p.DatabaseEngines {
+		for _, up := range e.OperatorUpgrades {
+			_, _ = fmt.Fprintf(w, "  %s/%s (operator %s -> %s)\n",
+				e.Namespace, pointer.Get(up.Name), pointer.Get(up.CurrentVersion), pointer.Get(up.TargetVersion))
+		}
+		for _, t := range e.PendingTasks {
+			_, _ = fmt.Fprintf(w, "    %s: %s %s\n", pointer.Get(t.Name), pointer.Get(t.PendingTask), pointer.Get(t.Message))
+		}
+	}
+}
+
+func writeFieldChanges(w io.Writer, fields []FieldChange, indent string) {
+	for _, f := range fields {
+		switch {
+		case f.From == "" && f.To == "":
+			_, _ = fmt.Fprintf(w, "%s%s\n", indent, f.Path)
+		default:
+			_, _ = fmt.Fprintf(w, "%s%s: %s -> %s\n", indent, f.Path,
+				valueOr(truncate(f.From), "<none>"), valueOr(truncate(f.To), "<none>"))
+		}
+	}
+}
+
+func truncate(s string) string {
+	if len(s) <= maxPlanValueLength {
+		return s
+	}
+	return s[:maxPlanValueLength] + "..."
+}
+
+func valueOr(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
@@ -0,0 +1,185 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/everest/pkg/cli/helm"
+)
+
+func TestDiffManifests(t *testing.T) {
+	t.Parallel()
+	from := helm.NewRenderedTemplate(`---
+# Source: everest/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: everest-server
+  namespace: everest-system
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+        - name: everest
+          image: percona/everest:1.4.0
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: everest-jwt
+  namespace: everest-system
+data:
+  id_rsa: b2xk
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: removed
+  namespace: everest-system
+`)
+	to := helm.NewRenderedTemplate(`---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: everest-server
+  namespace: everest-system
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+        - name: everest
+          image: percona/everest:1.5.0
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: everest-jwt
+  namespace: everest-system
+data:
+  id_rsa: bmV3
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: added
+  namespace: everest-system
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: databaseclusters.everest.percona.com
+`)
+
+	diff, err := diffManifests(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, &ManifestDiff{
+		Added:   []string{"ServiceAccount/everest-system/added"},
+		Removed: []string{"ConfigMap/everest-system/removed"},
+		Changed: []ResourceChange{
+			{
+				Resource: "Deployment/everest-system/everest-server",
+				Fields: []FieldChange{{
+					Path: "spec.template.spec.containers[0].image",
+					From: `"percona/everest:1.4.0"`,
+					To:   `"percona/everest:1.5.0"`,
+				}},
+			},
+			{
+				Resource: "Secret/everest-system/everest-jwt",
+				Fields:   []FieldChange{{Path: "data.id_rsa"}},
+			},
+		},
+	}, diff)
+}
+
+func TestDiffCRDs(t *testing.T) {
+	t.Parallel()
+	crd := func(versions ...apiextv1.CustomResourceDefinitionVersion) *apiextv1.CustomResourceDefinition {
+		return &apiextv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "databaseclusters.everest.percona.com"},
+			Spec:       apiextv1.CustomResourceDefinitionSpec{Versions: versions},
+		}
+	}
+	version := func(name string, storage bool, props ...string) apiextv1.CustomResourceDefinitionVersion {
+		schema := apiextv1.JSONSchemaProps{Type: "object", Properties: map[string]apiextv1.JSONSchemaProps{}}
+		for _, p := range props {
+			schema.Properties[p] = apiextv1.JSONSchemaProps{Type: "string"}
+		}
+		return apiextv1.CustomResourceDefinitionVersion{
+			Name:    name,
+			Storage: storage,
+			Schema:  &apiextv1.CustomResourceValidation{OpenAPIV3Schema: &schema},
+		}
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, diffCRDs(crd(version("v1alpha1", true, "a")), crd(version("v1alpha1", true, "a"))))
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		t.Parallel()
+		change := diffCRDs(
+			crd(version("v1alpha1", true, "a"), version("v1beta1", false)),
+			crd(version("v1alpha1", false, "a", "b"), version("v1", true)),
+		)
+		assert.Equal(t, &CRDChange{
+			Name:            "databaseclusters.everest.percona.com",
+			AddedVersions:   []string{"v1"},
+			RemovedVersions: []string{"v1beta1"},
+			ChangedVersions: []CRDVersionChange{{
+				Version: "v1alpha1",
+				Fields:  []FieldChange{{Path: "openAPIV3Schema.properties.b", To: `{"type":"string"}`}},
+			}},
+			FromStorageVersion: "v1alpha1",
+			ToStorageVersion:   "v1",
+		}, change)
+	})
+}
+
+func TestWritePlan(t *testing.T) {
+	t.Parallel()
+	plan := &Plan{
+		CurrentVersion: "1.4.0",
+		TargetVersion:  "1.5.0",
+		OperatorRequirements: []OperatorRequirement{
+			{Namespace: "db", Operator: "percona-xtradb-cluster-operator", Version: "1.12.0", Constraints: ">= 1.13.0"},
+		},
+		Releases: []ReleasePlan{{
+			Name:             "everest-system",
+			Namespace:        "everest-system",
+			FromChartVersion: "1.4.0",
+			ToChartVersion:   "1.5.0",
+			ManifestDiff:     ManifestDiff{Added: []string{"ServiceAccount/everest-system/added"}},
+		}},
+	}
+	assert.False(t, plan.RequirementsMet())
+
+	out := &bytes.Buffer{}
+	WritePlan(out, plan)
+	assert.Contains(t, out.String(), "✗ db/percona-xtradb-cluster-operator 1.12.0 (>= 1.13.0)")
+	assert.Contains(t, out.String(), "everest-system (chart 1.4.0 -> 1.5.0)")
+	assert.Contains(t, out.String(), "+ ServiceAccount/everest-system/added")
+}
@@ -0,0 +1,123 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/dbupgrade"
+	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+type (
+	// PostUpgradeTasksConfig defines configuration required for the post-upgrade-tasks command.
+	PostUpgradeTasksConfig struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// VersionMetadataURL stores hostname to retrieve version metadata information from.
+		VersionMetadataURL string
+		// If set, we will print the pretty output.
+		Pretty bool
+		// Namespace is the namespace to execute the pending tasks in.
+		Namespace string
+		// Databases is a list of database clusters to execute the pending tasks for.
+		// If empty, all database clusters with pending tasks are selected.
+		Databases []string
+		// BatchSize is the number of database clusters updated at a time.
+		BatchSize int
+		// NoWait is set if the database clusters of a batch shall not be waited for to become ready.
+		NoWait bool
+	}
+
+	// PostUpgradeTasks struct implements post-upgrade-tasks command.
+	PostUpgradeTasks struct {
+		l *zap.SugaredLogger
+
+		config        *PostUpgradeTasksConfig
+		kubeConnector kubernetes.KubernetesConnector
+	}
+)
+
+// NewPostUpgradeTasks returns a new PostUpgradeTasks struct.
+func NewPostUpgradeTasks(cfg *PostUpgradeTasksConfig, l *zap.SugaredLogger) (*PostUpgradeTasks, error) {
+	cli := &PostUpgradeTasks{
+		config: cfg,
+		l:      l.With("component", "post-upgrade-tasks"),
+	}
+	if cfg.Pretty {
+		cli.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(cli.l, cfg.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	cli.kubeConnector = k
+	return cli, nil
+}
+
+// Run executes the pending post-upgrade tasks and returns the results for every database cluster.
+func (p *PostUpgradeTasks) Run(ctx context.Context) (*api.PostUpgradeTasksJob, error) {
+	tasks, err := dbupgrade.PendingTasks(ctx, p.kubeConnector, versionservice.New(p.config.VersionMetadataURL), p.config.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var databases *[]string
+	if len(p.config.Databases) > 0 {
+		databases = &p.config.Databases
+	}
+	selected, skipped := dbupgrade.SelectTasks(tasks, databases)
+
+	p.l.Infof("Executing post-upgrade tasks in namespace '%s'", p.config.Namespace)
+	job := &api.PostUpgradeTasksJob{
+		Namespace: p.config.Namespace,
+		State:     api.PostUpgradeTasksJobStateSucceeded,
+		StartedAt: pointer.To(time.Now().UTC()),
+		Results:   skipped,
+	}
+	var mu sync.Mutex
+	// The database clusters are updated directly, the same way as by the other everestctl commands.
+	dbupgrade.NewExecutor(p.kubeConnector, p.kubeConnector).Execute(ctx, p.config.Namespace, selected,
+		p.config.BatchSize, !p.config.NoWait,
+		func(result api.PostUpgradeTaskResult) {
+			if result.State == api.PostUpgradeTaskResultStateInProgress {
+				p.l.Infof("Executing post-upgrade task of database cluster '%s'", pointer.Get(result.Task.Name))
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			job.Results = append(job.Results, result)
+			if result.State == api.PostUpgradeTaskResultStateFailed {
+				job.State = api.PostUpgradeTasksJobStateFailed
+			}
+		},
+	)
+	job.FinishedAt = pointer.To(time.Now().UTC())
+	slices.SortFunc(job.Results, func(a, b api.PostUpgradeTaskResult) int {
+		return strings.Compare(pointer.Get(a.Task.Name), pointer.Get(b.Task.Name))
+	})
+	return job, nil
+}
@@ -0,0 +1,370 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/cli/steps"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/output"
+)
+
+const (
+	snapshotSecretKey = "snapshot.json.gz"
+	everestCRDGroup   = "everest.percona.com"
+)
+
+// ErrNoSnapshot is returned when a rollback is requested but there is no pre-upgrade snapshot.
+var ErrNoSnapshot = errors.New("no pre-upgrade snapshot found")
+
+//nolint:gochecknoglobals
+var (
+	// snapshotConfigMaps are the names of the ConfigMaps in the system namespace captured by the snapshot.
+	snapshotConfigMaps = []string{common.EverestSettingsConfigMapName, common.EverestRBACConfigMapName}
+	// snapshotSecrets are the names of the Secrets in the system namespace captured by the snapshot.
+	snapshotSecrets = []string{common.EverestAccountsSecretName, common.EverestJWTSecretName}
+)
+
+type (
+	// Snapshot is the state of Everest captured before an upgrade. It is used to roll the upgrade back.
+	Snapshot struct {
+		// CreatedAt is the time the snapshot was taken.
+		CreatedAt time.Time `json:"createdAt"`
+		// FromVersion is the Everest version before the upgrade.
+		FromVersion string `json:"fromVersion"`
+		// ToVersion is the Everest version the upgrade was started for.
+		ToVersion string `json:"toVersion"`
+		// Releases are the Helm releases of Everest and of the DB namespaces.
+		Releases []ReleaseSnapshot `json:"releases"`
+		// CRDs are the Everest CRDs.
+		CRDs []apiextv1.CustomResourceDefinition `json:"crds"`
+		// ConfigMaps are the Everest settings ConfigMaps.
+		ConfigMaps []corev1.ConfigMap `json:"configMaps"`
+		// Secrets are the Everest settings Secrets.
+		Secrets []corev1.Secret `json:"secrets"`
+	}
+
+	// ReleaseSnapshot is the deployed revision of a Helm release.
+	ReleaseSnapshot struct {
+		Name         string `json:"name"`
+		Namespace    string `json:"namespace"`
+		Revision     int    `json:"revision"`
+		ChartVersion string `json:"chartVersion"`
+	}
+
+	// RollbackReport lists the changes reverted by a rollback and the ones that could not be reverted.
+	RollbackReport struct {
+		Reverted []string
+		Failed   []error
+	}
+
+	// helmReleases reads and rolls back Helm releases.
+	helmReleases interface {
+		GetReleaseInfo(name, namespace string) (helm.ReleaseInfo, error)
+		Rollback(name, namespace string, revision int) error
+	}
+
+	helmReleaseClient struct {
+		kubeconfigPath string
+	}
+)
+
+func (c *helmReleaseClient) GetReleaseInfo(name, namespace string) (helm.ReleaseInfo, error) {
+	return helm.GetReleaseInfo(name, namespace, c.kubeconfigPath)
+}
+
+func (c *helmReleaseClient) Rollback(name, namespace string, revision int) error {
+	return helm.Rollback(name, namespace, c.kubeconfigPath, revision)
+}
+
+func (u *Upgrade) newStepTakeSnapshot(fromVersion string) steps.Step {
+	return steps.Step{
+		Desc: "Taking pre-upgrade snapshot",
+		F: func(ctx context.Context) error {
+			s, err := u.takeSnapshot(ctx, fromVersion)
+			if err != nil {
+				return err
+			}
+			if err := u.saveSnapshot(ctx, s); err != nil {
+				return fmt.Errorf("could not save pre-upgrade snapshot: %w", err)
+			}
+			u.snapshot = s
+			return nil
+		},
+	}
+}
+
+// takeSnapshot captures the Helm release revisions, the Everest CRDs and the settings ConfigMaps and Secrets.
+func (u *Upgrade) takeSnapshot(ctx context.Context, fromVersion string) (*Snapshot, error) {
+	s := &Snapshot{
+		CreatedAt:   time.Now().UTC(),
+		FromVersion: fromVersion,
+		ToVersion:   u.upgradeToVersion,
+	}
+
+	releases := []string{common.SystemNamespace}
+	dbNamespaces, err := u.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get database namespaces: %w", err)
+	}
+	for _, ns := range dbNamespaces.Items {
+		releases = append(releases, ns.GetName())
+	}
+	// The release of each namespace has the same name as the namespace.
+	for _, ns := range releases {
+		info, err := u.releases.GetReleaseInfo(ns, ns)
+		if err != nil {
+			return nil, fmt.Errorf("could not get Helm release '%s': %w", ns, err)
+		}
+		s.Releases = append(s.Releases, ReleaseSnapshot{
+			Name:         ns,
+			Namespace:    ns,
+			Revision:     info.Revision,
+			ChartVersion: info.ChartVersion,
+		})
+	}
+
+	crds, err := u.kubeConnector.ListCRDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list CRDs: %w", err)
+	}
+	for _, crd := range crds.Items {
+		if crd.Spec.Group != everestCRDGroup {
+			continue
+		}
+		s.CRDs = append(s.CRDs, apiextv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: crd.GetName()},
+			Spec:       crd.Spec,
+		})
+	}
+
+	for _, name := range snapshotConfigMaps {
+		cm, err := u.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: name})
+		if k8serrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("could not get ConfigMap '%s': %w", name, err)
+		}
+		s.ConfigMaps = append(s.ConfigMaps, corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.SystemNamespace},
+			Data:       cm.Data,
+			BinaryData: cm.BinaryData,
+		})
+	}
+	for _, name := range snapshotSecrets {
+		secret, err := u.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: name})
+		if k8serrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("could not get Secret '%s': %w", name, err)
+		}
+		s.Secrets = append(s.Secrets, corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.SystemNamespace},
+			Data:       secret.Data,
+		})
+	}
+	return s, nil
+}
+
+// saveSnapshot stores the snapshot in a Secret in the system namespace, replacing the previous one.
+// The snapshot is compressed since the CRDs are large.
+func (u *Upgrade) saveSnapshot(ctx context.Context, s *Snapshot) error {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(s); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	secret, err := u.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestUpgradeSnapshotSecretName})
+	if k8serrors.IsNotFound(err) {
+		_, err = u.kubeConnector.CreateSecret(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: common.EverestUpgradeSnapshotSecretName, Namespace: common.SystemNamespace},
+			Data:       map[string][]byte{snapshotSecretKey: buf.Bytes()},
+		})
+		return err
+	} else if err != nil {
+		return err
+	}
+	secret.Data = map[string][]byte{snapshotSecretKey: buf.Bytes()}
+	_, err = u.kubeConnector.UpdateSecret(ctx, secret)
+	return err
+}
+
+// loadSnapshot returns the snapshot taken before the last upgrade.
+func (u *Upgrade) loadSnapshot(ctx context.Context) (*Snapshot, error) {
+	secret, err := u.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestUpgradeSnapshotSecretName})
+	if k8serrors.IsNotFound(err) {
+		return nil, ErrNoSnapshot
+	} else if err != nil {
+		return nil, err
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(secret.Data[snapshotSecretKey]))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("invalid pre-upgrade snapshot"))
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("invalid pre-upgrade snapshot"))
+	}
+	s := &Snapshot{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, errors.Join(err, errors.New("invalid pre-upgrade snapshot"))
+	}
+	return s, nil
+}
+
+// Rollback restores the state captured by the snapshot taken before the last upgrade.
+func (u *Upgrade) Rollback(ctx context.Context, out io.Writer) error {
+	s, err := u.loadSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	return u.rollbackToSnapshot(ctx, out, s)
+}
+
+func (u *Upgrade) rollbackToSnapshot(ctx context.Context, out io.Writer, s *Snapshot) error {
+	u.l.Infof("Rolling back Everest to the snapshot of version %s taken at %s", s.FromVersion, s.CreatedAt.Format(time.RFC3339))
+	report := u.restoreSnapshot(ctx, s)
+	u.printRollbackReport(out, report)
+	if len(report.Failed) > 0 {
+		return errors.Join(append([]error{errors.New("rollback is incomplete")}, report.Failed...)...)
+	}
+	// A snapshot is only rolled back to once. The next upgrade takes a new one.
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.EverestUpgradeSnapshotSecretName, Namespace: common.SystemNamespace},
+	}
+	if err := u.kubeConnector.DeleteSecret(ctx, secret); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("could not delete pre-upgrade snapshot: %w", err)
+	}
+	return nil
+}
+
+// restoreSnapshot reverts the changes made since the snapshot was taken, in the reverse order of the upgrade steps.
+// It carries on after a failure so that as much as possible is reverted.
+func (u *Upgrade) restoreSnapshot(ctx context.Context, s *Snapshot) *RollbackReport {
+	report := &RollbackReport{}
+	fail := func(format string, args ...any) {
+		report.Failed = append(report.Failed, fmt.Errorf(format, args...))
+	}
+
+	for _, rel := range s.Releases {
+		if rel.Revision == 0 {
+			fail("Helm release '%s' did not exist before the upgrade and cannot be rolled back", rel.Name)
+			continue
+		}
+		current, err := u.releases.GetReleaseInfo(rel.Name, rel.Namespace)
+		if err != nil {
+			fail("could not get Helm release '%s': %w", rel.Name, err)
+			continue
+		}
+		if current.Revision == rel.Revision {
+			continue
+		}
+		if err := u.releases.Rollback(rel.Name, rel.Namespace, rel.Revision); err != nil {
+			fail("could not roll back Helm release '%s' to revision %d: %w", rel.Name, rel.Revision, err)
+			continue
+		}
+		report.Reverted = append(report.Reverted, fmt.Sprintf("Helm release '%s' rolled back from revision %d (chart %s) to revision %d (chart %s)",
+			rel.Name, current.Revision, current.ChartVersion, rel.Revision, rel.ChartVersion))
+	}
+
+	for _, crd := range s.CRDs {
+		current, err := u.kubeConnector.GetCRD(ctx, types.NamespacedName{Name: crd.GetName()})
+		if err != nil {
+			fail("could not get CRD '%s': %w", crd.GetName(), err)
+			continue
+		}
+		if equality.Semantic.DeepEqual(current.Spec, crd.Spec) {
+			continue
+		}
+		current.Spec = crd.Spec
+		if _, err := u.kubeConnector.UpdateCRD(ctx, current); err != nil {
+			fail("could not restore CRD '%s': %w", crd.GetName(), err)
+			continue
+		}
+		report.Reverted = append(report.Reverted, fmt.Sprintf("CRD '%s' restored", crd.GetName()))
+	}
+
+	for _, cm := range s.ConfigMaps {
+		current, err := u.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: cm.GetNamespace(), Name: cm.GetName()})
+		if err != nil {
+			fail("could not get ConfigMap '%s': %w", cm.GetName(), err)
+			continue
+		}
+		if equality.Semantic.DeepEqual(current.Data, cm.Data) && equality.Semantic.DeepEqual(current.BinaryData, cm.BinaryData) {
+			continue
+		}
+		current.Data, current.BinaryData = cm.Data, cm.BinaryData
+		if _, err := u.kubeConnector.UpdateConfigMap(ctx, current); err != nil {
+			fail("could not restore ConfigMap '%s': %w", cm.GetName(), err)
+			continue
+		}
+		report.Reverted = append(report.Reverted, fmt.Sprintf("ConfigMap '%s' restored", cm.GetName()))
+	}
+
+	for _, secret := range s.Secrets {
+		current, err := u.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: secret.GetNamespace(), Name: secret.GetName()})
+		if err != nil {
+			fail("could not get Secret '%s': %w", secret.GetName(), err)
+			continue
+		}
+		if equality.Semantic.DeepEqual(current.Data, secret.Data) {
+			continue
+		}
+		current.Data = secret.Data
+		if _, err := u.kubeConnector.UpdateSecret(ctx, current); err != nil {
+			fail("could not restore Secret '%s': %w", secret.GetName(), err)
+			continue
+		}
+		report.Reverted = append(report.Reverted, fmt.Sprintf("Secret '%s' restored", secret.GetName()))
+	}
+	return report
+}
+
+func (u *Upgrade) printRollbackReport(out io.Writer, report *RollbackReport) {
+	if len(report.Reverted) == 0 && len(report.Failed) == 0 {
+		u.l.Info("Nothing to roll back")
+		_, _ = fmt.Fprint(out, output.Info("Nothing to roll back"))
+		return
+	}
+	for _, r := range report.Reverted {
+		u.l.Info(r)
+		_, _ = fmt.Fprint(out, output.Success("%s", r))
+	}
+	for _, err := range report.Failed {
+		u.l.Error(err)
+		_, _ = fmt.Fprint(out, output.Failure("%s", err))
+	}
+}
@@ -0,0 +1,210 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+type fakeHelmReleases struct {
+	releases    map[string]helm.ReleaseInfo
+	rollbackErr map[string]error
+}
+
+func (f *fakeHelmReleases) GetReleaseInfo(name, _ string) (helm.ReleaseInfo, error) {
+	return f.releases[name], nil
+}
+
+// Rollback creates a new revision, like Helm does.
+func (f *fakeHelmReleases) Rollback(name, _ string, _ int) error {
+	if err := f.rollbackErr[name]; err != nil {
+		return err
+	}
+	f.releases[name] = helm.ReleaseInfo{Revision: f.releases[name].Revision + 1}
+	return nil
+}
+
+func TestUpgrade_snapshotRollback(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	crd := func(name, group, version string) *apiextv1.CustomResourceDefinition {
+		return &apiextv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: apiextv1.CustomResourceDefinitionSpec{
+				Group: group,
+				Names: apiextv1.CustomResourceDefinitionNames{Plural: "things", Kind: "Thing"},
+				Scope: apiextv1.NamespaceScoped,
+				Versions: []apiextv1.CustomResourceDefinitionVersion{
+					{Name: version, Served: true, Storage: true},
+				},
+			},
+		}
+	}
+	newUpgrade := func(t *testing.T, releases *fakeHelmReleases) *Upgrade {
+		t.Helper()
+		mockClient := fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.CreateScheme()).
+			WithObjects(
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+					Name:   "db-ns",
+					Labels: map[string]string{common.KubernetesManagedByLabel: common.Everest},
+				}},
+				crd("things.everest.percona.com", "everest.percona.com", "v1alpha1"),
+				crd("things.example.com", "example.com", "v1"),
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: common.EverestRBACConfigMapName, Namespace: common.SystemNamespace},
+					Data:       map[string]string{"enabled": "true"},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: common.EverestJWTSecretName, Namespace: common.SystemNamespace},
+					Data:       map[string][]byte{"id_rsa": []byte("key")},
+				},
+			).
+			Build()
+		return &Upgrade{
+			l:                zap.NewNop().Sugar(),
+			config:           &Config{},
+			kubeConnector:    kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient),
+			releases:         releases,
+			upgradeToVersion: "1.5.0",
+		}
+	}
+	// upgrade simulates an upgrade that changed everything captured by the snapshot.
+	upgrade := func(t *testing.T, u *Upgrade, releases *fakeHelmReleases) {
+		t.Helper()
+		releases.releases[common.SystemNamespace] = helm.ReleaseInfo{Revision: 5, ChartVersion: "1.5.0"}
+		releases.releases["db-ns"] = helm.ReleaseInfo{Revision: 3, ChartVersion: "1.5.0"}
+
+		c, err := u.kubeConnector.GetCRD(ctx, types.NamespacedName{Name: "things.everest.percona.com"})
+		require.NoError(t, err)
+		c.Spec.Versions[0].Storage = false
+		c.Spec.Versions = append(c.Spec.Versions, apiextv1.CustomResourceDefinitionVersion{Name: "v1", Served: true, Storage: true})
+		_, err = u.kubeConnector.UpdateCRD(ctx, c)
+		require.NoError(t, err)
+
+		cm, err := u.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestRBACConfigMapName})
+		require.NoError(t, err)
+		cm.Data["enabled"] = "false"
+		_, err = u.kubeConnector.UpdateConfigMap(ctx, cm)
+		require.NoError(t, err)
+	}
+	beforeUpgrade := func() *fakeHelmReleases {
+		return &fakeHelmReleases{releases: map[string]helm.ReleaseInfo{
+			common.SystemNamespace: {Revision: 4, ChartVersion: "1.4.0"},
+			"db-ns":                {Revision: 2, ChartVersion: "1.4.0"},
+		}}
+	}
+
+	t.Run("take and load", func(t *testing.T) {
+		t.Parallel()
+
+		u := newUpgrade(t, beforeUpgrade())
+		s, err := u.takeSnapshot(ctx, "1.4.0")
+		require.NoError(t, err)
+		require.NoError(t, u.saveSnapshot(ctx, s))
+
+		loaded, err := u.loadSnapshot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "1.4.0", loaded.FromVersion)
+		assert.Equal(t, "1.5.0", loaded.ToVersion)
+		assert.Equal(t, []ReleaseSnapshot{
+			{Name: common.SystemNamespace, Namespace: common.SystemNamespace, Revision: 4, ChartVersion: "1.4.0"},
+			{Name: "db-ns", Namespace: "db-ns", Revision: 2, ChartVersion: "1.4.0"},
+		}, loaded.Releases)
+		require.Len(t, loaded.CRDs, 1)
+		assert.Equal(t, "things.everest.percona.com", loaded.CRDs[0].GetName())
+		require.Len(t, loaded.ConfigMaps, 1)
+		assert.Equal(t, map[string]string{"enabled": "true"}, loaded.ConfigMaps[0].Data)
+		require.Len(t, loaded.Secrets, 1)
+		assert.Equal(t, map[string][]byte{"id_rsa": []byte("key")}, loaded.Secrets[0].Data)
+	})
+
+	t.Run("no snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		u := newUpgrade(t, beforeUpgrade())
+		assert.ErrorIs(t, u.Rollback(ctx, io.Discard), ErrNoSnapshot)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		t.Parallel()
+
+		releases := beforeUpgrade()
+		u := newUpgrade(t, releases)
+		s, err := u.takeSnapshot(ctx, "1.4.0")
+		require.NoError(t, err)
+		require.NoError(t, u.saveSnapshot(ctx, s))
+		upgrade(t, u, releases)
+
+		report := u.restoreSnapshot(ctx, s)
+		assert.Empty(t, report.Failed)
+		assert.Equal(t, []string{
+			"Helm release 'everest-system' rolled back from revision 5 (chart 1.5.0) to revision 4 (chart 1.4.0)",
+			"Helm release 'db-ns' rolled back from revision 3 (chart 1.5.0) to revision 2 (chart 1.4.0)",
+			"CRD 'things.everest.percona.com' restored",
+			"ConfigMap 'everest-rbac' restored",
+		}, report.Reverted)
+
+		c, err := u.kubeConnector.GetCRD(ctx, types.NamespacedName{Name: "things.everest.percona.com"})
+		require.NoError(t, err)
+		assert.Len(t, c.Spec.Versions, 1)
+		cm, err := u.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestRBACConfigMapName})
+		require.NoError(t, err)
+		assert.Equal(t, "true", cm.Data["enabled"])
+
+		// The snapshot is deleted once everything is rolled back.
+		require.NoError(t, u.Rollback(ctx, io.Discard))
+		_, err = u.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestUpgradeSnapshotSecretName})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("partial rollback", func(t *testing.T) {
+		t.Parallel()
+
+		releases := beforeUpgrade()
+		u := newUpgrade(t, releases)
+		s, err := u.takeSnapshot(ctx, "1.4.0")
+		require.NoError(t, err)
+		require.NoError(t, u.saveSnapshot(ctx, s))
+		upgrade(t, u, releases)
+		releases.rollbackErr = map[string]error{"db-ns": errors.New("timed out")}
+
+		err = u.rollbackToSnapshot(ctx, io.Discard, s)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not roll back Helm release 'db-ns' to revision 2: timed out")
+		// The snapshot is kept so that the rollback can be retried.
+		_, err = u.loadSnapshot(ctx)
+		require.NoError(t, err)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/data"
 	"github.com/percona/everest/pkg/cli/helm"
 	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
 	"github.com/percona/everest/pkg/cli/steps"
@@ -42,6 +43,33 @@ func (u *Upgrade) newStepUpgradeHelmChart() steps.Step {
 	}
 }
 
+func (u *Upgrade) newStepApplyEverestServerRBAC() steps.Step {
+	return steps.Step{
+		Desc: "Applying Everest API RBAC",
+		F: func(ctx context.Context) error {
+			return u.kubeConnector.ApplyManifestFile(ctx, data.EverestServerRBAC, common.SystemNamespace)
+		},
+	}
+}
+
+func (u *Upgrade) newStepApplyNamespaceProvisioningRBAC() steps.Step {
+	return steps.Step{
+		Desc: "Enabling namespace provisioning from the Everest API",
+		F: func(ctx context.Context) error {
+			return u.kubeConnector.ApplyManifestFile(ctx, data.EverestServerNamespaceProvisioningRBAC, common.SystemNamespace)
+		},
+	}
+}
+
+func (u *Upgrade) newStepStoreBundledVersionService() steps.Step {
+	return steps.Step{
+		Desc: "Storing the version service data of the bundle",
+		F: func(ctx context.Context) error {
+			return u.bundle.StoreVersionService(ctx, u.kubeConnector, u.config.ImageRegistry)
+		},
+	}
+}
+
 func (u *Upgrade) newStepEnsureEverestOperator() steps.Step {
 	return steps.Step{
 		Desc: "Ensuring Everest operator deployment is ready",
@@ -137,16 +165,24 @@ func (u *Upgrade) upgradeEverestDBNamespaceHelmCharts(ctx context.Context) error
 	return nil
 }
 
+// upgradeEverestDBNamespaceHelmChart upgrades the DB namespace Helm chart, keeping the operator versions pinned in the namespace.
 func (u *Upgrade) upgradeEverestDBNamespaceHelmChart(ctx context.Context, namespace string) error {
+	rel, err := u.releases.GetReleaseInfo(namespace, namespace)
+	if err != nil {
+		return fmt.Errorf("could not get Helm release: %w", err)
+	}
 	installer := helm.Installer{
 		ReleaseName:      namespace,
 		ReleaseNamespace: namespace,
+		ImageRegistry:    u.config.ImageRegistry,
+		OperatorVersions: helm.OperatorVersionsFromValues(rel.Values),
 	}
 	if err := installer.Init(u.config.KubeconfigPath, helm.ChartOptions{
-		URL:       u.config.RepoURL,
-		Directory: utils.DBNamespaceSubChartPath(u.config.ChartDir),
-		Name:      helm.EverestDBNamespaceChartName,
-		Version:   u.upgradeToVersion,
+		ArchiveDir: u.config.ArchiveDir,
+		URL:        u.config.RepoURL,
+		Directory:  utils.DBNamespaceSubChartPath(u.config.ChartDir),
+		Name:       helm.EverestDBNamespaceChartName,
+		Version:    u.upgradeToVersion,
 	}); err != nil {
 		return fmt.Errorf("could not initialize Helm installer: %w", err)
 	}
@@ -192,14 +228,16 @@ func (u *Upgrade) helmAdoptDBNamespaces(ctx context.Context, namespace, version
 	installer := helm.Installer{
 		ReleaseName:      namespace,
 		ReleaseNamespace: namespace,
+		ImageRegistry:    u.config.ImageRegistry,
 		Values:           values,
 	}
 
 	if err := installer.Init(u.config.KubeconfigPath, helm.ChartOptions{
-		URL:       u.config.RepoURL,
-		Directory: utils.DBNamespaceSubChartPath(u.config.ChartDir),
-		Name:      helm.EverestDBNamespaceChartName,
-		Version:   version,
+		ArchiveDir: u.config.ArchiveDir,
+		URL:        u.config.RepoURL,
+		Directory:  utils.DBNamespaceSubChartPath(u.config.ChartDir),
+		Name:       helm.EverestDBNamespaceChartName,
+		Version:    version,
 	}); err != nil {
 		return fmt.Errorf("could not initialize Helm installer: %w", err)
 	}
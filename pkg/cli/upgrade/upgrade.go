@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/percona/everest/pkg/cli/bundle"
 	"github.com/percona/everest/pkg/cli/helm"
 	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
 	"github.com/percona/everest/pkg/cli/steps"
@@ -62,6 +63,16 @@ type (
 		Pretty bool
 		// SkipEnvDetection skips detecting the Kubernetes environment.
 		SkipEnvDetection bool
+		// Rollback is set if the last upgrade should be rolled back using the pre-upgrade snapshot
+		// instead of performing an upgrade.
+		Rollback bool
+		// NoAutoRollback is set if a failed upgrade should not be rolled back automatically.
+		NoAutoRollback bool
+		// Bundle is the path to the offline bundle to upgrade from instead of the version service and the Helm repository.
+		Bundle string
+		// EnableNamespaceProvisioning grants the Everest API server the permissions to provision the DB namespaces.
+		// If not set, the permissions granted by a previous installation or upgrade are kept.
+		EnableNamespaceProvisioning bool
 
 		helm.CLIOptions
 	}
@@ -73,12 +84,16 @@ type (
 		config         *Config
 		kubeConnector  kubernetes.KubernetesConnector
 		versionService versionservice.Interface
+		releases       helmReleases
+		// bundle is the offline bundle to upgrade from, if any.
+		bundle *bundle.Bundle
 
 		// these are set on calling Run
 		clusterType       kubernetes.ClusterType
 		helmReleaseExists bool
 		upgradeToVersion  string
 		helmInstaller     *helm.Installer
+		snapshot          *Snapshot
 	}
 
 	requirementsCheck struct {
@@ -120,22 +135,39 @@ func NewUpgrade(cfg *Config, l *zap.SugaredLogger) (*Upgrade, error) {
 	}
 
 	cli.kubeConnector = kubeClient
-	cli.versionService = versionservice.New(cfg.VersionMetadataURL)
+	// The version service used by everestctl differs from the one configured
+	// for Everest when upgrading from a bundle.
+	versionServiceURL := cfg.VersionMetadataURL
+	if cfg.Bundle != "" {
+		b, err := bundle.Open(cfg.Bundle)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ArchiveDir = b.ChartsDir()
+		versionServiceURL = b.VersionServiceURL()
+		cli.bundle = b
+	}
+	cli.versionService = versionservice.New(versionServiceURL)
+	cli.releases = &helmReleaseClient{kubeconfigPath: cfg.KubeconfigPath}
 	return cli, nil
 }
 
 // Run runs the operators installation process.
 func (u *Upgrade) Run(ctx context.Context) error {
-	everestVersion, err := cliVersion.EverestVersionFromDeployment(ctx, u.kubeConnector)
-	if err != nil {
-		return errors.Join(err, errors.New("could not retrieve Everest version"))
-	}
-
 	var out io.Writer = os.Stdout
 	if !u.config.Pretty {
 		out = io.Discard
 	}
 
+	if u.config.Rollback {
+		return u.Rollback(ctx, out)
+	}
+
+	everestVersion, err := cliVersion.EverestVersionFromDeployment(ctx, u.kubeConnector)
+	if err != nil {
+		return errors.Join(err, errors.New("could not retrieve Everest version"))
+	}
+
 	if err := u.setVersionInfo(ctx, everestVersion); err != nil {
 		if errors.Is(err, ErrNoUpdateAvailable) {
 			u.l.Info("You're running the latest version of Everest")
@@ -163,12 +195,21 @@ func (u *Upgrade) Run(ctx context.Context) error {
 	// We use this flag to trigger an adoption of the existing installation to Helm chart.
 	u.helmReleaseExists = common.CheckConstraint(everestVersion, ">= 1.4.0")
 
-	upgradeSteps := u.newUpgradeSteps()
+	upgradeSteps := u.newUpgradeSteps(everestVersion.String())
 
 	// Run steps.
 	_, _ = fmt.Fprintln(out, output.Info("Upgrading Everest to version %s", u.upgradeToVersion))
 	if err := steps.RunStepsWithSpinner(ctx, u.l, upgradeSteps, u.config.Pretty); err != nil {
-		return err
+		// The snapshot is only set once it is saved, so there is nothing to roll back to if taking it failed.
+		if u.snapshot == nil || u.config.NoAutoRollback {
+			return err
+		}
+		u.l.Warnf("Upgrade failed, rolling back to version %s", u.snapshot.FromVersion)
+		_, _ = fmt.Fprintln(out, output.Warn("Upgrade failed, rolling back to version %s", u.snapshot.FromVersion))
+		if rbErr := u.rollbackToSnapshot(ctx, out, u.snapshot); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return errors.Join(err, fmt.Errorf("upgrade rolled back to version %s", u.snapshot.FromVersion))
 	}
 
 	u.l.Infof("Everest has been upgraded to version %s", u.upgradeToVersion)
@@ -200,6 +241,7 @@ func (u *Upgrade) setupHelmInstaller(ctx context.Context) error {
 	overrides := helm.NewValues(helm.Values{
 		ClusterType:        u.clusterType,
 		VersionMetadataURL: u.config.VersionMetadataURL,
+		RepoURL:            u.config.RepoURL,
 	})
 
 	// We will apply the existing configmap and secret values to the new helm chart.
@@ -218,16 +260,22 @@ func (u *Upgrade) setupHelmInstaller(ctx context.Context) error {
 	installer := &helm.Installer{
 		ReleaseName:      common.SystemNamespace,
 		ReleaseNamespace: common.SystemNamespace,
+		ImageRegistry:    u.config.ImageRegistry,
 		Values:           values,
 	}
 	if err := installer.Init(u.config.KubeconfigPath, helm.ChartOptions{
-		URL:       u.config.RepoURL,
-		Directory: u.config.ChartDir,
-		Name:      helm.EverestChartName,
-		Version:   u.upgradeToVersion,
+		ArchiveDir: u.config.ArchiveDir,
+		URL:        u.config.RepoURL,
+		Directory:  u.config.ChartDir,
+		Name:       helm.EverestChartName,
+		Version:    u.upgradeToVersion,
 	}); err != nil {
 		return fmt.Errorf("could not initialize Helm installer: %w", err)
 	}
+	if u.bundle != nil {
+		// Everest uses the version service responses of the bundle served by the Everest server.
+		installer.Values["versionMetadataURL"] = bundle.InClusterVersionServiceURL(installer.GetParsedValues().Server)
+	}
 	u.helmInstaller = installer
 	return nil
 }
@@ -242,14 +290,24 @@ func (u *Upgrade) printPostUpgradeMessage(ctx context.Context, out io.Writer) er
 	return nil
 }
 
-func (u *Upgrade) newUpgradeSteps() []steps.Step {
-	return []steps.Step{
+func (u *Upgrade) newUpgradeSteps(fromVersion string) []steps.Step {
+	result := []steps.Step{
+		u.newStepTakeSnapshot(fromVersion),
 		u.newStepUpgradeCRDs(),
 		u.newStepUpgradeHelmChart(),
+		u.newStepApplyEverestServerRBAC(),
+	}
+	if u.config.EnableNamespaceProvisioning {
+		result = append(result, u.newStepApplyNamespaceProvisioningRBAC())
+	}
+	if u.bundle != nil {
+		result = append(result, u.newStepStoreBundledVersionService())
+	}
+	return append(result,
 		u.newStepEnsureEverestAPI(),
 		u.newStepEnsureEverestOperator(),
 		u.newStepEnsureCatalogSource(),
-	}
+	)
 }
 
 // ensureManagedByLabelOnDBNamespaces ensures that all database namespaces have the managed-by label set.
@@ -390,23 +448,49 @@ func (u *Upgrade) checkRequirements(ctx context.Context, supVer *common.Supporte
 }
 
 func (u *Upgrade) checkOperatorRequirements(ctx context.Context, supVer *common.SupportedVersion) error {
-	nss, err := u.kubeConnector.GetDBNamespaces(ctx)
+	results, err := u.getOperatorRequirements(ctx, supVer)
 	if err != nil {
 		return err
 	}
+	for _, r := range results {
+		if !r.Satisfied && r.Pinned {
+			return fmt.Errorf(
+				"%s is pinned to version %q in namespace %s, which does not meet requirements of %q. "+
+					"Update the pinned version with everestctl namespaces update first",
+				r.Operator, r.Version, r.Namespace, r.Constraints,
+			)
+		}
+		if !r.Satisfied {
+			return fmt.Errorf(
+				"%s version %q does not meet minimum requirements of %q",
+				r.Operator, r.Version, r.Constraints,
+			)
+		}
+	}
+	return nil
+}
+
+// getOperatorRequirements checks the versions of the operators installed in the DB namespaces
+// against the constraints of the Everest version.
+func (u *Upgrade) getOperatorRequirements(ctx context.Context, supVer *common.SupportedVersion) ([]OperatorRequirement, error) {
+	nss, err := u.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	cfg := []requirementsCheck{
 		{common.MySQLOperatorName, supVer.PXCOperator},
 		{common.PostgreSQLOperatorName, supVer.PGOperator},
 		{common.MongoDBOperatorName, supVer.PSMBDOperator},
 	}
+	results := []OperatorRequirement{}
 	for _, ns := range nss.Items {
-		u.l.Infof("Checking operator requirements in namespace %s", ns)
+		u.l.Infof("Checking operator requirements in namespace %s", ns.GetName())
 
 		for _, c := range cfg {
 			v, err := u.kubeConnector.GetInstalledOperatorVersion(ctx, types.NamespacedName{Namespace: ns.GetName(), Name: c.operatorName})
 			if err != nil && !errors.Is(err, kubernetes.ErrOperatorNotInstalled) {
-				return err
+				return nil, err
 			}
 
 			if v == nil {
@@ -415,16 +499,54 @@ func (u *Upgrade) checkOperatorRequirements(ctx context.Context, supVer *common.
 			}
 
 			u.l.Debugf("Found operator %s version %s. Checking contraints %q", c.operatorName, v, c.constraints.String())
-			if !c.constraints.Check(v) {
-				return fmt.Errorf(
-					"%s version %q does not meet minimum requirements of %q",
-					c.operatorName, v, supVer.PXCOperator.String(),
-				)
-			}
+			results = append(results, OperatorRequirement{
+				Namespace:   ns.GetName(),
+				Operator:    c.operatorName,
+				Version:     v.String(),
+				Constraints: c.constraints.String(),
+				Satisfied:   c.constraints.Check(v),
+			})
 			u.l.Debugf("Finished requirements check for operator %s", c.operatorName)
 		}
+
+		pinned, err := u.getPinnedOperatorRequirements(ns.GetName(), cfg)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, pinned...)
 	}
-	return nil
+	return results, nil
+}
+
+// getPinnedOperatorRequirements checks the operator versions pinned in the DB namespace
+// against the constraints of the Everest version.
+func (u *Upgrade) getPinnedOperatorRequirements(namespace string, cfg []requirementsCheck) ([]OperatorRequirement, error) {
+	rel, err := u.releases.GetReleaseInfo(namespace, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not get DB namespace '%s' Helm release: %w", namespace, err)
+	}
+	pins := helm.OperatorVersionsFromValues(rel.Values)
+	results := make([]OperatorRequirement, 0, len(pins))
+	for _, c := range cfg {
+		for op, pin := range pins {
+			if helm.DBNamespaceOperatorName(op) != c.operatorName {
+				continue
+			}
+			v, err := goversion.NewVersion(pin)
+			if err != nil {
+				return nil, fmt.Errorf("invalid version of %s pinned in namespace %s: %w", c.operatorName, namespace, err)
+			}
+			results = append(results, OperatorRequirement{
+				Namespace:   namespace,
+				Operator:    c.operatorName,
+				Version:     v.String(),
+				Constraints: c.constraints.String(),
+				Satisfied:   c.constraints.Check(v),
+				Pinned:      true,
+			})
+		}
+	}
+	return results, nil
 }
 
 func (u *Upgrade) applyConfigMapValues(ctx context.Context) error {
@@ -28,8 +28,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	versionservice "github.com/percona/everest/pkg/version_service"
 )
@@ -217,3 +221,37 @@ func TestUpgrade_canUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestUpgrade_checkPinnedOperatorRequirements(t *testing.T) {
+	t.Parallel()
+
+	dbNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "db-ns",
+		Labels: map[string]string{common.KubernetesManagedByLabel: common.Everest},
+	}}
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(
+		fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(dbNamespace).Build(),
+	)
+	u := &Upgrade{
+		l:             zap.NewNop().Sugar(),
+		kubeConnector: k,
+		releases: &fakeHelmReleases{releases: map[string]helm.ReleaseInfo{
+			"db-ns": {Revision: 1, Values: map[string]interface{}{
+				helm.OperatorVersionsValue: map[string]interface{}{"psmdb": "1.17.0"},
+			}},
+		}},
+	}
+
+	supVer := &common.SupportedVersion{PSMBDOperator: goversion.MustConstraints(goversion.NewConstraint(">= 1.18.0"))}
+	results, err := u.getOperatorRequirements(context.Background(), supVer)
+	require.NoError(t, err)
+	assert.Equal(t, []OperatorRequirement{{
+		Namespace:   "db-ns",
+		Operator:    common.MongoDBOperatorName,
+		Version:     "1.17.0",
+		Constraints: ">= 1.18.0",
+		Satisfied:   false,
+		Pinned:      true,
+	}}, results)
+	require.ErrorContains(t, u.checkOperatorRequirements(context.Background(), supVer), "pinned to version")
+}
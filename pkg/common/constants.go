@@ -89,6 +89,62 @@ const (
 	EverestSettingsConfigMapName = "everest-settings"
 	// EverestRBACConfigMapName is the name of the Everest RBAC ConfigMap.
 	EverestRBACConfigMapName = "everest-rbac"
+	// EverestEngineVersionPolicyConfigMapName is the name of the ConfigMap that holds
+	// the database engine version policy of a namespace.
+	EverestEngineVersionPolicyConfigMapName = "everest-engine-version-policy"
+	// EverestEngineConfigTemplatesConfigMapName is the name of the ConfigMap that holds
+	// the engine config templates of a namespace.
+	EverestEngineConfigTemplatesConfigMapName = "everest-engine-config-templates"
+	// EverestQuotaConfigMapName is the name of the ConfigMap that holds the quota of a namespace.
+	EverestQuotaConfigMapName = "everest-quota"
+	// EverestUpgradeSnapshotSecretName is the name of the Secret that holds the state of Everest captured before an upgrade.
+	EverestUpgradeSnapshotSecretName = "everest-upgrade-snapshot"
+	// EverestVersionServiceConfigMapName is the name of the ConfigMap that holds the version service
+	// responses of an offline bundle, served by the Everest server at VersionServicePath.
+	EverestVersionServiceConfigMapName = "everest-version-service"
+	// VersionServicePath is the path the Everest server serves the version service responses of an offline bundle at.
+	VersionServicePath = "/version-service"
+	// OperatorVersionPinnedAnnotation is the annotation of the OLM Subscriptions that holds the version
+	// the operator is pinned to in a DB namespace. The operator is not upgraded to other versions.
+	OperatorVersionPinnedAnnotation = "everest.percona.com/pinned-version"
+	// EverestUsageConfigMapPrefix is the name prefix of the ConfigMaps that hold a usage sample each.
+	EverestUsageConfigMapPrefix = "everest-usage-"
+	// EverestUsageLabel is the label of the ConfigMaps that hold the usage samples.
+	EverestUsageLabel = "everest.percona.com/usage-samples"
+	// EverestNamespaceProvisioningConfigMapPrefix is the name prefix of the ConfigMaps that hold
+	// the latest provisioning job of a DB namespace each.
+	EverestNamespaceProvisioningConfigMapPrefix = "everest-namespace-provisioning-"
+	// EverestNamespaceProvisioningLabel is the label of the ConfigMaps that hold the namespace provisioning jobs.
+	EverestNamespaceProvisioningLabel = "everest.percona.com/namespace-provisioning"
+	// EverestPostUpgradeTasksConfigMapPrefix is the name prefix of the ConfigMaps that hold
+	// the latest post-upgrade tasks execution of a DB namespace each.
+	EverestPostUpgradeTasksConfigMapPrefix = "everest-post-upgrade-tasks-"
+	// EverestPostUpgradeTasksLabel is the label of the ConfigMaps that hold the post-upgrade tasks executions.
+	EverestPostUpgradeTasksLabel = "everest.percona.com/post-upgrade-tasks"
+	// EverestFleetUpgradeConfigMapName is the name of the ConfigMap that holds the latest fleet upgrade rollout.
+	EverestFleetUpgradeConfigMapName = "everest-fleet-upgrade"
+	// EngineConfigTemplateAnnotation is the annotation used by database clusters to reference
+	// an engine config template.
+	EngineConfigTemplateAnnotation = "everest.percona.com/engine-config-template"
+	// StorageAutoscalingAnnotation is the annotation that holds the storage autoscaling settings of a database cluster.
+	StorageAutoscalingAnnotation = "everest.percona.com/storage-autoscaling"
+	// MonitoringStatusAnnotation is the annotation that holds the connectivity status of a monitoring config.
+	MonitoringStatusAnnotation = "everest.percona.com/monitoring-status"
+	// MonitoringLastCheckAnnotation is the annotation that holds the time of the last connectivity check.
+	MonitoringLastCheckAnnotation = "everest.percona.com/monitoring-last-check"
+	// MonitoringLastSuccessAnnotation is the annotation that holds the time of the last successful connectivity check.
+	MonitoringLastSuccessAnnotation = "everest.percona.com/monitoring-last-success"
+	// MonitoringLastErrorAnnotation is the annotation that holds the error of the last failed connectivity check.
+	MonitoringLastErrorAnnotation = "everest.percona.com/monitoring-last-error"
+	// PodSchedulingPolicyDefaultNamespacesAnnotation is the annotation that holds the comma-separated namespaces
+	// in which a pod scheduling policy is applied to the database clusters created without a policy.
+	// AllNamespaces makes the policy the default in every namespace without a namespace-specific default.
+	PodSchedulingPolicyDefaultNamespacesAnnotation = "everest.percona.com/default-for-namespaces"
+	// PodSchedulingPolicyAllowedNamespacesAnnotation is the annotation that holds the comma-separated namespaces
+	// whose database clusters may use a pod scheduling policy. A policy without it may be used in any namespace.
+	PodSchedulingPolicyAllowedNamespacesAnnotation = "everest.percona.com/allowed-namespaces"
+	// AllNamespaces matches every namespace in the namespace lists of the annotations.
+	AllNamespaces = "*"
 	// KubernetesManagedByLabel is the label used to identify resources managed by Everest.
 	KubernetesManagedByLabel = "app.kubernetes.io/managed-by"
 	// DatabaseClusterNameLabel is the label used to identify resources by DB cluster name.
@@ -0,0 +1,68 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+// EngineConfigTemplate is a reusable engine config for the database clusters.
+type EngineConfigTemplate struct {
+	Name        string                     `json:"name" yaml:"-"`
+	EngineType  everestv1alpha1.EngineType `json:"engineType" yaml:"engineType"`
+	Description string                     `json:"description,omitempty" yaml:"description,omitempty"`
+	Config      string                     `json:"config" yaml:"config"`
+}
+
+// EngineConfigTemplateList is a list of engine config templates.
+type EngineConfigTemplateList struct {
+	Items []EngineConfigTemplate `json:"items"`
+}
+
+// EngineConfigTemplatesToMap converts the engine config templates to a map struct.
+func EngineConfigTemplatesToMap(templates []EngineConfigTemplate) (map[string]string, error) {
+	result := make(map[string]string, len(templates))
+	for _, t := range templates {
+		raw, err := yaml.Marshal(t)
+		if err != nil {
+			return nil, err
+		}
+		result[t.Name] = string(raw)
+	}
+	return result, nil
+}
+
+// EngineConfigTemplatesFromMap tries to convert a map to the engine config templates sorted by name.
+func EngineConfigTemplatesFromMap(m map[string]string) ([]EngineConfigTemplate, error) {
+	result := make([]EngineConfigTemplate, 0, len(m))
+	for name, raw := range m {
+		t := EngineConfigTemplate{}
+		if err := yaml.Unmarshal([]byte(raw), &t); err != nil {
+			return nil, err
+		}
+		t.Name = name
+		result = append(result, t)
+	}
+	slices.SortFunc(result, func(a, b EngineConfigTemplate) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result, nil
+}
@@ -0,0 +1,130 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+// EngineVersionPolicyMode defines which database engine versions are allowed by an engine version policy.
+type EngineVersionPolicyMode string
+
+const (
+	// EngineVersionPolicyModeAll allows all the versions available for the database engine.
+	EngineVersionPolicyModeAll EngineVersionPolicyMode = "all"
+	// EngineVersionPolicyModeRecommended allows only the recommended versions of the database engine.
+	EngineVersionPolicyModeRecommended EngineVersionPolicyMode = "recommended"
+	// EngineVersionPolicyModePinned allows only the versions listed in the policy.
+	EngineVersionPolicyModePinned EngineVersionPolicyMode = "pinned"
+)
+
+// EngineVersionPolicy restricts the database engine versions that can be used in a namespace.
+type EngineVersionPolicy struct {
+	Engines map[everestv1alpha1.EngineType]EngineVersionRule `json:"engines,omitempty"`
+}
+
+// EngineVersionRule defines the allowed and the default versions of a database engine.
+type EngineVersionRule struct {
+	Mode            EngineVersionPolicyMode `json:"mode,omitempty" yaml:"mode,omitempty"`
+	AllowedVersions []string                `json:"allowedVersions,omitempty" yaml:"allowedVersions,omitempty"`
+	DefaultVersion  string                  `json:"defaultVersion,omitempty" yaml:"defaultVersion,omitempty"`
+}
+
+// Rule returns the rule for the given engine type.
+// All versions are allowed if the policy has no rule for the engine type.
+func (p *EngineVersionPolicy) Rule(engineType everestv1alpha1.EngineType) EngineVersionRule {
+	if p == nil {
+		return EngineVersionRule{}
+	}
+	return p.Engines[engineType]
+}
+
+// IsRestricted returns true if the rule does not allow all the available versions.
+func (r EngineVersionRule) IsRestricted() bool {
+	return r.Mode != "" && r.Mode != EngineVersionPolicyModeAll
+}
+
+// IsAllowed checks if the given version of the database engine is allowed by the rule.
+func (r EngineVersionRule) IsAllowed(version string, engine *everestv1alpha1.DatabaseEngine) bool {
+	switch r.Mode {
+	case EngineVersionPolicyModeRecommended:
+		c, ok := engine.Status.AvailableVersions.Engine[version]
+		return ok && c != nil && c.Status == everestv1alpha1.DBEngineComponentRecommended
+	case EngineVersionPolicyModePinned:
+		return slices.Contains(r.AllowedVersions, version)
+	case EngineVersionPolicyModeAll:
+	}
+	return true
+}
+
+// ApplyTo hides the versions of the database engine that are not allowed by the policy
+// and marks the default version as the only recommended one.
+func (p *EngineVersionPolicy) ApplyTo(engine *everestv1alpha1.DatabaseEngine) {
+	rule := p.Rule(engine.Spec.Type)
+	versions := engine.Status.AvailableVersions.Engine
+	for version := range versions {
+		if !rule.IsAllowed(version, engine) {
+			delete(versions, version)
+		}
+	}
+	if rule.DefaultVersion == "" {
+		return
+	}
+	if _, ok := versions[rule.DefaultVersion]; !ok {
+		return
+	}
+	for version, c := range versions {
+		if c == nil {
+			continue
+		}
+		switch {
+		case version == rule.DefaultVersion:
+			c.Status = everestv1alpha1.DBEngineComponentRecommended
+		case c.Status == everestv1alpha1.DBEngineComponentRecommended:
+			c.Status = everestv1alpha1.DBEngineComponentAvailable
+		}
+	}
+}
+
+// ToMap converts the EngineVersionPolicy struct to a map struct.
+func (p *EngineVersionPolicy) ToMap() (map[string]string, error) {
+	result := make(map[string]string, len(p.Engines))
+	for engineType, rule := range p.Engines {
+		raw, err := yaml.Marshal(rule)
+		if err != nil {
+			return nil, err
+		}
+		result[string(engineType)] = string(raw)
+	}
+	return result, nil
+}
+
+// FromMap tries to convert a map to the EngineVersionPolicy struct.
+func (p *EngineVersionPolicy) FromMap(m map[string]string) error {
+	p.Engines = make(map[everestv1alpha1.EngineType]EngineVersionRule, len(m))
+	for engineType, raw := range m {
+		rule := EngineVersionRule{}
+		if err := yaml.Unmarshal([]byte(raw), &rule); err != nil {
+			return err
+		}
+		p.Engines[everestv1alpha1.EngineType(engineType)] = rule
+	}
+	return nil
+}
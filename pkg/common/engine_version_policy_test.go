@@ -0,0 +1,130 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+func TestEngineVersionPolicyApplyTo(t *testing.T) {
+	t.Parallel()
+	newEngine := func() *everestv1alpha1.DatabaseEngine {
+		return &everestv1alpha1.DatabaseEngine{
+			Spec: everestv1alpha1.DatabaseEngineSpec{Type: everestv1alpha1.DatabaseEnginePXC},
+			Status: everestv1alpha1.DatabaseEngineStatus{
+				AvailableVersions: everestv1alpha1.Versions{
+					Engine: everestv1alpha1.ComponentsMap{
+						"8.0.35": &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentAvailable},
+						"8.0.36": &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentRecommended},
+						"8.0.37": &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentAvailable},
+					},
+				},
+			},
+		}
+	}
+
+	type testCase struct {
+		name     string
+		policy   *EngineVersionPolicy
+		expected map[string]everestv1alpha1.ComponentStatus
+	}
+
+	testCases := []testCase{
+		{
+			name:   "no policy",
+			policy: nil,
+			expected: map[string]everestv1alpha1.ComponentStatus{
+				"8.0.35": everestv1alpha1.DBEngineComponentAvailable,
+				"8.0.36": everestv1alpha1.DBEngineComponentRecommended,
+				"8.0.37": everestv1alpha1.DBEngineComponentAvailable,
+			},
+		},
+		{
+			name: "rule for another engine",
+			policy: &EngineVersionPolicy{Engines: map[everestv1alpha1.EngineType]EngineVersionRule{
+				everestv1alpha1.DatabaseEnginePSMDB: {Mode: EngineVersionPolicyModeRecommended},
+			}},
+			expected: map[string]everestv1alpha1.ComponentStatus{
+				"8.0.35": everestv1alpha1.DBEngineComponentAvailable,
+				"8.0.36": everestv1alpha1.DBEngineComponentRecommended,
+				"8.0.37": everestv1alpha1.DBEngineComponentAvailable,
+			},
+		},
+		{
+			name: "recommended only",
+			policy: &EngineVersionPolicy{Engines: map[everestv1alpha1.EngineType]EngineVersionRule{
+				everestv1alpha1.DatabaseEnginePXC: {Mode: EngineVersionPolicyModeRecommended},
+			}},
+			expected: map[string]everestv1alpha1.ComponentStatus{
+				"8.0.36": everestv1alpha1.DBEngineComponentRecommended,
+			},
+		},
+		{
+			name: "pinned with default",
+			policy: &EngineVersionPolicy{Engines: map[everestv1alpha1.EngineType]EngineVersionRule{
+				everestv1alpha1.DatabaseEnginePXC: {
+					Mode:            EngineVersionPolicyModePinned,
+					AllowedVersions: []string{"8.0.35", "8.0.36", "8.0.40"},
+					DefaultVersion:  "8.0.35",
+				},
+			}},
+			expected: map[string]everestv1alpha1.ComponentStatus{
+				"8.0.35": everestv1alpha1.DBEngineComponentRecommended,
+				"8.0.36": everestv1alpha1.DBEngineComponentAvailable,
+			},
+		},
+		{
+			name: "default version is not available",
+			policy: &EngineVersionPolicy{Engines: map[everestv1alpha1.EngineType]EngineVersionRule{
+				everestv1alpha1.DatabaseEnginePXC: {
+					Mode:           EngineVersionPolicyModeAll,
+					DefaultVersion: "8.0.40",
+				},
+			}},
+			expected: map[string]everestv1alpha1.ComponentStatus{
+				"8.0.35": everestv1alpha1.DBEngineComponentAvailable,
+				"8.0.36": everestv1alpha1.DBEngineComponentRecommended,
+				"8.0.37": everestv1alpha1.DBEngineComponentAvailable,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			engine := newEngine()
+			tc.policy.ApplyTo(engine)
+			res := make(map[string]everestv1alpha1.ComponentStatus, len(engine.Status.AvailableVersions.Engine))
+			for version, c := range engine.Status.AvailableVersions.Engine {
+				res[version] = c.Status
+			}
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestEngineVersionPolicyMap(t *testing.T) {
+	t.Parallel()
+	policy := EngineVersionPolicy{Engines: map[everestv1alpha1.EngineType]EngineVersionRule{
+		everestv1alpha1.DatabaseEnginePXC: {
+			Mode:            EngineVersionPolicyModePinned,
+			AllowedVersions: []string{"8.0.35", "8.0.36"},
+			DefaultVersion:  "8.0.36",
+		},
+		everestv1alpha1.DatabaseEnginePostgresql: {Mode: EngineVersionPolicyModeRecommended},
+	}}
+
+	m, err := policy.ToMap()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"pxc":        "mode: pinned\nallowedVersions:\n    - 8.0.35\n    - 8.0.36\ndefaultVersion: 8.0.36\n",
+		"postgresql": "mode: recommended\n",
+	}, m)
+
+	res := EngineVersionPolicy{}
+	require.NoError(t, res.FromMap(m))
+	assert.Equal(t, policy, res)
+}
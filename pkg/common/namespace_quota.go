@@ -0,0 +1,111 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	quotaKeyCPU                    = "cpu"
+	quotaKeyMemory                 = "memory"
+	quotaKeyDisk                   = "disk"
+	quotaKeyDatabaseClusters       = "databaseClusters"
+	quotaKeyDatabaseClusterBackups = "databaseClusterBackups"
+)
+
+// NamespaceQuota limits the resources that can be used by the database clusters in a namespace.
+// A nil field means that there is no limit.
+type NamespaceQuota struct {
+	CPU                    *resource.Quantity `json:"cpu,omitempty"`
+	Memory                 *resource.Quantity `json:"memory,omitempty"`
+	Disk                   *resource.Quantity `json:"disk,omitempty"`
+	DatabaseClusters       *int               `json:"databaseClusters,omitempty"`
+	DatabaseClusterBackups *int               `json:"databaseClusterBackups,omitempty"`
+}
+
+// NamespaceResourceUsage holds the resources used in a namespace.
+type NamespaceResourceUsage struct {
+	CPUMillis              uint64
+	MemoryBytes            uint64
+	DiskBytes              uint64
+	DatabaseClusters       int
+	DatabaseClusterBackups int
+}
+
+// IsEmpty returns true if the quota has no limits.
+func (q *NamespaceQuota) IsEmpty() bool {
+	return q == nil || (q.CPU == nil && q.Memory == nil && q.Disk == nil &&
+		q.DatabaseClusters == nil && q.DatabaseClusterBackups == nil)
+}
+
+// ToMap converts the NamespaceQuota struct to a map struct.
+func (q *NamespaceQuota) ToMap() map[string]string {
+	result := make(map[string]string)
+	if q.CPU != nil {
+		result[quotaKeyCPU] = q.CPU.String()
+	}
+	if q.Memory != nil {
+		result[quotaKeyMemory] = q.Memory.String()
+	}
+	if q.Disk != nil {
+		result[quotaKeyDisk] = q.Disk.String()
+	}
+	if q.DatabaseClusters != nil {
+		result[quotaKeyDatabaseClusters] = strconv.Itoa(*q.DatabaseClusters)
+	}
+	if q.DatabaseClusterBackups != nil {
+		result[quotaKeyDatabaseClusterBackups] = strconv.Itoa(*q.DatabaseClusterBackups)
+	}
+	return result
+}
+
+// FromMap tries to convert a map to the NamespaceQuota struct.
+func (q *NamespaceQuota) FromMap(m map[string]string) error {
+	*q = NamespaceQuota{}
+	for key, dst := range map[string]**resource.Quantity{
+		quotaKeyCPU:    &q.CPU,
+		quotaKeyMemory: &q.Memory,
+		quotaKeyDisk:   &q.Disk,
+	} {
+		raw, ok := m[key]
+		if !ok {
+			continue
+		}
+		v, err := resource.ParseQuantity(raw)
+		if err != nil {
+			return err
+		}
+		*dst = &v
+	}
+	for key, dst := range map[string]**int{
+		quotaKeyDatabaseClusters:       &q.DatabaseClusters,
+		quotaKeyDatabaseClusterBackups: &q.DatabaseClusterBackups,
+	} {
+		raw, ok := m[key]
+		if !ok {
+			continue
+		}
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+		*dst = &v
+	}
+	return nil
+}
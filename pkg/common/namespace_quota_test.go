@@ -0,0 +1,31 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestNamespaceQuotaMap(t *testing.T) {
+	t.Parallel()
+	cpu := resource.MustParse("2500m")
+	disk := resource.MustParse("100Gi")
+	quota := &NamespaceQuota{CPU: &cpu, Disk: &disk, DatabaseClusters: pointer.ToInt(3)}
+
+	m := quota.ToMap()
+	assert.Equal(t, map[string]string{"cpu": "2500m", "disk": "100Gi", "databaseClusters": "3"}, m)
+
+	restored := &NamespaceQuota{}
+	require.NoError(t, restored.FromMap(m))
+	assert.Equal(t, quota.ToMap(), restored.ToMap())
+	assert.Nil(t, restored.Memory)
+	assert.Nil(t, restored.DatabaseClusterBackups)
+	assert.False(t, restored.IsEmpty())
+
+	require.NoError(t, restored.FromMap(nil))
+	assert.True(t, restored.IsEmpty())
+	require.Error(t, restored.FromMap(map[string]string{"databaseClusterBackups": "many"}))
+}
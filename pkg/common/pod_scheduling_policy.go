@@ -0,0 +1,80 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"slices"
+	"strings"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+// ParseNamespaceList parses a comma-separated list of namespaces.
+func ParseNamespaceList(s string) []string {
+	var result []string
+	for _, ns := range strings.Split(s, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" && !slices.Contains(result, ns) {
+			result = append(result, ns)
+		}
+	}
+	return result
+}
+
+// PodSchedulingPolicyAllowedNamespaces returns the namespaces allowed to use the pod scheduling policy.
+// Nil is returned if the policy may be used in any namespace.
+func PodSchedulingPolicyAllowedNamespaces(psp *everestv1alpha1.PodSchedulingPolicy) []string {
+	allowed := ParseNamespaceList(psp.GetAnnotations()[PodSchedulingPolicyAllowedNamespacesAnnotation])
+	if slices.Contains(allowed, AllNamespaces) {
+		return nil
+	}
+	return allowed
+}
+
+// PodSchedulingPolicyDefaultNamespaces returns the namespaces in which the pod scheduling policy is the default.
+func PodSchedulingPolicyDefaultNamespaces(psp *everestv1alpha1.PodSchedulingPolicy) []string {
+	return ParseNamespaceList(psp.GetAnnotations()[PodSchedulingPolicyDefaultNamespacesAnnotation])
+}
+
+// IsPodSchedulingPolicyAllowed returns true if the pod scheduling policy may be used in the namespace.
+func IsPodSchedulingPolicyAllowed(psp *everestv1alpha1.PodSchedulingPolicy, namespace string) bool {
+	allowed := PodSchedulingPolicyAllowedNamespaces(psp)
+	return allowed == nil || slices.Contains(allowed, namespace)
+}
+
+// DefaultPodSchedulingPolicy returns the policy applied to the database clusters of the engine type
+// created in the namespace without a policy, or nil if there is none.
+// A policy that is the default in the namespace takes precedence over a policy that is the default in all namespaces.
+func DefaultPodSchedulingPolicy(
+	policies []everestv1alpha1.PodSchedulingPolicy,
+	engineType everestv1alpha1.EngineType,
+	namespace string,
+) *everestv1alpha1.PodSchedulingPolicy {
+	var fallback *everestv1alpha1.PodSchedulingPolicy
+	for i := range policies {
+		psp := &policies[i]
+		if psp.Spec.EngineType != engineType || !IsPodSchedulingPolicyAllowed(psp, namespace) {
+			continue
+		}
+		defaults := PodSchedulingPolicyDefaultNamespaces(psp)
+		if slices.Contains(defaults, namespace) {
+			return psp
+		}
+		if fallback == nil && slices.Contains(defaults, AllNamespaces) {
+			fallback = psp
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,49 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+func TestParseNamespaceList(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, ParseNamespaceList(""))
+	assert.Equal(t, []string{"ns-1", "ns-2"}, ParseNamespaceList(" ns-1,,ns-2 , ns-1"))
+}
+
+func TestDefaultPodSchedulingPolicy(t *testing.T) {
+	t.Parallel()
+	policy := func(name string, engineType everestv1alpha1.EngineType, defaults, allowed string) everestv1alpha1.PodSchedulingPolicy {
+		annotations := map[string]string{PodSchedulingPolicyDefaultNamespacesAnnotation: defaults}
+		if allowed != "" {
+			annotations[PodSchedulingPolicyAllowedNamespacesAnnotation] = allowed
+		}
+		return everestv1alpha1.PodSchedulingPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations},
+			Spec:       everestv1alpha1.PodSchedulingPolicySpec{EngineType: engineType},
+		}
+	}
+	policies := []everestv1alpha1.PodSchedulingPolicy{
+		policy("pxc-all", everestv1alpha1.DatabaseEnginePXC, AllNamespaces, ""),
+		policy("pxc-prod", everestv1alpha1.DatabaseEnginePXC, "prod", "prod,staging"),
+		policy("psmdb-none", everestv1alpha1.DatabaseEnginePSMDB, "", ""),
+	}
+
+	name := func(psp *everestv1alpha1.PodSchedulingPolicy) string {
+		if psp == nil {
+			return ""
+		}
+		return psp.GetName()
+	}
+	assert.Equal(t, "pxc-prod", name(DefaultPodSchedulingPolicy(policies, everestv1alpha1.DatabaseEnginePXC, "prod")))
+	assert.Equal(t, "pxc-all", name(DefaultPodSchedulingPolicy(policies, everestv1alpha1.DatabaseEnginePXC, "staging")))
+	assert.Equal(t, "", name(DefaultPodSchedulingPolicy(policies, everestv1alpha1.DatabaseEnginePSMDB, "prod")))
+
+	assert.True(t, IsPodSchedulingPolicyAllowed(&policies[0], "dev"))
+	assert.True(t, IsPodSchedulingPolicyAllowed(&policies[1], "staging"))
+	assert.False(t, IsPodSchedulingPolicyAllowed(&policies[1], "dev"))
+}
@@ -0,0 +1,49 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"time"
+)
+
+// UsageSample is a snapshot of the resources requested and used by the database clusters
+// managed by Everest at a point in time.
+type UsageSample struct {
+	Time time.Time `json:"time"`
+	// Interval is the sampling interval, a sample accounts for at most this duration.
+	Interval time.Duration          `json:"interval"`
+	Clusters []DatabaseClusterUsage `json:"clusters"`
+}
+
+// DatabaseClusterUsage holds the resources requested and used by a database cluster.
+// The backups of a deleted database cluster are still accounted to it.
+type DatabaseClusterUsage struct {
+	Namespace            string `json:"namespace"`
+	Name                 string `json:"name"`
+	EngineType           string `json:"engineType,omitempty"`
+	RequestedCPUMillis   uint64 `json:"requestedCpuMillis"`
+	RequestedMemoryBytes uint64 `json:"requestedMemoryBytes"`
+	// UsedCPUMillis and UsedMemoryBytes are nil if the metrics API is not available in the cluster.
+	UsedCPUMillis      *uint64 `json:"usedCpuMillis,omitempty"`
+	UsedMemoryBytes    *uint64 `json:"usedMemoryBytes,omitempty"`
+	RequestedDiskBytes uint64  `json:"requestedDiskBytes"`
+	// UsedDiskBytes is the space used on the volumes of the database cluster according to the kubelets.
+	// It is nil if the volume stats of the kubelets are not available.
+	UsedDiskBytes *uint64 `json:"usedDiskBytes,omitempty"`
+	// BackupBytes is the size of the backups whose size is reported by the database operator.
+	BackupBytes uint64 `json:"backupBytes"`
+	Backups     int    `json:"backups"`
+}
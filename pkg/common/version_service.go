@@ -0,0 +1,24 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import "strings"
+
+// VersionServiceConfigMapKey returns the key of the EverestVersionServiceConfigMapName ConfigMap
+// holding the version service response served at the given path, such as versions/v1/pxc-operator/1.16.0.
+func VersionServiceConfigMapKey(path string) string {
+	return strings.ReplaceAll(strings.Trim(path, "/"), "/", "_")
+}
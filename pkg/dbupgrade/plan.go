@@ -0,0 +1,317 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbupgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	goversion "github.com/hashicorp/go-version"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+var (
+	errDBEngineUpgradeUnavailable   = errors.New("provided target version is not available for upgrade")
+	errDBEngineInvalidTargetVersion = errors.New("invalid target version provided for upgrade")
+)
+
+// TargetVersionFunc returns the operator version the database engine is upgraded to,
+// or an empty string if the operator of the engine is not upgraded.
+type TargetVersionFunc func(engine *everestv1alpha1.DatabaseEngine) string
+
+// NextUpgradeVersion upgrades the operators to the next pending upgrade version of the engines.
+func NextUpgradeVersion(engine *everestv1alpha1.DatabaseEngine) string {
+	return engine.Status.GetNextUpgradeVersion()
+}
+
+// SupportedUpgradeVersion upgrades the operators to the greatest pending upgrade version of the
+// engines that meets the operator constraints of the given Everest version.
+func SupportedUpgradeVersion(supVer *common.SupportedVersion) TargetVersionFunc {
+	return func(engine *everestv1alpha1.DatabaseEngine) string {
+		var constraints goversion.Constraints
+		switch engine.Spec.Type {
+		case everestv1alpha1.DatabaseEnginePXC:
+			constraints = supVer.PXCOperator
+		case everestv1alpha1.DatabaseEnginePSMDB:
+			constraints = supVer.PSMBDOperator
+		case everestv1alpha1.DatabaseEnginePostgresql:
+			constraints = supVer.PGOperator
+		}
+
+		var target *goversion.Version
+		for _, pending := range engine.Status.PendingOperatorUpgrades {
+			v, err := goversion.NewVersion(pending.TargetVersion)
+			if err != nil || !constraints.Check(v) {
+				continue
+			}
+			if target == nil || v.GreaterThan(target) {
+				target = v
+			}
+		}
+		if target == nil {
+			return ""
+		}
+		return target.Original()
+	}
+}
+
+// GetUpgradePlan returns the operator upgrades of the database engines in the given namespace
+// together with the pre-flight checks of their database clusters. If there are no upgrades,
+// the pending actions are the post-upgrade tasks of the database clusters.
+func GetUpgradePlan(
+	ctx context.Context,
+	k kubernetes.KubernetesConnector,
+	vs versionservice.Interface,
+	namespace string,
+	targetVersion TargetVersionFunc,
+) (*api.UpgradePlan, error) {
+	result, err := GetOperatorUpgradePlan(ctx, k, vs, namespace, targetVersion)
+	if err != nil {
+		return nil, err
+	}
+	// No upgrades available, so we will check if our clusters are ready for current version.
+	if len(pointer.Get(result.Upgrades)) == 0 {
+		result.PendingActions = pointer.To([]api.UpgradeTask{})
+		engines, err := k.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
+		if err != nil {
+			return nil, err
+		}
+		for _, engine := range engines.Items {
+			tasks, err := getDBPostUpgradeTasks(ctx, k, &engine)
+			if err != nil {
+				return nil, err
+			}
+			*result.PendingActions = append(*result.PendingActions, tasks...)
+		}
+	}
+	return result, nil
+}
+
+// GetOperatorUpgradePlan returns the operator upgrades of the database engines in the given
+// namespace together with the pre-flight checks of their database clusters.
+func GetOperatorUpgradePlan(
+	ctx context.Context,
+	k kubernetes.KubernetesConnector,
+	vs versionservice.Interface,
+	namespace string,
+	targetVersion TargetVersionFunc,
+) (*api.UpgradePlan, error) {
+	engines, err := k.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &api.UpgradePlan{
+		Upgrades:       pointer.To([]api.Upgrade{}),
+		PendingActions: pointer.To([]api.UpgradeTask{}),
+	}
+
+	for _, engine := range engines.Items {
+		nextVersion := targetVersion(&engine)
+		if nextVersion == "" {
+			continue
+		}
+
+		upgrade := &api.Upgrade{
+			CurrentVersion: pointer.To(engine.Status.OperatorVersion),
+			Name:           pointer.To(engine.GetName()),
+			TargetVersion:  pointer.To(nextVersion),
+		}
+		*result.Upgrades = append(*result.Upgrades, *upgrade)
+		pf, err := getOperatorUpgradePreflight(ctx, k, vs, nextVersion, &engine)
+		if err != nil {
+			return nil, err
+		}
+		*result.PendingActions = append(*result.PendingActions, pf.databases...)
+	}
+	return result, nil
+}
+
+type operatorUpgradePreflight struct {
+	currentVersion string
+	databases      []api.UpgradeTask
+}
+
+type upgradePreflightCheckArgs struct {
+	targetVersion  string
+	engine         *everestv1alpha1.DatabaseEngine
+	versionService versionservice.Interface
+}
+
+func getOperatorUpgradePreflight(
+	ctx context.Context,
+	k kubernetes.KubernetesConnector,
+	vs versionservice.Interface,
+	targetVersion string,
+	engine *everestv1alpha1.DatabaseEngine,
+) (*operatorUpgradePreflight, error) {
+	namespace := engine.GetNamespace()
+	// Get all database clusters in the namespace.
+	databases, err := k.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	// Filter out databases not using this engine type.
+	databases.Items = slices.DeleteFunc(databases.Items, func(db everestv1alpha1.DatabaseCluster) bool {
+		return db.Spec.Engine.Type != engine.Spec.Type
+	})
+
+	if err := validateOperatorUpgradeVersion(engine.Status.OperatorVersion, targetVersion); err != nil {
+		return nil, err
+	}
+
+	args := upgradePreflightCheckArgs{
+		targetVersion:  targetVersion,
+		engine:         engine,
+		versionService: vs,
+	}
+	result, err := getUpgradePreflightChecksResult(ctx, databases.Items, args)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to run preflight checks"))
+	}
+	return result, nil
+}
+
+func getUpgradePreflightChecksResult(
+	ctx context.Context,
+	dbs []everestv1alpha1.DatabaseCluster,
+	args upgradePreflightCheckArgs,
+) (*operatorUpgradePreflight, error) {
+	// Check that this version is available for upgrade.
+	if u := args.engine.Status.GetPendingUpgrade(args.targetVersion); u == nil {
+		return nil, errDBEngineUpgradeUnavailable
+	}
+
+	// Perform checks for each given DB.
+	dbResults := make([]api.UpgradeTask, 0, len(dbs))
+	for _, db := range dbs {
+		result, err := getUpgradePreflightCheckResultForDatabase(ctx, db, args)
+		if err != nil {
+			return nil, err
+		}
+		dbResults = append(dbResults, result)
+	}
+
+	// Sort by name.
+	slices.SortFunc(dbResults, func(a, b api.UpgradeTask) int {
+		return strings.Compare(pointer.Get(a.Name), pointer.Get(b.Name))
+	})
+
+	return &operatorUpgradePreflight{
+		databases:      dbResults,
+		currentVersion: args.engine.Status.OperatorVersion,
+	}, nil
+}
+
+func validateOperatorUpgradeVersion(currentVersion, targetVersion string) error {
+	targetsv, err := goversion.NewSemver(targetVersion)
+	if err != nil {
+		return err
+	}
+	currentsv, err := goversion.NewSemver(currentVersion)
+	if err != nil {
+		return err
+	}
+	if targetsv.LessThanOrEqual(currentsv) {
+		return errors.Join(errDBEngineInvalidTargetVersion, errors.New("target version must be greater than the current version"))
+	}
+	return nil
+}
+
+func getUpgradePreflightCheckResultForDatabase(
+	ctx context.Context,
+	database everestv1alpha1.DatabaseCluster,
+	args upgradePreflightCheckArgs,
+) (api.UpgradeTask, error) {
+	// Check that the database engine is at the desired version.
+	if valid, minReqVer, err := CheckDBEngineVersion(ctx, args.versionService, args.engine, args.targetVersion, database); err != nil {
+		return api.UpgradeTask{},
+			errors.Join(err, errors.New("failed to validate database engine version for operator upgrade"))
+	} else if !valid {
+		return api.UpgradeTask{
+			Name:        pointer.To(database.GetName()),
+			PendingTask: pointer.To(api.UpgradeEngine),
+			Message: pointer.ToString(
+				fmt.Sprintf("Upgrade DB version to %s or higher", minReqVer)),
+		}, nil
+	}
+
+	// Check that DB is at recommended CRVersion.
+	if recCRVersion := database.Status.RecommendedCRVersion; recCRVersion != nil {
+		return api.UpgradeTask{
+			Name:        pointer.To(database.GetName()),
+			PendingTask: pointer.To(api.Restart),
+			Message: pointer.ToString(
+				fmt.Sprintf("Update CRVersion to %s", *recCRVersion)),
+		}, nil
+	}
+
+	// Check that DB is running.
+	if database.Status.Status != everestv1alpha1.AppStateReady {
+		return api.UpgradeTask{
+			Name:        pointer.To(database.GetName()),
+			PendingTask: pointer.To(api.NotReady),
+			Message:     pointer.ToString("Database is not ready"),
+		}, nil
+	}
+
+	// Database is in desired state for performing operator upgrade.
+	return api.UpgradeTask{
+		Name:        pointer.To(database.GetName()),
+		PendingTask: pointer.To(api.Ready),
+	}, nil
+}
+
+func getDBPostUpgradeTasks(
+	ctx context.Context,
+	k kubernetes.KubernetesConnector,
+	engine *everestv1alpha1.DatabaseEngine,
+) ([]api.UpgradeTask, error) {
+	namespace := engine.GetNamespace()
+	// List all clusters in this namespace.
+	clusters, err := k.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that every cluster is using the recommended CRVersion.
+	checks := []api.UpgradeTask{}
+	for _, cluster := range clusters.Items {
+		if cluster.Spec.Engine.Type != engine.Spec.Type {
+			continue
+		}
+		check := api.UpgradeTask{
+			Name: pointer.To(cluster.Name),
+		}
+		check.PendingTask = pointer.To(api.Ready)
+		if recVer := cluster.Status.RecommendedCRVersion; recVer != nil {
+			check.PendingTask = pointer.To(api.Restart)
+			check.Message = pointer.To(fmt.Sprintf("Database needs restart to use CRVersion '%s'", *recVer))
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
@@ -0,0 +1,285 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dbupgrade holds the logic of the database engine operator upgrades
+// shared by the Everest API server and everestctl.
+package dbupgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/cenkalti/backoff"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+const (
+	defaultPollInterval = 10 * time.Second
+	defaultTimeout      = 30 * time.Minute
+)
+
+var (
+	errDatabaseClusterUpdateNotAllowed = errors.New("db operations are not allowed in current db state")
+	errNoPendingTasks                  = errors.New("database cluster has no pending tasks")
+
+	updateBackoff = backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), 10) //nolint:mnd
+)
+
+// DatabaseClusterUpdater updates database clusters.
+type DatabaseClusterUpdater interface {
+	UpdateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error)
+}
+
+// Task is a pending post-upgrade task of a database cluster together with
+// the change that completes it.
+type Task struct {
+	api.UpgradeTask
+	// EngineVersion is the database engine version to upgrade to, if not empty.
+	EngineVersion string
+	// CRVersion is the CRVersion to update to, if not empty.
+	CRVersion string
+}
+
+// apply applies the change to the given database cluster.
+func (t Task) apply(db *everestv1alpha1.DatabaseCluster) {
+	if t.EngineVersion != "" {
+		db.Spec.Engine.Version = t.EngineVersion
+	}
+	if t.CRVersion != "" {
+		db.Spec.Engine.CRVersion = pointer.ToString(t.CRVersion)
+	}
+}
+
+// PendingTasks returns the post-upgrade tasks of the database clusters in the given namespace,
+// sorted by database cluster name.
+func PendingTasks(
+	ctx context.Context,
+	k kubernetes.KubernetesConnector,
+	vs versionservice.Interface,
+	namespace string,
+) ([]Task, error) {
+	engines, err := k.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	databases, err := k.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []Task{}
+	for _, db := range databases.Items {
+		idx := slices.IndexFunc(engines.Items, func(e everestv1alpha1.DatabaseEngine) bool {
+			return e.Spec.Type == db.Spec.Engine.Type
+		})
+		// The database engine needs to be upgraded before the operator can be upgraded.
+		if idx >= 0 {
+			engine := engines.Items[idx]
+			if nextVersion := engine.Status.GetNextUpgradeVersion(); nextVersion != "" {
+				valid, minReqVer, err := CheckDBEngineVersion(ctx, vs, &engine, nextVersion, db)
+				if err != nil {
+					return nil, errors.Join(err, errors.New("failed to validate database engine version for operator upgrade"))
+				}
+				if !valid {
+					tasks = append(tasks, Task{
+						UpgradeTask: api.UpgradeTask{
+							Name:        pointer.ToString(db.GetName()),
+							PendingTask: pointer.To(api.UpgradeEngine),
+							Message:     pointer.ToString(fmt.Sprintf("Upgrade DB version to %s", minReqVer)),
+						},
+						EngineVersion: minReqVer,
+					})
+					continue
+				}
+			}
+		}
+		// The database needs a restart to use the recommended CRVersion.
+		if recVer := db.Status.RecommendedCRVersion; recVer != nil {
+			tasks = append(tasks, Task{
+				UpgradeTask: api.UpgradeTask{
+					Name:        pointer.ToString(db.GetName()),
+					PendingTask: pointer.To(api.Restart),
+					Message:     pointer.ToString(fmt.Sprintf("Update CRVersion to %s", *recVer)),
+				},
+				CRVersion: *recVer,
+			})
+		}
+	}
+
+	slices.SortFunc(tasks, func(a, b Task) int {
+		return strings.Compare(pointer.Get(a.Name), pointer.Get(b.Name))
+	})
+	return tasks, nil
+}
+
+// SelectTasks returns the tasks of the given database clusters. A nil list of databases
+// selects all the tasks. The requested database clusters without pending tasks are
+// returned as skipped results.
+func SelectTasks(tasks []Task, databases *[]string) ([]Task, []api.PostUpgradeTaskResult) {
+	if databases == nil {
+		return tasks, nil
+	}
+	selected := make([]Task, 0, len(*databases))
+	skipped := []api.PostUpgradeTaskResult{}
+	for _, name := range *databases {
+		idx := slices.IndexFunc(tasks, func(t Task) bool {
+			return pointer.Get(t.Name) == name
+		})
+		if idx < 0 {
+			skipped = append(skipped, api.PostUpgradeTaskResult{
+				Task:    api.UpgradeTask{Name: pointer.ToString(name)},
+				State:   api.PostUpgradeTaskResultStateSkipped,
+				Message: pointer.ToString(errNoPendingTasks.Error()),
+			})
+			continue
+		}
+		selected = append(selected, tasks[idx])
+	}
+	return selected, skipped
+}
+
+// Executor executes the post-upgrade tasks of database clusters. The database clusters
+// are updated with the updater, so the API server can validate the updates the same way
+// as the updates made through the API.
+type Executor struct {
+	kubeConnector kubernetes.KubernetesConnector
+	updater       DatabaseClusterUpdater
+
+	pollInterval time.Duration
+	timeout      time.Duration
+}
+
+// NewExecutor returns a new post-upgrade tasks executor.
+func NewExecutor(k kubernetes.KubernetesConnector, updater DatabaseClusterUpdater) *Executor {
+	return &Executor{
+		kubeConnector: k,
+		updater:       updater,
+		pollInterval:  defaultPollInterval,
+		timeout:       defaultTimeout,
+	}
+}
+
+// Execute executes the tasks in the given namespace in batches of batchSize database clusters.
+// The report function is called when the execution of a task starts and when it finishes.
+func (e *Executor) Execute(
+	ctx context.Context,
+	namespace string,
+	tasks []Task,
+	batchSize int,
+	waitForReady bool,
+	report func(api.PostUpgradeTaskResult),
+) {
+	for batch := range slices.Chunk(tasks, max(batchSize, 1)) {
+		var wg sync.WaitGroup
+		for _, task := range batch {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				report(api.PostUpgradeTaskResult{
+					Task:  task.UpgradeTask,
+					State: api.PostUpgradeTaskResultStateInProgress,
+				})
+				report(e.execute(ctx, namespace, task, waitForReady))
+			}()
+		}
+		wg.Wait()
+	}
+}
+
+// execute applies the task to the database cluster and optionally
+// waits for the database cluster to become ready.
+func (e *Executor) execute(
+	ctx context.Context,
+	namespace string,
+	task Task,
+	waitForReady bool,
+) api.PostUpgradeTaskResult {
+	result := api.PostUpgradeTaskResult{
+		Task:  task.UpgradeTask,
+		State: api.PostUpgradeTaskResultStateSucceeded,
+	}
+	key := types.NamespacedName{Namespace: namespace, Name: pointer.Get(task.Name)}
+
+	var generation int64
+	// We wrap this logic in a retry loop to reduce the chances of resource conflicts.
+	err := backoff.Retry(func() error {
+		db, err := e.kubeConnector.GetDatabaseCluster(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !kubernetes.IsDatabaseClusterUpdateAllowed(db) {
+			return backoff.Permanent(fmt.Errorf("%w: %s", errDatabaseClusterUpdateNotAllowed, db.Status.Status))
+		}
+		task.apply(db)
+		updated, err := e.updater.UpdateDatabaseCluster(ctx, db)
+		if err != nil {
+			return err
+		}
+		generation = updated.GetGeneration()
+		return nil
+	}, backoff.WithContext(updateBackoff, ctx),
+	)
+	switch {
+	case errors.Is(err, errDatabaseClusterUpdateNotAllowed):
+		result.State = api.PostUpgradeTaskResultStateSkipped
+		result.Message = pointer.ToString(err.Error())
+		return result
+	case err != nil:
+		result.State = api.PostUpgradeTaskResultStateFailed
+		result.Message = pointer.ToString(err.Error())
+		return result
+	}
+
+	if !waitForReady {
+		return result
+	}
+	if err := e.waitForDatabaseClusterReady(ctx, key, generation); err != nil {
+		result.State = api.PostUpgradeTaskResultStateFailed
+		result.Message = pointer.ToString(err.Error())
+	}
+	return result
+}
+
+// waitForDatabaseClusterReady waits until the database cluster has observed the
+// given generation and is ready.
+func (e *Executor) waitForDatabaseClusterReady(ctx context.Context, key types.NamespacedName, generation int64) error {
+	err := wait.PollUntilContextTimeout(ctx, e.pollInterval, e.timeout, true,
+		func(ctx context.Context) (bool, error) {
+			db, err := e.kubeConnector.GetDatabaseCluster(ctx, key)
+			if err != nil {
+				return false, err
+			}
+			return db.Status.ObservedGeneration >= generation &&
+				db.Status.Status == everestv1alpha1.AppStateReady, nil
+		},
+	)
+	if err != nil {
+		return errors.Join(err, errors.New("database cluster did not become ready"))
+	}
+	return nil
+}
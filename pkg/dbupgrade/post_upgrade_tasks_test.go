@@ -0,0 +1,147 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbupgrade
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+func TestExecutor(t *testing.T) {
+	t.Parallel()
+
+	const ns = "test-ns"
+	newDB := func(name string, state everestv1alpha1.AppState, recCRVersion *string) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+			},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{
+					Type:      everestv1alpha1.DatabaseEnginePXC,
+					CRVersion: pointer.ToString("1.0.0"),
+				},
+			},
+			Status: everestv1alpha1.DatabaseClusterStatus{
+				Status:               state,
+				RecommendedCRVersion: recCRVersion,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		objs          []ctrlclient.Object
+		req           *api.PostUpgradeTasksExecution
+		wantResults   map[string]api.PostUpgradeTaskResultState
+		wantCRVersion map[string]string
+	}{
+		{
+			name: "all pending tasks",
+			objs: []ctrlclient.Object{
+				newDB("db-1", everestv1alpha1.AppStateReady, pointer.ToString("1.1.0")),
+				newDB("db-2", everestv1alpha1.AppStateRestoring, pointer.ToString("1.1.0")),
+				newDB("db-3", everestv1alpha1.AppStateReady, nil),
+			},
+			req: &api.PostUpgradeTasksExecution{
+				WaitForReady: pointer.ToBool(false),
+			},
+			wantResults: map[string]api.PostUpgradeTaskResultState{
+				"db-1": api.PostUpgradeTaskResultStateSucceeded,
+				"db-2": api.PostUpgradeTaskResultStateSkipped,
+			},
+			wantCRVersion: map[string]string{
+				"db-1": "1.1.0",
+				"db-2": "1.0.0",
+				"db-3": "1.0.0",
+			},
+		},
+		{
+			name: "selected databases in batches",
+			objs: []ctrlclient.Object{
+				newDB("db-1", everestv1alpha1.AppStateReady, pointer.ToString("1.1.0")),
+				newDB("db-2", everestv1alpha1.AppStateReady, pointer.ToString("1.1.0")),
+				newDB("db-3", everestv1alpha1.AppStateReady, nil),
+			},
+			req: &api.PostUpgradeTasksExecution{
+				Databases:    &[]string{"db-2", "db-3"},
+				BatchSize:    pointer.ToInt(2),
+				WaitForReady: pointer.ToBool(false),
+			},
+			wantResults: map[string]api.PostUpgradeTaskResultState{
+				"db-2": api.PostUpgradeTaskResultStateSucceeded,
+				"db-3": api.PostUpgradeTaskResultStateSkipped,
+			},
+			wantCRVersion: map[string]string{
+				"db-1": "1.0.0",
+				"db-2": "1.1.0",
+				"db-3": "1.0.0",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mockClient := fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.CreateScheme()).
+				WithObjects(tc.objs...).
+				Build()
+
+			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+			tasks, err := PendingTasks(context.Background(), k, versionservice.New(""), ns)
+			require.NoError(t, err)
+			selected, skipped := SelectTasks(tasks, tc.req.Databases)
+
+			got := make(map[string]api.PostUpgradeTaskResultState, len(tasks))
+			for _, r := range skipped {
+				got[pointer.Get(r.Task.Name)] = r.State
+			}
+			var mu sync.Mutex
+			NewExecutor(k, k).Execute(context.Background(), ns, selected,
+				pointer.Get(tc.req.BatchSize), pointer.Get(tc.req.WaitForReady),
+				func(r api.PostUpgradeTaskResult) {
+					mu.Lock()
+					defer mu.Unlock()
+					got[pointer.Get(r.Task.Name)] = r.State
+				},
+			)
+			assert.Equal(t, tc.wantResults, got)
+
+			for name, crVersion := range tc.wantCRVersion {
+				db, err := k.GetDatabaseCluster(context.Background(), types.NamespacedName{Namespace: ns, Name: name})
+				require.NoError(t, err)
+				assert.Equal(t, crVersion, pointer.Get(db.Spec.Engine.CRVersion))
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbupgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	goversion "github.com/hashicorp/go-version"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+// CheckDBEngineVersion checks that the current engine version of the database cluster is
+// greater than or equal to the minimum supported version for the target operator version.
+// Returns the minimum supported version as well.
+func CheckDBEngineVersion(
+	ctx context.Context,
+	vs versionservice.Interface,
+	engine *everestv1alpha1.DatabaseEngine,
+	targetVersion string,
+	database everestv1alpha1.DatabaseCluster,
+) (bool, string, error) {
+	engineType := engine.Spec.Type
+	operator, found := versionservice.EngineTypeToOperatorName[engineType]
+	if !found {
+		return false, "", fmt.Errorf("unsupported engine type %s", engineType)
+	}
+
+	allSupportedVersions, err := vs.GetSupportedEngineVersions(ctx, operator, targetVersion)
+	if err != nil {
+		return false, "", errors.Join(err, errors.New("failed to get supported engine versions"))
+	}
+
+	currentVersion, err := goversion.NewVersion(database.Spec.Engine.Version)
+	if err != nil {
+		return false, "", err
+	}
+
+	// We search for the smallest available version greater than or equal to the current major version.
+	var minSupportedMajVersion *goversion.Version
+	for _, supportedVersion := range allSupportedVersions {
+		ver, err := goversion.NewVersion(supportedVersion)
+		if err != nil {
+			return false, "", err
+		}
+		if currentVersion.Segments()[0] > ver.Segments()[0] {
+			continue // ignore if major version is less than the current major version.
+		}
+		if minSupportedMajVersion == nil || ver.LessThan(minSupportedMajVersion) {
+			minSupportedMajVersion = ver
+		}
+	}
+
+	if minSupportedMajVersion == nil {
+		return false, "", fmt.Errorf("no minimum supported versions found for %s", operator)
+	}
+
+	return currentVersion.GreaterThanOrEqual(minSupportedMajVersion), minSupportedMajVersion.Original(), nil
+}
@@ -0,0 +1,274 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engineconfig provides helpers to work with the engine configs of database clusters.
+package engineconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+const yamlIndent = 2
+
+var (
+	errUnsupportedEngineType = errors.New("unsupported database engine type")
+	errInvalidSection        = errors.New("invalid section header")
+	errNotAMapping           = errors.New("config should be a YAML mapping")
+)
+
+// Validate checks that the config can be parsed for the given engine type.
+func Validate(engineType everestv1alpha1.EngineType, config string) error {
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		_, err := parseYAML(config)
+		return err
+	case everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.DatabaseEnginePostgresql:
+		_, err := parseINI(config)
+		return err
+	}
+	return fmt.Errorf("%w: %s", errUnsupportedEngineType, engineType)
+}
+
+// Merge merges the override config into the base config of the given engine type.
+// The options set in override take precedence over the options set in base.
+// The base config is returned as is if the merge does not change any option.
+func Merge(engineType everestv1alpha1.EngineType, base, override string) (string, error) {
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		return mergeYAML(base, override)
+	case everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.DatabaseEnginePostgresql:
+		return mergeINI(base, override)
+	}
+	return "", fmt.Errorf("%w: %s", errUnsupportedEngineType, engineType)
+}
+
+// parseYAML parses a YAML config and returns its top-level mapping node.
+// Returns nil for an empty config.
+func parseYAML(config string) (*yaml.Node, error) {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(config), doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errNotAMapping
+	}
+	return doc, nil
+}
+
+func mergeYAML(base, override string) (string, error) {
+	baseDoc, err := parseYAML(base)
+	if err != nil {
+		return "", err
+	}
+	overrideDoc, err := parseYAML(override)
+	if err != nil {
+		return "", err
+	}
+	if overrideDoc == nil {
+		return base, nil
+	}
+	if baseDoc == nil {
+		return override, nil
+	}
+	if !mergeMappingNodes(baseDoc.Content[0], overrideDoc.Content[0]) {
+		return base, nil
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(yamlIndent)
+	if err := enc.Encode(baseDoc); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// mergeMappingNodes merges the override mapping into the base mapping recursively.
+// Returns true if base has been changed.
+func mergeMappingNodes(base, override *yaml.Node) bool {
+	changed := false
+	for i := 0; i+1 < len(override.Content); i += 2 {
+		key, value := override.Content[i], override.Content[i+1]
+		idx := -1
+		for j := 0; j+1 < len(base.Content); j += 2 {
+			if base.Content[j].Value == key.Value {
+				idx = j
+				break
+			}
+		}
+		switch {
+		case idx < 0:
+			base.Content = append(base.Content, key, value)
+			changed = true
+		case base.Content[idx+1].Kind == yaml.MappingNode && value.Kind == yaml.MappingNode:
+			if mergeMappingNodes(base.Content[idx+1], value) {
+				changed = true
+			}
+		case !nodesEqual(base.Content[idx+1], value):
+			base.Content[idx+1] = value
+			changed = true
+		}
+	}
+	return changed
+}
+
+func nodesEqual(a, b *yaml.Node) bool {
+	if a.Kind != b.Kind || a.Value != b.Value || len(a.Content) != len(b.Content) {
+		return false
+	}
+	for i := range a.Content {
+		if !nodesEqual(a.Content[i], b.Content[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// iniOption is an option of an INI-like config (my.cnf, postgresql.conf).
+type iniOption struct {
+	section string
+	key     string
+	line    string
+}
+
+// parseINI returns the options of an INI-like config in the order of appearance.
+// Options that appear before the first section header belong to the "" section.
+func parseINI(config string) ([]iniOption, error) {
+	options := []iniOption{}
+	section := ""
+	for _, line := range strings.Split(config, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case isINIComment(line):
+			continue
+		case strings.HasPrefix(line, "["):
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("%w: %s", errInvalidSection, line)
+			}
+			section = iniSectionName(line)
+		default:
+			options = append(options, iniOption{section: section, key: iniKey(line), line: line})
+		}
+	}
+	return options, nil
+}
+
+func isINIComment(line string) bool {
+	return line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";")
+}
+
+func iniSectionName(line string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(line, "["), "]")))
+}
+
+// iniKey returns the normalized name of the option defined in the given line.
+// MySQL treats dashes and underscores in option names equally and PostgreSQL
+// parameter names are case-insensitive.
+func iniKey(line string) string {
+	key, _, _ := strings.Cut(line, "=")
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), "-", "_")
+}
+
+//nolint:cyclop
+func mergeINI(base, override string) (string, error) {
+	if _, err := parseINI(base); err != nil {
+		return "", err
+	}
+	overrides, err := parseINI(override)
+	if err != nil {
+		return "", err
+	}
+	if len(overrides) == 0 {
+		return base, nil
+	}
+
+	// The last occurrence of an option wins.
+	values := make(map[iniOption]string, len(overrides))
+	sections := []string{}
+	for _, o := range overrides {
+		values[iniOption{section: o.section, key: o.key}] = o.line
+		if !slices.Contains(sections, o.section) {
+			sections = append(sections, o.section)
+		}
+	}
+
+	result := []string{}
+	used := make(map[iniOption]bool, len(values))
+	// Options before the first section header belong to the "" section.
+	seenSections := map[string]bool{"": true}
+	changed := false
+	// flush appends the options of the section that are not defined in base.
+	flush := func(section string) {
+		for _, o := range overrides {
+			k := iniOption{section: o.section, key: o.key}
+			if o.section != section || used[k] {
+				continue
+			}
+			result = append(result, values[k])
+			used[k] = true
+			changed = true
+		}
+	}
+
+	section := ""
+	for _, line := range strings.Split(strings.TrimRight(base, "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case isINIComment(trimmed):
+		case strings.HasPrefix(trimmed, "["):
+			flush(section)
+			section = iniSectionName(trimmed)
+			seenSections[section] = true
+		default:
+			k := iniOption{section: section, key: iniKey(trimmed)}
+			if v, ok := values[k]; ok {
+				used[k] = true
+				if trimmed != v {
+					line = v
+					changed = true
+				}
+			}
+		}
+		result = append(result, line)
+	}
+	flush(section)
+
+	for _, s := range sections {
+		if seenSections[s] {
+			continue
+		}
+		result = append(result, "["+s+"]")
+		flush(s)
+	}
+
+	if !changed {
+		return base, nil
+	}
+	return strings.TrimLeft(strings.Join(result, "\n"), "\n") + "\n", nil
+}
@@ -0,0 +1,92 @@
+package engineconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		name       string
+		engineType everestv1alpha1.EngineType
+		base       string
+		override   string
+		expected   string
+	}
+
+	testCases := []testCase{
+		{
+			name:       "pxc empty base",
+			engineType: everestv1alpha1.DatabaseEnginePXC,
+			base:       "",
+			override:   "[mysqld]\nmax_connections = 250\n",
+			expected:   "[mysqld]\nmax_connections = 250\n",
+		},
+		{
+			name:       "pxc override and add options",
+			engineType: everestv1alpha1.DatabaseEnginePXC,
+			base:       "[mysqld]\n# tuning\nmax_connections = 100\nwsrep_debug = 1\n",
+			override:   "[mysqld]\nmax-connections = 250\ninnodb_buffer_pool_size = 1G\n",
+			expected:   "[mysqld]\n# tuning\nmax-connections = 250\nwsrep_debug = 1\ninnodb_buffer_pool_size = 1G\n",
+		},
+		{
+			name:       "pxc add section",
+			engineType: everestv1alpha1.DatabaseEnginePXC,
+			base:       "[mysqld]\nmax_connections = 100\n",
+			override:   "[client]\nport = 3306\n",
+			expected:   "[mysqld]\nmax_connections = 100\n[client]\nport = 3306\n",
+		},
+		{
+			name:       "pxc unchanged",
+			engineType: everestv1alpha1.DatabaseEnginePXC,
+			base:       "[mysqld]\nmax_connections = 100",
+			override:   "[mysqld]\nmax_connections = 100\n",
+			expected:   "[mysqld]\nmax_connections = 100",
+		},
+		{
+			name:       "postgresql",
+			engineType: everestv1alpha1.DatabaseEnginePostgresql,
+			base:       "shared_buffers = 128MB\nmax_connections = 100\n",
+			override:   "Max_Connections = 300\nwork_mem = 8MB\n",
+			expected:   "shared_buffers = 128MB\nMax_Connections = 300\nwork_mem = 8MB\n",
+		},
+		{
+			name:       "psmdb",
+			engineType: everestv1alpha1.DatabaseEnginePSMDB,
+			base:       "operationProfiling:\n  mode: slowOp\n  slowOpThresholdMs: 200\n",
+			override:   "operationProfiling:\n  slowOpThresholdMs: 100\nsetParameter:\n  ttlMonitorSleepSecs: 60\n",
+			expected:   "operationProfiling:\n  mode: slowOp\n  slowOpThresholdMs: 100\nsetParameter:\n  ttlMonitorSleepSecs: 60\n",
+		},
+		{
+			name:       "psmdb unchanged",
+			engineType: everestv1alpha1.DatabaseEnginePSMDB,
+			base:       "operationProfiling:\n    mode: slowOp\n",
+			override:   "operationProfiling:\n  mode: slowOp\n",
+			expected:   "operationProfiling:\n    mode: slowOp\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := Merge(tc.engineType, tc.base, tc.override)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, Validate(everestv1alpha1.DatabaseEnginePXC, "[mysqld]\nmax_connections = 100\n"))
+	require.NoError(t, Validate(everestv1alpha1.DatabaseEnginePSMDB, ""))
+	require.ErrorIs(t, Validate(everestv1alpha1.DatabaseEnginePXC, "[mysqld\nmax_connections = 100\n"), errInvalidSection)
+	require.ErrorIs(t, Validate(everestv1alpha1.DatabaseEnginePSMDB, "- a\n- b\n"), errNotAMapping)
+	require.Error(t, Validate(everestv1alpha1.DatabaseEnginePSMDB, "a: [b\n"))
+	require.ErrorIs(t, Validate("mysql", ""), errUnsupportedEngineType)
+}
@@ -31,3 +31,17 @@ func (k *Kubernetes) UpdateConfigMap(ctx context.Context, config *corev1.ConfigM
 	}
 	return config, nil
 }
+
+// ListConfigMaps returns list of k8s configmaps that match the criteria.
+func (k *Kubernetes) ListConfigMaps(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.ConfigMapList, error) {
+	result := &corev1.ConfigMapList{}
+	if err := k.k8sClient.List(ctx, result, opts...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteConfigMap deletes k8s configmap.
+func (k *Kubernetes) DeleteConfigMap(ctx context.Context, config *corev1.ConfigMap) error {
+	return k.k8sClient.Delete(ctx, config)
+}
@@ -35,3 +35,20 @@ func (k *Kubernetes) ListCRDs(ctx context.Context, opts ...ctrlclient.ListOption
 func (k *Kubernetes) DeleteCRD(ctx context.Context, obj *apiextv1.CustomResourceDefinition) error {
 	return k.k8sClient.Delete(ctx, obj)
 }
+
+// GetCRD returns a CRD that matches the criteria.
+func (k *Kubernetes) GetCRD(ctx context.Context, key ctrlclient.ObjectKey) (*apiextv1.CustomResourceDefinition, error) {
+	result := &apiextv1.CustomResourceDefinition{}
+	if err := k.k8sClient.Get(ctx, key, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateCRD updates a CRD.
+func (k *Kubernetes) UpdateCRD(ctx context.Context, obj *apiextv1.CustomResourceDefinition) (*apiextv1.CustomResourceDefinition, error) {
+	if err := k.k8sClient.Update(ctx, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
@@ -141,3 +141,25 @@ func (k *Kubernetes) DatabasesExist(ctx context.Context, opts ...ctrlclient.List
 	}
 	return false, nil
 }
+
+// IsDatabaseClusterUpdateAllowed checks if the database cluster can be updated in its current state.
+// It returns false in case DB cluster is in one of the following states:
+// - restoring
+// - deleting
+// - upgrading
+// - resizingVolumes
+func IsDatabaseClusterUpdateAllowed(db *everestv1alpha1.DatabaseCluster) bool {
+	if db == nil {
+		return false
+	}
+
+	switch db.Status.Status {
+	case everestv1alpha1.AppStateRestoring,
+		everestv1alpha1.AppStateDeleting,
+		everestv1alpha1.AppStateUpgrading,
+		everestv1alpha1.AppStateResizingVolumes:
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,143 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+//nolint:gochecknoglobals
+var engineConfigTemplateResource = schema.GroupResource{Resource: "engineconfigtemplates"}
+
+// ListEngineConfigTemplates returns the engine config templates of the namespace sorted by name.
+func (k *Kubernetes) ListEngineConfigTemplates(ctx context.Context, namespace string) (*common.EngineConfigTemplateList, error) {
+	_, templates, err := k.getEngineConfigTemplates(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &common.EngineConfigTemplateList{Items: templates}, nil
+}
+
+// GetEngineConfigTemplate returns the engine config template of the namespace by name.
+func (k *Kubernetes) GetEngineConfigTemplate(ctx context.Context, namespace, name string) (*common.EngineConfigTemplate, error) {
+	_, templates, err := k.getEngineConfigTemplates(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(templates, func(t common.EngineConfigTemplate) bool {
+		return t.Name == name
+	})
+	if idx < 0 {
+		return nil, k8serrors.NewNotFound(engineConfigTemplateResource, name)
+	}
+	return &templates[idx], nil
+}
+
+// CreateEngineConfigTemplate creates an engine config template in the namespace.
+func (k *Kubernetes) CreateEngineConfigTemplate(ctx context.Context, namespace string, template *common.EngineConfigTemplate) (*common.EngineConfigTemplate, error) {
+	cm, templates, err := k.getEngineConfigTemplates(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if slices.ContainsFunc(templates, func(t common.EngineConfigTemplate) bool {
+		return t.Name == template.Name
+	}) {
+		return nil, k8serrors.NewAlreadyExists(engineConfigTemplateResource, template.Name)
+	}
+	if err := k.saveEngineConfigTemplates(ctx, cm, append(templates, *template)); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// UpdateEngineConfigTemplate updates an existing engine config template in the namespace.
+func (k *Kubernetes) UpdateEngineConfigTemplate(ctx context.Context, namespace string, template *common.EngineConfigTemplate) (*common.EngineConfigTemplate, error) {
+	cm, templates, err := k.getEngineConfigTemplates(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(templates, func(t common.EngineConfigTemplate) bool {
+		return t.Name == template.Name
+	})
+	if idx < 0 {
+		return nil, k8serrors.NewNotFound(engineConfigTemplateResource, template.Name)
+	}
+	templates[idx] = *template
+	if err := k.saveEngineConfigTemplates(ctx, cm, templates); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// DeleteEngineConfigTemplate deletes an engine config template from the namespace.
+func (k *Kubernetes) DeleteEngineConfigTemplate(ctx context.Context, namespace, name string) error {
+	cm, templates, err := k.getEngineConfigTemplates(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(templates, func(t common.EngineConfigTemplate) bool {
+		return t.Name == name
+	})
+	if idx < 0 {
+		return k8serrors.NewNotFound(engineConfigTemplateResource, name)
+	}
+	return k.saveEngineConfigTemplates(ctx, cm, slices.Delete(templates, idx, idx+1))
+}
+
+// getEngineConfigTemplates returns the ConfigMap that holds the engine config templates of the namespace
+// and the templates. A new ConfigMap object is returned if it does not exist yet.
+func (k *Kubernetes) getEngineConfigTemplates(ctx context.Context, namespace string) (*corev1.ConfigMap, []common.EngineConfigTemplate, error) {
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: common.EverestEngineConfigTemplatesConfigMapName})
+	if k8serrors.IsNotFound(err) {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.EverestEngineConfigTemplatesConfigMapName,
+				Namespace: namespace,
+			},
+		}, []common.EngineConfigTemplate{}, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	templates, err := common.EngineConfigTemplatesFromMap(cm.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cm, templates, nil
+}
+
+func (k *Kubernetes) saveEngineConfigTemplates(ctx context.Context, cm *corev1.ConfigMap, templates []common.EngineConfigTemplate) error {
+	data, err := common.EngineConfigTemplatesToMap(templates)
+	if err != nil {
+		return err
+	}
+	cm.Data = data
+	// The ConfigMap has not been created yet.
+	if cm.GetResourceVersion() == "" {
+		_, err = k.CreateConfigMap(ctx, cm)
+		return err
+	}
+	_, err = k.UpdateConfigMap(ctx, cm)
+	return err
+}
@@ -0,0 +1,76 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+// GetEngineVersionPolicy returns the database engine version policy of the namespace.
+// An empty policy is returned if the namespace has no policy.
+func (k *Kubernetes) GetEngineVersionPolicy(ctx context.Context, namespace string) (*common.EngineVersionPolicy, error) {
+	policy := &common.EngineVersionPolicy{}
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: common.EverestEngineVersionPolicyConfigMapName})
+	if k8serrors.IsNotFound(err) {
+		return policy, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := policy.FromMap(cm.Data); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// UpdateEngineVersionPolicy replaces the database engine version policy of the namespace.
+func (k *Kubernetes) UpdateEngineVersionPolicy(ctx context.Context, namespace string, policy *common.EngineVersionPolicy) (*common.EngineVersionPolicy, error) {
+	data, err := policy.ToMap()
+	if err != nil {
+		return nil, err
+	}
+
+	cm, getErr := k.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: common.EverestEngineVersionPolicyConfigMapName})
+	if getErr != nil && !k8serrors.IsNotFound(getErr) {
+		return nil, getErr
+	}
+
+	if k8serrors.IsNotFound(getErr) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.EverestEngineVersionPolicyConfigMapName,
+				Namespace: namespace,
+			},
+			Data: data,
+		}
+		if _, err := k.CreateConfigMap(ctx, cm); err != nil {
+			return nil, err
+		}
+		return policy, nil
+	}
+
+	cm.Data = data
+	if _, err := k.UpdateConfigMap(ctx, cm); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
@@ -0,0 +1,94 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+//nolint:gochecknoglobals
+var (
+	// everestNamespacedKinds are the kinds of the namespaced Everest resources.
+	// The owners come before the resources they own.
+	everestNamespacedKinds = []string{
+		"DatabaseCluster",
+		"DatabaseClusterBackup",
+		"DatabaseClusterRestore",
+		"DatabaseEngine",
+		"BackupStorage",
+		"MonitoringConfig",
+	}
+	// everestClusterKinds are the kinds of the cluster-scoped Everest resources.
+	everestClusterKinds = []string{
+		"PodSchedulingPolicy",
+	}
+)
+
+// ListEverestResources returns the Everest resources in the namespace.
+// If the namespace is empty, the cluster-scoped Everest resources are returned.
+func (k *Kubernetes) ListEverestResources(ctx context.Context, namespace string) ([]unstructured.Unstructured, error) {
+	kinds := everestNamespacedKinds
+	if namespace == "" {
+		kinds = everestClusterKinds
+	}
+	var result []unstructured.Unstructured
+	for _, kind := range kinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(everestv1alpha1.GroupVersion.WithKind(kind + "List"))
+		if err := k.k8sClient.List(ctx, list, ctrlclient.InNamespace(namespace)); err != nil {
+			// The kinds added by newer versions of the Everest operator may not be installed.
+			if meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) {
+				continue
+			}
+			return nil, err
+		}
+		result = append(result, list.Items...)
+	}
+	return result, nil
+}
+
+// OrphanEverestResources deletes the Everest resources in the namespace without deleting the resources they own,
+// such as the clusters and the backups of the database operators. The finalizers of the Everest resources are
+// removed, so it must only be called once the Everest operator is not running anymore.
+func (k *Kubernetes) OrphanEverestResources(ctx context.Context, namespace string) error {
+	objs, err := k.ListEverestResources(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		if len(obj.GetFinalizers()) > 0 {
+			patch := ctrlclient.MergeFrom(obj.DeepCopy())
+			obj.SetFinalizers(nil)
+			if err := k.k8sClient.Patch(ctx, &obj, patch); ctrlclient.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+		if err := k.k8sClient.Delete(ctx, &obj,
+			ctrlclient.PropagationPolicy(metav1.DeletePropagationOrphan),
+		); ctrlclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+func TestOrphanEverestResources(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := fakeclient.NewClientBuilder().WithScheme(CreateScheme()).WithObjects(
+		&everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "ns", Finalizers: []string{"everest.percona.com/upstream-cluster-cleanup"}},
+		},
+		&everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "ns"},
+		},
+		&everestv1alpha1.BackupStorage{
+			ObjectMeta: metav1.ObjectMeta{Name: "storage", Namespace: "other"},
+		},
+		&everestv1alpha1.PodSchedulingPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+		},
+	).Build()
+	k := NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(c)
+
+	objs, err := k.ListEverestResources(ctx, "ns")
+	require.NoError(t, err)
+	require.Len(t, objs, 2)
+	assert.Equal(t, "DatabaseCluster", objs[0].GetKind())
+	assert.Equal(t, "DatabaseClusterBackup", objs[1].GetKind())
+
+	objs, err = k.ListEverestResources(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	assert.Equal(t, "policy", objs[0].GetName())
+
+	require.NoError(t, k.OrphanEverestResources(ctx, "ns"))
+	objs, err = k.ListEverestResources(ctx, "ns")
+	require.NoError(t, err)
+	assert.Empty(t, objs)
+
+	storages := &everestv1alpha1.BackupStorageList{}
+	require.NoError(t, c.List(ctx, storages, ctrlclient.InNamespace("other")))
+	assert.Len(t, storages.Items, 1)
+}
@@ -4,6 +4,7 @@ package kubernetes
 
 import (
 	"context"
+	"time"
 
 	goversion "github.com/hashicorp/go-version"
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -12,7 +13,9 @@ import (
 	storagev1 "k8s.io/api/storage/v1"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -23,6 +26,8 @@ import (
 
 // KubernetesConnector ...
 type KubernetesConnector interface {
+	// IsAllowed returns true if the client is allowed to perform the verb on the resource in all namespaces.
+	IsAllowed(ctx context.Context, verb, group, resource string) (bool, error)
 	// Accounts returns an implementation of the accounts interface that
 	// manages everest accounts directly via ConfigMaps.
 	Accounts() accounts.Interface
@@ -52,8 +57,14 @@ type KubernetesConnector interface {
 	CreateConfigMap(ctx context.Context, config *corev1.ConfigMap) (*corev1.ConfigMap, error)
 	// UpdateConfigMap updates k8s configmap.
 	UpdateConfigMap(ctx context.Context, config *corev1.ConfigMap) (*corev1.ConfigMap, error)
+	// ListConfigMaps returns list of k8s configmaps that match the criteria.
+	ListConfigMaps(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.ConfigMapList, error)
+	// DeleteConfigMap deletes k8s configmap.
+	DeleteConfigMap(ctx context.Context, config *corev1.ConfigMap) error
 	// GetClusterServiceVersion retrieves a ClusterServiceVersion that matches the criteria.
 	GetClusterServiceVersion(ctx context.Context, key ctrlclient.ObjectKey) (*olmv1alpha1.ClusterServiceVersion, error)
+	// UpdateClusterServiceVersion updates a ClusterServiceVersion and returns the updated object.
+	UpdateClusterServiceVersion(ctx context.Context, csv *olmv1alpha1.ClusterServiceVersion) (*olmv1alpha1.ClusterServiceVersion, error)
 	// ListClusterServiceVersion list all CSVs that match the criteria.
 	// This method returns a list of full objects (meta and spec).
 	ListClusterServiceVersion(ctx context.Context, opts ...ctrlclient.ListOption) (*olmv1alpha1.ClusterServiceVersionList, error)
@@ -66,6 +77,10 @@ type KubernetesConnector interface {
 	ListCRDs(ctx context.Context, opts ...ctrlclient.ListOption) (*apiextv1.CustomResourceDefinitionList, error)
 	// DeleteCRD deletes a CRD that matches the criteria.
 	DeleteCRD(ctx context.Context, obj *apiextv1.CustomResourceDefinition) error
+	// GetCRD returns a CRD that matches the criteria.
+	GetCRD(ctx context.Context, key ctrlclient.ObjectKey) (*apiextv1.CustomResourceDefinition, error)
+	// UpdateCRD updates a CRD.
+	UpdateCRD(ctx context.Context, obj *apiextv1.CustomResourceDefinition) (*apiextv1.CustomResourceDefinition, error)
 	// ListDatabaseClusters returns list of managed database clusters that match the criteria.
 	// This method returns a list of full objects (meta and spec).
 	ListDatabaseClusters(ctx context.Context, opts ...ctrlclient.ListOption) (*everestv1alpha1.DatabaseClusterList, error)
@@ -128,6 +143,21 @@ type KubernetesConnector interface {
 	RestartDeployment(ctx context.Context, key ctrlclient.ObjectKey) error
 	// WaitForRollout waits for rollout of deployment that matches the criteria.
 	WaitForRollout(ctx context.Context, key ctrlclient.ObjectKey) error
+	// ListEngineConfigTemplates returns the engine config templates of the namespace sorted by name.
+	ListEngineConfigTemplates(ctx context.Context, namespace string) (*common.EngineConfigTemplateList, error)
+	// GetEngineConfigTemplate returns the engine config template of the namespace by name.
+	GetEngineConfigTemplate(ctx context.Context, namespace, name string) (*common.EngineConfigTemplate, error)
+	// CreateEngineConfigTemplate creates an engine config template in the namespace.
+	CreateEngineConfigTemplate(ctx context.Context, namespace string, template *common.EngineConfigTemplate) (*common.EngineConfigTemplate, error)
+	// UpdateEngineConfigTemplate updates an existing engine config template in the namespace.
+	UpdateEngineConfigTemplate(ctx context.Context, namespace string, template *common.EngineConfigTemplate) (*common.EngineConfigTemplate, error)
+	// DeleteEngineConfigTemplate deletes an engine config template from the namespace.
+	DeleteEngineConfigTemplate(ctx context.Context, namespace, name string) error
+	// GetEngineVersionPolicy returns the database engine version policy of the namespace.
+	// An empty policy is returned if the namespace has no policy.
+	GetEngineVersionPolicy(ctx context.Context, namespace string) (*common.EngineVersionPolicy, error)
+	// UpdateEngineVersionPolicy replaces the database engine version policy of the namespace.
+	UpdateEngineVersionPolicy(ctx context.Context, namespace string, policy *common.EngineVersionPolicy) (*common.EngineVersionPolicy, error)
 	// GetInstallPlan retrieves an OLM install plan that matches the criteria.
 	GetInstallPlan(ctx context.Context, key ctrlclient.ObjectKey) (*olmv1alpha1.InstallPlan, error)
 	// UpdateInstallPlan updates OLM install plan.
@@ -176,11 +206,38 @@ type KubernetesConnector interface {
 	ListNamespaces(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.NamespaceList, error)
 	// UpdateNamespace updates the given namespace.
 	UpdateNamespace(ctx context.Context, namespace *corev1.Namespace) (*corev1.Namespace, error)
+	// GetNamespaceQuota returns the quota of the namespace.
+	// An empty quota is returned if the namespace has no quota.
+	GetNamespaceQuota(ctx context.Context, namespace string) (*common.NamespaceQuota, error)
+	// UpdateNamespaceQuota replaces the quota of the namespace.
+	UpdateNamespaceQuota(ctx context.Context, namespace string, quota *common.NamespaceQuota) error
+	// GetNamespaceResourceUsage returns the resources used in the namespace.
+	// The CPU, memory and disk usage is the sum of the resources requested in the specs of the database clusters,
+	// so that the database clusters which have not started yet are taken into account.
+	GetNamespaceResourceUsage(ctx context.Context, namespace string) (*common.NamespaceResourceUsage, error)
+	// ListWorkerNodes returns list of cluster workers nodes.
+	// This method returns a list of full objects (meta and spec).
+	// It filters out nodes with the following taints:
+	// - node.cloudprovider.kubernetes.io/uninitialized=NoSchedule
+	// - node.kubernetes.io/unschedulable=NoSchedule
+	// - node-role.kubernetes.io/master=NoSchedule
+	ListWorkerNodes(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.NodeList, error)
 	// ApplyManifestFile accepts manifest file contents, parses into []runtime.Object
 	// and applies them against the cluster.
 	ApplyManifestFile(ctx context.Context, fileBytes []byte, namespace string, ignoreObjects ...ctrlclient.Object) error
+	// DeleteManifestFile accepts manifest file contents, parses into []runtime.Object
+	// and deletes them from the cluster. Objects that do not exist are skipped.
+	DeleteManifestFile(fileBytes []byte, namespace string) error
 	// ApplyObject applies object.
 	ApplyObject(obj runtime.Object) error
+	// DeleteObject deletes object.
+	DeleteObject(obj runtime.Object) error
+	// GetUnstructuredObject returns the object of the kind that matches the criteria.
+	GetUnstructuredObject(ctx context.Context, gvk schema.GroupVersionKind, key ctrlclient.ObjectKey) (*unstructured.Unstructured, error)
+	// CreateUnstructuredObject creates the object.
+	CreateUnstructuredObject(ctx context.Context, obj *unstructured.Unstructured) error
+	// UpdateUnstructuredObject updates the object.
+	UpdateUnstructuredObject(ctx context.Context, obj *unstructured.Unstructured) error
 	// GetInstalledOperatorVersion returns the version of installed operator that matches the criteria.
 	GetInstalledOperatorVersion(ctx context.Context, key ctrlclient.ObjectKey) (*goversion.Version, error)
 	// ListInstalledOperators returns the list of installed operators that match the criteria.
@@ -230,6 +287,10 @@ type KubernetesConnector interface {
 	// ListStorageClasses returns list of storage classes that match the criteria.
 	// This method returns a list of full objects (meta and spec).
 	ListStorageClasses(ctx context.Context, opts ...ctrlclient.ListOption) (*storagev1.StorageClassList, error)
+	// ListCSIStorageCapacities returns list of the capacities reported by the CSI drivers that match the criteria.
+	ListCSIStorageCapacities(ctx context.Context, opts ...ctrlclient.ListOption) (*storagev1.CSIStorageCapacityList, error)
+	// ListPersistentVolumeClaims returns list of persistent volume claims that match the criteria.
+	ListPersistentVolumeClaims(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.PersistentVolumeClaimList, error)
 	// GetSubscription returns OLM subscription that matches the criteria.
 	GetSubscription(ctx context.Context, key ctrlclient.ObjectKey) (*olmv1alpha1.Subscription, error)
 	// ListSubscriptions lists OLM subscriptions that match the criteria.
@@ -237,7 +298,43 @@ type KubernetesConnector interface {
 	ListSubscriptions(ctx context.Context, opts ...ctrlclient.ListOption) (*olmv1alpha1.SubscriptionList, error)
 	// DeleteSubscription deletes OLM subscription that matches the criteria.
 	DeleteSubscription(ctx context.Context, obj *olmv1alpha1.Subscription) error
+	// GetPinnedOperatorVersions returns the versions the operators in the given namespace are pinned to,
+	// keyed by the package names of their OLM subscriptions. Operators that are not pinned are omitted.
+	GetPinnedOperatorVersions(ctx context.Context, namespace string) (map[string]string, error)
 	// ListPods returns list of pods that match the criteria.
 	// This method returns a list of full objects (meta and spec).
 	ListPods(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.PodList, error)
+	// GetPodLogs returns the last lines of the logs of the container of the pod that matches the criteria.
+	GetPodLogs(ctx context.Context, key ctrlclient.ObjectKey, container string, tailLines int64) ([]byte, error)
+	// GetDatabaseClustersUsage returns the resources requested and used by the database clusters in the namespace.
+	// The CPU and memory usage is only known if the metrics API is available in the cluster.
+	// The used disk is not set, it is reported by the kubelets, see GetDatabaseClusterVolumesUsage.
+	GetDatabaseClustersUsage(ctx context.Context, namespace string) ([]common.DatabaseClusterUsage, error)
+	// SaveUsageSample stores the usage sample in a ConfigMap of its own,
+	// so the size of the ConfigMaps doesn't grow with the number of samples.
+	SaveUsageSample(ctx context.Context, sample *common.UsageSample) error
+	// ListUsageSamples returns the usage samples taken in the [from, to) time range sorted by time.
+	ListUsageSamples(ctx context.Context, from, to time.Time) ([]common.UsageSample, error)
+	// DeleteUsageSamplesBefore deletes the ConfigMaps with the usage samples taken before the given time.
+	DeleteUsageSamplesBefore(ctx context.Context, t time.Time) error
+	// GetDatabaseClusterVolumesUsage returns the usage of the persistent volumes of the database clusters
+	// in the namespace by database cluster name. The usage is read from the stats summary of the kubelets
+	// of the nodes the database pods run on, so only the volumes mounted by running pods are reported.
+	GetDatabaseClusterVolumesUsage(ctx context.Context, namespace string) (map[string][]VolumeUsage, error)
+	// CreateEvent creates an event of the type (Normal or Warning) about the object.
+	CreateEvent(ctx context.Context, obj ctrlclient.Object, eventType, reason, message string) error
+	// ListEvents returns the events that match the criteria.
+	ListEvents(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.EventList, error)
+	// ListEverestResources returns the Everest resources in the namespace.
+	// If the namespace is empty, the cluster-scoped Everest resources are returned.
+	ListEverestResources(ctx context.Context, namespace string) ([]unstructured.Unstructured, error)
+	// OrphanEverestResources deletes the Everest resources in the namespace without deleting the resources they own,
+	// such as the clusters and the backups of the database operators. The finalizers of the Everest resources are
+	// removed, so it must only be called once the Everest operator is not running anymore.
+	OrphanEverestResources(ctx context.Context, namespace string) error
+	// RunWithLeaderElection runs the function only while the current replica of the Everest server
+	// holds the lease with the given name in the Everest system namespace, so that a single replica
+	// runs it at a time. The context passed to the function is canceled when the lease is lost.
+	// Blocks until the context is canceled.
+	RunWithLeaderElection(ctx context.Context, name string, run func(ctx context.Context)) error
 }
@@ -0,0 +1,81 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgo "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	leaseDuration = 30 * time.Second
+	renewDeadline = 20 * time.Second
+	retryPeriod   = 5 * time.Second
+)
+
+// RunWithLeaderElection runs the function only while the current replica of the Everest server
+// holds the lease with the given name in the Everest system namespace, so that a single replica
+// runs it at a time. The context passed to the function is canceled when the lease is lost.
+// Blocks until the context is canceled.
+func (k *Kubernetes) RunWithLeaderElection(ctx context.Context, name string, run func(ctx context.Context)) error {
+	if k.restConfig == nil {
+		return errors.New("no REST config to run the leader election")
+	}
+	clientset, err := clientgo.NewForConfig(k.restConfig)
+	if err != nil {
+		return err
+	}
+	// The pod name is unique among the replicas.
+	identity, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	l := k.l.With("lease", name)
+	for ctx.Err() == nil {
+		leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+			Lock: &resourcelock.LeaseLock{
+				LeaseMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: k.Namespace(),
+				},
+				Client:     clientset.CoordinationV1(),
+				LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+			},
+			ReleaseOnCancel: true,
+			LeaseDuration:   leaseDuration,
+			RenewDeadline:   renewDeadline,
+			RetryPeriod:     retryPeriod,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					l.Infof("%s acquired the lease", identity)
+					run(ctx)
+				},
+				OnStoppedLeading: func() {
+					l.Infof("%s released the lease", identity)
+				},
+			},
+		})
+	}
+	return nil
+}
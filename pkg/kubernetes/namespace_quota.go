@@ -0,0 +1,145 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+)
+
+// GetNamespaceQuota returns the quota of the namespace.
+// An empty quota is returned if the namespace has no quota.
+func (k *Kubernetes) GetNamespaceQuota(ctx context.Context, namespace string) (*common.NamespaceQuota, error) {
+	quota := &common.NamespaceQuota{}
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: common.EverestQuotaConfigMapName})
+	if k8serrors.IsNotFound(err) {
+		return quota, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := quota.FromMap(cm.Data); err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+// UpdateNamespaceQuota replaces the quota of the namespace.
+func (k *Kubernetes) UpdateNamespaceQuota(ctx context.Context, namespace string, quota *common.NamespaceQuota) error {
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: common.EverestQuotaConfigMapName})
+	if k8serrors.IsNotFound(err) {
+		_, err = k.CreateConfigMap(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.EverestQuotaConfigMapName,
+				Namespace: namespace,
+			},
+			Data: quota.ToMap(),
+		})
+		return err
+	} else if err != nil {
+		return err
+	}
+	cm.Data = quota.ToMap()
+	_, err = k.UpdateConfigMap(ctx, cm)
+	return err
+}
+
+// RequestedResources holds the resources requested by the pods of a database cluster.
+type RequestedResources struct {
+	CPUMillis   int64
+	MemoryBytes int64
+	DiskBytes   int64
+}
+
+// GetRequestedResources returns the resources requested by the engine, proxy and config server pods of the
+// database cluster according to its spec. Proxies without replicas set default to the number of engine replicas,
+// the same way as the operators do it. Unsharded MongoDB clusters have no proxies.
+func GetRequestedResources(db *everestv1alpha1.DatabaseCluster) RequestedResources {
+	if db == nil {
+		return RequestedResources{}
+	}
+	engine := db.Spec.Engine
+	replicas := int64(engine.Replicas)
+	sharding := db.Spec.Sharding
+	sharded := sharding != nil && sharding.Enabled
+	if sharded {
+		replicas *= int64(sharding.Shards)
+	}
+	result := RequestedResources{
+		CPUMillis:   replicas * engine.Resources.CPU.MilliValue(),
+		MemoryBytes: replicas * engine.Resources.Memory.Value(),
+		DiskBytes:   replicas * engine.Storage.Size.Value(),
+	}
+	if engine.Type == everestv1alpha1.DatabaseEnginePSMDB && !sharded {
+		return result
+	}
+	if sharded {
+		configServer := getConfigServerRequestedResources(db)
+		result.CPUMillis += configServer.CPUMillis
+		result.MemoryBytes += configServer.MemoryBytes
+		result.DiskBytes += configServer.DiskBytes
+	}
+	proxy := db.Spec.Proxy
+	proxyReplicas := int64(engine.Replicas)
+	if proxy.Replicas != nil {
+		proxyReplicas = int64(*proxy.Replicas)
+	}
+	result.CPUMillis += proxyReplicas * proxy.Resources.CPU.MilliValue()
+	result.MemoryBytes += proxyReplicas * proxy.Resources.Memory.Value()
+	return result
+}
+
+// getConfigServerRequestedResources returns the resources requested by the config server pods of
+// the sharded MongoDB cluster according to its config server spec. The spec sets no CPU and memory
+// for the config servers, so only their volumes, sized as the engine storage, are requested.
+func getConfigServerRequestedResources(db *everestv1alpha1.DatabaseCluster) RequestedResources {
+	replicas := int64(db.Spec.Sharding.ConfigServer.Replicas)
+	return RequestedResources{
+		DiskBytes: replicas * db.Spec.Engine.Storage.Size.Value(),
+	}
+}
+
+// GetNamespaceResourceUsage returns the resources used in the namespace.
+// The CPU, memory and disk usage is the sum of the resources requested in the specs of the database clusters,
+// so that the database clusters which have not started yet are taken into account.
+func (k *Kubernetes) GetNamespaceResourceUsage(ctx context.Context, namespace string) (*common.NamespaceResourceUsage, error) {
+	usage := &common.NamespaceResourceUsage{}
+	databases, err := k.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	usage.DatabaseClusters = len(databases.Items)
+	for _, db := range databases.Items {
+		requested := GetRequestedResources(&db)
+		usage.CPUMillis += uint64(requested.CPUMillis)     //nolint:gosec
+		usage.MemoryBytes += uint64(requested.MemoryBytes) //nolint:gosec
+		usage.DiskBytes += uint64(requested.DiskBytes)     //nolint:gosec
+	}
+
+	backups, err := k.ListDatabaseClusterBackups(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	usage.DatabaseClusterBackups = len(backups.Items)
+	return usage, nil
+}
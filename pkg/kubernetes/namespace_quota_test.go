@@ -0,0 +1,141 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+)
+
+func quotaTestDB(name string, engineType everestv1alpha1.EngineType, replicas int32) *everestv1alpha1.DatabaseCluster {
+	return &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Type:     engineType,
+				Replicas: replicas,
+				Resources: everestv1alpha1.Resources{
+					CPU:    resource.MustParse("1"),
+					Memory: resource.MustParse("2G"),
+				},
+				Storage: everestv1alpha1.Storage{Size: resource.MustParse("10G")},
+			},
+			Proxy: everestv1alpha1.Proxy{
+				Resources: everestv1alpha1.Resources{
+					CPU:    resource.MustParse("500m"),
+					Memory: resource.MustParse("1G"),
+				},
+			},
+		},
+	}
+}
+
+func TestGetRequestedResources(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name     string
+		db       func() *everestv1alpha1.DatabaseCluster
+		expected RequestedResources
+	}{
+		{
+			name: "proxy replicas set",
+			db: func() *everestv1alpha1.DatabaseCluster {
+				db := quotaTestDB("db", everestv1alpha1.DatabaseEnginePXC, 3)
+				db.Spec.Proxy.Replicas = pointer.ToInt32(2)
+				return db
+			},
+			expected: RequestedResources{CPUMillis: 4000, MemoryBytes: 8_000_000_000, DiskBytes: 30_000_000_000},
+		},
+		{
+			name: "proxy replicas default to engine replicas",
+			db: func() *everestv1alpha1.DatabaseCluster {
+				return quotaTestDB("db", everestv1alpha1.DatabaseEnginePXC, 3)
+			},
+			expected: RequestedResources{CPUMillis: 4500, MemoryBytes: 9_000_000_000, DiskBytes: 30_000_000_000},
+		},
+		{
+			name: "unsharded psmdb has no proxy",
+			db: func() *everestv1alpha1.DatabaseCluster {
+				return quotaTestDB("db", everestv1alpha1.DatabaseEnginePSMDB, 3)
+			},
+			expected: RequestedResources{CPUMillis: 3000, MemoryBytes: 6_000_000_000, DiskBytes: 30_000_000_000},
+		},
+		{
+			name: "sharded psmdb",
+			db: func() *everestv1alpha1.DatabaseCluster {
+				db := quotaTestDB("db", everestv1alpha1.DatabaseEnginePSMDB, 3)
+				db.Spec.Proxy.Replicas = pointer.ToInt32(2)
+				db.Spec.Sharding = &everestv1alpha1.Sharding{
+					Enabled:      true,
+					Shards:       2,
+					ConfigServer: everestv1alpha1.ConfigServer{Replicas: 3},
+				}
+				return db
+			},
+			// 6 shard replicas and 2 routers, the 3 config servers only request their volumes.
+			expected: RequestedResources{CPUMillis: 7000, MemoryBytes: 14_000_000_000, DiskBytes: 90_000_000_000},
+		},
+		{
+			name: "nil database cluster",
+			db: func() *everestv1alpha1.DatabaseCluster {
+				return nil
+			},
+			expected: RequestedResources{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, GetRequestedResources(tc.db()))
+		})
+	}
+}
+
+func TestGetNamespaceResourceUsage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	// The database clusters count towards the usage as soon as they are created,
+	// even if none of their pods are running yet.
+	c := fakeclient.NewClientBuilder().WithScheme(CreateScheme()).WithObjects(
+		quotaTestDB("db-1", everestv1alpha1.DatabaseEnginePXC, 3),
+		quotaTestDB("db-2", everestv1alpha1.DatabaseEnginePSMDB, 1),
+		&everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "ns"},
+		},
+	).Build()
+	k := NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(c)
+
+	usage, err := k.GetNamespaceResourceUsage(ctx, "ns")
+	require.NoError(t, err)
+	assert.Equal(t, &common.NamespaceResourceUsage{
+		CPUMillis:              5500,
+		MemoryBytes:            11_000_000_000,
+		DiskBytes:              40_000_000_000,
+		DatabaseClusters:       2,
+		DatabaseClusterBackups: 1,
+	}, usage)
+}
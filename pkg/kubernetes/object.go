@@ -70,6 +70,24 @@ func (k *Kubernetes) ApplyManifestFile(ctx context.Context, fileBytes []byte, na
 	return nil
 }
 
+// DeleteManifestFile accepts manifest file contents, parses into []runtime.Object
+// and deletes them from the cluster. Objects that do not exist are skipped.
+func (k *Kubernetes) DeleteManifestFile(fileBytes []byte, namespace string) error {
+	objs, err := k.getObjects(fileBytes)
+	if err != nil {
+		return err
+	}
+	for _, o := range objs {
+		if err := unstructured.SetNestedField(o.Object, namespace, "metadata", "namespace"); err != nil {
+			return err
+		}
+		if err := k.DeleteObject(o); ctrlclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k *Kubernetes) getObjects(f []byte) ([]*unstructured.Unstructured, error) {
 	var objs []*unstructured.Unstructured
 	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(f), objectsBufferSize)
@@ -123,6 +141,32 @@ func (k *Kubernetes) ApplyObject(obj runtime.Object) error {
 	return k.applyObject(helper, namespace, name, obj)
 }
 
+// DeleteObject deletes object.
+func (k *Kubernetes) DeleteObject(obj runtime.Object) error {
+	groupResources, err := restmapper.GetAPIGroupResources(k.getDiscoveryClient())
+	if err != nil {
+		return err
+	}
+	mapper := restmapper.NewDiscoveryRESTMapper(groupResources)
+
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	gk := schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind}
+	mapping, err := mapper.RESTMapping(gk, gvk.Version)
+	if err != nil {
+		return err
+	}
+	namespace, name, err := k.retrieveMetaFromObject(obj)
+	if err != nil {
+		return err
+	}
+	cli, err := k.resourceClient(mapping.GroupVersionKind.GroupVersion())
+	if err != nil {
+		return err
+	}
+	_, err = resource.NewHelper(cli, mapping).Delete(namespace, name)
+	return err
+}
+
 func (k *Kubernetes) applyObject(helper *resource.Helper, namespace, name string, obj runtime.Object) error {
 	if _, err := helper.Get(namespace, name); err != nil {
 		_, err = helper.Create(namespace, false, obj)
@@ -148,7 +192,7 @@ func (k *Kubernetes) applyTemplateCustomization(ctx context.Context, u *unstruct
 		return err
 	}
 
-	if ok && kind == "ClusterRoleBinding" {
+	if ok && (kind == "ClusterRoleBinding" || kind == "RoleBinding") {
 		if err := k.updateClusterRoleBinding(u, namespace); err != nil {
 			return err
 		}
@@ -234,3 +278,23 @@ func (k *Kubernetes) resourceClient(gv schema.GroupVersion) (rest.Interface, err
 	}
 	return rest.RESTClientFor(cfg)
 }
+
+// GetUnstructuredObject returns the object of the kind that matches the criteria.
+func (k *Kubernetes) GetUnstructuredObject(ctx context.Context, gvk schema.GroupVersionKind, key ctrlclient.ObjectKey) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err := k.k8sClient.Get(ctx, key, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// CreateUnstructuredObject creates the object.
+func (k *Kubernetes) CreateUnstructuredObject(ctx context.Context, obj *unstructured.Unstructured) error {
+	return k.k8sClient.Create(ctx, obj)
+}
+
+// UpdateUnstructuredObject updates the object.
+func (k *Kubernetes) UpdateUnstructuredObject(ctx context.Context, obj *unstructured.Unstructured) error {
+	return k.k8sClient.Update(ctx, obj)
+}
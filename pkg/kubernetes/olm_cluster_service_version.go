@@ -33,6 +33,14 @@ func (k *Kubernetes) GetClusterServiceVersion(ctx context.Context, key ctrlclien
 	return result, nil
 }
 
+// UpdateClusterServiceVersion updates a ClusterServiceVersion and returns the updated object.
+func (k *Kubernetes) UpdateClusterServiceVersion(ctx context.Context, csv *olmv1alpha1.ClusterServiceVersion) (*olmv1alpha1.ClusterServiceVersion, error) {
+	if err := k.k8sClient.Update(ctx, csv); err != nil {
+		return nil, err
+	}
+	return csv, nil
+}
+
 // ListClusterServiceVersion list all CSVs that match the criteria.
 // This method returns a list of full objects (meta and spec).
 func (k *Kubernetes) ListClusterServiceVersion(ctx context.Context, opts ...ctrlclient.ListOption) (*olmv1alpha1.ClusterServiceVersionList, error) {
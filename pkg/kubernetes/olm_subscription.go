@@ -20,6 +20,8 @@ import (
 
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/common"
 )
 
 // GetSubscription returns OLM subscription that matches the criteria.
@@ -45,3 +47,22 @@ func (k *Kubernetes) ListSubscriptions(ctx context.Context, opts ...ctrlclient.L
 func (k *Kubernetes) DeleteSubscription(ctx context.Context, obj *olmv1alpha1.Subscription) error {
 	return k.k8sClient.Delete(ctx, obj)
 }
+
+// GetPinnedOperatorVersions returns the versions the operators in the given namespace are pinned to,
+// keyed by the package names of their OLM subscriptions. Operators that are not pinned are omitted.
+func (k *Kubernetes) GetPinnedOperatorVersions(ctx context.Context, namespace string) (map[string]string, error) {
+	subs, err := k.ListSubscriptions(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]string, len(subs.Items))
+	for _, sub := range subs.Items {
+		if sub.Spec == nil {
+			continue
+		}
+		if version := sub.GetAnnotations()[common.OperatorVersionPinnedAnnotation]; version != "" {
+			result[sub.Spec.Package] = version
+		}
+	}
+	return result, nil
+}
@@ -2,8 +2,10 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
 
 	corev1 "k8s.io/api/core/v1"
+	clientgo "k8s.io/client-go/kubernetes"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -16,3 +18,18 @@ func (k *Kubernetes) ListPods(ctx context.Context, opts ...ctrlclient.ListOption
 	}
 	return result, nil
 }
+
+// GetPodLogs returns the last lines of the logs of the container of the pod that matches the criteria.
+func (k *Kubernetes) GetPodLogs(ctx context.Context, key ctrlclient.ObjectKey, container string, tailLines int64) ([]byte, error) {
+	if k.restConfig == nil {
+		return nil, errors.New("no REST config to get the pod logs")
+	}
+	clientset, err := clientgo.NewForConfig(k.restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return clientset.CoreV1().Pods(key.Namespace).GetLogs(key.Name, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: &tailLines,
+	}).DoRaw(ctx)
+}
@@ -174,27 +174,92 @@ func (k *Kubernetes) GetConsumedCPUAndMemory(ctx context.Context, namespace stri
 		if ppod.Status.Phase != corev1.PodRunning {
 			continue
 		}
-		nonTerminatedInitContainers := make([]corev1.Container, 0, len(ppod.Spec.InitContainers))
-		for _, container := range ppod.Spec.InitContainers {
-			if !IsContainerInState(
-				ppod.Status.InitContainerStatuses, ContainerStateTerminated,
-			) {
-				nonTerminatedInitContainers = append(nonTerminatedInitContainers, container)
-			}
-		}
-		for _, container := range append(ppod.Spec.Containers, nonTerminatedInitContainers...) {
-			cpu, memory, err := getResources(container.Resources.Requests)
-			if err != nil {
-				return 0, 0, errors.Join(err, errors.New("failed to sum all consumed resources"))
-			}
-			cpuMillis += cpu
-			memoryBytes += memory
+		cpu, memory, err := getPodRequests(ppod)
+		if err != nil {
+			return 0, 0, errors.Join(err, errors.New("failed to sum all consumed resources"))
 		}
+		cpuMillis += cpu
+		memoryBytes += memory
 	}
 
 	return cpuMillis, memoryBytes, nil
 }
 
+// getPodRequests returns the CPU and memory requested by the containers of the pod
+// and by its init containers that haven't terminated.
+func getPodRequests(pod corev1.Pod) (cpuMillis uint64, memoryBytes uint64, err error) { //nolint:nonamedreturns
+	nonTerminatedInitContainers := make([]corev1.Container, 0, len(pod.Spec.InitContainers))
+	for _, container := range pod.Spec.InitContainers {
+		if !IsContainerInState(
+			pod.Status.InitContainerStatuses, ContainerStateTerminated,
+		) {
+			nonTerminatedInitContainers = append(nonTerminatedInitContainers, container)
+		}
+	}
+	for _, container := range append(pod.Spec.Containers, nonTerminatedInitContainers...) {
+		cpu, memory, err := getResources(container.Resources.Requests)
+		if err != nil {
+			return 0, 0, err
+		}
+		cpuMillis += cpu
+		memoryBytes += memory
+	}
+	return cpuMillis, memoryBytes, nil
+}
+
+// NodeResources holds the allocatable resources of a node and the resources requested by the pods on it.
+type NodeResources struct {
+	Name                   string
+	AllocatableCPUMillis   uint64
+	AllocatableMemoryBytes uint64
+	RequestedCPUMillis     uint64
+	RequestedMemoryBytes   uint64
+}
+
+// AvailableCPUMillis returns the allocatable CPU not requested by the pods.
+func (r NodeResources) AvailableCPUMillis() uint64 {
+	if r.RequestedCPUMillis > r.AllocatableCPUMillis {
+		return 0
+	}
+	return r.AllocatableCPUMillis - r.RequestedCPUMillis
+}
+
+// AvailableMemoryBytes returns the allocatable memory not requested by the pods.
+func (r NodeResources) AvailableMemoryBytes() uint64 {
+	if r.RequestedMemoryBytes > r.AllocatableMemoryBytes {
+		return 0
+	}
+	return r.AllocatableMemoryBytes - r.RequestedMemoryBytes
+}
+
+// GetNodesResources returns the resources of the nodes in the same order.
+// Like the scheduler, it counts the requests of all the pods assigned to a node that haven't finished.
+func GetNodesResources(nodes []corev1.Node, pods []corev1.Pod) ([]NodeResources, error) {
+	result := make([]NodeResources, len(nodes))
+	index := make(map[string]int, len(nodes))
+	for i, node := range nodes {
+		cpu, memory, err := getResources(node.Status.Allocatable)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not get allocatable resources of the node"))
+		}
+		result[i] = NodeResources{Name: node.GetName(), AllocatableCPUMillis: cpu, AllocatableMemoryBytes: memory}
+		index[node.GetName()] = i
+	}
+	for _, pod := range pods {
+		i, ok := index[pod.Spec.NodeName]
+		if !ok || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		cpu, memory, err := getPodRequests(pod)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to sum resources requested on the node"))
+		}
+		result[i].RequestedCPUMillis += cpu
+		result[i].RequestedMemoryBytes += memory
+	}
+	return result, nil
+}
+
 // GetConsumedDiskBytes returns consumed bytes. The strategy differs based on k8s cluster type.
 func (k *Kubernetes) GetConsumedDiskBytes(
 	_ context.Context, clusterType ClusterType, volumes *corev1.PersistentVolumeList,
@@ -2,12 +2,24 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/AlekSi/pointer"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	annotationDefaultStorageClass     = "storageclass.kubernetes.io/is-default-class"
+	annotationBetaDefaultStorageClass = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// ErrStorageShrink is returned when the storage of a database cluster would be shrunk.
+var ErrStorageShrink = errors.New("cannot shrink storage size")
+
 // ListPersistentVolumes returns list of persistent volumes that match the criteria.
 // This method returns a list of full objects (meta and spec).
 func (k *Kubernetes) ListPersistentVolumes(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.PersistentVolumeList, error) {
@@ -27,3 +39,62 @@ func (k *Kubernetes) ListStorageClasses(ctx context.Context, opts ...ctrlclient.
 	}
 	return result, nil
 }
+
+// ListCSIStorageCapacities returns list of the capacities reported by the CSI drivers that match the criteria.
+func (k *Kubernetes) ListCSIStorageCapacities(ctx context.Context, opts ...ctrlclient.ListOption) (*storagev1.CSIStorageCapacityList, error) {
+	result := &storagev1.CSIStorageCapacityList{}
+	if err := k.k8sClient.List(ctx, result, opts...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListPersistentVolumeClaims returns list of persistent volume claims that match the criteria.
+func (k *Kubernetes) ListPersistentVolumeClaims(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.PersistentVolumeClaimList, error) {
+	result := &corev1.PersistentVolumeClaimList{}
+	if err := k.k8sClient.List(ctx, result, opts...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IsDefaultStorageClass returns true if the storage class is marked as the default one.
+func IsDefaultStorageClass(sc *storagev1.StorageClass) bool {
+	annotations := sc.GetAnnotations()
+	return annotations[annotationDefaultStorageClass] == "true" || annotations[annotationBetaDefaultStorageClass] == "true"
+}
+
+// FindStorageClass returns the storage class with the name, or the default storage class
+// if the name is empty. It returns nil if there is no such storage class.
+// Like Kubernetes, the newest storage class is used if several are marked as the default one.
+func FindStorageClass(classes []storagev1.StorageClass, name string) *storagev1.StorageClass {
+	var result *storagev1.StorageClass
+	for i := range classes {
+		sc := &classes[i]
+		switch {
+		case name != "":
+			if sc.GetName() == name {
+				return sc
+			}
+		case IsDefaultStorageClass(sc):
+			if result == nil || sc.GetCreationTimestamp().After(result.GetCreationTimestamp().Time) {
+				result = sc
+			}
+		}
+	}
+	return result
+}
+
+// CheckStorageResize returns nil if volumes of the storage class can be resized from the current to the requested size.
+// Otherwise, it returns an error explaining why the resize is not feasible.
+func CheckStorageResize(sc *storagev1.StorageClass, current, requested resource.Quantity) error {
+	switch requested.Cmp(current) {
+	case -1:
+		return ErrStorageShrink
+	case 1:
+		if !pointer.Get(sc.AllowVolumeExpansion) {
+			return fmt.Errorf("storage class '%s' does not allow volume expansion", sc.GetName())
+		}
+	}
+	return nil
+}
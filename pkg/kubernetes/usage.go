@@ -0,0 +1,325 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+const (
+	databaseClusterInstanceLabel = "app.kubernetes.io/instance"
+	usageConfigMapTimeLayout     = "20060102150405"
+	// usageSampleKey is the key of the usage sample in its ConfigMap.
+	usageSampleKey = "sample"
+)
+
+//nolint:gochecknoglobals
+var (
+	// podMetricsListGVK is the GroupVersionKind of the pod metrics served by the metrics API.
+	podMetricsListGVK = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetricsList"}
+	// upstreamBackupListGVKs are the GroupVersionKinds of the backups of the database operators.
+	// The backups have the same names as the DatabaseClusterBackups they are created for.
+	upstreamBackupListGVKs = []schema.GroupVersionKind{
+		{Group: "pxc.percona.com", Version: "v1", Kind: "PerconaXtraDBClusterBackupList"},
+		{Group: "psmdb.percona.com", Version: "v1", Kind: "PerconaServerMongoDBBackupList"},
+		{Group: "pgv2.percona.com", Version: "v2", Kind: "PerconaPGBackupList"},
+	}
+)
+
+// GetDatabaseClustersUsage returns the resources requested and used by the database clusters in the namespace.
+// The CPU and memory usage is only known if the metrics API is available in the cluster.
+// The used disk is not set, it is reported by the kubelets, see GetDatabaseClusterVolumesUsage.
+func (k *Kubernetes) GetDatabaseClustersUsage(ctx context.Context, namespace string) ([]common.DatabaseClusterUsage, error) { //nolint:funlen,cyclop
+	databases, err := k.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	usages := make(map[string]*common.DatabaseClusterUsage, len(databases.Items))
+	get := func(name string) *common.DatabaseClusterUsage {
+		u, ok := usages[name]
+		if !ok {
+			u = &common.DatabaseClusterUsage{Namespace: namespace, Name: name}
+			usages[name] = u
+		}
+		return u
+	}
+	for _, db := range databases.Items {
+		get(db.GetName()).EngineType = string(db.Spec.Engine.Type)
+	}
+
+	pods, err := k.ListPods(ctx, ctrlclient.InNamespace(namespace), ctrlclient.HasLabels{databaseClusterInstanceLabel})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to list pods"))
+	}
+	podsUsage, err := k.getPodsUsage(ctx, namespace)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get the usage of the pods"))
+	}
+	for _, pod := range pods.Items {
+		u, ok := usages[pod.GetLabels()[databaseClusterInstanceLabel]]
+		if !ok || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		cpu, memory, err := getPodRequests(pod)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to get the requests of pod %s", pod.GetName()))
+		}
+		u.RequestedCPUMillis += cpu
+		u.RequestedMemoryBytes += memory
+		if podsUsage == nil {
+			continue
+		}
+		if u.UsedCPUMillis == nil {
+			u.UsedCPUMillis, u.UsedMemoryBytes = new(uint64), new(uint64)
+		}
+		podUsage := podsUsage[pod.GetName()]
+		*u.UsedCPUMillis += podUsage[0]
+		*u.UsedMemoryBytes += podUsage[1]
+	}
+
+	claims, err := k.ListPersistentVolumeClaims(ctx, ctrlclient.InNamespace(namespace), ctrlclient.HasLabels{databaseClusterInstanceLabel})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to list persistent volume claims"))
+	}
+	for _, pvc := range claims.Items {
+		u, ok := usages[pvc.GetLabels()[databaseClusterInstanceLabel]]
+		if !ok {
+			continue
+		}
+		u.RequestedDiskBytes += quantityBytes(pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+	}
+
+	backups, err := k.ListDatabaseClusterBackups(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	backupSizes, err := k.getUpstreamBackupSizes(ctx, namespace)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get the sizes of the backups"))
+	}
+	for _, backup := range backups.Items {
+		u := get(backup.Spec.DBClusterName)
+		u.Backups++
+		u.BackupBytes += backupSizes[backup.GetName()]
+	}
+
+	result := make([]common.DatabaseClusterUsage, 0, len(usages))
+	for _, u := range usages {
+		result = append(result, *u)
+	}
+	slices.SortFunc(result, func(a, b common.DatabaseClusterUsage) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result, nil
+}
+
+// isUsageSourceUnavailable returns true if the error means that a source of the usage is not available
+// in the cluster or to the Everest server, in which case the usage it provides is unknown.
+func isUsageSourceUnavailable(err error) bool {
+	return meta.IsNoMatchError(err) || k8serrors.IsNotFound(err) ||
+		k8serrors.IsServiceUnavailable(err) || k8serrors.IsForbidden(err)
+}
+
+// getPodsUsage returns the CPU millis and memory bytes used by the pods in the namespace
+// according to the metrics API. It returns nil if the metrics API is not available.
+func (k *Kubernetes) getPodsUsage(ctx context.Context, namespace string) (map[string][2]uint64, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(podMetricsListGVK)
+	if err := k.k8sClient.List(ctx, list, ctrlclient.InNamespace(namespace)); err != nil {
+		if isUsageSourceUnavailable(err) {
+			k.l.Debugf("Pod metrics are not available in namespace %s: %v", namespace, err)
+			return nil, nil //nolint:nilnil
+		}
+		return nil, err
+	}
+	result := make(map[string][2]uint64, len(list.Items))
+	for _, item := range list.Items {
+		containers, _, err := unstructured.NestedSlice(item.Object, "containers")
+		if err != nil {
+			return nil, err
+		}
+		var usage [2]uint64
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for i, name := range []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)} {
+				raw, _, _ := unstructured.NestedString(container, "usage", name)
+				q, err := resource.ParseQuantity(raw)
+				if err != nil {
+					continue
+				}
+				if i == 0 {
+					usage[i] += uint64(max(q.MilliValue(), 0))
+				} else {
+					usage[i] += quantityBytes(q)
+				}
+			}
+		}
+		result[item.GetName()] = usage
+	}
+	return result, nil
+}
+
+// getUpstreamBackupSizes returns the sizes of the backups in the namespace reported by the database operators.
+// The backups without a reported size are omitted.
+func (k *Kubernetes) getUpstreamBackupSizes(ctx context.Context, namespace string) (map[string]uint64, error) {
+	result := make(map[string]uint64)
+	for _, gvk := range upstreamBackupListGVKs {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk)
+		if err := k.k8sClient.List(ctx, list, ctrlclient.InNamespace(namespace)); err != nil {
+			// The operator is not installed or its backups can't be read.
+			if isUsageSourceUnavailable(err) {
+				k.l.Debugf("%s is not available in namespace %s: %v", gvk.Kind, namespace, err)
+				continue
+			}
+			return nil, err
+		}
+		for _, item := range list.Items {
+			size, ok, _ := unstructured.NestedFieldNoCopy(item.Object, "status", "size")
+			if !ok {
+				continue
+			}
+			if bytes, ok := parseBackupSize(size); ok {
+				result[item.GetName()] = bytes
+			}
+		}
+	}
+	return result, nil
+}
+
+// parseBackupSize parses a size reported either as a number of bytes or as a string
+// like "1.5Gi", "1.5GB" or "1500000".
+func parseBackupSize(size interface{}) (uint64, bool) {
+	switch v := size.(type) {
+	case int64:
+		return uint64(max(v, 0)), true
+	case float64:
+		return uint64(max(v, 0)), true
+	case string:
+		if n, err := strconv.ParseUint(v, 10, 64); err == nil {
+			return n, true
+		}
+		q, err := resource.ParseQuantity(strings.TrimSuffix(strings.ReplaceAll(v, " ", ""), "B"))
+		if err != nil {
+			return 0, false
+		}
+		return quantityBytes(q), true
+	}
+	return 0, false
+}
+
+func quantityBytes(q resource.Quantity) uint64 {
+	return uint64(max(q.Value(), 0))
+}
+
+func usageConfigMapName(t time.Time) string {
+	return common.EverestUsageConfigMapPrefix + t.UTC().Format(usageConfigMapTimeLayout)
+}
+
+// SaveUsageSample stores the usage sample in a ConfigMap of its own,
+// so the size of the ConfigMaps doesn't grow with the number of samples.
+func (k *Kubernetes) SaveUsageSample(ctx context.Context, sample *common.UsageSample) error {
+	data, err := json.Marshal(sample)
+	if err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      usageConfigMapName(sample.Time),
+			Namespace: common.SystemNamespace,
+			Labels:    map[string]string{common.EverestUsageLabel: "true"},
+		},
+		Data: map[string]string{usageSampleKey: string(data)},
+	}
+	_, err = k.CreateConfigMap(ctx, cm)
+	if k8serrors.IsAlreadyExists(err) {
+		// A sample taken within the same second replaces the previous one.
+		_, err = k.UpdateConfigMap(ctx, cm)
+	}
+	return err
+}
+
+// ListUsageSamples returns the usage samples taken in the [from, to) time range sorted by time.
+func (k *Kubernetes) ListUsageSamples(ctx context.Context, from, to time.Time) ([]common.UsageSample, error) {
+	cms, err := k.ListConfigMaps(ctx, ctrlclient.InNamespace(common.SystemNamespace), ctrlclient.HasLabels{common.EverestUsageLabel})
+	if err != nil {
+		return nil, err
+	}
+	var result []common.UsageSample
+	for _, cm := range cms.Items {
+		t, ok := usageConfigMapTime(cm.GetName())
+		if !ok || t.Before(from) || !t.Before(to) {
+			continue
+		}
+		sample := common.UsageSample{}
+		if err := json.Unmarshal([]byte(cm.Data[usageSampleKey]), &sample); err != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to decode usage sample %s", cm.GetName()))
+		}
+		result = append(result, sample)
+	}
+	slices.SortFunc(result, func(a, b common.UsageSample) int {
+		return a.Time.Compare(b.Time)
+	})
+	return result, nil
+}
+
+// DeleteUsageSamplesBefore deletes the ConfigMaps with the usage samples taken before the given time.
+func (k *Kubernetes) DeleteUsageSamplesBefore(ctx context.Context, t time.Time) error {
+	cms, err := k.ListConfigMaps(ctx, ctrlclient.InNamespace(common.SystemNamespace), ctrlclient.HasLabels{common.EverestUsageLabel})
+	if err != nil {
+		return err
+	}
+	for _, cm := range cms.Items {
+		sampleTime, ok := usageConfigMapTime(cm.GetName())
+		if !ok || !sampleTime.Before(t) {
+			continue
+		}
+		if err := k.DeleteConfigMap(ctx, &cm); ctrlclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func usageConfigMapTime(name string) (time.Time, bool) {
+	value, ok := strings.CutPrefix(name, common.EverestUsageConfigMapPrefix)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(usageConfigMapTimeLayout, value)
+	return t, err == nil
+}
@@ -0,0 +1,160 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VolumeUsage is the disk space used on a persistent volume.
+type VolumeUsage struct {
+	// PersistentVolumeClaim is the name of the claim of the volume.
+	PersistentVolumeClaim string
+	// UsedBytes is the space used on the volume.
+	UsedBytes uint64
+	// CapacityBytes is the size of the filesystem of the volume.
+	CapacityBytes uint64
+	// RequestedBytes is the storage requested by the claim of the volume.
+	RequestedBytes uint64
+	// Resizing is true while the volume is being expanded to the requested size.
+	Resizing bool
+}
+
+// statsSummary is the part of the kubelet stats summary that holds the volume usage.
+type statsSummary struct {
+	Pods []struct {
+		PodRef struct {
+			Namespace string `json:"namespace"`
+		} `json:"podRef"`
+		Volumes []struct {
+			UsedBytes     *uint64 `json:"usedBytes"`
+			CapacityBytes *uint64 `json:"capacityBytes"`
+			PVCRef        *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// GetDatabaseClusterVolumesUsage returns the usage of the persistent volumes of the database clusters
+// in the namespace by database cluster name. The usage is read from the stats summary of the kubelets
+// of the nodes the database pods run on, so only the volumes mounted by running pods are reported.
+func (k *Kubernetes) GetDatabaseClusterVolumesUsage(ctx context.Context, namespace string) (map[string][]VolumeUsage, error) {
+	claims, err := k.ListPersistentVolumeClaims(ctx, ctrlclient.InNamespace(namespace), ctrlclient.HasLabels{databaseClusterInstanceLabel})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to list persistent volume claims"))
+	}
+	if len(claims.Items) == 0 {
+		return map[string][]VolumeUsage{}, nil
+	}
+	pods, err := k.ListPods(ctx, ctrlclient.InNamespace(namespace), ctrlclient.HasLabels{databaseClusterInstanceLabel})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to list pods"))
+	}
+	var nodes []string
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName != "" && !slices.Contains(nodes, pod.Spec.NodeName) {
+			nodes = append(nodes, pod.Spec.NodeName)
+		}
+	}
+
+	volumes := make(map[string]VolumeUsage)
+	for _, node := range nodes {
+		data, err := k.getNodeStatsSummary(ctx, node)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to get the stats summary of node %s", node))
+		}
+		usage, err := parseVolumesUsage(data, namespace)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to parse the stats summary of node %s", node))
+		}
+		for _, v := range usage {
+			volumes[v.PersistentVolumeClaim] = v
+		}
+	}
+
+	result := make(map[string][]VolumeUsage)
+	for _, pvc := range claims.Items {
+		v, ok := volumes[pvc.GetName()]
+		if !ok {
+			continue
+		}
+		v.RequestedBytes = quantityBytes(pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+		v.Resizing = isPersistentVolumeClaimResizing(pvc)
+		db := pvc.GetLabels()[databaseClusterInstanceLabel]
+		result[db] = append(result[db], v)
+	}
+	return result, nil
+}
+
+// isPersistentVolumeClaimResizing returns true if the capacity of the claim is below its request
+// or if the volume or its filesystem is being resized.
+func isPersistentVolumeClaimResizing(pvc corev1.PersistentVolumeClaim) bool {
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	capacity := pvc.Status.Capacity[corev1.ResourceStorage]
+	if capacity.Cmp(requested) < 0 {
+		return true
+	}
+	return slices.ContainsFunc(pvc.Status.Conditions, func(c corev1.PersistentVolumeClaimCondition) bool {
+		return c.Status == corev1.ConditionTrue &&
+			(c.Type == corev1.PersistentVolumeClaimResizing || c.Type == corev1.PersistentVolumeClaimFileSystemResizePending)
+	})
+}
+
+// getNodeStatsSummary returns the stats summary of the kubelet of the node through the API server proxy.
+func (k *Kubernetes) getNodeStatsSummary(ctx context.Context, node string) ([]byte, error) {
+	if k.restConfig == nil {
+		return nil, errors.New("the REST config is not set")
+	}
+	client, err := k.resourceClient(corev1.SchemeGroupVersion)
+	if err != nil {
+		return nil, err
+	}
+	return client.Get().Resource("nodes").Name(node).SubResource("proxy", "stats", "summary").DoRaw(ctx)
+}
+
+// parseVolumesUsage returns the usage of the persistent volumes in the namespace found in a kubelet stats summary.
+func parseVolumesUsage(data []byte, namespace string) ([]VolumeUsage, error) {
+	summary := &statsSummary{}
+	if err := json.Unmarshal(data, summary); err != nil {
+		return nil, err
+	}
+	var result []VolumeUsage
+	for _, pod := range summary.Pods {
+		if pod.PodRef.Namespace != namespace {
+			continue
+		}
+		for _, v := range pod.Volumes {
+			if v.PVCRef == nil || v.PVCRef.Namespace != namespace || v.UsedBytes == nil || v.CapacityBytes == nil {
+				continue
+			}
+			result = append(result, VolumeUsage{
+				PersistentVolumeClaim: v.PVCRef.Name,
+				UsedBytes:             *v.UsedBytes,
+				CapacityBytes:         *v.CapacityBytes,
+			})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,49 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestIsPersistentVolumeClaimResizing(t *testing.T) {
+	t.Parallel()
+
+	pvc := func(requested, capacity string, conditions ...corev1.PersistentVolumeClaimConditionType) corev1.PersistentVolumeClaim {
+		claim := corev1.PersistentVolumeClaim{
+			Spec: corev1.PersistentVolumeClaimSpec{Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(requested)},
+			}},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)},
+			},
+		}
+		for _, c := range conditions {
+			claim.Status.Conditions = append(claim.Status.Conditions,
+				corev1.PersistentVolumeClaimCondition{Type: c, Status: corev1.ConditionTrue})
+		}
+		return claim
+	}
+
+	assert.False(t, isPersistentVolumeClaimResizing(pvc("10Gi", "10Gi")))
+	assert.True(t, isPersistentVolumeClaimResizing(pvc("15Gi", "10Gi")))
+	assert.True(t, isPersistentVolumeClaimResizing(pvc("15Gi", "15Gi", corev1.PersistentVolumeClaimFileSystemResizePending)))
+	assert.True(t, isPersistentVolumeClaimResizing(pvc("15Gi", "15Gi", corev1.PersistentVolumeClaimResizing)))
+}
@@ -0,0 +1,108 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const (
+	// DefaultCheckInterval is the default interval between the connectivity checks.
+	DefaultCheckInterval = 5 * time.Minute
+
+	checkTimeout = 30 * time.Second
+)
+
+// Checker periodically checks the connectivity of all monitoring configs in the
+// namespaces managed by Everest and records the results in their annotations.
+type Checker struct {
+	kubeConnector kubernetes.KubernetesConnector
+	l             *zap.SugaredLogger
+	interval      time.Duration
+}
+
+// NewChecker returns a new connectivity checker.
+func NewChecker(k kubernetes.KubernetesConnector, l *zap.SugaredLogger, interval time.Duration) *Checker {
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+	return &Checker{
+		kubeConnector: k,
+		l:             l.With("component", "monitoring-checker"),
+		interval:      interval,
+	}
+}
+
+// Run runs the checks until the context is canceled.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		if err := c.CheckAll(ctx); err != nil {
+			c.l.Error(errors.Join(err, errors.New("failed to check monitoring instances")))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll checks all monitoring configs once. The monitoring configs are updated only if
+// their connectivity status has changed. The errors of a namespace don't prevent the other
+// namespaces from being checked, all of them are returned.
+func (c *Checker) CheckAll(ctx context.Context) error {
+	namespaces, err := c.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, ns := range namespaces.Items {
+		if err := c.checkNamespace(ctx, ns.GetName()); err != nil {
+			errs = append(errs, fmt.Errorf("namespace %s: %w", ns.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Checker) checkNamespace(ctx context.Context, namespace string) error {
+	configs, err := c.kubeConnector.ListMonitoringConfigs(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	for _, mc := range configs.Items {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		changed := CheckWithSecret(checkCtx, c.kubeConnector, &mc, time.Now())
+		cancel()
+		if changed {
+			if _, err := c.kubeConnector.UpdateMonitoringConfig(ctx, &mc); err != nil {
+				// The monitoring config might have been changed or deleted meanwhile,
+				// it will be checked again on the next run.
+				c.l.Warnf("could not update the status of monitoring config %s/%s: %v", mc.GetNamespace(), mc.GetName(), err)
+			}
+		}
+	}
+	return nil
+}
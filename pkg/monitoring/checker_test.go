@@ -0,0 +1,150 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitoring
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/pmm"
+	"github.com/percona/everest/pkg/pmm/pmmtest"
+)
+
+func newMonitoringConfig(url string, verifyTLS bool) *everestv1alpha1.MonitoringConfig {
+	return &everestv1alpha1.MonitoringConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "pmm", Namespace: "test-ns"},
+		Spec: everestv1alpha1.MonitoringConfigSpec{
+			Type:                  everestv1alpha1.PMMMonitoringType,
+			CredentialsSecretName: "pmm",
+			PMM:                   everestv1alpha1.PMMConfig{URL: url},
+			VerifyTLS:             pointer.ToBool(verifyTLS),
+		},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	s := pmmtest.NewServer("3.1.0", "admin", "admin")
+	defer s.Close()
+	tlsServer := pmmtest.NewTLSServer("3.1.0", "admin", "admin")
+	defer tlsServer.Close()
+	closed := pmmtest.NewServer("3.1.0", "admin", "admin")
+	closed.Close()
+
+	token, err := pmm.CreateServiceAccountToken(ctx, s.URL, "everest", "admin", "admin", false)
+	require.NoError(t, err)
+
+	mc := newMonitoringConfig(s.URL, false)
+	assert.True(t, Check(ctx, mc, token, now))
+	assert.Equal(t, map[string]string{
+		common.MonitoringStatusAnnotation:      StatusOK,
+		common.MonitoringLastCheckAnnotation:   "2025-01-02T03:04:05Z",
+		common.MonitoringLastSuccessAnnotation: "2025-01-02T03:04:05Z",
+	}, mc.GetAnnotations())
+
+	// The annotations are not touched while the status stays the same.
+	assert.False(t, Check(ctx, mc, token, now.Add(time.Second)))
+	assert.Equal(t, "2025-01-02T03:04:05Z", mc.GetAnnotations()[common.MonitoringLastCheckAnnotation])
+
+	s.RevokeTokens()
+	assert.True(t, Check(ctx, mc, token, now.Add(time.Minute)))
+	assert.Equal(t, StatusUnauthorized, mc.GetAnnotations()[common.MonitoringStatusAnnotation])
+	assert.Equal(t, "2025-01-02T03:05:05Z", mc.GetAnnotations()[common.MonitoringLastCheckAnnotation])
+	assert.Equal(t, "2025-01-02T03:04:05Z", mc.GetAnnotations()[common.MonitoringLastSuccessAnnotation])
+	assert.Contains(t, mc.GetAnnotations()[common.MonitoringLastErrorAnnotation], "invalid username or password")
+
+	expiring := pmmtest.NewServer("3.1.0", "admin", "admin")
+	defer expiring.Close()
+	token, err = pmm.CreateServiceAccountToken(ctx, expiring.URL, "everest", "admin", "admin", false)
+	require.NoError(t, err)
+	expiring.ExpireTokens()
+	mc = newMonitoringConfig(expiring.URL, false)
+	Check(ctx, mc, token, now)
+	assert.Equal(t, StatusExpired, mc.GetAnnotations()[common.MonitoringStatusAnnotation])
+
+	adminToken := &pmm.Token{Username: "admin", Key: "admin"}
+	for name, tc := range map[string]struct {
+		mc     *everestv1alpha1.MonitoringConfig
+		status string
+	}{
+		"self-signed certificate": {mc: newMonitoringConfig(tlsServer.URL, true), status: StatusTLSError},
+		"skip TLS verification":   {mc: newMonitoringConfig(tlsServer.URL, false), status: StatusOK},
+		"unreachable":             {mc: newMonitoringConfig(closed.URL, false), status: StatusUnreachable},
+	} {
+		Check(ctx, tc.mc, adminToken, now)
+		assert.Equal(t, tc.status, tc.mc.GetAnnotations()[common.MonitoringStatusAnnotation], name)
+	}
+}
+
+func TestCheckerCheckAll(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	s := pmmtest.NewServer("2.44.0", "admin", "admin")
+	defer s.Close()
+	key, err := pmm.CreatePMMApiKey(ctx, s.URL, "everest", "admin", "admin", false)
+	require.NoError(t, err)
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   "test-ns",
+				Labels: map[string]string{common.KubernetesManagedByLabel: common.Everest},
+			}},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "pmm", Namespace: "test-ns"},
+				Data:       map[string][]byte{"apiKey": []byte(key), "username": []byte(pmm.APIKeyUsername)},
+			},
+			newMonitoringConfig(s.URL, false),
+		).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	checker := NewChecker(k, zap.NewNop().Sugar(), 0)
+
+	require.NoError(t, checker.CheckAll(ctx))
+	mc, err := k.GetMonitoringConfig(ctx, types.NamespacedName{Namespace: "test-ns", Name: "pmm"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusOK, mc.GetAnnotations()[common.MonitoringStatusAnnotation])
+
+	// The monitoring config is not updated while the status stays the same.
+	require.NoError(t, checker.CheckAll(ctx))
+	unchanged, err := k.GetMonitoringConfig(ctx, types.NamespacedName{Namespace: "test-ns", Name: "pmm"})
+	require.NoError(t, err)
+	assert.Equal(t, mc.GetResourceVersion(), unchanged.GetResourceVersion())
+
+	s.RevokeTokens()
+	require.NoError(t, checker.CheckAll(ctx))
+	mc, err = k.GetMonitoringConfig(ctx, types.NamespacedName{Namespace: "test-ns", Name: "pmm"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusUnauthorized, mc.GetAnnotations()[common.MonitoringStatusAnnotation])
+}
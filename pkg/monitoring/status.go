@@ -0,0 +1,160 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package monitoring provides the connectivity checks of the monitoring instances.
+package monitoring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/pmm"
+)
+
+// Connectivity statuses of a monitoring instance.
+const (
+	// StatusOK means that PMM is reachable and accepts the token.
+	StatusOK = "ok"
+	// StatusUnreachable means that PMM cannot be reached at the configured URL.
+	StatusUnreachable = "unreachable"
+	// StatusTLSError means that the TLS certificate of PMM cannot be verified.
+	StatusTLSError = "tlsError"
+	// StatusUnauthorized means that PMM rejects the token, e.g. it has been revoked.
+	StatusUnauthorized = "unauthorized"
+	// StatusExpired means that the PMM token has expired and has to be rotated.
+	StatusExpired = "expired"
+	// StatusError means that the check failed for any other reason.
+	StatusError = "error"
+)
+
+const (
+	secretAPIKey   = "apiKey"
+	secretUsername = "username"
+	secretAPIKeyID = "apiKeyID"
+)
+
+// SecretData returns the data of the credentials secret of a monitoring config that holds the token.
+func SecretData(token *pmm.Token) map[string]string {
+	data := map[string]string{
+		secretAPIKey:   token.Key,
+		secretUsername: token.Username,
+	}
+	if token.ID != 0 {
+		data[secretAPIKeyID] = strconv.Itoa(token.ID)
+	}
+	return data
+}
+
+// TokenFromSecret returns the PMM token stored in the credentials secret of a monitoring config.
+func TokenFromSecret(secret *corev1.Secret) *pmm.Token {
+	get := func(key string) string {
+		if v, ok := secret.Data[key]; ok {
+			return string(v)
+		}
+		return secret.StringData[key]
+	}
+	token := pmm.TokenFromKey(get(secretAPIKey))
+	if username := get(secretUsername); username != "" {
+		token.Username = username
+	}
+	// The ID is not known for the keys provided by the user and for the secrets
+	// created by the older Everest versions.
+	token.ID, _ = strconv.Atoi(get(secretAPIKeyID))
+	return token
+}
+
+// Check checks the connectivity of the monitoring config with the token
+// and records the result in the annotations of the monitoring config.
+// The monitoring config is not updated in Kubernetes.
+// Returns true if the recorded connectivity status has changed.
+func Check(ctx context.Context, mc *everestv1alpha1.MonitoringConfig, token *pmm.Token, now time.Time) bool {
+	skipTLSVerify := !pointer.Get(mc.Spec.VerifyTLS)
+	err := pmm.CheckToken(ctx, mc.Spec.PMM.URL, token, skipTLSVerify)
+	return SetStatus(mc, err, now)
+}
+
+// CheckWithSecret does the same as Check, but reads the token from the credentials secret.
+func CheckWithSecret(ctx context.Context, k kubernetes.KubernetesConnector, mc *everestv1alpha1.MonitoringConfig, now time.Time) bool {
+	secret, err := k.GetSecret(ctx, types.NamespacedName{Namespace: mc.GetNamespace(), Name: mc.Spec.CredentialsSecretName})
+	if err != nil {
+		return SetStatus(mc, errors.Join(err, errors.New("could not get the credentials secret")), now)
+	}
+	return Check(ctx, mc, TokenFromSecret(secret), now)
+}
+
+// SetStatus records the result of a connectivity check in the annotations of the monitoring config.
+// Returns false and leaves the annotations untouched if the result is the same as the recorded one.
+func SetStatus(mc *everestv1alpha1.MonitoringConfig, checkErr error, now time.Time) bool {
+	annotations := mc.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	status := statusOf(checkErr)
+	lastError := ""
+	if checkErr != nil {
+		lastError = checkErr.Error()
+	}
+	if recorded, ok := annotations[common.MonitoringStatusAnnotation]; ok && recorded == status &&
+		annotations[common.MonitoringLastErrorAnnotation] == lastError {
+		return false
+	}
+	annotations[common.MonitoringStatusAnnotation] = status
+	annotations[common.MonitoringLastCheckAnnotation] = now.UTC().Format(time.RFC3339)
+	if checkErr == nil {
+		annotations[common.MonitoringLastSuccessAnnotation] = annotations[common.MonitoringLastCheckAnnotation]
+		delete(annotations, common.MonitoringLastErrorAnnotation)
+	} else {
+		annotations[common.MonitoringLastErrorAnnotation] = lastError
+	}
+	mc.SetAnnotations(annotations)
+	return true
+}
+
+func statusOf(err error) string {
+	var (
+		certErr      *tls.CertificateVerificationError
+		unknownCAErr x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		invalidErr   x509.CertificateInvalidError
+		recordErr    tls.RecordHeaderError
+		netErr       net.Error
+	)
+	switch {
+	case err == nil:
+		return StatusOK
+	case errors.Is(err, pmm.ErrTokenExpired):
+		return StatusExpired
+	case errors.Is(err, pmm.ErrUnauthorized):
+		return StatusUnauthorized
+	case errors.As(err, &certErr), errors.As(err, &unknownCAErr), errors.As(err, &hostnameErr),
+		errors.As(err, &invalidErr), errors.As(err, &recordErr):
+		return StatusTLSError
+	case errors.As(err, &netErr):
+		return StatusUnreachable
+	}
+	return StatusError
+}
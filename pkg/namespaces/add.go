@@ -26,6 +26,7 @@ import (
 	"helm.sh/helm/v3/pkg/cli/values"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/percona/everest/pkg/cli/bundle"
 	"github.com/percona/everest/pkg/cli/helm"
 	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
 	"github.com/percona/everest/pkg/cli/steps"
@@ -36,6 +37,7 @@ import (
 	"github.com/percona/everest/pkg/output"
 	. "github.com/percona/everest/pkg/utils/must" //nolint:revive,stylecheck
 	"github.com/percona/everest/pkg/version"
+	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
 type (
@@ -78,15 +80,26 @@ type (
 		// This flag is set internally only, so that the add functionality may
 		// be re-used for updating the namespace as well.
 		Update bool
+		// Bundle is the path to the offline bundle to load the Helm charts from instead of the Helm repository.
+		Bundle string
 		// Helm related options
 		HelmConfig helm.CLIOptions
+		// OperatorVersions are the changes of the operator versions pinned in the namespaces.
+		OperatorVersions OperatorVersionUpdate
+		// KeepInstalledOperators is set if the operators installed in the existing namespaces are kept as is,
+		// e.g. when only their pinned versions are updated. Operators is ignored in this case.
+		KeepInstalledOperators bool
+		// VersionMetadataURL is the URL of the version service to check the pinned operator versions against.
+		VersionMetadataURL string
 	}
 
 	// NamespaceAdder provides the functionality to add namespaces.
 	NamespaceAdder struct {
-		l          *zap.SugaredLogger
-		cfg        NamespaceAddConfig
-		kubeClient kubernetes.KubernetesConnector
+		l              *zap.SugaredLogger
+		cfg            NamespaceAddConfig
+		kubeClient     kubernetes.KubernetesConnector
+		versionService versionservice.Interface
+		getRelease     func(name, namespace string) (helm.ReleaseInfo, error)
 	}
 )
 
@@ -182,7 +195,7 @@ func (cfg *NamespaceAddConfig) PopulateOperators(ctx context.Context) error {
 // - namespace names
 // - namespace ownership
 func (cfg *NamespaceAddConfig) ValidateNamespaces(ctx context.Context, nsList []string) error {
-	if err := validateNamespaceNames(nsList); err != nil {
+	if err := ValidateNamespaceNames(nsList); err != nil {
 		return err
 	}
 
@@ -190,7 +203,14 @@ func (cfg *NamespaceAddConfig) ValidateNamespaces(ctx context.Context, nsList []
 	if err != nil {
 		return err
 	}
+	return cfg.validateNamespaces(ctx, k, nsList)
+}
 
+func (cfg *NamespaceAddConfig) validateNamespaces(
+	ctx context.Context,
+	k kubernetes.KubernetesConnector,
+	nsList []string,
+) error {
 	for _, ns := range nsList {
 		if err := cfg.validateNamespaceOwnership(ctx, k, ns); err != nil {
 			return err
@@ -260,6 +280,31 @@ func (cfg *NamespaceAddConfig) detectKubernetesEnv(ctx context.Context, l *zap.S
 
 // NewNamespaceAdd returns a new CLI operation to add namespaces.
 func NewNamespaceAdd(c NamespaceAddConfig, l *zap.SugaredLogger) (*NamespaceAdder, error) {
+	n, err := newNamespaceAdd(c, l)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := cliutils.NewKubeConnector(n.l, c.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	n.kubeClient = k
+	return n, nil
+}
+
+// NewNamespaceAddWithClient returns a new operation to add namespaces that uses the provided Kubernetes client.
+// It allows adding namespaces from within the cluster, e.g. by the Everest server.
+func NewNamespaceAddWithClient(c NamespaceAddConfig, k kubernetes.KubernetesConnector, l *zap.SugaredLogger) (*NamespaceAdder, error) {
+	n, err := newNamespaceAdd(c, l)
+	if err != nil {
+		return nil, err
+	}
+	n.kubeClient = k
+	return n, nil
+}
+
+func newNamespaceAdd(c NamespaceAddConfig, l *zap.SugaredLogger) (*NamespaceAdder, error) {
 	{
 		// validate the provided configuration
 		if len(c.NamespaceList) == 0 {
@@ -267,26 +312,61 @@ func NewNamespaceAdd(c NamespaceAddConfig, l *zap.SugaredLogger) (*NamespaceAdde
 			return nil, ErrNamespaceListEmpty
 		}
 
-		if !(c.Operators.PXC || c.Operators.PG || c.Operators.PSMDB) {
+		if !c.KeepInstalledOperators && !(c.Operators.PXC || c.Operators.PG || c.Operators.PSMDB) {
 			// need to select at least one operator to install
 			return nil, ErrOperatorsNotSelected
 		}
+
+		if !c.KeepInstalledOperators {
+			if err := c.OperatorVersions.checkOperators(c.Operators); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	vs := versionservice.New(c.VersionMetadataURL)
+	if c.Bundle != "" {
+		b, err := bundle.Open(c.Bundle)
+		if err != nil {
+			return nil, err
+		}
+		c.HelmConfig.ArchiveDir = b.ChartsDir()
+		vs = b.VersionService()
 	}
 
 	n := &NamespaceAdder{
-		cfg: c,
-		l:   l.With("component", "namespace-adder"),
+		cfg:            c,
+		l:              l.With("component", "namespace-adder"),
+		versionService: vs,
+		getRelease: func(name, namespace string) (helm.ReleaseInfo, error) {
+			return helm.GetReleaseInfo(name, namespace, c.KubeconfigPath)
+		},
 	}
 	if c.Pretty {
 		n.l = zap.NewNop().Sugar()
 	}
+	return n, nil
+}
 
-	k, err := cliutils.NewKubeConnector(n.l, c.KubeconfigPath)
-	if err != nil {
-		return nil, err
+// Validate validates the namespaces of the operation against the cluster.
+// In addition to the checks done by NamespaceAddConfig.ValidateNamespaces,
+// it checks that no operators are removed from the updated namespaces.
+func (n *NamespaceAdder) Validate(ctx context.Context) error {
+	if err := ValidateNamespaceNames(n.cfg.NamespaceList); err != nil {
+		return err
 	}
-	n.kubeClient = k
-	return n, nil
+	if err := n.cfg.validateNamespaces(ctx, n.kubeClient, n.cfg.NamespaceList); err != nil {
+		return err
+	}
+	if !n.cfg.Update || n.cfg.KeepInstalledOperators {
+		return nil
+	}
+	for _, namespace := range n.cfg.NamespaceList {
+		if err := n.validateNamespaceUpdate(ctx, namespace); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Run namespace add operation.
@@ -323,7 +403,13 @@ func (n *NamespaceAdder) Run(ctx context.Context) error {
 
 // GetNamespaceInstallSteps returns the steps to install namespaces.
 func (n *NamespaceAdder) GetNamespaceInstallSteps(ctx context.Context, dbNSChartVersion string) ([]steps.Step, error) {
-	if n.cfg.Update {
+	if !n.cfg.OperatorVersions.IsEmpty() && !version.IsDev(dbNSChartVersion) {
+		if err := n.cfg.OperatorVersions.checkSupported(ctx, n.versionService, dbNSChartVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	if n.cfg.Update && !n.cfg.KeepInstalledOperators {
 		// validate operators updated list for each namespace.
 		for _, namespace := range n.cfg.NamespaceList {
 			err := n.validateNamespaceUpdate(ctx, namespace)
@@ -343,17 +429,20 @@ func (n *NamespaceAdder) GetNamespaceInstallSteps(ctx context.Context, dbNSChart
 		installSteps = append(installSteps,
 			n.newStepInstallNamespace(dbNSChartVersion, namespace),
 		)
+		if n.cfg.HelmConfig.ImageRegistry != "" {
+			installSteps = append(installSteps, n.newStepRewriteOperatorImages(namespace))
+		}
 	}
 
 	return installSteps, nil
 }
 
-func (n *NamespaceAdder) getValues() values.Options {
+func (n *NamespaceAdder) getValues(operators OperatorConfig) values.Options {
 	var v []string
 	v = append(v, "cleanupOnUninstall=false") // uninstall command will do the clean-up on its own.
-	v = append(v, fmt.Sprintf("pxc=%t", n.cfg.Operators.PXC))
-	v = append(v, fmt.Sprintf("postgresql=%t", n.cfg.Operators.PG))
-	v = append(v, fmt.Sprintf("psmdb=%t", n.cfg.Operators.PSMDB))
+	v = append(v, fmt.Sprintf("pxc=%t", operators.PXC))
+	v = append(v, fmt.Sprintf("postgresql=%t", operators.PG))
+	v = append(v, fmt.Sprintf("psmdb=%t", operators.PSMDB))
 	v = append(v, fmt.Sprintf("telemetry=%t", !n.cfg.DisableTelemetry))
 
 	if n.cfg.ClusterType == kubernetes.ClusterTypeOpenShift {
@@ -384,18 +473,33 @@ func (n *NamespaceAdder) provisionDBNamespace(
 	if err != nil {
 		return err
 	}
-	values := Must(helmutils.MergeVals(n.getValues(), nil))
+	operators, pins, err := n.namespaceOperators(namespace)
+	if err != nil {
+		return err
+	}
+	values := Must(helmutils.MergeVals(n.getValues(operators), nil))
+	if len(pins) > 0 {
+		// Record the pinned versions in the release, so that they are kept on upgrades.
+		recorded := make(map[string]interface{}, len(pins))
+		for op, v := range pins {
+			recorded[op] = v
+		}
+		values[helm.OperatorVersionsValue] = recorded
+	}
 	installer := helm.Installer{
 		ReleaseName:            namespace,
 		ReleaseNamespace:       namespace,
+		ImageRegistry:          n.cfg.HelmConfig.ImageRegistry,
 		Values:                 values,
 		CreateReleaseNamespace: !nsExists,
+		OperatorVersions:       pins,
 	}
 	if err := installer.Init(n.cfg.KubeconfigPath, helm.ChartOptions{
-		Directory: cliutils.DBNamespaceSubChartPath(n.cfg.HelmConfig.ChartDir),
-		URL:       n.cfg.HelmConfig.RepoURL,
-		Name:      helm.EverestDBNamespaceChartName,
-		Version:   version,
+		ArchiveDir: n.cfg.HelmConfig.ArchiveDir,
+		Directory:  cliutils.DBNamespaceSubChartPath(n.cfg.HelmConfig.ChartDir),
+		URL:        n.cfg.HelmConfig.RepoURL,
+		Name:       helm.EverestDBNamespaceChartName,
+		Version:    version,
 	}); err != nil {
 		return fmt.Errorf("could not initialize Helm installer: %w", err)
 	}
@@ -403,6 +507,30 @@ func (n *NamespaceAdder) provisionDBNamespace(
 	return installer.Install(ctx)
 }
 
+// namespaceOperators returns the operators to install in the namespace and their pinned versions.
+// The versions pinned in the existing Helm release are kept unless they are changed.
+func (n *NamespaceAdder) namespaceOperators(namespace string) (OperatorConfig, map[string]string, error) {
+	rel, err := n.getRelease(namespace, namespace)
+	if err != nil {
+		return OperatorConfig{}, nil, fmt.Errorf("could not get Helm release: %w", err)
+	}
+	operators := n.cfg.Operators
+	if n.cfg.KeepInstalledOperators {
+		if rel.Revision == 0 {
+			return OperatorConfig{}, nil, NewErrNamespaceNotManagedByEverest(namespace)
+		}
+		operators = operatorsFromValues(rel.Values)
+	}
+	if err := n.cfg.OperatorVersions.checkOperators(operators); err != nil {
+		return OperatorConfig{}, nil, fmt.Errorf("namespace '%s': %w", namespace, err)
+	}
+	pins := helm.OperatorVersionsFromValues(rel.Values)
+	if err := n.cfg.OperatorVersions.Apply(pins); err != nil {
+		return OperatorConfig{}, nil, err
+	}
+	return operators, pins, nil
+}
+
 func (n *NamespaceAdder) validateNamespaceUpdate(ctx context.Context, namespace string) error {
 	subscriptions, err := n.kubeClient.ListSubscriptions(ctx, client.InNamespace(namespace))
 	if err != nil {
@@ -165,7 +165,7 @@ func TestValidateNamespaces(t *testing.T) {
 	for _, tc := range tcases {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			err := validateNamespaceNames(tc.input)
+			err := ValidateNamespaceNames(tc.input)
 			assert.Equal(t, tc.error, err)
 			// assert.ElementsMatch(t, tc.output, output)
 		})
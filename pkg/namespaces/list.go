@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestOperator "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/cli/helm"
 	cliutils "github.com/percona/everest/pkg/cli/utils"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
@@ -75,6 +76,7 @@ type (
 		// Name is the namespace name.
 		Name string
 		// InstalledOperators is a list of installed Percona operators in the namespace.
+		// The pinned version is shown next to the installed one, e.g. psmdb(v1.19.0, pinned v1.18.0).
 		InstalledOperators []string
 	}
 
@@ -83,6 +85,7 @@ type (
 		cfg        NamespaceListConfig
 		kubeClient kubernetes.KubernetesConnector
 		l          *zap.SugaredLogger
+		getRelease func(name, namespace string) (helm.ReleaseInfo, error)
 	}
 )
 
@@ -91,6 +94,9 @@ func NewNamespaceLister(c NamespaceListConfig, l *zap.SugaredLogger) (*Namespace
 	n := &NamespaceLister{
 		cfg: c,
 		l:   l.With("component", "namespace-lister"),
+		getRelease: func(name, namespace string) (helm.ReleaseInfo, error) {
+			return helm.GetReleaseInfo(name, namespace, c.KubeconfigPath)
+		},
 	}
 	if c.Pretty {
 		n.l = zap.NewNop().Sugar()
@@ -152,6 +158,11 @@ func (nsL *NamespaceLister) getNamespaceOperators(ctx context.Context, ns *v1.Na
 		if err != nil && client.IgnoreNotFound(err) != nil {
 			return []string{}, fmt.Errorf("cannot list installed operators in namespace='%s': %w", ns.GetName(), err)
 		}
+		rel, err := nsL.getRelease(ns.GetName(), ns.GetName())
+		if err != nil {
+			return []string{}, fmt.Errorf("cannot get Helm release of namespace='%s': %w", ns.GetName(), err)
+		}
+		pins := helm.OperatorVersionsFromValues(rel.Values)
 
 		for _, sub := range subList.Items {
 			csv, csvErr := nsL.kubeClient.GetClusterServiceVersion(ctx, types.NamespacedName{
@@ -169,12 +180,24 @@ func (nsL *NamespaceLister) getNamespaceOperators(ctx context.Context, ns *v1.Na
 					err,
 				)
 			}
-			toReturn = append(toReturn, fmt.Sprintf("%s(v%s)", convertDbOperatorName(sub.GetName()), v.String()))
+			name := convertDbOperatorName(sub.GetName())
+			toReturn = append(toReturn, fmt.Sprintf("%s(%s)", name, operatorVersionInfo(v, pins[name])))
 		}
 	}
 	return toReturn, nil
 }
 
+// operatorVersionInfo returns the installed operator version along with the pinned one, if any.
+func operatorVersionInfo(installed *goversion.Version, pinned string) string {
+	if pinned == "" {
+		return "v" + installed.String()
+	}
+	if p, err := goversion.NewVersion(pinned); err == nil && p.Equal(installed) {
+		return fmt.Sprintf("v%s, pinned", installed)
+	}
+	return fmt.Sprintf("v%s, pinned v%s", installed, pinned)
+}
+
 func convertDbOperatorName(name string) string {
 	switch strings.ToLower(name) {
 	case common.MongoDBOperatorName:
@@ -0,0 +1,93 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"context"
+	"fmt"
+
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/cli/steps"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func (n *NamespaceAdder) newStepRewriteOperatorImages(namespace string) steps.Step {
+	return steps.Step{
+		Desc: fmt.Sprintf("Pulling the operator images of namespace '%s' from '%s'", namespace, n.cfg.HelmConfig.ImageRegistry),
+		F: func(ctx context.Context) error {
+			return rewriteOperatorImages(ctx, n.kubeClient, namespace, n.cfg.HelmConfig.ImageRegistry)
+		},
+	}
+}
+
+// rewriteOperatorImages makes the operators installed by OLM in the namespace pull their images
+// from the registry. The images of the operators are set by their ClusterServiceVersions,
+// which are not rendered by Helm, so they are rewritten once OLM creates them.
+func rewriteOperatorImages(ctx context.Context, k kubernetes.KubernetesConnector, namespace, registry string) error {
+	return wait.PollUntilContextTimeout(ctx, pollInterval, pollTimeout, true, func(ctx context.Context) (bool, error) {
+		subs, err := k.ListSubscriptions(ctx, client.InNamespace(namespace))
+		if err != nil {
+			return false, err
+		}
+		for _, sub := range subs.Items {
+			if sub.Status.InstalledCSV == "" {
+				return false, nil
+			}
+			csv, err := k.GetClusterServiceVersion(ctx, types.NamespacedName{Namespace: namespace, Name: sub.Status.InstalledCSV})
+			if k8serrors.IsNotFound(err) {
+				return false, nil
+			} else if err != nil {
+				return false, err
+			}
+			rewritten, err := rewriteClusterServiceVersionImages(csv, registry)
+			if err != nil {
+				return false, err
+			}
+			if equality.Semantic.DeepEqual(csv, rewritten) {
+				continue
+			}
+			if _, err := k.UpdateClusterServiceVersion(ctx, rewritten); k8serrors.IsConflict(err) {
+				return false, nil
+			} else if err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+}
+
+// rewriteClusterServiceVersionImages returns a copy of the ClusterServiceVersion with the images
+// of its deployments and related images pulled from the registry.
+func rewriteClusterServiceVersionImages(csv *olmv1alpha1.ClusterServiceVersion, registry string) (*olmv1alpha1.ClusterServiceVersion, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(csv)
+	if err != nil {
+		return nil, err
+	}
+	helm.RewriteImages(obj["spec"], "image", registry)
+	result := &olmv1alpha1.ClusterServiceVersion{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
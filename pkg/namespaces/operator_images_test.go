@@ -0,0 +1,51 @@
+package namespaces
+
+import (
+	"testing"
+
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRewriteClusterServiceVersionImages(t *testing.T) {
+	t.Parallel()
+	csv := &olmv1alpha1.ClusterServiceVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "percona-xtradb-cluster-operator.v1.16.0", Namespace: "ns"},
+		Spec: olmv1alpha1.ClusterServiceVersionSpec{
+			InstallStrategy: olmv1alpha1.NamedInstallStrategy{
+				StrategySpec: olmv1alpha1.StrategyDetailsDeployment{
+					DeploymentSpecs: []olmv1alpha1.StrategyDeploymentSpec{{
+						Name: "percona-xtradb-cluster-operator",
+						Spec: appsv1.DeploymentSpec{
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{
+										Name:  "operator",
+										Image: "docker.io/percona/percona-xtradb-cluster-operator:1.16.0",
+									}},
+								},
+							},
+						},
+					}},
+				},
+			},
+			RelatedImages: []olmv1alpha1.RelatedImage{{
+				Name:  "operator",
+				Image: "percona/percona-xtradb-cluster-operator:1.16.0",
+			}},
+		},
+	}
+
+	result, err := rewriteClusterServiceVersionImages(csv, "registry.local:5000")
+	require.NoError(t, err)
+	assert.Equal(t, "registry.local:5000/percona/percona-xtradb-cluster-operator:1.16.0",
+		result.Spec.InstallStrategy.StrategySpec.DeploymentSpecs[0].Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "registry.local:5000/percona/percona-xtradb-cluster-operator:1.16.0", result.Spec.RelatedImages[0].Image)
+	assert.Equal(t, csv.GetName(), result.GetName())
+	// The original is not changed.
+	assert.Equal(t, "percona/percona-xtradb-cluster-operator:1.16.0", csv.Spec.RelatedImages[0].Image)
+}
@@ -0,0 +1,147 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/percona/everest/pkg/cli/steps"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/common"
+)
+
+// QuotaUnlimited is the value of a quota flag that removes the limit.
+const QuotaUnlimited = "none"
+
+var errNegativeQuota = errors.New("quota cannot be negative")
+
+// QuotaUpdate contains the changes of the namespace quota.
+// A nil field means that the corresponding limit is left unchanged.
+type QuotaUpdate struct {
+	CPU                    *string
+	Memory                 *string
+	Disk                   *string
+	DatabaseClusters       *string
+	DatabaseClusterBackups *string
+}
+
+// IsEmpty returns true if the update does not change any limit.
+func (u QuotaUpdate) IsEmpty() bool {
+	return u.CPU == nil && u.Memory == nil && u.Disk == nil &&
+		u.DatabaseClusters == nil && u.DatabaseClusterBackups == nil
+}
+
+// Apply applies the update to the quota.
+func (u QuotaUpdate) Apply(quota *common.NamespaceQuota) error {
+	for name, f := range map[string]struct {
+		value *string
+		dst   **resource.Quantity
+	}{
+		"cpu":    {u.CPU, &quota.CPU},
+		"memory": {u.Memory, &quota.Memory},
+		"disk":   {u.Disk, &quota.Disk},
+	} {
+		if f.value == nil {
+			continue
+		}
+		if *f.value == QuotaUnlimited {
+			*f.dst = nil
+			continue
+		}
+		q, err := resource.ParseQuantity(*f.value)
+		if err != nil {
+			return fmt.Errorf("invalid %s quota: %w", name, err)
+		}
+		if q.Sign() < 0 {
+			return fmt.Errorf("invalid %s quota: %w", name, errNegativeQuota)
+		}
+		*f.dst = &q
+	}
+	for name, f := range map[string]struct {
+		value *string
+		dst   **int
+	}{
+		"database clusters":        {u.DatabaseClusters, &quota.DatabaseClusters},
+		"database cluster backups": {u.DatabaseClusterBackups, &quota.DatabaseClusterBackups},
+	} {
+		if f.value == nil {
+			continue
+		}
+		if *f.value == QuotaUnlimited {
+			*f.dst = nil
+			continue
+		}
+		n, err := strconv.Atoi(*f.value)
+		if err != nil {
+			return fmt.Errorf("invalid %s quota: %w", name, err)
+		}
+		if n < 0 {
+			return fmt.Errorf("invalid %s quota: %w", name, errNegativeQuota)
+		}
+		*f.dst = &n
+	}
+	return nil
+}
+
+// UpdateNamespaceQuotas applies the quota update to the given namespaces.
+func UpdateNamespaceQuotas(
+	ctx context.Context,
+	kubeconfigPath string,
+	namespaces []string,
+	update QuotaUpdate,
+	l *zap.SugaredLogger,
+	pretty bool,
+) error {
+	// Validate the flags before changing any namespace.
+	if err := update.Apply(&common.NamespaceQuota{}); err != nil {
+		return err
+	}
+
+	if pretty {
+		l = zap.NewNop().Sugar()
+	}
+	k, err := cliutils.NewKubeConnector(l, kubeconfigPath)
+	if err != nil {
+		return err
+	}
+
+	quotaSteps := make([]steps.Step, 0, len(namespaces))
+	for _, ns := range namespaces {
+		quotaSteps = append(quotaSteps, steps.Step{
+			Desc: fmt.Sprintf("Updating quota of namespace '%s'", ns),
+			F: func(ctx context.Context) error {
+				quota, err := k.GetNamespaceQuota(ctx, ns)
+				if err != nil {
+					return fmt.Errorf("cannot get quota of namespace='%s': %w", ns, err)
+				}
+				if err := update.Apply(quota); err != nil {
+					return err
+				}
+				if err := k.UpdateNamespaceQuota(ctx, ns, quota); err != nil {
+					return fmt.Errorf("cannot update quota of namespace='%s': %w", ns, err)
+				}
+				return nil
+			},
+		})
+	}
+	return steps.RunStepsWithSpinner(ctx, l, quotaSteps, pretty)
+}
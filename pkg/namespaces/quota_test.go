@@ -0,0 +1,37 @@
+package namespaces
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+func TestQuotaUpdateApply(t *testing.T) {
+	t.Parallel()
+
+	cpu := resource.MustParse("4")
+	quota := &common.NamespaceQuota{CPU: &cpu, DatabaseClusters: pointer.ToInt(5)}
+	update := QuotaUpdate{
+		CPU:                    pointer.ToString(QuotaUnlimited),
+		Memory:                 pointer.ToString("16Gi"),
+		DatabaseClusterBackups: pointer.ToString("10"),
+	}
+	require.NoError(t, update.Apply(quota))
+
+	assert.Nil(t, quota.CPU)
+	require.NotNil(t, quota.Memory)
+	assert.Equal(t, "16Gi", quota.Memory.String())
+	assert.Nil(t, quota.Disk)
+	assert.Equal(t, pointer.ToInt(5), quota.DatabaseClusters)
+	assert.Equal(t, pointer.ToInt(10), quota.DatabaseClusterBackups)
+
+	assert.True(t, QuotaUpdate{}.IsEmpty())
+	assert.False(t, update.IsEmpty())
+	require.Error(t, QuotaUpdate{Disk: pointer.ToString("lots")}.Apply(quota))
+	require.ErrorIs(t, QuotaUpdate{DatabaseClusters: pointer.ToString("-1")}.Apply(quota), errNegativeQuota)
+}
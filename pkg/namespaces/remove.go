@@ -71,7 +71,7 @@ type (
 // - namespace names
 // - namespace ownership
 func (cfg *NamespaceRemoveConfig) ValidateNamespaces(ctx context.Context, nsList []string) error {
-	if err := validateNamespaceNames(nsList); err != nil {
+	if err := ValidateNamespaceNames(nsList); err != nil {
 		return err
 	}
 
@@ -79,7 +79,14 @@ func (cfg *NamespaceRemoveConfig) ValidateNamespaces(ctx context.Context, nsList
 	if err != nil {
 		return err
 	}
+	return cfg.validateNamespaces(ctx, k, nsList)
+}
 
+func (cfg *NamespaceRemoveConfig) validateNamespaces(
+	ctx context.Context,
+	k kubernetes.KubernetesConnector,
+	nsList []string,
+) error {
 	for _, ns := range nsList {
 		if err := cfg.validateNamespaceOwnership(ctx, k, ns); err != nil {
 			return err
@@ -138,6 +145,25 @@ func NewNamespaceRemove(c NamespaceRemoveConfig, l *zap.SugaredLogger) (*Namespa
 	return n, nil
 }
 
+// NewNamespaceRemoveWithClient returns a new operation to remove namespaces that uses the provided Kubernetes client.
+// It allows removing namespaces from within the cluster, e.g. by the Everest server.
+func NewNamespaceRemoveWithClient(c NamespaceRemoveConfig, k kubernetes.KubernetesConnector, l *zap.SugaredLogger) *NamespaceRemover {
+	return &NamespaceRemover{
+		cfg:           c,
+		kubeConnector: k,
+		l:             l.With("component", "namespace-remover"),
+	}
+}
+
+// Validate validates the namespaces of the operation against the cluster.
+// See NamespaceRemoveConfig.ValidateNamespaces.
+func (r *NamespaceRemover) Validate(ctx context.Context) error {
+	if err := ValidateNamespaceNames(r.cfg.NamespaceList); err != nil {
+		return err
+	}
+	return r.cfg.validateNamespaces(ctx, r.kubeConnector, r.cfg.NamespaceList)
+}
+
 // Run the namespace removal operation.
 func (r *NamespaceRemover) Run(ctx context.Context) error {
 	// This command expects a Helm based installation (< 1.4.0)
@@ -145,13 +171,16 @@ func (r *NamespaceRemover) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	return steps.RunStepsWithSpinner(ctx, r.l, r.Steps(), r.cfg.Pretty)
+}
 
+// Steps returns the steps to remove the namespaces.
+func (r *NamespaceRemover) Steps() []steps.Step {
 	var removalSteps []steps.Step
 	for _, ns := range r.cfg.NamespaceList {
 		removalSteps = append(removalSteps, NewRemoveNamespaceSteps(ns, r.cfg.KeepNamespace, r.kubeConnector)...)
 	}
-
-	return steps.RunStepsWithSpinner(ctx, r.l, removalSteps, r.cfg.Pretty)
+	return removalSteps
 }
 
 // NewRemoveNamespaceSteps returns the steps to remove a namespace.
@@ -206,6 +235,30 @@ func NewRemoveNamespaceSteps(namespace string, keepNs bool, k kubernetes.Kuberne
 	return removeSteps
 }
 
+// NewUnmanageNamespaceSteps returns the steps to stop managing a namespace by Everest while keeping
+// its database clusters, their backups and the database operators running.
+// The Everest operator must not be running anymore when the steps are run.
+func NewUnmanageNamespaceSteps(namespace string, k kubernetes.KubernetesConnector) []steps.Step {
+	return []steps.Step{
+		{
+			Desc: fmt.Sprintf("Releasing database clusters in namespace '%s'", namespace),
+			F: func(ctx context.Context) error {
+				return k.OrphanEverestResources(ctx, namespace)
+			},
+		},
+		{
+			Desc: fmt.Sprintf("Releasing database namespace '%s'", namespace),
+			F: func(ctx context.Context) error {
+				// Keep the database operators installed by the Helm chart.
+				if err := helm.ForgetRelease(namespace, namespace, k.Kubeconfig()); err != nil {
+					return errors.Join(err, errors.New("failed to release helm chart"))
+				}
+				return removeEverestLabelFromNamespace(ctx, k, namespace)
+			},
+		},
+	}
+}
+
 func removeEverestLabelFromNamespace(ctx context.Context, k kubernetes.KubernetesConnector, namespace string) error {
 	return wait.PollUntilContextTimeout(ctx, pollInterval, pollTimeout, false, func(ctx context.Context) (bool, error) {
 		ns, err := k.GetNamespace(ctx, types.NamespacedName{Name: namespace})
@@ -34,7 +34,7 @@ import (
 // ParseNamespaceNames parses a comma-separated namespaces string.
 // It returns a list of namespaces.
 // Note: namespace names are not validated.
-// Use validateNamespaceNames to validate them.
+// Use ValidateNamespaceNames to validate them.
 func ParseNamespaceNames(namespaces string) []string {
 	result := []string{}
 	for _, ns := range strings.Split(namespaces, ",") {
@@ -51,11 +51,11 @@ func ParseNamespaceNames(namespaces string) []string {
 	return result
 }
 
-// validateNamespaceNames validates a list of namespaces parsed by ParseNamespaceNames.
+// ValidateNamespaceNames validates a list of namespaces parsed by ParseNamespaceNames.
 // It validates the names to be:
 // - RFC-1035 compatible
 // - not reserved by Everest core
-func validateNamespaceNames(nsList []string) error {
+func ValidateNamespaceNames(nsList []string) error {
 	if len(nsList) == 0 {
 		return ErrNamespaceListEmpty
 	}
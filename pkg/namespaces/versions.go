@@ -0,0 +1,159 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	goversion "github.com/hashicorp/go-version"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/common"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+// OperatorVersionUnpinned is the value of an operator version flag that removes the pinned version.
+const OperatorVersionUnpinned = "latest"
+
+// OperatorVersionUpdate contains the changes of the operator versions pinned in a namespace.
+// A nil field means that the corresponding pinned version is left unchanged.
+type OperatorVersionUpdate struct {
+	PG    *string
+	PSMDB *string
+	PXC   *string
+}
+
+// IsEmpty returns true if the update does not change any pinned version.
+func (u OperatorVersionUpdate) IsEmpty() bool {
+	return u.PG == nil && u.PSMDB == nil && u.PXC == nil
+}
+
+// fields returns the changed versions keyed by the DB namespace chart values enabling the operators.
+func (u OperatorVersionUpdate) fields() map[string]*string {
+	return map[string]*string{
+		"postgresql": u.PG,
+		"psmdb":      u.PSMDB,
+		"pxc":        u.PXC,
+	}
+}
+
+// Apply applies the update to the pinned versions, keyed by the DB namespace chart values enabling the operators.
+func (u OperatorVersionUpdate) Apply(pins map[string]string) error {
+	for op, v := range u.fields() {
+		if v == nil {
+			continue
+		}
+		if *v == OperatorVersionUnpinned {
+			delete(pins, op)
+			continue
+		}
+		ver, err := goversion.NewSemver(*v)
+		if err != nil {
+			return fmt.Errorf("invalid %s version: %w", helm.DBNamespaceOperatorName(op), err)
+		}
+		pins[op] = ver.String()
+	}
+	return nil
+}
+
+// checkOperators checks that the operators whose versions are pinned are installed.
+func (u OperatorVersionUpdate) checkOperators(operators OperatorConfig) error {
+	enabled := map[string]bool{"postgresql": operators.PG, "psmdb": operators.PSMDB, "pxc": operators.PXC}
+	for op, v := range u.fields() {
+		if v != nil && *v != OperatorVersionUnpinned && !enabled[op] {
+			return fmt.Errorf("cannot pin the version of %s, which is not installed", helm.DBNamespaceOperatorName(op))
+		}
+	}
+	return nil
+}
+
+// versionServiceOperators maps the DB namespace chart values enabling the operators to the operator names
+// of the version service.
+//
+//nolint:gochecknoglobals
+var versionServiceOperators = map[string]string{
+	"postgresql": versionservice.PGOperatorName,
+	"psmdb":      versionservice.PSMDBOperatorName,
+	"pxc":        versionservice.PXCOperatorName,
+}
+
+// checkSupported checks that the pinned versions are supported by the Everest version
+// and are known to the version service, so that they can be installed.
+func (u OperatorVersionUpdate) checkSupported(ctx context.Context, vs versionservice.Interface, everestVersion string) error {
+	pins := map[string]string{}
+	if err := u.Apply(pins); err != nil {
+		return err
+	}
+	if len(pins) == 0 {
+		return nil
+	}
+
+	supVer, err := supportedVersion(ctx, vs, everestVersion)
+	if err != nil {
+		return err
+	}
+	constraints := map[string]goversion.Constraints{
+		"postgresql": supVer.PGOperator,
+		"psmdb":      supVer.PSMBDOperator,
+		"pxc":        supVer.PXCOperator,
+	}
+	for op, pin := range pins {
+		v := goversion.Must(goversion.NewVersion(pin))
+		if c := constraints[op]; !c.Check(v) {
+			return fmt.Errorf("%s version %s is not supported by Everest %s, which requires %q",
+				helm.DBNamespaceOperatorName(op), pin, everestVersion, c.String())
+		}
+		if _, err := vs.GetSupportedEngineVersions(ctx, versionServiceOperators[op], pin); err != nil {
+			return errors.Join(fmt.Errorf("%s version %s is not available", helm.DBNamespaceOperatorName(op), pin), err)
+		}
+	}
+	return nil
+}
+
+// supportedVersion returns the versions supported by the Everest version according to the version service.
+func supportedVersion(ctx context.Context, vs versionservice.Interface, everestVersion string) (*common.SupportedVersion, error) {
+	target, err := goversion.NewVersion(everestVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Everest version %s: %w", everestVersion, err)
+	}
+	meta, err := vs.GetEverestMetadata(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not fetch version metadata"))
+	}
+	for _, m := range meta.GetVersions() {
+		v, err := goversion.NewVersion(m.GetVersion())
+		if err != nil || !v.Equal(target) {
+			continue
+		}
+		return common.NewSupportedVersion(m)
+	}
+	return nil, fmt.Errorf("no version metadata found for Everest %s", everestVersion)
+}
+
+// operatorsFromValues returns the operators enabled in the DB namespace chart values.
+func operatorsFromValues(values map[string]interface{}) OperatorConfig {
+	enabled := func(key string) bool {
+		v, ok := values[key].(bool)
+		return ok && v
+	}
+	return OperatorConfig{
+		PG:    enabled("postgresql"),
+		PSMDB: enabled("psmdb"),
+		PXC:   enabled("pxc"),
+	}
+}
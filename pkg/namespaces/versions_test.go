@@ -0,0 +1,76 @@
+package namespaces
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	version "github.com/Percona-Lab/percona-version-service/versionpb"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+func TestOperatorVersionUpdateApply(t *testing.T) {
+	t.Parallel()
+
+	pins := map[string]string{"pxc": "1.14.0", "postgresql": "2.4.1"}
+	update := OperatorVersionUpdate{
+		PXC:   pointer.ToString(OperatorVersionUnpinned),
+		PSMDB: pointer.ToString("v1.18.0"),
+	}
+	require.NoError(t, update.Apply(pins))
+	assert.Equal(t, map[string]string{"psmdb": "1.18.0", "postgresql": "2.4.1"}, pins)
+
+	assert.True(t, OperatorVersionUpdate{}.IsEmpty())
+	assert.False(t, update.IsEmpty())
+	require.Error(t, OperatorVersionUpdate{PG: pointer.ToString("2.x")}.Apply(pins))
+}
+
+func TestOperatorVersionUpdateCheck(t *testing.T) {
+	t.Parallel()
+
+	vs := versionservice.NewMockInterface(t)
+	vs.On("GetEverestMetadata", mock.Anything).Return(&version.MetadataResponse{
+		Versions: []*version.MetadataVersion{
+			{Version: "1.5.0", Supported: map[string]string{"psmdbOperator": ">= 1.17.0, <= 1.18.0"}},
+			{Version: "1.6.0", Supported: map[string]string{"psmdbOperator": ">= 1.18.0, <= 1.19.0"}},
+		},
+	}, nil)
+	vs.On("GetSupportedEngineVersions", mock.Anything, versionservice.PSMDBOperatorName, "1.18.0").Return([]string{"7.0.15"}, nil)
+	vs.On("GetSupportedEngineVersions", mock.Anything, versionservice.PSMDBOperatorName, "1.18.9").Return(nil, errors.New("invalid response"))
+	ctx := context.Background()
+
+	update := OperatorVersionUpdate{PSMDB: pointer.ToString("1.18.0")}
+	require.NoError(t, update.checkSupported(ctx, vs, "1.6.0"))
+
+	// The pinned version must be known to the version service.
+	update = OperatorVersionUpdate{PSMDB: pointer.ToString("1.18.9")}
+	require.ErrorContains(t, update.checkSupported(ctx, vs, "1.6.0"), "not available")
+
+	update = OperatorVersionUpdate{PSMDB: pointer.ToString("1.18.0")}
+	require.NoError(t, update.checkOperators(OperatorConfig{PSMDB: true}))
+	require.ErrorContains(t, update.checkOperators(OperatorConfig{PXC: true}), "not installed")
+
+	update = OperatorVersionUpdate{PSMDB: pointer.ToString("1.17.0")}
+	require.ErrorContains(t, update.checkSupported(ctx, vs, "1.6.0"), "not supported by Everest 1.6.0")
+	require.ErrorContains(t, update.checkSupported(ctx, vs, "1.7.0"), "no version metadata found")
+
+	// Removing a pinned version needs no checks.
+	update = OperatorVersionUpdate{PSMDB: pointer.ToString(OperatorVersionUnpinned)}
+	require.NoError(t, update.checkSupported(ctx, vs, "1.7.0"))
+	require.NoError(t, update.checkOperators(OperatorConfig{}))
+}
+
+func TestOperatorVersionInfo(t *testing.T) {
+	t.Parallel()
+
+	installed := goversion.Must(goversion.NewVersion("1.19.0"))
+	assert.Equal(t, "v1.19.0", operatorVersionInfo(installed, ""))
+	assert.Equal(t, "v1.19.0, pinned", operatorVersionInfo(installed, "1.19.0"))
+	assert.Equal(t, "v1.19.0, pinned v1.18.0", operatorVersionInfo(installed, "1.18.0"))
+}
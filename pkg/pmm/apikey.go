@@ -16,29 +16,21 @@
 package pmm
 
 import (
-	"bytes"
 	"context"
-	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/percona/everest/cmd/config"
 )
 
-func newHTTPClient(insecure bool) *http.Client {
-	client := http.DefaultClient
-	client.Transport = &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: insecure, //nolint:gosec
-		},
-	}
-	return client
+type apiKey struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
 }
 
 // CreatePMMApiKey creates a new API key in PMM by using the provided username and password.
+// API keys are not supported by PMM 3 and later, use CreateToken instead.
 func CreatePMMApiKey(
 	ctx context.Context,
 	hostname, apiKeyName, user, password string,
@@ -47,56 +39,35 @@ func CreatePMMApiKey(
 	if config.Debug {
 		return "test-api-key", nil
 	}
-	apiKey := map[string]string{
-		"name": apiKeyName,
-		"role": "Admin",
-	}
-	b, err := json.Marshal(apiKey)
-	if err != nil {
-		return "", err
-	}
-
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodPost,
-		fmt.Sprintf("%s/graph/api/auth/keys", hostname),
-		bytes.NewReader(b),
-	)
-	if err != nil {
-		return "", err
-	}
-	req.Close = true
-	req.Header.Set("Content-Type", "application/json; charset=utf-8")
-	req.SetBasicAuth(user, password)
-
-	httpClient := newHTTPClient(skipTLSVerify)
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return "", err
-	}
-
-	defer resp.Body.Close() //nolint:errcheck
-	data, err := io.ReadAll(resp.Body)
+	token, err := createAPIKey(ctx, hostname, apiKeyName, user, password, skipTLSVerify)
 	if err != nil {
 		return "", err
 	}
+	return token.Key, nil
+}
 
-	if resp.StatusCode >= http.StatusBadRequest {
-		var pmmErr *pmmErrorMessage
-		if err := json.Unmarshal(data, &pmmErr); err != nil {
-			return "", errors.Join(err, fmt.Errorf("PMM returned an unknown error. HTTP status code %d", resp.StatusCode))
-		}
-		return "", fmt.Errorf("PMM returned an error with message: %s", pmmErr.Message)
+func createAPIKey(
+	ctx context.Context,
+	hostname, apiKeyName, user, password string,
+	skipTLSVerify bool,
+) (*Token, error) {
+	apiKey := map[string]string{
+		"name": apiKeyName,
+		"role": "Admin",
 	}
 
 	var m map[string]interface{}
-	if err := json.Unmarshal(data, &m); err != nil {
-		return "", err
+	if err := doRequest(ctx, http.MethodPost, fmt.Sprintf("%s/graph/api/auth/keys", hostname),
+		user, password, skipTLSVerify, apiKey, &m,
+	); err != nil {
+		return nil, err
 	}
 	key, ok := m["key"].(string)
 	if !ok {
-		return "", errors.New("cannot unmarshal key in createAdminToken")
+		return nil, errors.New("cannot unmarshal key in createAdminToken")
 	}
+	// JSON numbers are decoded as float64.
+	id, _ := m["id"].(float64)
 
-	return key, nil
+	return &Token{Key: key, Username: APIKeyUsername, ID: int(id), Name: apiKeyName}, nil
 }
@@ -16,6 +16,99 @@
 // Package pmm provides methods for working with PMM.
 package pmm
 
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrUnauthorized is returned when PMM rejects the provided credentials.
+	ErrUnauthorized = errors.New("PMM rejected the credentials")
+	// ErrTokenExpired is returned when PMM rejects the provided API key or service account token
+	// because it has expired. It wraps ErrUnauthorized.
+	ErrTokenExpired = fmt.Errorf("%w: the token has expired", ErrUnauthorized)
+
+	// errNotFound is returned when PMM responds with 404 Not Found.
+	errNotFound = errors.New("PMM returned HTTP status 404 Not Found")
+)
+
 type pmmErrorMessage struct {
 	Message string `json:"message"`
 }
+
+func newHTTPClient(insecure bool) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: insecure, //nolint:gosec
+			},
+		},
+	}
+}
+
+// doRequest sends a request to PMM authenticated with the provided username and password.
+// The JSON encoded in and out are the request and the response bodies, both can be nil.
+func doRequest(
+	ctx context.Context,
+	method, url, user, password string,
+	skipTLSVerify bool,
+	in, out any,
+) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	req.Close = true
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.SetBasicAuth(user, password)
+
+	resp, err := newHTTPClient(skipTLSVerify).Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s %s", errNotFound, method, url)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		var pmmErr *pmmErrorMessage
+		if err := json.Unmarshal(data, &pmmErr); err != nil {
+			return errors.Join(err, fmt.Errorf("PMM returned an unknown error. HTTP status code %d", resp.StatusCode))
+		}
+		// PMM responds with "Expired API key" to both the expired API keys and service account tokens.
+		if resp.StatusCode == http.StatusUnauthorized && strings.Contains(strings.ToLower(pmmErr.Message), "expired") {
+			return fmt.Errorf("%w: %s", ErrTokenExpired, pmmErr.Message)
+		}
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return fmt.Errorf("%w: %s", ErrUnauthorized, pmmErr.Message)
+		}
+		return fmt.Errorf("PMM returned an error with message: %s", pmmErr.Message)
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,342 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pmmtest provides a fake PMM server for tests.
+package pmmtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	goversion "github.com/hashicorp/go-version"
+)
+
+const (
+	apiKeyUsername       = "api_key"
+	serviceTokenUsername = "service_token"
+	adminRole            = "Admin"
+)
+
+// APIKey is an API key created in the fake PMM server.
+type APIKey struct {
+	ID   int
+	Name string
+	Key  string
+}
+
+// ServiceAccount is a service account created in the fake PMM server.
+type ServiceAccount struct {
+	ID     int
+	Name   string
+	Role   string
+	Tokens []string
+}
+
+// Server is a fake PMM server that implements the parts of the PMM API used by Everest.
+// PMM 3 and later support service accounts only, the older versions support API keys only.
+// API keys have the Admin role, so they can be used to delete API keys.
+type Server struct {
+	*httptest.Server
+
+	version  *goversion.Version
+	user     string
+	password string
+
+	mu              sync.Mutex
+	nextID          int
+	apiKeys         []APIKey
+	serviceAccounts []ServiceAccount
+	// tokens maps the valid API keys and service account tokens to their usernames.
+	tokens map[string]string
+	// expired holds the API keys and service account tokens that have expired.
+	expired map[string]struct{}
+}
+
+type isAdminKey struct{}
+
+// NewServer starts a fake PMM server of the given version that accepts the given credentials.
+// The server shall be closed by the caller.
+func NewServer(version, user, password string) *Server {
+	s := newServer(version, user, password)
+	s.Start()
+	return s
+}
+
+// NewTLSServer does the same as NewServer, but the server uses TLS with a self-signed certificate.
+func NewTLSServer(version, user, password string) *Server {
+	s := newServer(version, user, password)
+	s.StartTLS()
+	return s
+}
+
+func newServer(version, user, password string) *Server {
+	s := &Server{
+		version:  goversion.Must(goversion.NewVersion(version)),
+		user:     user,
+		password: password,
+		nextID:   1,
+		tokens:   make(map[string]string),
+		expired:  make(map[string]struct{}),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/server/version", s.handleVersion(true))
+	mux.HandleFunc("GET /v1/version", s.handleVersion(false))
+	mux.HandleFunc("GET /graph/api/auth/keys", s.handleListAPIKeys)
+	mux.HandleFunc("POST /graph/api/auth/keys", s.handleCreateAPIKey)
+	mux.HandleFunc("DELETE /graph/api/auth/keys/{id}", s.handleDeleteAPIKey)
+	mux.HandleFunc("GET /graph/api/serviceaccounts/search", s.handleSearchServiceAccounts)
+	mux.HandleFunc("POST /graph/api/serviceaccounts", s.handleCreateServiceAccount)
+	mux.HandleFunc("DELETE /graph/api/serviceaccounts/{id}", s.handleDeleteServiceAccount)
+	mux.HandleFunc("POST /graph/api/serviceaccounts/{id}/tokens", s.handleCreateServiceAccountToken)
+	s.Server = httptest.NewUnstartedServer(s.authenticate(mux))
+	return s
+}
+
+// APIKeys returns the names of the API keys that exist in the server.
+func (s *Server) APIKeys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, 0, len(s.apiKeys))
+	for _, k := range s.apiKeys {
+		names = append(names, k.Name)
+	}
+	return names
+}
+
+// ServiceAccounts returns the service accounts that exist in the server.
+func (s *Server) ServiceAccounts() []ServiceAccount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]ServiceAccount{}, s.serviceAccounts...)
+}
+
+// RevokeTokens invalidates all the API keys and service account tokens
+// without deleting the API keys and the service accounts.
+func (s *Server) RevokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = make(map[string]string)
+}
+
+// ExpireTokens makes all the API keys and service account tokens expire.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for token := range s.tokens {
+		s.expired[token] = struct{}{}
+	}
+}
+
+func (s *Server) isV3() bool {
+	return s.version.Segments()[0] >= 3 //nolint:mnd
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "invalid username or password"})
+			return
+		}
+		isAdmin := user == s.user && password == s.password
+		if !isAdmin {
+			s.mu.Lock()
+			tokenUser, ok := s.tokens[password]
+			_, expired := s.expired[password]
+			s.mu.Unlock()
+			if ok && expired {
+				writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "Expired API key"})
+				return
+			}
+			if !ok || tokenUser != user {
+				writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "invalid username or password"})
+				return
+			}
+			isAdmin = tokenUser == apiKeyUsername
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), isAdminKey{}, isAdmin)))
+	})
+}
+
+// requireAdmin responds with 403 Forbidden if the request is not authenticated as an admin.
+func requireAdmin(w http.ResponseWriter, r *http.Request) bool {
+	if isAdmin, _ := r.Context().Value(isAdminKey{}).(bool); !isAdmin {
+		writeJSON(w, http.StatusForbidden, map[string]string{"message": "permission denied"})
+		return false
+	}
+	return true
+}
+
+func (s *Server) handleVersion(v3Endpoint bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if v3Endpoint != s.isV3() {
+			writeJSON(w, http.StatusNotFound, map[string]string{"message": "Not Found"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"version": s.version.String()})
+	}
+}
+
+func (s *Server) handleListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]map[string]any, 0, len(s.apiKeys))
+	for _, k := range s.apiKeys {
+		keys = append(keys, map[string]any{"id": k.ID, "name": k.Name, "role": adminRole})
+	}
+	writeJSON(w, http.StatusOK, keys)
+}
+
+func (s *Server) handleCreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	if s.isV3() {
+		writeJSON(w, http.StatusGone, map[string]string{"message": "API keys are not supported"})
+		return
+	}
+	if !requireAdmin(w, r) {
+		return
+	}
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := APIKey{ID: s.nextID, Name: req.Name, Key: fmt.Sprintf("api-key-%d", s.nextID)}
+	s.nextID++
+	s.apiKeys = append(s.apiKeys, key)
+	s.tokens[key.Key] = apiKeyUsername
+	writeJSON(w, http.StatusOK, map[string]any{"id": key.ID, "name": key.Name, "key": key.Key})
+}
+
+func (s *Server) handleDeleteAPIKey(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
+		return
+	}
+	id, _ := strconv.Atoi(r.PathValue("id"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	idx := slices.IndexFunc(s.apiKeys, func(k APIKey) bool { return k.ID == id })
+	if idx < 0 {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "API key not found"})
+		return
+	}
+	delete(s.tokens, s.apiKeys[idx].Key)
+	s.apiKeys = slices.Delete(s.apiKeys, idx, idx+1)
+	writeJSON(w, http.StatusOK, map[string]string{"message": "API key deleted"})
+}
+
+func (s *Server) handleSearchServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
+		return
+	}
+	query := r.URL.Query().Get("query")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	accounts := []map[string]any{}
+	for _, a := range s.serviceAccounts {
+		if strings.Contains(a.Name, query) {
+			accounts = append(accounts, map[string]any{"id": a.ID, "name": a.Name, "role": a.Role})
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"totalCount": len(accounts), "serviceAccounts": accounts})
+}
+
+func (s *Server) handleCreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	if !s.isV3() {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "Not Found"})
+		return
+	}
+	if !requireAdmin(w, r) {
+		return
+	}
+	var req struct {
+		Name string `json:"name"`
+		Role string `json:"role"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	account := ServiceAccount{ID: s.nextID, Name: req.Name, Role: req.Role}
+	s.nextID++
+	s.serviceAccounts = append(s.serviceAccounts, account)
+	writeJSON(w, http.StatusCreated, map[string]any{"id": account.ID, "name": account.Name, "role": account.Role})
+}
+
+func (s *Server) handleDeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
+		return
+	}
+	id, _ := strconv.Atoi(r.PathValue("id"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	idx := slices.IndexFunc(s.serviceAccounts, func(a ServiceAccount) bool { return a.ID == id })
+	if idx < 0 {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "service account not found"})
+		return
+	}
+	for _, t := range s.serviceAccounts[idx].Tokens {
+		delete(s.tokens, t)
+	}
+	s.serviceAccounts = slices.Delete(s.serviceAccounts, idx, idx+1)
+	writeJSON(w, http.StatusOK, map[string]string{"message": "Service account deleted"})
+}
+
+func (s *Server) handleCreateServiceAccountToken(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
+		return
+	}
+	id, _ := strconv.Atoi(r.PathValue("id"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	idx := slices.IndexFunc(s.serviceAccounts, func(a ServiceAccount) bool { return a.ID == id })
+	if idx < 0 {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "service account not found"})
+		return
+	}
+	account := &s.serviceAccounts[idx]
+	key := fmt.Sprintf("glsa_token-%d-%d", id, len(account.Tokens)+1)
+	account.Tokens = append(account.Tokens, key)
+	s.tokens[key] = serviceTokenUsername
+	writeJSON(w, http.StatusOK, map[string]any{"id": len(account.Tokens), "name": account.Name, "key": key})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body) //nolint:errchkjson
+}
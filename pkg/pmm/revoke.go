@@ -0,0 +1,121 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pmm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// EverestTokenPrefix is the name prefix of the API keys and service accounts created by Everest.
+const EverestTokenPrefix = "everest-"
+
+// EverestTokenName returns a new unique name for a token of the monitoring instance.
+func EverestTokenName(monitoringInstance string) string {
+	return fmt.Sprintf("%s%s-%s", EverestTokenPrefix, monitoringInstance, uuid.NewString())
+}
+
+// maxServiceAccounts is the page size used to list service accounts.
+const maxServiceAccounts = 1000
+
+var (
+	// ErrUnknownTokenID is returned when a token without ID is revoked.
+	ErrUnknownTokenID = errors.New("the ID of the PMM token is unknown")
+	// ErrAdminCredentialsRequired is returned when a token is revoked without the PMM admin credentials.
+	ErrAdminCredentialsRequired = errors.New("the PMM admin username and password are required to revoke the PMM token")
+)
+
+// RevokeToken revokes the token in PMM by using the provided PMM admin username and password.
+// The service account that owns a service account token is deleted together with all its tokens.
+// A token cannot be used to revoke itself, the service account tokens are not allowed to manage
+// the service accounts.
+func RevokeToken(
+	ctx context.Context,
+	hostname, user, password string,
+	token *Token,
+	skipTLSVerify bool,
+) error {
+	if token.ID == 0 {
+		return ErrUnknownTokenID
+	}
+	if user == "" || password == "" || password == token.Key {
+		return ErrAdminCredentialsRequired
+	}
+	path := "auth/keys"
+	if token.IsServiceToken() {
+		path = "serviceaccounts"
+	}
+	if err := doRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/graph/api/%s/%d", hostname, path, token.ID),
+		user, password, skipTLSVerify, nil, nil,
+	); err != nil {
+		return errors.Join(err, fmt.Errorf("could not revoke PMM token %q (ID %d)", token.Name, token.ID))
+	}
+	return nil
+}
+
+type serviceAccountsPage struct {
+	ServiceAccounts []serviceAccount `json:"serviceAccounts"`
+}
+
+// ListEverestTokens returns the API keys (PMM 2) or the service accounts (PMM 3 and later)
+// created by Everest in PMM. The Key of the returned tokens is empty.
+func ListEverestTokens(
+	ctx context.Context,
+	hostname, user, password string,
+	skipTLSVerify bool,
+) ([]Token, error) {
+	v, err := GetVersion(ctx, hostname, user, password, skipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Token
+	if !supportsServiceAccounts(v) {
+		var keys []apiKey
+		if err := doRequest(ctx, http.MethodGet, fmt.Sprintf("%s/graph/api/auth/keys", hostname),
+			user, password, skipTLSVerify, nil, &keys,
+		); err != nil {
+			return nil, errors.Join(err, errors.New("could not list PMM API keys"))
+		}
+		for _, k := range keys {
+			if strings.HasPrefix(k.Name, EverestTokenPrefix) {
+				result = append(result, Token{Username: APIKeyUsername, ID: k.ID, Name: k.Name})
+			}
+		}
+		return result, nil
+	}
+
+	page := &serviceAccountsPage{}
+	if err := doRequest(ctx, http.MethodGet,
+		fmt.Sprintf("%s/graph/api/serviceaccounts/search?perpage=%d&query=%s", hostname, maxServiceAccounts, url.QueryEscape(EverestTokenPrefix)),
+		user, password, skipTLSVerify, nil, page,
+	); err != nil {
+		return nil, errors.Join(err, errors.New("could not list PMM service accounts"))
+	}
+	for _, a := range page.ServiceAccounts {
+		// The search query matches the name anywhere, not only the prefix.
+		if strings.HasPrefix(a.Name, EverestTokenPrefix) {
+			result = append(result, Token{Username: ServiceTokenUsername, ID: a.ID, Name: a.Name})
+		}
+	}
+	return result, nil
+}
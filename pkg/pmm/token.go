@@ -0,0 +1,142 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pmm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+
+	"github.com/percona/everest/cmd/config"
+)
+
+const (
+	// APIKeyUsername is the username PMM clients use to authenticate with an API key.
+	APIKeyUsername = "api_key"
+	// ServiceTokenUsername is the username PMM clients use to authenticate with a service account token.
+	ServiceTokenUsername = "service_token"
+
+	// serviceAccountTokenPrefix is the prefix of the service account tokens.
+	serviceAccountTokenPrefix = "glsa_"
+
+	// serviceAccountRole is the minimal role that allows PMM clients to register
+	// nodes and services and to push the metrics.
+	serviceAccountRole = "Editor"
+	// serviceAccountsMinMajorVersion is the first major version of PMM that
+	// doesn't support API keys and requires service accounts instead.
+	serviceAccountsMinMajorVersion = 3
+)
+
+// Token is the credential PMM clients use to connect to PMM.
+type Token struct {
+	// Key is the API key or the service account token.
+	// It is empty for the tokens listed in PMM.
+	Key string `json:"-"`
+	// Username is the username that shall be used together with Key.
+	Username string `json:"username"`
+	// ID is the ID of the API key or of the service account that owns the token.
+	// It is 0 if unknown, e.g. for the keys provided by the user.
+	ID int `json:"id"`
+	// Name is the name of the API key or of the service account.
+	Name string `json:"name"`
+}
+
+// IsServiceToken returns true if the token is a service account token.
+func (t *Token) IsServiceToken() bool {
+	return t.Username == ServiceTokenUsername
+}
+
+// TokenFromKey returns the token for a key provided by the user.
+// The key can be either an API key or a service account token.
+func TokenFromKey(key string) *Token {
+	if strings.HasPrefix(key, serviceAccountTokenPrefix) {
+		return &Token{Key: key, Username: ServiceTokenUsername}
+	}
+	return &Token{Key: key, Username: APIKeyUsername}
+}
+
+// CheckToken checks that PMM is reachable at the hostname and accepts the token.
+func CheckToken(ctx context.Context, hostname string, token *Token, skipTLSVerify bool) error {
+	_, err := GetVersion(ctx, hostname, token.Username, token.Key, skipTLSVerify)
+	return err
+}
+
+// CreateToken creates a new credential for PMM clients by using the provided username and password.
+// A service account with a token is created for PMM 3 and later, an API key for the older versions.
+func CreateToken(
+	ctx context.Context,
+	hostname, name, user, password string,
+	skipTLSVerify bool,
+) (*Token, error) {
+	if config.Debug {
+		return &Token{Key: "test-api-key", Username: APIKeyUsername}, nil
+	}
+	v, err := GetVersion(ctx, hostname, user, password, skipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+	if !supportsServiceAccounts(v) {
+		return createAPIKey(ctx, hostname, name, user, password, skipTLSVerify)
+	}
+	return CreateServiceAccountToken(ctx, hostname, name, user, password, skipTLSVerify)
+}
+
+// supportsServiceAccounts returns true if Everest shall use service accounts with the PMM version.
+func supportsServiceAccounts(v *goversion.Version) bool {
+	return v.Segments()[0] >= serviceAccountsMinMajorVersion
+}
+
+type serviceAccount struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type serviceAccountToken struct {
+	Key string `json:"key"`
+}
+
+// CreateServiceAccountToken creates a new service account in PMM and returns a token of it.
+func CreateServiceAccountToken(
+	ctx context.Context,
+	hostname, name, user, password string,
+	skipTLSVerify bool,
+) (*Token, error) {
+	account := &serviceAccount{}
+	if err := doRequest(ctx, http.MethodPost, fmt.Sprintf("%s/graph/api/serviceaccounts", hostname),
+		user, password, skipTLSVerify,
+		map[string]any{"name": name, "role": serviceAccountRole, "isDisabled": false},
+		account,
+	); err != nil {
+		return nil, errors.Join(err, errors.New("could not create PMM service account"))
+	}
+
+	token := &serviceAccountToken{}
+	if err := doRequest(ctx, http.MethodPost, fmt.Sprintf("%s/graph/api/serviceaccounts/%d/tokens", hostname, account.ID),
+		user, password, skipTLSVerify,
+		map[string]any{"name": name},
+		token,
+	); err != nil {
+		return nil, errors.Join(err, errors.New("could not create PMM service account token"))
+	}
+	if token.Key == "" {
+		return nil, errors.New("PMM returned an empty service account token")
+	}
+	return &Token{Key: token.Key, Username: ServiceTokenUsername, ID: account.ID, Name: name}, nil
+}
@@ -0,0 +1,120 @@
+package pmm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/pmm/pmmtest"
+)
+
+func TestCreateToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("PMM 2 API key", func(t *testing.T) {
+		t.Parallel()
+		s := pmmtest.NewServer("2.44.0", "admin", "admin")
+		defer s.Close()
+
+		token, err := CreateToken(context.Background(), s.URL, "everest-pmm", "admin", "admin", false)
+		require.NoError(t, err)
+		assert.Equal(t, &Token{Key: "api-key-1", Username: APIKeyUsername, ID: 1, Name: "everest-pmm"}, token)
+		assert.Equal(t, []string{"everest-pmm"}, s.APIKeys())
+		assert.Empty(t, s.ServiceAccounts())
+	})
+
+	t.Run("PMM 3 service account token", func(t *testing.T) {
+		t.Parallel()
+		s := pmmtest.NewServer("3.1.0", "admin", "admin")
+		defer s.Close()
+
+		token, err := CreateToken(context.Background(), s.URL, "everest-pmm", "admin", "admin", false)
+		require.NoError(t, err)
+		assert.Equal(t, &Token{Key: "glsa_token-1-1", Username: ServiceTokenUsername, ID: 1, Name: "everest-pmm"}, token)
+		assert.Empty(t, s.APIKeys())
+		assert.Equal(t, []pmmtest.ServiceAccount{
+			{ID: 1, Name: "everest-pmm", Role: serviceAccountRole, Tokens: []string{"glsa_token-1-1"}},
+		}, s.ServiceAccounts())
+	})
+
+	t.Run("wrong credentials", func(t *testing.T) {
+		t.Parallel()
+		s := pmmtest.NewServer("3.1.0", "admin", "admin")
+		defer s.Close()
+
+		_, err := CreateToken(context.Background(), s.URL, "everest-pmm", "admin", "wrong", false)
+		require.ErrorContains(t, err, "invalid username or password")
+		assert.Empty(t, s.ServiceAccounts())
+	})
+}
+
+func TestGetVersion(t *testing.T) {
+	t.Parallel()
+	for _, version := range []string{"2.41.1", "3.0.0"} {
+		t.Run(version, func(t *testing.T) {
+			t.Parallel()
+			s := pmmtest.NewServer(version, "admin", "admin")
+			defer s.Close()
+
+			v, err := GetVersion(context.Background(), s.URL, "admin", "admin", false)
+			require.NoError(t, err)
+			assert.Equal(t, version, v.String())
+		})
+	}
+}
+
+func TestTokenFromKey(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, ServiceTokenUsername, TokenFromKey("glsa_abc").Username)
+	assert.Equal(t, APIKeyUsername, TokenFromKey("eyJrIjoi").Username)
+}
+
+func TestRevokeToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("PMM 2 API key", func(t *testing.T) {
+		t.Parallel()
+		s := pmmtest.NewServer("2.44.0", "admin", "admin")
+		defer s.Close()
+
+		token, err := CreateToken(ctx, s.URL, "everest-pmm-1", "admin", "admin", false)
+		require.NoError(t, err)
+		_, err = CreateToken(ctx, s.URL, "other", "admin", "admin", false)
+		require.NoError(t, err)
+
+		tokens, err := ListEverestTokens(ctx, s.URL, "admin", "admin", false)
+		require.NoError(t, err)
+		assert.Equal(t, []Token{{Username: APIKeyUsername, ID: 1, Name: "everest-pmm-1"}}, tokens)
+
+		// A token cannot revoke itself.
+		require.ErrorIs(t, RevokeToken(ctx, s.URL, token.Username, token.Key, token, false), ErrAdminCredentialsRequired)
+		require.NoError(t, RevokeToken(ctx, s.URL, "admin", "admin", token, false))
+		assert.Equal(t, []string{"other"}, s.APIKeys())
+		require.ErrorIs(t, CheckToken(ctx, s.URL, token, false), ErrUnauthorized)
+	})
+
+	t.Run("PMM 3 service account", func(t *testing.T) {
+		t.Parallel()
+		s := pmmtest.NewServer("3.1.0", "admin", "admin")
+		defer s.Close()
+
+		token, err := CreateToken(ctx, s.URL, "everest-pmm-1", "admin", "admin", false)
+		require.NoError(t, err)
+
+		tokens, err := ListEverestTokens(ctx, s.URL, "admin", "admin", false)
+		require.NoError(t, err)
+		assert.Equal(t, []Token{{Username: ServiceTokenUsername, ID: 1, Name: "everest-pmm-1"}}, tokens)
+
+		require.ErrorIs(t, RevokeToken(ctx, s.URL, token.Username, token.Key, token, false), ErrAdminCredentialsRequired)
+		require.NoError(t, RevokeToken(ctx, s.URL, "admin", "admin", token, false))
+		assert.Empty(t, s.ServiceAccounts())
+	})
+
+	t.Run("unknown ID", func(t *testing.T) {
+		t.Parallel()
+		require.ErrorIs(t, RevokeToken(ctx, "http://localhost", "admin", "admin", TokenFromKey("key"), false), ErrUnknownTokenID)
+	})
+}
@@ -0,0 +1,55 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pmm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	goversion "github.com/hashicorp/go-version"
+)
+
+type versionResponse struct {
+	Version string `json:"version"`
+}
+
+// GetVersion returns the version of the PMM server.
+func GetVersion(
+	ctx context.Context,
+	hostname, user, password string,
+	skipTLSVerify bool,
+) (*goversion.Version, error) {
+	resp := &versionResponse{}
+	// PMM 3 serves the version at /v1/server/version, the older versions at /v1/version.
+	err := doRequest(ctx, http.MethodGet, fmt.Sprintf("%s/v1/server/version", hostname),
+		user, password, skipTLSVerify, nil, resp,
+	)
+	if errors.Is(err, errNotFound) {
+		err = doRequest(ctx, http.MethodGet, fmt.Sprintf("%s/v1/version", hostname),
+			user, password, skipTLSVerify, nil, resp,
+		)
+	}
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not get PMM version"))
+	}
+	v, err := goversion.NewVersion(resp.Version)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse PMM version %q: %w", resp.Version, err)
+	}
+	return v, nil
+}
@@ -52,8 +52,11 @@ const (
 	ResourceDatabaseClusterCredentials = "database-cluster-credentials"
 	ResourceDatabaseClusterRestores    = "database-cluster-restores"
 	ResourceDatabaseEngines            = "database-engines"
+	ResourceEngineConfigTemplates      = "engine-config-templates"
+	ResourceEngineVersionPolicies      = "engine-version-policies"
 	ResourceMonitoringInstances        = "monitoring-instances"
 	ResourceNamespaces                 = "namespaces"
+	ResourceNamespaceProvisioning      = "namespace-provisioning"
 	ResourcePodSchedulingPolicies      = "pod-scheduling-policies"
 )
 
@@ -256,6 +259,7 @@ func loadAdminPolicy(enf casbin.IEnforcer) error {
 	for resource := range resources {
 		object := "*/*"
 		if resource == ResourceNamespaces ||
+			resource == ResourceNamespaceProvisioning ||
 			resource == ResourcePodSchedulingPolicies {
 			object = "*"
 		}
@@ -320,6 +324,7 @@ func Can(ctx context.Context, filePath string, k kubernetes.KubernetesConnector,
 	if object == "*" || object == "all" {
 		object = "/"
 		if resource == ResourceNamespaces ||
+			resource == ResourceNamespaceProvisioning ||
 			resource == ResourcePodSchedulingPolicies {
 			object = ""
 		}
@@ -0,0 +1,218 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+// DefaultReportRange is the default time range of a usage report.
+const DefaultReportRange = 30 * 24 * time.Hour
+
+// GetReport returns the usage report of the [from, to) time range.
+// The report is limited to the namespace if it's not empty.
+func GetReport(ctx context.Context, k kubernetes.KubernetesConnector, from, to time.Time, namespace string) (*api.UsageReport, error) {
+	samples, err := k.ListUsageSamples(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return Report(samples, from, to, namespace), nil
+}
+
+// Report aggregates the samples of the [from, to) time range per namespace and per database cluster.
+// A sample accounts for the time until the next sample, but at most for its sampling interval,
+// so the time Everest was not running is not accounted.
+func Report(samples []common.UsageSample, from, to time.Time, namespace string) *api.UsageReport {
+	namespaces := make(map[string]*aggregate)
+	clusters := make(map[[2]string]*aggregate)
+	count := 0
+	for i, sample := range samples {
+		if sample.Time.Before(from) || !sample.Time.Before(to) {
+			continue
+		}
+		count++
+		end := to
+		if i+1 < len(samples) && samples[i+1].Time.Before(to) {
+			end = samples[i+1].Time
+		}
+		duration := end.Sub(sample.Time)
+		if sample.Interval > 0 {
+			duration = min(duration, sample.Interval)
+		}
+		hours := duration.Hours()
+
+		totals := make(map[string]*common.DatabaseClusterUsage)
+		for _, u := range sample.Clusters {
+			if namespace != "" && u.Namespace != namespace {
+				continue
+			}
+			key := [2]string{u.Namespace, u.Name}
+			if clusters[key] == nil {
+				clusters[key] = &aggregate{namespace: u.Namespace, name: u.Name}
+			}
+			clusters[key].add(u, hours)
+
+			total, ok := totals[u.Namespace]
+			if !ok {
+				total = &common.DatabaseClusterUsage{Namespace: u.Namespace}
+				totals[u.Namespace] = total
+			}
+			addUsage(total, u)
+		}
+		for ns, total := range totals {
+			if namespaces[ns] == nil {
+				namespaces[ns] = &aggregate{namespace: ns}
+			}
+			namespaces[ns].add(*total, hours)
+		}
+	}
+
+	return &api.UsageReport{
+		From:             from,
+		To:               to,
+		Samples:          count,
+		Namespaces:       toAPIAggregates(namespaces),
+		DatabaseClusters: toAPIAggregates(clusters),
+	}
+}
+
+func addUsage(dst *common.DatabaseClusterUsage, u common.DatabaseClusterUsage) {
+	dst.RequestedCPUMillis += u.RequestedCPUMillis
+	dst.RequestedMemoryBytes += u.RequestedMemoryBytes
+	dst.RequestedDiskBytes += u.RequestedDiskBytes
+	dst.BackupBytes += u.BackupBytes
+	dst.Backups += u.Backups
+	if u.UsedCPUMillis != nil && u.UsedMemoryBytes != nil {
+		if dst.UsedCPUMillis == nil {
+			dst.UsedCPUMillis, dst.UsedMemoryBytes = new(uint64), new(uint64)
+		}
+		*dst.UsedCPUMillis += *u.UsedCPUMillis
+		*dst.UsedMemoryBytes += *u.UsedMemoryBytes
+	}
+	if u.UsedDiskBytes != nil {
+		if dst.UsedDiskBytes == nil {
+			dst.UsedDiskBytes = new(uint64)
+		}
+		*dst.UsedDiskBytes += *u.UsedDiskBytes
+	}
+}
+
+// aggregate accumulates the usage of a database cluster or a namespace over the samples.
+type aggregate struct {
+	namespace       string
+	name            string
+	engineType      string
+	hours           float64
+	backups         int
+	cpuRequested    stat
+	cpuUsed         stat
+	memoryRequested stat
+	memoryUsed      stat
+	diskRequested   stat
+	diskUsed        stat
+	backupBytes     stat
+}
+
+func (a *aggregate) add(u common.DatabaseClusterUsage, hours float64) {
+	if u.EngineType != "" {
+		a.engineType = u.EngineType
+	}
+	a.hours += hours
+	a.backups = max(a.backups, u.Backups)
+	a.cpuRequested.add(u.RequestedCPUMillis, hours)
+	a.memoryRequested.add(u.RequestedMemoryBytes, hours)
+	a.diskRequested.add(u.RequestedDiskBytes, hours)
+	a.backupBytes.add(u.BackupBytes, hours)
+	if u.UsedCPUMillis != nil {
+		a.cpuUsed.add(*u.UsedCPUMillis, hours)
+	}
+	if u.UsedMemoryBytes != nil {
+		a.memoryUsed.add(*u.UsedMemoryBytes, hours)
+	}
+	if u.UsedDiskBytes != nil {
+		a.diskUsed.add(*u.UsedDiskBytes, hours)
+	}
+}
+
+func (a *aggregate) toAPI() api.UsageAggregate {
+	result := api.UsageAggregate{
+		Namespace:            a.namespace,
+		Name:                 a.name,
+		EngineType:           a.engineType,
+		Hours:                a.hours,
+		Backups:              a.backups,
+		CpuRequestedMillis:   a.cpuRequested.toAPI(),
+		MemoryRequestedBytes: a.memoryRequested.toAPI(),
+		DiskRequestedBytes:   a.diskRequested.toAPI(),
+		BackupBytes:          a.backupBytes.toAPI(),
+	}
+	// The usage is unknown if the metrics API or the volume stats were never available.
+	if a.cpuUsed.samples > 0 {
+		cpu := a.cpuUsed.toAPI()
+		result.CpuUsedMillis = &cpu
+	}
+	if a.memoryUsed.samples > 0 {
+		memory := a.memoryUsed.toAPI()
+		result.MemoryUsedBytes = &memory
+	}
+	if a.diskUsed.samples > 0 {
+		disk := a.diskUsed.toAPI()
+		result.DiskUsedBytes = &disk
+	}
+	return result
+}
+
+func toAPIAggregates[K comparable](aggregates map[K]*aggregate) []api.UsageAggregate {
+	result := make([]api.UsageAggregate, 0, len(aggregates))
+	for _, a := range aggregates {
+		result = append(result, a.toAPI())
+	}
+	slices.SortFunc(result, func(a, b api.UsageAggregate) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	return result
+}
+
+// stat accumulates a time-weighted statistic of a resource.
+type stat struct {
+	samples int
+	hours   float64
+	total   float64
+	peak    float64
+}
+
+func (s *stat) add(value uint64, hours float64) {
+	v := float64(value)
+	s.samples++
+	s.hours += hours
+	s.total += v * hours
+	s.peak = max(s.peak, v)
+}
+
+func (s *stat) toAPI() api.UsageStat {
+	result := api.UsageStat{Peak: s.peak, Total: s.total}
+	if s.hours > 0 {
+		result.Average = s.total / s.hours
+	}
+	return result
+}
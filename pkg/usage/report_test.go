@@ -0,0 +1,108 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+)
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	t0 := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	usage := func(ns, name string, cpu uint64, usedCPU *uint64) common.DatabaseClusterUsage {
+		return common.DatabaseClusterUsage{
+			Namespace:          ns,
+			Name:               name,
+			EngineType:         "pxc",
+			RequestedCPUMillis: cpu,
+			UsedCPUMillis:      usedCPU,
+			UsedMemoryBytes:    usedCPU,
+			UsedDiskBytes:      pointer.ToUint64(10),
+			Backups:            1,
+		}
+	}
+	samples := []common.UsageSample{
+		// Before the time range.
+		{Time: t0.Add(-time.Hour), Interval: time.Hour, Clusters: []common.DatabaseClusterUsage{
+			usage("ns1", "a", 9000, nil),
+		}},
+		{Time: t0, Interval: time.Hour, Clusters: []common.DatabaseClusterUsage{
+			usage("ns1", "a", 1000, pointer.ToUint64(500)),
+			usage("ns1", "c", 500, nil),
+			usage("ns2", "b", 2000, nil),
+		}},
+		{Time: t0.Add(time.Hour), Interval: time.Hour, Clusters: []common.DatabaseClusterUsage{
+			usage("ns1", "a", 1000, pointer.ToUint64(700)),
+		}},
+		// Everest was not running for 3 hours, which is not accounted.
+		{Time: t0.Add(5 * time.Hour), Interval: time.Hour, Clusters: []common.DatabaseClusterUsage{
+			usage("ns1", "a", 3000, nil),
+		}},
+	}
+	to := t0.Add(5*time.Hour + 30*time.Minute)
+
+	t.Run("all namespaces", func(t *testing.T) {
+		t.Parallel()
+
+		report := Report(samples, t0, to, "")
+		assert.Equal(t, 3, report.Samples)
+		require.Len(t, report.DatabaseClusters, 3)
+		a := report.DatabaseClusters[0]
+		assert.Equal(t, "a", a.Name)
+		assert.Equal(t, "pxc", a.EngineType)
+		assert.InDelta(t, 2.5, a.Hours, 1e-9)
+		assert.Equal(t, api.UsageStat{Average: 1400, Peak: 3000, Total: 3500}, a.CpuRequestedMillis)
+		assert.Equal(t, &api.UsageStat{Average: 600, Peak: 700, Total: 1200}, a.CpuUsedMillis)
+		assert.Equal(t, &api.UsageStat{Average: 10, Peak: 10, Total: 25}, a.DiskUsedBytes)
+		assert.Equal(t, 1, a.Backups)
+		assert.Equal(t, "c", report.DatabaseClusters[1].Name)
+		assert.Nil(t, report.DatabaseClusters[1].CpuUsedMillis)
+		assert.Equal(t, "b", report.DatabaseClusters[2].Name)
+
+		require.Len(t, report.Namespaces, 2)
+		ns1 := report.Namespaces[0]
+		assert.Equal(t, "ns1", ns1.Namespace)
+		assert.Empty(t, ns1.Name)
+		assert.InDelta(t, 2.5, ns1.Hours, 1e-9)
+		assert.Equal(t, api.UsageStat{Average: 1600, Peak: 3000, Total: 4000}, ns1.CpuRequestedMillis)
+		assert.Equal(t, &api.UsageStat{Average: 600, Peak: 700, Total: 1200}, ns1.CpuUsedMillis)
+		assert.Equal(t, &api.UsageStat{Average: 14, Peak: 20, Total: 35}, ns1.DiskUsedBytes)
+		assert.Equal(t, 2, ns1.Backups)
+		ns2 := report.Namespaces[1]
+		assert.InDelta(t, 1.0, ns2.Hours, 1e-9)
+		assert.Equal(t, api.UsageStat{Average: 2000, Peak: 2000, Total: 2000}, ns2.CpuRequestedMillis)
+		assert.Nil(t, ns2.CpuUsedMillis)
+	})
+
+	t.Run("namespace", func(t *testing.T) {
+		t.Parallel()
+
+		report := Report(samples, t0, to, "ns2")
+		require.Len(t, report.DatabaseClusters, 1)
+		assert.Equal(t, "b", report.DatabaseClusters[0].Name)
+		require.Len(t, report.Namespaces, 1)
+		assert.Equal(t, "ns2", report.Namespaces[0].Namespace)
+	})
+}
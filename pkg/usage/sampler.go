@@ -0,0 +1,142 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package usage samples and reports the resources requested and used by the database clusters.
+package usage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const (
+	// DefaultSampleInterval is the default interval between the usage samples.
+	DefaultSampleInterval = time.Hour
+	// DefaultRetention is the default duration the usage samples are kept for.
+	DefaultRetention = 400 * 24 * time.Hour
+)
+
+// Sampler periodically samples the resources requested and used by the database clusters
+// in the namespaces managed by Everest and stores the samples in ConfigMaps, one per sample.
+// The samples older than the retention are deleted.
+type Sampler struct {
+	kubeConnector kubernetes.KubernetesConnector
+	l             *zap.SugaredLogger
+	interval      time.Duration
+	retention     time.Duration
+}
+
+// NewSampler returns a new usage sampler.
+func NewSampler(k kubernetes.KubernetesConnector, l *zap.SugaredLogger, interval, retention time.Duration) *Sampler {
+	if interval <= 0 {
+		interval = DefaultSampleInterval
+	}
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	return &Sampler{
+		kubeConnector: k,
+		l:             l.With("component", "usage-sampler"),
+		interval:      interval,
+		retention:     retention,
+	}
+}
+
+// Run takes the samples until the context is canceled.
+func (s *Sampler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.SampleAll(ctx, time.Now()); err != nil {
+			s.l.Error(errors.Join(err, errors.New("failed to sample the resource usage")))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SampleAll takes a sample, stores it and deletes the expired samples.
+func (s *Sampler) SampleAll(ctx context.Context, now time.Time) error {
+	sample, err := s.Sample(ctx, now)
+	if err != nil {
+		return err
+	}
+	if err := s.kubeConnector.SaveUsageSample(ctx, sample); err != nil {
+		return errors.Join(err, errors.New("failed to save the usage sample"))
+	}
+	if err := s.kubeConnector.DeleteUsageSamplesBefore(ctx, now.Add(-s.retention)); err != nil {
+		return errors.Join(err, errors.New("failed to delete the expired usage samples"))
+	}
+	return nil
+}
+
+// Sample returns the resources requested and used by the database clusters at the moment.
+func (s *Sampler) Sample(ctx context.Context, now time.Time) (*common.UsageSample, error) {
+	namespaces, err := s.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sample := &common.UsageSample{
+		Time:     now.UTC().Truncate(time.Second),
+		Interval: s.interval,
+		Clusters: []common.DatabaseClusterUsage{},
+	}
+	for _, ns := range namespaces.Items {
+		clusters, err := s.kubeConnector.GetDatabaseClustersUsage(ctx, ns.GetName())
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to get the resource usage in namespace "+ns.GetName()))
+		}
+		if err := s.setUsedDisk(ctx, ns.GetName(), clusters); err != nil {
+			return nil, errors.Join(err, errors.New("failed to get the volume usage in namespace "+ns.GetName()))
+		}
+		sample.Clusters = append(sample.Clusters, clusters...)
+	}
+	return sample, nil
+}
+
+// setUsedDisk sets the space used on the volumes of the database clusters according to the kubelets.
+// The used disk stays unknown if the Everest server can't read the stats of the kubelets
+// or if no pod of the database cluster mounts its volumes.
+func (s *Sampler) setUsedDisk(ctx context.Context, namespace string, clusters []common.DatabaseClusterUsage) error {
+	volumes, err := s.kubeConnector.GetDatabaseClusterVolumesUsage(ctx, namespace)
+	if k8serrors.IsForbidden(err) {
+		s.l.Debugf("Volume stats are not available in namespace %s: %v", namespace, err)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for i, u := range clusters {
+		dbVolumes, ok := volumes[u.Name]
+		if !ok {
+			continue
+		}
+		var used uint64
+		for _, v := range dbVolumes {
+			used += v.UsedBytes
+		}
+		clusters[i].UsedDiskBytes = &used
+	}
+	return nil
+}
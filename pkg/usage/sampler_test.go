@@ -0,0 +1,187 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+// volumeUsageConnector reports a fixed volume usage since the kubelet stats are not available in tests.
+type volumeUsageConnector struct {
+	kubernetes.KubernetesConnector
+	usage map[string][]kubernetes.VolumeUsage
+	err   error
+}
+
+func (c *volumeUsageConnector) GetDatabaseClusterVolumesUsage(context.Context, string) (map[string][]kubernetes.VolumeUsage, error) {
+	return c.usage, c.err
+}
+
+func TestSampler(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	instance := map[string]string{"app.kubernetes.io/instance": "db"}
+	pod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns", Labels: instance},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name: "mysql",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				}},
+			}}},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	backup := func(name, cluster string) *everestv1alpha1.DatabaseClusterBackup {
+		return &everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			Spec:       everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: cluster, BackupStorageName: "s3"},
+		}
+	}
+	upstreamBackup := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "pxc.percona.com/v1",
+		"kind":       "PerconaXtraDBClusterBackup",
+		"metadata":   map[string]interface{}{"name": "backup-1", "namespace": "test-ns"},
+		"status":     map[string]interface{}{"size": "1.5GB"},
+	}}
+	podMetrics := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "metrics.k8s.io/v1beta1",
+		"kind":       "PodMetrics",
+		"metadata":   map[string]interface{}{"name": "db-pxc-0", "namespace": "test-ns"},
+		"containers": []interface{}{
+			map[string]interface{}{"name": "mysql", "usage": map[string]interface{}{"cpu": "120m", "memory": "512Mi"}},
+		},
+	}}
+	expiredSamples := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:      "everest-usage-20240101000000",
+		Namespace: common.SystemNamespace,
+		Labels:    map[string]string{common.EverestUsageLabel: "true"},
+	}}
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   "test-ns",
+				Labels: map[string]string{common.KubernetesManagedByLabel: common.Everest},
+			}},
+			&everestv1alpha1.DatabaseCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test-ns"},
+				Spec: everestv1alpha1.DatabaseClusterSpec{
+					Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC, Replicas: 2},
+				},
+			},
+			pod("db-pxc-0", corev1.PodRunning),
+			pod("db-pxc-1", corev1.PodPending),
+			pod("db-restore", corev1.PodSucceeded),
+			&corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "datadir-db-pxc-0", Namespace: "test-ns", Labels: instance},
+				Spec: corev1.PersistentVolumeClaimSpec{Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+				}},
+			},
+			backup("backup-1", "db"),
+			backup("backup-2", "db"),
+			backup("backup-3", "deleted-db"),
+			upstreamBackup,
+			podMetrics,
+			expiredSamples,
+		).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	sampler := NewSampler(&volumeUsageConnector{
+		KubernetesConnector: k,
+		usage: map[string][]kubernetes.VolumeUsage{
+			"db": {{PersistentVolumeClaim: "datadir-db-pxc-0", UsedBytes: 3 << 30, CapacityBytes: 10 << 30}},
+		},
+	}, zap.NewNop().Sugar(), 0, 0)
+
+	require.NoError(t, sampler.SampleAll(ctx, now))
+	samples, err := k.ListUsageSamples(ctx, now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []common.UsageSample{{
+		Time:     now,
+		Interval: DefaultSampleInterval,
+		Clusters: []common.DatabaseClusterUsage{
+			{
+				Namespace:            "test-ns",
+				Name:                 "db",
+				EngineType:           "pxc",
+				RequestedCPUMillis:   1000,
+				RequestedMemoryBytes: 2 << 30,
+				UsedCPUMillis:        pointer.ToUint64(120),
+				UsedMemoryBytes:      pointer.ToUint64(512 << 20),
+				RequestedDiskBytes:   10 << 30,
+				UsedDiskBytes:        pointer.ToUint64(3 << 30),
+				BackupBytes:          1500000000,
+				Backups:              2,
+			},
+			{
+				Namespace: "test-ns",
+				Name:      "deleted-db",
+				Backups:   1,
+			},
+		},
+	}}, samples)
+
+	cms, err := k.ListConfigMaps(ctx, ctrlclient.InNamespace(common.SystemNamespace))
+	require.NoError(t, err)
+	require.Len(t, cms.Items, 1)
+	assert.Equal(t, "everest-usage-20250310120000", cms.Items[0].GetName())
+
+	// Every sample is stored in a ConfigMap of its own.
+	require.NoError(t, sampler.SampleAll(ctx, now.Add(time.Hour)))
+	samples, err = k.ListUsageSamples(ctx, now, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, samples, 2)
+	cms, err = k.ListConfigMaps(ctx, ctrlclient.InNamespace(common.SystemNamespace))
+	require.NoError(t, err)
+	assert.Len(t, cms.Items, 2)
+
+	// The used disk is unknown if the kubelet stats can't be read.
+	sampler = NewSampler(&volumeUsageConnector{
+		KubernetesConnector: k,
+		err:                 k8serrors.NewForbidden(corev1.Resource("nodes/proxy"), "node-1", errors.New("forbidden")),
+	}, zap.NewNop().Sugar(), 0, 0)
+	sample, err := sampler.Sample(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, sample.Clusters, 2)
+	assert.Nil(t, sample.Clusters[0].UsedDiskBytes)
+	assert.Equal(t, uint64(10<<30), sample.Clusters[0].RequestedDiskBytes)
+}
@@ -59,12 +59,21 @@ type Interface interface {
 }
 
 type versionServiceClient struct {
-	url string
+	url    string
+	client *http.Client
 }
 
 // New returns a new version service client.
+// The file:// URLs are served from the local filesystem, which allows using
+// the version service responses stored in an offline bundle.
 func New(url string) Interface { //nolint:ireturn
-	return &versionServiceClient{url: url}
+	client := http.DefaultClient
+	if strings.HasPrefix(url, "file://") {
+		transport := &http.Transport{}
+		transport.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+		client = &http.Client{Transport: transport}
+	}
+	return &versionServiceClient{url: url, client: client}
 }
 
 //nolint:gochecknoglobals
@@ -86,7 +95,7 @@ func (c *versionServiceClient) GetSupportedEngineVersions(ctx context.Context, o
 	if err != nil {
 		return nil, errors.Join(err, errors.New("could not create version service request"))
 	}
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.client.Do(req)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("could not retrieve version response"))
 	}
@@ -147,7 +156,7 @@ func (c *versionServiceClient) GetEverestMetadata(ctx context.Context) (*percona
 	if err != nil {
 		return nil, errors.Join(err, errors.New("could not create Everest metadata request"))
 	}
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.client.Do(req)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("could not retrieve Everest metadata"))
 	}